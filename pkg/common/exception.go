@@ -1,7 +1,7 @@
 package common
 
 type ExceptionDetails struct {
-	ExceptionText string `xml:",chardata" yaml:"exception"`
-	ExceptionCode string `xml:"code,attr" yaml:"exceptionCode"`
-	LocatorCode   string `xml:"locator,attr,omitempty" yaml:"locatorCode"`
+	ExceptionText string `xml:",chardata" yaml:"exception" json:"text"`
+	ExceptionCode string `xml:"code,attr" yaml:"exceptionCode" json:"code"`
+	LocatorCode   string `xml:"locator,attr,omitempty" yaml:"locatorCode" json:"locator,omitempty"`
 }
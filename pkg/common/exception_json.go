@@ -0,0 +1,153 @@
+package common
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Content types of the exception report encodings
+const (
+	XMLContentType         = `text/xml`
+	JSONContentType        = `application/json`
+	ProblemJSONContentType = `application/problem+json`
+)
+
+// ExceptionReportJSON is the JSON representation of an exception report
+type ExceptionReportJSON struct {
+	Version    string             `json:"version"`
+	Language   string             `json:"lang,omitempty"`
+	Exceptions []ExceptionDetails `json:"exceptions"`
+}
+
+// Problem is a RFC 7807 problem details object, the exceptions of the report are added as an extension member
+type Problem struct {
+	Type       string             `json:"type,omitempty"`
+	Title      string             `json:"title"`
+	Status     int                `json:"status,omitempty"`
+	Detail     string             `json:"detail,omitempty"`
+	Exceptions []ExceptionDetails `json:"exceptions,omitempty"`
+}
+
+// NewProblem builds a Problem from the details of one or more exceptions,
+// the title is the code of the first exception and the detail contains all the exception texts
+func NewProblem(details []ExceptionDetails) Problem {
+	p := Problem{Exceptions: details}
+	var texts []string
+	for _, d := range details {
+		if p.Title == `` {
+			p.Title = d.ExceptionCode
+		}
+		if d.ExceptionText != `` {
+			texts = append(texts, d.ExceptionText)
+		}
+	}
+	p.Detail = strings.Join(texts, `; `)
+	return p
+}
+
+// Exception is implemented by the exceptions of the services
+type Exception interface {
+	Error() string
+	Code() string
+	Locator() string
+}
+
+// Details returns the text, code and locator of the exceptions, as they are written in a report
+func Details[E Exception](exceptions []E) []ExceptionDetails {
+	details := make([]ExceptionDetails, 0, len(exceptions))
+	for _, e := range exceptions {
+		details = append(details, ExceptionDetails{ExceptionText: e.Error(), ExceptionCode: e.Code(), LocatorCode: e.Locator()})
+	}
+	return details
+}
+
+// EncodeExceptions returns the report as a XML, JSON or problem+json document depending on the format, together with
+// its content type. The format is a value as accepted by ReportContentType. The XML report and the HTTP status of the
+// problem are specific to the service and version, so they are given by toXML and status.
+func EncodeExceptions(report ExceptionReportJSON, format string, status int, toXML func() []byte) ([]byte, string) {
+	contentType := ReportContentType(format)
+	switch contentType {
+	case JSONContentType:
+		return report.ToBytes(), contentType
+	case ProblemJSONContentType:
+		p := NewProblem(report.Exceptions)
+		p.Status = status
+		return p.ToBytes(), contentType
+	default:
+		return toXML(), contentType
+	}
+}
+
+// ToBytes makes from a ExceptionReportJSON a []byte
+func (r ExceptionReportJSON) ToBytes() []byte {
+	b, _ := json.MarshalIndent(r, "", " ")
+	return b
+}
+
+// ToBytes makes from a Problem a []byte
+func (p Problem) ToBytes() []byte {
+	b, _ := json.MarshalIndent(p, "", " ")
+	return b
+}
+
+// ReportContentType returns the content type of the exception report encoding for a format,
+// like the value of an EXCEPTIONS parameter. Unknown formats result in XML.
+func ReportContentType(format string) string {
+	switch mediaType(format) {
+	case ProblemJSONContentType, `problem+json`:
+		return ProblemJSONContentType
+	case JSONContentType, `json`, `application/vnd.ogc.se_json`:
+		return JSONContentType
+	default:
+		return XMLContentType
+	}
+}
+
+// NegotiateReportContentType selects the content type of the exception report for an Accept header,
+// the media range with the highest quality wins. XML is returned when no JSON encoding is preferred.
+func NegotiateReportContentType(accept string) string {
+	type candidate struct {
+		contentType string
+		quality     float64
+	}
+
+	var candidates []candidate
+	for _, mediaRange := range strings.Split(accept, `,`) {
+		parts := strings.Split(mediaRange, `;`)
+		quality := 1.0
+		for _, param := range parts[1:] {
+			if k, v, ok := strings.Cut(strings.TrimSpace(param), `=`); ok && strings.EqualFold(k, `q`) {
+				if q, err := strconv.ParseFloat(v, 64); err == nil {
+					quality = q
+				}
+			}
+		}
+		if quality <= 0 {
+			continue
+		}
+
+		switch mediaType(parts[0]) {
+		case ProblemJSONContentType:
+			candidates = append(candidates, candidate{ProblemJSONContentType, quality})
+		case JSONContentType:
+			candidates = append(candidates, candidate{JSONContentType, quality})
+		case XMLContentType, `application/xml`, `application/*`, `text/*`, `*/*`:
+			candidates = append(candidates, candidate{XMLContentType, quality})
+		}
+	}
+
+	if len(candidates) == 0 {
+		return XMLContentType
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].quality > candidates[j].quality
+	})
+	return candidates[0].contentType
+}
+
+// mediaType returns the lower case media type without parameters
+func mediaType(s string) string {
+	return strings.ToLower(strings.TrimSpace(strings.SplitN(s, `;`, 2)[0]))
+}
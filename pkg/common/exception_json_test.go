@@ -0,0 +1,112 @@
+package common
+
+import (
+	"testing"
+)
+
+func TestReportContentType(t *testing.T) {
+	var tests = []struct {
+		format      string
+		contentType string
+	}{
+		0: {format: ``, contentType: XMLContentType},
+		1: {format: `XML`, contentType: XMLContentType},
+		2: {format: `JSON`, contentType: JSONContentType},
+		3: {format: `application/json; charset=utf-8`, contentType: JSONContentType},
+		4: {format: `application/problem+json`, contentType: ProblemJSONContentType},
+		5: {format: `image/png`, contentType: XMLContentType},
+	}
+
+	for k, test := range tests {
+		if contentType := ReportContentType(test.format); contentType != test.contentType {
+			t.Errorf("test: %d, expected: %s,\n got: %s", k, test.contentType, contentType)
+		}
+	}
+}
+
+func TestNegotiateReportContentType(t *testing.T) {
+	var tests = []struct {
+		accept      string
+		contentType string
+	}{
+		0: {accept: ``, contentType: XMLContentType},
+		1: {accept: `*/*`, contentType: XMLContentType},
+		2: {accept: `application/json`, contentType: JSONContentType},
+		3: {accept: `application/problem+json, application/json;q=0.9`, contentType: ProblemJSONContentType},
+		4: {accept: `text/xml;q=0.5, application/json;q=0.8`, contentType: JSONContentType},
+		5: {accept: `application/json, text/xml`, contentType: JSONContentType},
+		6: {accept: `application/json;q=0, text/html`, contentType: XMLContentType},
+		7: {accept: `text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8`, contentType: XMLContentType},
+	}
+
+	for k, test := range tests {
+		if contentType := NegotiateReportContentType(test.accept); contentType != test.contentType {
+			t.Errorf("test: %d, expected: %s,\n got: %s", k, test.contentType, contentType)
+		}
+	}
+}
+
+func TestNewProblem(t *testing.T) {
+	details := []ExceptionDetails{
+		{ExceptionCode: `MissingParameterValue`, ExceptionText: `Missing key: VERSION`, LocatorCode: `VERSION`},
+		{ExceptionCode: `InvalidParameterValue`, ExceptionText: `SERVICE contains a invalid value: WKS`},
+	}
+
+	expected := `{
+ "title": "MissingParameterValue",
+ "detail": "Missing key: VERSION; SERVICE contains a invalid value: WKS",
+ "exceptions": [
+  {
+   "text": "Missing key: VERSION",
+   "code": "MissingParameterValue",
+   "locator": "VERSION"
+  },
+  {
+   "text": "SERVICE contains a invalid value: WKS",
+   "code": "InvalidParameterValue"
+  }
+ ]
+}`
+	if b := NewProblem(details).ToBytes(); string(b) != expected {
+		t.Errorf("expected: %s,\n got: %s", expected, b)
+	}
+}
+
+type testException struct {
+	text, code, locator string
+}
+
+func (e testException) Error() string {
+	return e.text
+}
+
+func (e testException) Code() string {
+	return e.code
+}
+
+func (e testException) Locator() string {
+	return e.locator
+}
+
+func TestEncodeExceptions(t *testing.T) {
+	details := Details([]testException{{text: `Missing key: VERSION`, code: `MissingParameterValue`, locator: `VERSION`}})
+	report := ExceptionReportJSON{Version: `2.0.0`, Exceptions: details}
+
+	var tests = []struct {
+		format      string
+		contentType string
+		body        string
+	}{
+		0: {format: `json`, contentType: JSONContentType, body: string(report.ToBytes())},
+		1: {format: `application/problem+json`, contentType: ProblemJSONContentType,
+			body: string(Problem{Title: `MissingParameterValue`, Status: 400, Detail: `Missing key: VERSION`, Exceptions: details}.ToBytes())},
+		2: {format: `XML`, contentType: XMLContentType, body: `<report/>`},
+	}
+
+	for k, test := range tests {
+		body, contentType := EncodeExceptions(report, test.format, 400, func() []byte { return []byte(`<report/>`) })
+		if contentType != test.contentType || string(body) != test.body {
+			t.Errorf("test: %d, expected: %s %s,\n got: %s %s", k, test.contentType, test.body, contentType, body)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package common
+
+import (
+	"net/http"
+)
+
+// statusCodes contains the HTTP status codes for the exception codes shared by the OGC services:
+// the codes of OWS Common 1.1 Table 28 and OWS Common 2.0 Table 27, and the
+// CurrentUpdateSequence code of the WMS and WMTS specifications
+var statusCodes = map[string]int{
+	`OperationNotSupported`:    http.StatusNotImplemented,
+	`MissingParameterValue`:    http.StatusBadRequest,
+	`InvalidParameterValue`:    http.StatusBadRequest,
+	`VersionNegotiationFailed`: http.StatusBadRequest,
+	`CurrentUpdateSequence`:    http.StatusNotModified,
+	`InvalidUpdateSequence`:    http.StatusBadRequest,
+	`OptionNotSupported`:       http.StatusNotImplemented,
+	`NoApplicableCode`:         http.StatusInternalServerError,
+}
+
+// ExceptionStatusCode returns the HTTP status code for an exception code, the overrides of a
+// service version take precedence over the shared codes.
+// The returned bool is false when the exception code is not known.
+func ExceptionStatusCode(code string, overrides map[string]int) (int, bool) {
+	if status, ok := overrides[code]; ok {
+		return status, true
+	}
+	status, ok := statusCodes[code]
+	return status, ok
+}
+
+// StatusCode returns the HTTP status code for a report with the given exceptions,
+// unknown exception codes are considered to be server errors
+func StatusCode[E Exception](overrides map[string]int, exceptions ...E) int {
+	codes := make([]int, 0, len(exceptions))
+	for _, e := range exceptions {
+		status, ok := ExceptionStatusCode(e.Code(), overrides)
+		if !ok {
+			status = http.StatusInternalServerError
+		}
+		codes = append(codes, status)
+	}
+	return CombineStatusCodes(codes...)
+}
+
+// CombineStatusCodes combines the HTTP status codes of the exceptions in a single report.
+// When all exceptions share a status code that code is used, otherwise a server error
+// results in 500 Internal Server Error and client errors result in 400 Bad Request.
+// A report without exceptions results in 200 OK.
+func CombineStatusCodes(codes ...int) int {
+	if len(codes) == 0 {
+		return http.StatusOK
+	}
+
+	combined := codes[0]
+	for _, code := range codes[1:] {
+		if code == combined {
+			continue
+		}
+		if code >= http.StatusInternalServerError || combined >= http.StatusInternalServerError {
+			combined = http.StatusInternalServerError
+		} else {
+			combined = http.StatusBadRequest
+		}
+	}
+	return combined
+}
@@ -0,0 +1,53 @@
+package common
+
+import (
+	"testing"
+)
+
+func TestCombineStatusCodes(t *testing.T) {
+	var tests = []struct {
+		codes  []int
+		status int
+	}{
+		0: {codes: nil, status: 200},
+		1: {codes: []int{501}, status: 501},
+		2: {codes: []int{403, 403}, status: 403},
+		3: {codes: []int{400, 403}, status: 400},
+		4: {codes: []int{400, 501}, status: 500},
+		5: {codes: []int{501, 500, 501}, status: 500},
+	}
+
+	for k, test := range tests {
+		if status := CombineStatusCodes(test.codes...); status != test.status {
+			t.Errorf("test: %d, expected: %d,\n got: %d", k, test.status, status)
+		}
+	}
+}
+
+func TestStatusCode(t *testing.T) {
+	overrides := map[string]int{`TileOutOfRange`: 400, `NoApplicableCode`: 503}
+
+	var tests = []struct {
+		codes     []string
+		overrides map[string]int
+		status    int
+	}{
+		0: {codes: nil, status: 200},
+		1: {codes: []string{`MissingParameterValue`}, status: 400},
+		2: {codes: []string{`CurrentUpdateSequence`}, status: 304},
+		3: {codes: []string{`TileOutOfRange`}, status: 500},
+		4: {codes: []string{`TileOutOfRange`}, overrides: overrides, status: 400},
+		5: {codes: []string{`NoApplicableCode`}, overrides: overrides, status: 503},
+		6: {codes: []string{`TileOutOfRange`, `OperationNotSupported`}, overrides: overrides, status: 500},
+	}
+
+	for k, test := range tests {
+		exceptions := make([]testException, 0, len(test.codes))
+		for _, code := range test.codes {
+			exceptions = append(exceptions, testException{code: code})
+		}
+		if status := StatusCode(test.overrides, exceptions...); status != test.status {
+			t.Errorf("test: %d, expected: %d,\n got: %d", k, test.status, status)
+		}
+	}
+}
@@ -0,0 +1,23 @@
+package common
+
+import (
+	"strconv"
+	"strings"
+)
+
+// CompareUpdateSequence compares two update sequences like strings.Compare does. Update sequences that are
+// both integers are compared by their value, others as strings, which orders ISO 8601 timestamps.
+func CompareUpdateSequence(a, b string) int {
+	x, errA := strconv.ParseInt(strings.TrimSpace(a), 10, 64)
+	y, errB := strconv.ParseInt(strings.TrimSpace(b), 10, 64)
+	if errA == nil && errB == nil {
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		}
+		return 0
+	}
+	return strings.Compare(strings.TrimSpace(a), strings.TrimSpace(b))
+}
@@ -0,0 +1,21 @@
+package common
+
+import "testing"
+
+func TestCompareUpdateSequence(t *testing.T) {
+	var tests = []struct {
+		a, b   string
+		result int
+	}{
+		0: {a: `9`, b: `10`, result: -1},
+		1: {a: `10`, b: `10`, result: 0},
+		2: {a: `2021-03-01T12:00:00Z`, b: `2021-02-28T12:00:00Z`, result: 1},
+		3: {a: `abc`, b: `abd`, result: -1},
+	}
+
+	for k, test := range tests {
+		if result := CompareUpdateSequence(test.a, test.b); result != test.result {
+			t.Errorf("test: %d, expected: %d,\n got: %d", k, test.result, result)
+		}
+	}
+}
@@ -0,0 +1,200 @@
+package crs
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Authorities used by the registry
+const (
+	EPSG = `EPSG`
+	// CRS is the authority of the OGC CRS codes like CRS:84 as defined in WMS 1.3.0 Annex B
+	CRS = `CRS`
+)
+
+// AxisOrder of the coordinates in a CRS
+type AxisOrder int
+
+const (
+	// EastNorth is the x/y (longitude/latitude) axis order
+	EastNorth AxisOrder = iota
+	// NorthEast is the y/x (latitude/longitude) axis order
+	NorthEast
+)
+
+// Units of the coordinates in a CRS
+type Units string
+
+const (
+	Metre  Units = `m`
+	Degree Units = `degree`
+)
+
+// Definition of a coordinate reference system
+type Definition struct {
+	Authority  string
+	Code       int
+	Name       string
+	Units      Units
+	Geographic bool
+	AxisOrder  AxisOrder
+}
+
+// String returns the short notation of the CRS, like EPSG:4326 or CRS:84
+func (d Definition) String() string {
+	return d.Authority + `:` + strconv.Itoa(d.Code)
+}
+
+// URN returns the OGC URN of the CRS, like urn:ogc:def:crs:EPSG::4326
+func (d Definition) URN() string {
+	if d.Authority == CRS {
+		return `urn:ogc:def:crs:OGC:1.3:CRS` + strconv.Itoa(d.Code)
+	}
+	return `urn:ogc:def:crs:` + d.Authority + `::` + strconv.Itoa(d.Code)
+}
+
+// URI returns the OGC http URI of the CRS, like http://www.opengis.net/def/crs/EPSG/0/4326
+func (d Definition) URI() string {
+	if d.Authority == CRS {
+		return `http://www.opengis.net/def/crs/OGC/1.3/CRS` + strconv.Itoa(d.Code)
+	}
+	return `http://www.opengis.net/def/crs/` + d.Authority + `/0/` + strconv.Itoa(d.Code)
+}
+
+// NorthEast returns if the first axis of the CRS is the northing/latitude
+func (d Definition) NorthEast() bool {
+	return d.AxisOrder == NorthEast
+}
+
+var (
+	registryMu sync.RWMutex
+	registry   = map[string]Definition{}
+)
+
+func key(authority string, code int) string {
+	return strings.ToUpper(authority) + `:` + strconv.Itoa(code)
+}
+
+// Register adds or replaces a CRS definition in the registry
+func Register(d Definition) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	registry[key(d.Authority, d.Code)] = d
+}
+
+// Lookup returns the registered CRS definition for the given authority and code
+func Lookup(authority string, code int) (Definition, bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	d, ok := registry[key(authority, code)]
+	return d, ok
+}
+
+// LookupString returns the registered CRS definition for a CRS in one of the notations supported by Parse
+func LookupString(s string) (Definition, bool) {
+	authority, code, ok := Parse(s)
+	if !ok {
+		return Definition{}, false
+	}
+	return Lookup(authority, code)
+}
+
+// Parse normalises the different notations of a CRS to an authority and code.
+// Supported are:
+//
+//	EPSG:4326
+//	CRS:84
+//	urn:ogc:def:crs:EPSG::4326
+//	urn:ogc:def:crs:EPSG:6.6:4326
+//	urn:x-ogc:def:crs:EPSG:4326
+//	urn:ogc:def:crs:OGC:1.3:CRS84
+//	http://www.opengis.net/def/crs/EPSG/0/4326
+//	http://www.opengis.net/def/crs/OGC/1.3/CRS84
+//	http://www.opengis.net/gml/srs/epsg.xml#4326
+//
+// For other notations ending on :<code> the part before the code is returned as the authority.
+func Parse(s string) (string, int, bool) {
+	s = strings.TrimSpace(s)
+	lower := strings.ToLower(s)
+
+	switch {
+	case strings.HasPrefix(lower, `urn:`):
+		return parseURN(s)
+	case strings.HasPrefix(lower, `http://`) || strings.HasPrefix(lower, `https://`):
+		return parseURI(s)
+	}
+
+	i := strings.LastIndex(s, `:`)
+	if i < 1 {
+		return ``, 0, false
+	}
+	code, err := strconv.Atoi(s[i+1:])
+	if err != nil {
+		return ``, 0, false
+	}
+	return normaliseAuthority(s[:i]), code, true
+}
+
+// parseURN parses urn:ogc:def:crs:{authority}:{version}:{code}
+func parseURN(s string) (string, int, bool) {
+	parts := strings.Split(s, `:`)
+	if len(parts) < 5 {
+		return ``, 0, false
+	}
+	authority := parts[4]
+	return authorityCode(authority, parts[len(parts)-1])
+}
+
+// parseURI parses http://www.opengis.net/def/crs/{authority}/{version}/{code}
+// and http://www.opengis.net/gml/srs/epsg.xml#{code}
+func parseURI(s string) (string, int, bool) {
+	if i := strings.Index(strings.ToLower(s), `epsg.xml#`); i > -1 {
+		code, err := strconv.Atoi(s[i+len(`epsg.xml#`):])
+		if err != nil {
+			return ``, 0, false
+		}
+		return EPSG, code, true
+	}
+
+	i := strings.Index(s, `/def/crs/`)
+	if i < 0 {
+		return ``, 0, false
+	}
+	parts := strings.Split(strings.Trim(s[i+len(`/def/crs/`):], `/`), `/`)
+	if len(parts) < 3 {
+		return ``, 0, false
+	}
+	return authorityCode(parts[0], parts[len(parts)-1])
+}
+
+// authorityCode resolves the authority and code parts of a URN or URI,
+// where the OGC codes like CRS84 are normalised to CRS:84
+func authorityCode(authority, code string) (string, int, bool) {
+	if strings.EqualFold(authority, `OGC`) {
+		if !strings.HasPrefix(strings.ToUpper(code), CRS) {
+			return ``, 0, false
+		}
+		c, err := strconv.Atoi(code[len(CRS):])
+		if err != nil {
+			return ``, 0, false
+		}
+		return CRS, c, true
+	}
+
+	c, err := strconv.Atoi(code)
+	if err != nil {
+		return ``, 0, false
+	}
+	return normaliseAuthority(authority), c, true
+}
+
+func normaliseAuthority(authority string) string {
+	switch {
+	case strings.Contains(strings.ToUpper(authority), EPSG):
+		return EPSG
+	case strings.EqualFold(authority, CRS), strings.EqualFold(authority, `OGC`):
+		return CRS
+	}
+	return authority
+}
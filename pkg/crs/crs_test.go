@@ -0,0 +1,79 @@
+package crs
+
+import "testing"
+
+func TestParse(t *testing.T) {
+	var tests = []struct {
+		input     string
+		authority string
+		code      int
+		ok        bool
+	}{
+		0:  {input: ``},
+		1:  {input: `EPSG:4326`, authority: EPSG, code: 4326, ok: true},
+		2:  {input: `epsg:28992`, authority: EPSG, code: 28992, ok: true},
+		3:  {input: `CRS:84`, authority: CRS, code: 84, ok: true},
+		4:  {input: `urn:ogc:def:crs:EPSG::4326`, authority: EPSG, code: 4326, ok: true},
+		5:  {input: `urn:ogc:def:crs:EPSG:6.6:4326`, authority: EPSG, code: 4326, ok: true},
+		6:  {input: `urn:x-ogc:def:crs:EPSG:4258`, authority: EPSG, code: 4258, ok: true},
+		7:  {input: `urn:ogc:def:crs:OGC:1.3:CRS84`, authority: CRS, code: 84, ok: true},
+		8:  {input: `urn:ogc:def:crs:OGC::CRS84`, authority: CRS, code: 84, ok: true},
+		9:  {input: `http://www.opengis.net/def/crs/EPSG/0/28992`, authority: EPSG, code: 28992, ok: true},
+		10: {input: `https://www.opengis.net/def/crs/OGC/1.3/CRS84`, authority: CRS, code: 84, ok: true},
+		11: {input: `http://www.opengis.net/gml/srs/epsg.xml#4326`, authority: EPSG, code: 4326, ok: true},
+		12: {input: `urn:ogc:def:crs:OGC:1.3:WGS84`},
+		13: {input: `EPSG:not a number`},
+		14: {input: `http://www.opengis.net/def/crs/EPSG/0/`},
+	}
+
+	for k, test := range tests {
+		authority, code, ok := Parse(test.input)
+		if authority != test.authority || code != test.code || ok != test.ok {
+			t.Errorf("test: %d, expected: %s %d %t,\n got: %s %d %t", k, test.authority, test.code, test.ok, authority, code, ok)
+		}
+	}
+}
+
+func TestLookupString(t *testing.T) {
+	var tests = []struct {
+		input     string
+		found     bool
+		northEast bool
+		units     Units
+		urn       string
+		uri       string
+	}{
+		0: {input: `EPSG:4326`, found: true, northEast: true, units: Degree,
+			urn: `urn:ogc:def:crs:EPSG::4326`, uri: `http://www.opengis.net/def/crs/EPSG/0/4326`},
+		1: {input: `CRS:84`, found: true, northEast: false, units: Degree,
+			urn: `urn:ogc:def:crs:OGC:1.3:CRS84`, uri: `http://www.opengis.net/def/crs/OGC/1.3/CRS84`},
+		2: {input: `http://www.opengis.net/def/crs/EPSG/0/28992`, found: true, northEast: false, units: Metre,
+			urn: `urn:ogc:def:crs:EPSG::28992`, uri: `http://www.opengis.net/def/crs/EPSG/0/28992`},
+		3: {input: `urn:ogc:def:crs:EPSG::3035`, found: true, northEast: true, units: Metre,
+			urn: `urn:ogc:def:crs:EPSG::3035`, uri: `http://www.opengis.net/def/crs/EPSG/0/3035`},
+		4: {input: `EPSG:1234`},
+	}
+
+	for k, test := range tests {
+		d, found := LookupString(test.input)
+		if found != test.found {
+			t.Errorf("test: %d, expected found: %t,\n got: %t", k, test.found, found)
+			continue
+		}
+		if !found {
+			continue
+		}
+		if d.NorthEast() != test.northEast || d.Units != test.units || d.URN() != test.urn || d.URI() != test.uri {
+			t.Errorf("test: %d, expected: %t %s %s %s,\n got: %t %s %s %s", k, test.northEast, test.units, test.urn, test.uri, d.NorthEast(), d.Units, d.URN(), d.URI())
+		}
+	}
+}
+
+func TestRegister(t *testing.T) {
+	Register(Definition{Authority: EPSG, Code: 2056, Name: `CH1903+ / LV95`, Units: Metre, AxisOrder: EastNorth})
+
+	d, found := LookupString(`urn:ogc:def:crs:EPSG::2056`)
+	if !found || d.Name != `CH1903+ / LV95` {
+		t.Errorf("expected the registered definition, got: %v", d)
+	}
+}
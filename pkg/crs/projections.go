@@ -0,0 +1,137 @@
+package crs
+
+import "math"
+
+const (
+	deg2rad = math.Pi / 180.0
+	rad2deg = 180.0 / math.Pi
+)
+
+// ellipsoid defined by its semi-major axis and inverse flattening
+type ellipsoid struct {
+	a    float64
+	invf float64
+}
+
+var (
+	grs80     = ellipsoid{a: 6378137.0, invf: 298.257222101}
+	wgs84     = ellipsoid{a: 6378137.0, invf: 298.257223563}
+	bessel    = ellipsoid{a: 6377397.155, invf: 299.1528128}
+	sphereR   = 6378137.0
+	maxMercat = 85.0511287798066
+)
+
+func (e ellipsoid) f() float64 {
+	return 1 / e.invf
+}
+
+// e2 returns the square of the eccentricity
+func (e ellipsoid) e2() float64 {
+	f := e.f()
+	return 2*f - f*f
+}
+
+// geographic is the identity Projection for WGS84 based geographic CRS, like EPSG:4326 and CRS:84.
+// ETRS89 (EPSG:4258) is considered equal to WGS84, the difference is less than a meter.
+type geographic struct{}
+
+func (geographic) Forward(lon, lat float64) (float64, float64) {
+	return lon, lat
+}
+
+func (geographic) Inverse(x, y float64) (float64, float64) {
+	return x, y
+}
+
+// webMercator is the spherical Mercator projection of EPSG:3857
+type webMercator struct{}
+
+func (webMercator) Forward(lon, lat float64) (float64, float64) {
+	lat = math.Max(-maxMercat, math.Min(maxMercat, lat))
+	x := sphereR * lon * deg2rad
+	y := sphereR * math.Log(math.Tan(math.Pi/4+lat*deg2rad/2))
+	return x, y
+}
+
+func (webMercator) Inverse(x, y float64) (float64, float64) {
+	lon := x / sphereR * rad2deg
+	lat := (2*math.Atan(math.Exp(y/sphereR)) - math.Pi/2) * rad2deg
+	return lon, lat
+}
+
+// datum is a geographic CRS on another ellipsoid than WGS84
+// that is related to WGS84 by a 7 parameter Helmert transformation
+type datum struct {
+	ellipsoid ellipsoid
+	helmert   helmert
+}
+
+func (d datum) Forward(lon, lat float64) (float64, float64) {
+	x, y, z := toGeocentric(wgs84, lon, lat)
+	x, y, z = d.helmert.inverse(x, y, z)
+	return fromGeocentric(d.ellipsoid, x, y, z)
+}
+
+func (d datum) Inverse(lon, lat float64) (float64, float64) {
+	x, y, z := toGeocentric(d.ellipsoid, lon, lat)
+	x, y, z = d.helmert.forward(x, y, z)
+	return fromGeocentric(wgs84, x, y, z)
+}
+
+// helmert contains the parameters of a position vector transformation (EPSG method 9606) to WGS84,
+// with the translations in meters, the rotations in arc-seconds and the scale in parts per million
+type helmert struct {
+	tx, ty, tz float64
+	rx, ry, rz float64
+	ds         float64
+}
+
+func (h helmert) params() (float64, float64, float64, float64) {
+	arcsec := deg2rad / 3600
+	return h.rx * arcsec, h.ry * arcsec, h.rz * arcsec, 1 + h.ds*1e-6
+}
+
+// forward transforms geocentric coordinates to WGS84
+func (h helmert) forward(x, y, z float64) (float64, float64, float64) {
+	rx, ry, rz, m := h.params()
+	return m*(x-rz*y+ry*z) + h.tx,
+		m*(rz*x+y-rx*z) + h.ty,
+		m*(-ry*x+rx*y+z) + h.tz
+}
+
+// inverse transforms geocentric WGS84 coordinates back, using the transposed rotation matrix
+func (h helmert) inverse(x, y, z float64) (float64, float64, float64) {
+	rx, ry, rz, m := h.params()
+	x, y, z = (x-h.tx)/m, (y-h.ty)/m, (z-h.tz)/m
+	return x + rz*y - ry*z,
+		-rz*x + y + rx*z,
+		ry*x - rx*y + z
+}
+
+// toGeocentric converts longitude/latitude on the ellipsoid, with a height of 0, to geocentric coordinates
+func toGeocentric(e ellipsoid, lon, lat float64) (float64, float64, float64) {
+	phi, lambda := lat*deg2rad, lon*deg2rad
+	e2 := e.e2()
+	nu := e.a / math.Sqrt(1-e2*math.Sin(phi)*math.Sin(phi))
+	return nu * math.Cos(phi) * math.Cos(lambda),
+		nu * math.Cos(phi) * math.Sin(lambda),
+		nu * (1 - e2) * math.Sin(phi)
+}
+
+// fromGeocentric converts geocentric coordinates to longitude/latitude on the ellipsoid
+func fromGeocentric(e ellipsoid, x, y, z float64) (float64, float64) {
+	e2 := e.e2()
+	p := math.Sqrt(x*x + y*y)
+	lambda := math.Atan2(y, x)
+	phi := math.Atan2(z, p*(1-e2))
+	for i := 0; i < 10; i++ {
+		nu := e.a / math.Sqrt(1-e2*math.Sin(phi)*math.Sin(phi))
+		next := math.Atan2(z+e2*nu*math.Sin(phi), p)
+		if math.Abs(next-phi) < 1e-12 {
+			phi = next
+			break
+		}
+		phi = next
+	}
+	return lambda * rad2deg, phi * rad2deg
+}
@@ -0,0 +1,61 @@
+package crs
+
+// definitions contains the built-in CRS definitions
+var definitions = []Definition{
+	// OGC
+	{Authority: CRS, Code: 84, Name: `WGS 84 longitude-latitude`, Units: Degree, Geographic: true, AxisOrder: EastNorth},
+	{Authority: CRS, Code: 83, Name: `NAD83 longitude-latitude`, Units: Degree, Geographic: true, AxisOrder: EastNorth},
+	{Authority: CRS, Code: 27, Name: `NAD27 longitude-latitude`, Units: Degree, Geographic: true, AxisOrder: EastNorth},
+
+	// Geographic
+	{Authority: EPSG, Code: 4326, Name: `WGS 84`, Units: Degree, Geographic: true, AxisOrder: NorthEast},
+	{Authority: EPSG, Code: 4258, Name: `ETRS89`, Units: Degree, Geographic: true, AxisOrder: NorthEast},
+	{Authority: EPSG, Code: 4289, Name: `Amersfoort`, Units: Degree, Geographic: true, AxisOrder: NorthEast},
+	{Authority: EPSG, Code: 4269, Name: `NAD83`, Units: Degree, Geographic: true, AxisOrder: NorthEast},
+	{Authority: EPSG, Code: 4267, Name: `NAD27`, Units: Degree, Geographic: true, AxisOrder: NorthEast},
+	{Authority: EPSG, Code: 4230, Name: `ED50`, Units: Degree, Geographic: true, AxisOrder: NorthEast},
+	{Authority: EPSG, Code: 4313, Name: `BD72`, Units: Degree, Geographic: true, AxisOrder: NorthEast},
+	{Authority: EPSG, Code: 4314, Name: `DHDN`, Units: Degree, Geographic: true, AxisOrder: NorthEast},
+
+	// Projected
+	{Authority: EPSG, Code: 3857, Name: `WGS 84 / Pseudo-Mercator`, Units: Metre, AxisOrder: EastNorth},
+	{Authority: EPSG, Code: 900913, Name: `Google Maps Global Mercator`, Units: Metre, AxisOrder: EastNorth},
+	{Authority: EPSG, Code: 28992, Name: `Amersfoort / RD New`, Units: Metre, AxisOrder: EastNorth},
+	{Authority: EPSG, Code: 31370, Name: `BD72 / Belgian Lambert 72`, Units: Metre, AxisOrder: EastNorth},
+	{Authority: EPSG, Code: 3035, Name: `ETRS89-extended / LAEA Europe`, Units: Metre, AxisOrder: NorthEast},
+	{Authority: EPSG, Code: 3034, Name: `ETRS89-extended / LCC Europe`, Units: Metre, AxisOrder: NorthEast},
+	{Authority: EPSG, Code: 25831, Name: `ETRS89 / UTM zone 31N`, Units: Metre, AxisOrder: EastNorth},
+	{Authority: EPSG, Code: 25832, Name: `ETRS89 / UTM zone 32N`, Units: Metre, AxisOrder: EastNorth},
+	{Authority: EPSG, Code: 25833, Name: `ETRS89 / UTM zone 33N`, Units: Metre, AxisOrder: EastNorth},
+	{Authority: EPSG, Code: 32631, Name: `WGS 84 / UTM zone 31N`, Units: Metre, AxisOrder: EastNorth},
+	{Authority: EPSG, Code: 32632, Name: `WGS 84 / UTM zone 32N`, Units: Metre, AxisOrder: EastNorth},
+	{Authority: EPSG, Code: 32633, Name: `WGS 84 / UTM zone 33N`, Units: Metre, AxisOrder: EastNorth},
+}
+
+// amersfoort is the Amersfoort datum (EPSG:4289) with the Amersfoort to WGS 84 (3)
+// transformation (EPSG:15739), this approximation is accurate to about a meter
+var amersfoort = datum{
+	ellipsoid: bessel,
+	helmert:   helmert{tx: 565.2369, ty: 50.0087, tz: 465.658, rx: -0.406857, ry: 0.350733, rz: -1.87035, ds: 4.0812},
+}
+
+func init() {
+	for _, d := range definitions {
+		Register(d)
+	}
+
+	for _, c := range []struct {
+		authority string
+		code      int
+	}{{CRS, 84}, {EPSG, 4326}, {EPSG, 4258}} {
+		RegisterProjection(c.authority, c.code, geographic{})
+	}
+	RegisterProjection(EPSG, 3857, webMercator{})
+	RegisterProjection(EPSG, 900913, webMercator{})
+	RegisterProjection(EPSG, 4289, amersfoort)
+	RegisterProjection(EPSG, 28992, newObliqueStereographic(amersfoort, 52.15616055555555, 5.38763888888889, 0.9999079, 155000, 463000))
+	for zone := 31; zone <= 33; zone++ {
+		RegisterProjection(EPSG, 25800+zone, newUTM(grs80, zone))
+		RegisterProjection(EPSG, 32600+zone, newUTM(wgs84, zone))
+	}
+}
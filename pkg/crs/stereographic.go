@@ -0,0 +1,84 @@
+package crs
+
+import "math"
+
+// obliqueStereographic is the double stereographic projection (EPSG method 9809)
+// as used by Amersfoort / RD New (EPSG:28992)
+type obliqueStereographic struct {
+	datum  datum
+	lat0   float64
+	lon0   float64
+	k0     float64
+	falseE float64
+	falseN float64
+
+	// derived constants
+	e, r, n, c, chi0 float64
+}
+
+func newObliqueStereographic(d datum, lat0, lon0, k0, falseE, falseN float64) *obliqueStereographic {
+	p := &obliqueStereographic{datum: d, lat0: lat0 * deg2rad, lon0: lon0 * deg2rad, k0: k0, falseE: falseE, falseN: falseN}
+
+	e2 := d.ellipsoid.e2()
+	p.e = math.Sqrt(e2)
+	sin0 := math.Sin(p.lat0)
+	rho0 := d.ellipsoid.a * (1 - e2) / math.Pow(1-e2*sin0*sin0, 1.5)
+	nu0 := d.ellipsoid.a / math.Sqrt(1-e2*sin0*sin0)
+	p.r = math.Sqrt(rho0 * nu0)
+	p.n = math.Sqrt(1 + e2*math.Pow(math.Cos(p.lat0), 4)/(1-e2))
+
+	s1 := (1 + sin0) / (1 - sin0)
+	s2 := (1 - p.e*sin0) / (1 + p.e*sin0)
+	w1 := math.Pow(s1*math.Pow(s2, p.e), p.n)
+	sinChi0 := (w1 - 1) / (w1 + 1)
+	p.c = (p.n + sin0) * (1 - sinChi0) / ((p.n - sin0) * (1 + sinChi0))
+	w2 := p.c * w1
+	p.chi0 = math.Asin((w2 - 1) / (w2 + 1))
+
+	return p
+}
+
+func (p *obliqueStereographic) Forward(lon, lat float64) (float64, float64) {
+	lon, lat = p.datum.Forward(lon, lat)
+	phi, lambda := lat*deg2rad, lon*deg2rad
+
+	bigLambda := p.n*(lambda-p.lon0) + p.lon0
+	sinPhi := math.Sin(phi)
+	sa := (1 + sinPhi) / (1 - sinPhi)
+	sb := (1 - p.e*sinPhi) / (1 + p.e*sinPhi)
+	w := p.c * math.Pow(sa*math.Pow(sb, p.e), p.n)
+	chi := math.Asin((w - 1) / (w + 1))
+
+	dl := bigLambda - p.lon0
+	b := 1 + math.Sin(chi)*math.Sin(p.chi0) + math.Cos(chi)*math.Cos(p.chi0)*math.Cos(dl)
+	x := p.falseE + 2*p.r*p.k0*math.Cos(chi)*math.Sin(dl)/b
+	y := p.falseN + 2*p.r*p.k0*(math.Sin(chi)*math.Cos(p.chi0)-math.Cos(chi)*math.Sin(p.chi0)*math.Cos(dl))/b
+	return x, y
+}
+
+func (p *obliqueStereographic) Inverse(x, y float64) (float64, float64) {
+	de, dn := x-p.falseE, y-p.falseN
+	g := 2 * p.r * p.k0 * math.Tan(math.Pi/4-p.chi0/2)
+	h := 4*p.r*p.k0*math.Tan(p.chi0) + g
+	i := math.Atan(de / (h + dn))
+	j := math.Atan(de/(g-dn)) - i
+	chi := p.chi0 + 2*math.Atan((dn-de*math.Tan(j/2))/(2*p.r*p.k0))
+	bigLambda := j + 2*i + p.lon0
+	lambda := (bigLambda-p.lon0)/p.n + p.lon0
+
+	psi := 0.5 * math.Log((1+math.Sin(chi))/(p.c*(1-math.Sin(chi)))) / p.n
+	phi := 2*math.Atan(math.Exp(psi)) - math.Pi/2
+	e2 := p.e * p.e
+	for k := 0; k < 10; k++ {
+		sinPhi := math.Sin(phi)
+		psiI := math.Log(math.Tan(phi/2+math.Pi/4) * math.Pow((1-p.e*sinPhi)/(1+p.e*sinPhi), p.e/2))
+		next := phi - (psiI-psi)*math.Cos(phi)*(1-e2*sinPhi*sinPhi)/(1-e2)
+		if math.Abs(next-phi) < 1e-12 {
+			phi = next
+			break
+		}
+		phi = next
+	}
+
+	return p.datum.Inverse(lambda*rad2deg, phi*rad2deg)
+}
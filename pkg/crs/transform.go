@@ -0,0 +1,98 @@
+package crs
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// ErrNoTransformation is returned when there is no transformation available between two CRS
+var ErrNoTransformation = errors.New("no transformation available")
+
+// DefaultDensify is the default number of points added between the corners of a bounding box edge
+// when a bounding box is transformed, so the curvature of the edges in the target CRS is taken in account
+const DefaultDensify = 10
+
+// Projection converts coordinates between a CRS and WGS84 longitude/latitude.
+// All coordinates are in the x/y (east/north) axis order, for geographic CRS this is longitude/latitude in degrees.
+type Projection interface {
+	// Forward converts WGS84 longitude/latitude to coordinates in the CRS
+	Forward(lon, lat float64) (x, y float64)
+	// Inverse converts coordinates in the CRS to WGS84 longitude/latitude
+	Inverse(x, y float64) (lon, lat float64)
+}
+
+var projections = map[string]Projection{}
+
+// RegisterProjection adds or replaces the Projection used to transform coordinates of the given CRS
+func RegisterProjection(authority string, code int, p Projection) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	projections[key(authority, code)] = p
+}
+
+// projectionFor returns the Projection for a CRS in one of the notations supported by Parse
+func projectionFor(s string) (Projection, error) {
+	authority, code, ok := Parse(s)
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown CRS %s", ErrNoTransformation, s)
+	}
+
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	p, ok := projections[key(authority, code)]
+	if !ok {
+		return nil, fmt.Errorf("%w: unsupported CRS %s", ErrNoTransformation, s)
+	}
+	return p, nil
+}
+
+// Transform converts a coordinate from one CRS to another.
+// The coordinates are in the x/y (east/north) axis order, regardless of the axis order of the CRS.
+func Transform(from, to string, x, y float64) (float64, float64, error) {
+	source, err := projectionFor(from)
+	if err != nil {
+		return 0, 0, err
+	}
+	target, err := projectionFor(to)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	lon, lat := source.Inverse(x, y)
+	tx, ty := target.Forward(lon, lat)
+	if math.IsNaN(tx) || math.IsNaN(ty) || math.IsInf(tx, 0) || math.IsInf(ty, 0) {
+		return 0, 0, fmt.Errorf("%w: coordinate %f %f can not be transformed from %s to %s", ErrNoTransformation, x, y, from, to)
+	}
+	return tx, ty, nil
+}
+
+// TransformBounds converts a bounding box from one CRS to another, the edges of the
+// bounding box are densified with the given number of points before transformation
+// and the result is the bounding box of all the transformed points.
+// The coordinates are in the x/y (east/north) axis order, regardless of the axis order of the CRS.
+func TransformBounds(from, to string, minx, miny, maxx, maxy float64, densify int) ([4]float64, error) {
+	if densify < 0 {
+		densify = 0
+	}
+
+	result := [4]float64{math.Inf(1), math.Inf(1), math.Inf(-1), math.Inf(-1)}
+	steps := densify + 1
+	for i := 0; i <= steps; i++ {
+		fx := minx + (maxx-minx)*float64(i)/float64(steps)
+		fy := miny + (maxy-miny)*float64(i)/float64(steps)
+
+		for _, p := range [][2]float64{{fx, miny}, {fx, maxy}, {minx, fy}, {maxx, fy}} {
+			x, y, err := Transform(from, to, p[0], p[1])
+			if err != nil {
+				return [4]float64{}, err
+			}
+			result[0] = math.Min(result[0], x)
+			result[1] = math.Min(result[1], y)
+			result[2] = math.Max(result[2], x)
+			result[3] = math.Max(result[3], y)
+		}
+	}
+
+	return result, nil
+}
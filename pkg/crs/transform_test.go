@@ -0,0 +1,80 @@
+package crs
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestTransform(t *testing.T) {
+	var tests = []struct {
+		from, to  string
+		x, y      float64
+		ex, ey    float64
+		tolerance float64
+		err       error
+	}{
+		// The origin of RD, Onze Lieve Vrouwetoren in Amersfoort
+		0: {from: `EPSG:28992`, to: `EPSG:4326`, x: 155000, y: 463000, ex: 5.38720621, ey: 52.15517440, tolerance: 1e-5},
+		1: {from: `EPSG:4326`, to: `EPSG:28992`, x: 5.38720621, y: 52.15517440, ex: 155000, ey: 463000, tolerance: 1},
+		2: {from: `EPSG:4326`, to: `EPSG:3857`, x: 5, y: 52, ex: 556597.4539663679, ey: 6800125.454397305, tolerance: 1e-3},
+		3: {from: `EPSG:3857`, to: `CRS:84`, x: 556597.4539663679, y: 6800125.454397305, ex: 5, ey: 52, tolerance: 1e-9},
+		4: {from: `EPSG:4258`, to: `EPSG:25831`, x: 3, y: 0, ex: 500000, ey: 0, tolerance: 1e-3},
+		5: {from: `EPSG:4258`, to: `EPSG:25831`, x: 5, y: 52, ex: 637294.366, ey: 5762926.813, tolerance: 1e-2},
+		6: {from: `EPSG:32632`, to: `EPSG:4326`, x: 500000, y: 5761038.213, ex: 9, ey: 52, tolerance: 1e-7},
+		7: {from: `EPSG:28992`, to: `EPSG:1234`, err: ErrNoTransformation},
+		8: {from: `unknown`, to: `EPSG:4326`, err: ErrNoTransformation},
+	}
+
+	for k, test := range tests {
+		x, y, err := Transform(test.from, test.to, test.x, test.y)
+		if test.err != nil {
+			if !errors.Is(err, test.err) {
+				t.Errorf("test: %d, expected: %v,\n got: %v", k, test.err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("test: %d, expected no error,\n got: %v", k, err)
+			continue
+		}
+		if math.Abs(x-test.ex) > test.tolerance || math.Abs(y-test.ey) > test.tolerance {
+			t.Errorf("test: %d, expected: %f %f,\n got: %f %f", k, test.ex, test.ey, x, y)
+		}
+	}
+}
+
+func TestTransformRoundTrip(t *testing.T) {
+	for _, to := range []string{`EPSG:28992`, `EPSG:3857`, `EPSG:4289`, `EPSG:25831`, `EPSG:25832`, `EPSG:25833`, `EPSG:32631`} {
+		x, y, err := Transform(`EPSG:4326`, to, 6.5, 52.5)
+		if err != nil {
+			t.Errorf("%s, expected no error,\n got: %v", to, err)
+			continue
+		}
+		lon, lat, err := Transform(to, `EPSG:4326`, x, y)
+		if err != nil || math.Abs(lon-6.5) > 1e-7 || math.Abs(lat-52.5) > 1e-7 {
+			t.Errorf("%s, expected: 6.5 52.5,\n got: %f %f %v", to, lon, lat, err)
+		}
+	}
+}
+
+func TestTransformBounds(t *testing.T) {
+	bounds, err := TransformBounds(`EPSG:28992`, `EPSG:4326`, 0, 300000, 280000, 625000, DefaultDensify)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	// The densified bounds are larger than those of the corners alone
+	corners, _ := TransformBounds(`EPSG:28992`, `EPSG:4326`, 0, 300000, 280000, 625000, 0)
+	if bounds[1] > corners[1] || bounds[3] < corners[3] {
+		t.Errorf("expected the densified bounds: %v to contain: %v", bounds, corners)
+	}
+
+	expected := [4]float64{3.05, 50.67, 7.28, 53.61}
+	for i := range expected {
+		if math.Abs(bounds[i]-expected[i]) > 0.01 {
+			t.Errorf("expected: %v,\n got: %v", expected, bounds)
+			break
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package crs
+
+import "math"
+
+// transverseMercator is the Transverse Mercator projection (EPSG method 9807)
+// using the Krüger series, as used by the UTM zones
+type transverseMercator struct {
+	lon0   float64
+	k0     float64
+	falseE float64
+	falseN float64
+
+	// derived constants
+	bigA               float64
+	alpha, beta, delta [3]float64
+	n                  float64
+}
+
+func newTransverseMercator(e ellipsoid, lon0, k0, falseE, falseN float64) *transverseMercator {
+	n := e.f() / (2 - e.f())
+	n2, n3 := n*n, n*n*n
+
+	return &transverseMercator{
+		lon0:   lon0 * deg2rad,
+		k0:     k0,
+		falseE: falseE,
+		falseN: falseN,
+		n:      n,
+		bigA:   e.a / (1 + n) * (1 + n2/4 + n2*n2/64),
+		alpha:  [3]float64{n/2 - 2*n2/3 + 5*n3/16, 13*n2/48 - 3*n3/5, 61 * n3 / 240},
+		beta:   [3]float64{n/2 - 2*n2/3 + 37*n3/96, n2/48 + n3/15, 17 * n3 / 480},
+		delta:  [3]float64{2*n - 2*n2/3 - 2*n3, 7*n2/3 - 8*n3/5, 56 * n3 / 15},
+	}
+}
+
+// newUTM returns the Transverse Mercator projection of a northern UTM zone
+func newUTM(e ellipsoid, zone int) *transverseMercator {
+	return newTransverseMercator(e, float64(zone*6-183), 0.9996, 500000, 0)
+}
+
+func (p *transverseMercator) Forward(lon, lat float64) (float64, float64) {
+	phi, lambda := lat*deg2rad, lon*deg2rad-p.lon0
+
+	c := 2 * math.Sqrt(p.n) / (1 + p.n)
+	t := math.Sinh(math.Atanh(math.Sin(phi)) - c*math.Atanh(c*math.Sin(phi)))
+	xi := math.Atan2(t, math.Cos(lambda))
+	eta := math.Atanh(math.Sin(lambda) / math.Sqrt(1+t*t))
+
+	x, y := eta, xi
+	for j := 1; j <= 3; j++ {
+		a := p.alpha[j-1]
+		x += a * math.Cos(2*float64(j)*xi) * math.Sinh(2*float64(j)*eta)
+		y += a * math.Sin(2*float64(j)*xi) * math.Cosh(2*float64(j)*eta)
+	}
+
+	return p.falseE + p.k0*p.bigA*x, p.falseN + p.k0*p.bigA*y
+}
+
+func (p *transverseMercator) Inverse(x, y float64) (float64, float64) {
+	xi := (y - p.falseN) / (p.k0 * p.bigA)
+	eta := (x - p.falseE) / (p.k0 * p.bigA)
+
+	xiP, etaP := xi, eta
+	for j := 1; j <= 3; j++ {
+		b := p.beta[j-1]
+		xiP -= b * math.Sin(2*float64(j)*xi) * math.Cosh(2*float64(j)*eta)
+		etaP -= b * math.Cos(2*float64(j)*xi) * math.Sinh(2*float64(j)*eta)
+	}
+
+	chi := math.Asin(math.Sin(xiP) / math.Cosh(etaP))
+	phi := chi
+	for j := 1; j <= 3; j++ {
+		phi += p.delta[j-1] * math.Sin(2*float64(j)*chi)
+	}
+	lambda := p.lon0 + math.Atan2(math.Sinh(etaP), math.Cos(xiP))
+
+	return lambda * rad2deg, phi * rad2deg
+}
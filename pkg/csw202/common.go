@@ -0,0 +1,158 @@
+// Package csw202 contains the requests and responses of the Catalogue Service for the Web 2.0.2 (CSW), the metadata
+// catalogue the view and download services point to with their MetadataURLs. The exceptions are the OWS Common 1.1
+// exceptions of the wsc110 package.
+//
+// The constraints of a GetRecords request are either a Filter Encoding 1.1.0 filter, parsed with the wfs110 package,
+// or the OGC CQL text as is. The ISO 19139 gmd:MD_Metadata records are passed through without being interpreted.
+package csw202
+
+import (
+	"encoding/xml"
+	"net/url"
+	"strings"
+
+	"github.com/pdok/ogc-specifications/pkg/utils"
+	"github.com/pdok/ogc-specifications/pkg/wsc110"
+)
+
+const (
+	getcapabilities = `GetCapabilities`
+	getrecords      = `GetRecords`
+	getrecordbyid   = `GetRecordById`
+	describerecord  = `DescribeRecord`
+
+	Service = `CSW`
+	Version = `2.0.2`
+)
+
+// CSW 2.0.2 Keys
+const (
+	SERVICE = `SERVICE`
+	REQUEST = `REQUEST`
+	VERSION = `VERSION`
+
+	NAMESPACE      = `NAMESPACE`
+	OUTPUTFORMAT   = `OUTPUTFORMAT`
+	OUTPUTSCHEMA   = `OUTPUTSCHEMA`
+	ELEMENTSETNAME = `ELEMENTSETNAME`
+)
+
+// Namespaces of the CSW 2.0.2 documents
+const (
+	Namespace      = `http://www.opengis.net/cat/csw/2.0.2`
+	OGCNamespace   = `http://www.opengis.net/ogc`
+	OWSNamespace   = `http://www.opengis.net/ows`
+	DCNamespace    = `http://purl.org/dc/elements/1.1/`
+	DCTNamespace   = `http://purl.org/dc/terms/`
+	GMDNamespace   = `http://www.isotc211.org/2005/gmd`
+	XMLSchema      = `http://www.w3.org/XML/Schema`
+	applicationXML = `application/xml`
+)
+
+// The element sets of the records, from the least to the most elements
+const (
+	Brief   = `brief`
+	Summary = `summary`
+	Full    = `full`
+)
+
+// requestPrefixes contains the prefixes used in the struct tags of the requests, the elements of the Filter Encoding
+// 1.1.0 filters are unprefixed so the wfs110 filter can be used. CSW 2.0.2 uses OWS Common 1.0,
+// the OWS Common 1.1 parameters of clients that mix them up are accepted as well.
+var requestPrefixes = utils.Prefixes{
+	Namespace:        `csw`,
+	OGCNamespace:     ``,
+	OWSNamespace:     `ows`,
+	wsc110.Namespace: `ows`,
+}
+
+// newEncoder returns an Encoder for the requests, declaring the OWS Common 1.0 namespace for the ows prefix
+func newEncoder(prefixes utils.Prefixes) *utils.Encoder {
+	e := utils.NewEncoder(prefixes)
+	e.Register(`ows`, OWSNamespace)
+	return e
+}
+
+// elementSetNames contains the valid element set names
+var elementSetNames = []string{Brief, Summary, Full}
+
+// outputSchemas contains the schemas the records can be returned in
+var outputSchemas = []string{Namespace, GMDNamespace}
+
+// BaseRequest contains the attributes every CSW 2.0.2 request, except GetCapabilities, has
+type BaseRequest struct {
+	Service string             `xml:"service,attr" yaml:"service"`
+	Version string             `xml:"version,attr" yaml:"version"`
+	Attr    utils.XMLAttribute `xml:",attr" yaml:"attr"`
+}
+
+// parseQueryParameters builds the BaseRequest from the query parameters, the SERVICE and VERSION are mandatory
+func (b *BaseRequest) parseQueryParameters(query url.Values) wsc110.Exceptions {
+	var exceptions wsc110.Exceptions
+	b.Service, b.Version = first(query, SERVICE), first(query, VERSION)
+	for _, k := range []struct{ key, value, expected string }{{SERVICE, b.Service, Service}, {VERSION, b.Version, Version}} {
+		switch {
+		case k.value == ``:
+			exceptions = append(exceptions, wsc110.MissingParameterValue(k.key))
+		case !strings.EqualFold(k.value, k.expected):
+			exceptions = append(exceptions, wsc110.InvalidParameterValue(k.value, k.key))
+		}
+	}
+	return exceptions
+}
+
+// setQueryParameters adds the base parameters and the request to the query
+func (b BaseRequest) setQueryParameters(query url.Values, request string) {
+	query[SERVICE] = []string{b.Service}
+	query[VERSION] = []string{b.Version}
+	query[REQUEST] = []string{request}
+}
+
+// stripAttr returns the attributes of a XML request that aren't parameters of the request
+func stripAttr(attr utils.XMLAttribute, parameters ...string) utils.XMLAttribute {
+	var n []xml.Attr
+	for _, a := range attr {
+		if !contains(parameters, a.Name.Local) {
+			n = append(n, a)
+		}
+	}
+	return utils.StripDuplicateAttr(n)
+}
+
+// first returns the first value of the key in the query, the keys are case insensitive
+func first(query url.Values, key string) string {
+	for k, v := range query {
+		if strings.EqualFold(k, key) && len(v) > 0 {
+			return v[0]
+		}
+	}
+	return ``
+}
+
+// list splits a comma separated list, leaving out the empty items
+func list(s string) []string {
+	var items []string
+	for _, item := range strings.Split(s, `,`) {
+		if item = strings.TrimSpace(item); item != `` {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func sp(s string) *string {
+	return &s
+}
+
+func ip(i int) *int {
+	return &i
+}
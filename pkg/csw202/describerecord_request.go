@@ -0,0 +1,81 @@
+package csw202
+
+import (
+	"encoding/xml"
+	"net/url"
+
+	"github.com/pdok/ogc-specifications/pkg/utils"
+	"github.com/pdok/ogc-specifications/pkg/wsc110"
+)
+
+// DescribeRecord Keys
+const (
+	TYPENAME       = `TYPENAME`
+	SCHEMALANGUAGE = `SCHEMALANGUAGE`
+)
+
+// DescribeRecordRequest struct with the needed parameters/attributes needed for making a DescribeRecord request
+type DescribeRecordRequest struct {
+	XMLName xml.Name `xml:"csw:DescribeRecord" yaml:"describeRecord"`
+	BaseRequest
+	OutputFormat   *string  `xml:"outputFormat,attr,omitempty" yaml:"outputFormat,omitempty"`
+	SchemaLanguage *string  `xml:"schemaLanguage,attr,omitempty" yaml:"schemaLanguage,omitempty"`
+	TypeName       []string `xml:"csw:TypeName" yaml:"typeName,omitempty"`
+}
+
+// Type returns DescribeRecord
+func (r DescribeRecordRequest) Type() string {
+	return describerecord
+}
+
+// Validate checks the values of the DescribeRecord request, the schemas are described in XML Schema
+func (r DescribeRecordRequest) Validate(_ wsc110.Capabilities) wsc110.Exceptions {
+	var exceptions wsc110.Exceptions
+	if r.OutputFormat != nil && *r.OutputFormat != applicationXML {
+		exceptions = append(exceptions, wsc110.InvalidParameterValue(*r.OutputFormat, OUTPUTFORMAT))
+	}
+	if r.SchemaLanguage != nil && *r.SchemaLanguage != XMLSchema && *r.SchemaLanguage != `XMLSCHEMA` {
+		exceptions = append(exceptions, wsc110.InvalidParameterValue(*r.SchemaLanguage, SCHEMALANGUAGE))
+	}
+	if len(exceptions) > 0 {
+		return exceptions
+	}
+	return nil
+}
+
+// ParseXML builds a DescribeRecord object based on a XML document
+func (r *DescribeRecordRequest) ParseXML(doc []byte) wsc110.Exceptions {
+	var xmlattributes utils.XMLAttribute
+	if err := xml.Unmarshal(doc, &xmlattributes); err != nil {
+		return wsc110.Exceptions{wsc110.MissingParameterValue()}
+	}
+	if err := utils.UnmarshalPrefixed(doc, r, requestPrefixes); err != nil {
+		return wsc110.Exceptions{wsc110.NoApplicableCode(err.Error())}
+	}
+	r.Attr = stripAttr(xmlattributes, `service`, `version`, `outputFormat`, `schemaLanguage`)
+	return nil
+}
+
+// ParseQueryParameters builds a DescribeRecord object based on the available query parameters
+func (r *DescribeRecordRequest) ParseQueryParameters(query url.Values) wsc110.Exceptions {
+	var rpv describeRecordRequestParameterValue
+	if exceptions := rpv.parseQueryParameters(query); exceptions != nil {
+		return exceptions
+	}
+	return r.parseDescribeRecordRequestParameterValue(rpv)
+}
+
+// ToQueryParameters builds a new query string that will be proxied
+func (r DescribeRecordRequest) ToQueryParameters() url.Values {
+	var rpv describeRecordRequestParameterValue
+	rpv.parseDescribeRecordRequest(r)
+	return rpv.toQueryParameters()
+}
+
+// ToXML builds a 'new' XML document 'based' on the 'original' XML document
+func (r DescribeRecordRequest) ToXML() []byte {
+	r.XMLName = xml.Name{}
+	e := newEncoder(requestPrefixes)
+	doc, _ := e.Marshal(r)
+	return doc
+}
@@ -0,0 +1,86 @@
+package csw202
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/pdok/ogc-specifications/pkg/wsc110"
+)
+
+type describeRecordRequestParameterValue struct {
+	BaseRequest
+	namespace      *string `yaml:"namespace"`
+	typeName       *string `yaml:"typeName"`       // [0..*]
+	outputFormat   *string `yaml:"outputFormat"`   // default: application/xml
+	schemaLanguage *string `yaml:"schemaLanguage"` // default: http://www.w3.org/XML/Schema
+}
+
+func (dpv *describeRecordRequestParameterValue) parseQueryParameters(query url.Values) wsc110.Exceptions {
+	exceptions := dpv.BaseRequest.parseQueryParameters(query)
+	for k, v := range query {
+		if len(v) != 1 {
+			exceptions = append(exceptions, wsc110.InvalidParameterValue(strings.Join(v, ","), k))
+			continue
+		}
+		vp := v[0]
+		switch strings.ToUpper(k) {
+		case NAMESPACE:
+			dpv.namespace = &vp
+		case TYPENAME:
+			dpv.typeName = &vp
+		case OUTPUTFORMAT:
+			dpv.outputFormat = &vp
+		case SCHEMALANGUAGE:
+			dpv.schemaLanguage = &vp
+		}
+	}
+
+	if len(exceptions) > 0 {
+		return exceptions
+	}
+	return nil
+}
+
+func (r *DescribeRecordRequest) parseDescribeRecordRequestParameterValue(dpv describeRecordRequestParameterValue) wsc110.Exceptions {
+	r.XMLName.Local = describerecord
+	r.BaseRequest = BaseRequest{Service: dpv.Service, Version: dpv.Version}
+	if dpv.namespace != nil {
+		attr, ok := parseNamespaces(*dpv.namespace)
+		if !ok {
+			return wsc110.Exceptions{wsc110.InvalidParameterValue(*dpv.namespace, NAMESPACE)}
+		}
+		r.Attr = attr
+	}
+	if dpv.typeName != nil {
+		r.TypeName = list(*dpv.typeName)
+	}
+	r.OutputFormat = dpv.outputFormat
+	r.SchemaLanguage = dpv.schemaLanguage
+	return nil
+}
+
+func (dpv *describeRecordRequestParameterValue) parseDescribeRecordRequest(r DescribeRecordRequest) {
+	dpv.BaseRequest = BaseRequest{Service: r.Service, Version: r.Version}
+	if ns := namespaces(r.Attr); ns != `` {
+		dpv.namespace = &ns
+	}
+	if len(r.TypeName) > 0 {
+		dpv.typeName = sp(strings.Join(r.TypeName, `,`))
+	}
+	dpv.outputFormat = r.OutputFormat
+	dpv.schemaLanguage = r.SchemaLanguage
+}
+
+func (dpv describeRecordRequestParameterValue) toQueryParameters() url.Values {
+	query := url.Values{}
+	dpv.BaseRequest.setQueryParameters(query, describerecord)
+	for _, p := range []struct {
+		key   string
+		value *string
+	}{{NAMESPACE, dpv.namespace}, {TYPENAME, dpv.typeName}, {OUTPUTFORMAT, dpv.outputFormat}, {SCHEMALANGUAGE, dpv.schemaLanguage}} {
+		if p.value != nil {
+			query[p.key] = []string{*p.value}
+		}
+	}
+	return query
+}
@@ -0,0 +1,93 @@
+package csw202
+
+import (
+	"encoding/xml"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/pdok/ogc-specifications/pkg/wsc110"
+)
+
+func TestDescribeRecordParseQueryParameters(t *testing.T) {
+	var tests = []struct {
+		query     url.Values
+		result    DescribeRecordRequest
+		exception string
+	}{
+		0: {query: url.Values{SERVICE: {`CSW`}, VERSION: {`2.0.2`}, REQUEST: {`DescribeRecord`}},
+			result: DescribeRecordRequest{BaseRequest: BaseRequest{Service: Service, Version: Version}}},
+		1: {query: url.Values{`service`: {`CSW`}, `version`: {`2.0.2`}, `request`: {`DescribeRecord`}, `typeName`: {`csw:Record,gmd:MD_Metadata`},
+			`namespace`: {`xmlns(gmd=http://www.isotc211.org/2005/gmd)`}, `schemaLanguage`: {XMLSchema}},
+			result: DescribeRecordRequest{BaseRequest: BaseRequest{Service: Service, Version: Version,
+				Attr: []xml.Attr{{Name: xml.Name{Space: `xmlns`, Local: `gmd`}, Value: GMDNamespace}}},
+				TypeName: []string{`csw:Record`, `gmd:MD_Metadata`}, SchemaLanguage: sp(XMLSchema)}},
+		2: {query: url.Values{SERVICE: {`CSW`}, VERSION: {`2.0.2`}, REQUEST: {`DescribeRecord`}, NAMESPACE: {`gmd=http://www.isotc211.org/2005/gmd`}},
+			exception: `InvalidParameterValue`},
+		3: {query: url.Values{SERVICE: {`CSW`}, REQUEST: {`DescribeRecord`}}, exception: `MissingParameterValue`},
+	}
+
+	for k, test := range tests {
+		var r DescribeRecordRequest
+		exceptions := r.ParseQueryParameters(test.query)
+		if test.exception != `` {
+			if len(exceptions) != 1 || exceptions[0].Code() != test.exception {
+				t.Errorf("test: %d, expected: %s,\n got: %v", k, test.exception, exceptions)
+			}
+			continue
+		}
+		if exceptions != nil {
+			t.Errorf("test: %d, expected no exceptions,\n got: %v", k, exceptions)
+			continue
+		}
+		test.result.XMLName.Local = describerecord
+		if !reflect.DeepEqual(r, test.result) {
+			t.Errorf("test: %d, expected: %+v,\n got: %+v", k, test.result, r)
+		}
+	}
+}
+
+func TestDescribeRecordToQueryParameters(t *testing.T) {
+	r := DescribeRecordRequest{BaseRequest: BaseRequest{Service: Service, Version: Version,
+		Attr: []xml.Attr{{Name: xml.Name{Space: `xmlns`, Local: `csw`}, Value: Namespace}}}, TypeName: []string{`csw:Record`}}
+	expected := url.Values{SERVICE: {`CSW`}, VERSION: {`2.0.2`}, REQUEST: {`DescribeRecord`},
+		NAMESPACE: {`xmlns(csw=http://www.opengis.net/cat/csw/2.0.2)`}, TYPENAME: {`csw:Record`}}
+	if query := r.ToQueryParameters(); !reflect.DeepEqual(query, expected) {
+		t.Errorf("test: %d, expected: %v,\n got: %v", 0, expected, query)
+	}
+}
+
+func TestDescribeRecordParseXML(t *testing.T) {
+	doc := `<csw:DescribeRecord xmlns:csw="http://www.opengis.net/cat/csw/2.0.2" service="CSW" version="2.0.2" outputFormat="application/xml"><csw:TypeName>csw:Record</csw:TypeName></csw:DescribeRecord>`
+	var r DescribeRecordRequest
+	if exceptions := r.ParseXML([]byte(doc)); exceptions != nil {
+		t.Fatalf("test: %d, expected no exceptions,\n got: %v", 0, exceptions)
+	}
+	if !reflect.DeepEqual(r.TypeName, []string{`csw:Record`}) || r.OutputFormat == nil || *r.OutputFormat != `application/xml` {
+		t.Errorf("test: %d, expected: %s,\n got: %+v", 0, doc, r)
+	}
+	// the namespace declarations of the document are kept as attributes
+	expected := `<csw:DescribeRecord service="CSW" version="2.0.2" xmlns:csw="http://www.opengis.net/cat/csw/2.0.2" outputFormat="application/xml"><csw:TypeName>csw:Record</csw:TypeName></csw:DescribeRecord>`
+	if result := string(r.ToXML()); !strings.HasSuffix(result, expected) {
+		t.Errorf("test: %d, expected: %s,\n got: %s", 0, expected, result)
+	}
+}
+
+func TestDescribeRecordValidate(t *testing.T) {
+	var tests = []struct {
+		request    DescribeRecordRequest
+		exceptions wsc110.Exceptions
+	}{
+		0: {request: DescribeRecordRequest{OutputFormat: sp(`application/xml`), SchemaLanguage: sp(`XMLSCHEMA`)}},
+		1: {request: DescribeRecordRequest{OutputFormat: sp(`application/json`), SchemaLanguage: sp(`http://www.w3.org/TR/xmlschema-1/`)},
+			exceptions: wsc110.Exceptions{wsc110.InvalidParameterValue(`application/json`, OUTPUTFORMAT),
+				wsc110.InvalidParameterValue(`http://www.w3.org/TR/xmlschema-1/`, SCHEMALANGUAGE)}},
+	}
+
+	for k, test := range tests {
+		if exceptions := test.request.Validate(nil); !reflect.DeepEqual(exceptions, test.exceptions) {
+			t.Errorf("test: %d, expected: %v,\n got: %v", k, test.exceptions, exceptions)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package csw202
+
+import (
+	"encoding/xml"
+	"net/url"
+	"strings"
+
+	"github.com/pdok/ogc-specifications/pkg/utils"
+	"github.com/pdok/ogc-specifications/pkg/wsc110"
+)
+
+// GetCapabilitiesRequest struct with the needed parameters/attributes needed for making a GetCapabilities request
+type GetCapabilitiesRequest struct {
+	XMLName                          xml.Name           `xml:"csw:GetCapabilities" yaml:"getCapabilities"`
+	Service                          string             `xml:"service,attr" yaml:"service"`
+	Attr                             utils.XMLAttribute `xml:",attr" yaml:"attr"`
+	wsc110.GetCapabilitiesParameters `yaml:",inline"`
+}
+
+// Type returns GetCapabilities
+func (gc GetCapabilitiesRequest) Type() string {
+	return getcapabilities
+}
+
+// Validate validates the OWS Common parameters of the GetCapabilities request
+func (gc GetCapabilitiesRequest) Validate(_ wsc110.Capabilities) wsc110.Exceptions {
+	var exceptions wsc110.Exceptions
+	if _, exception := gc.NegotiateVersion(Version); exception != nil {
+		exceptions = append(exceptions, exception)
+	}
+	exceptions = append(exceptions, gc.ValidateSections(sections...)...)
+	return exceptions
+}
+
+// ParseXML builds a GetCapabilities object based on a XML document
+func (gc *GetCapabilitiesRequest) ParseXML(body []byte) wsc110.Exceptions {
+	var xmlattributes utils.XMLAttribute
+	if err := xml.Unmarshal(body, &xmlattributes); err != nil {
+		return wsc110.Exceptions{wsc110.MissingParameterValue()}
+	}
+	if err := utils.UnmarshalPrefixed(body, gc, requestPrefixes); err != nil {
+		return wsc110.Exceptions{wsc110.NoApplicableCode(err.Error())}
+	}
+	var n []xml.Attr
+	for _, a := range xmlattributes {
+		switch strings.ToUpper(a.Name.Local) {
+		case SERVICE:
+		case wsc110.UPDATESEQUENCE:
+		default:
+			n = append(n, a)
+		}
+	}
+
+	gc.Attr = utils.StripDuplicateAttr(n)
+	return nil
+}
+
+// ParseQueryParameters builds a GetCapabilities object based on the available query parameters
+func (gc *GetCapabilitiesRequest) ParseQueryParameters(query url.Values) wsc110.Exceptions {
+	for k, v := range query {
+		switch strings.ToUpper(k) {
+		case REQUEST:
+			if strings.EqualFold(v[0], getcapabilities) {
+				gc.XMLName.Local = getcapabilities
+			}
+		case SERVICE:
+			gc.Service = strings.ToUpper(v[0])
+		}
+	}
+	return gc.GetCapabilitiesParameters.ParseQueryParameters(query)
+}
+
+// ToQueryParameters builds a new query string that will be proxied
+func (gc GetCapabilitiesRequest) ToQueryParameters() url.Values {
+	querystring := make(map[string][]string)
+	querystring[REQUEST] = []string{getcapabilities}
+	querystring[SERVICE] = []string{gc.Service}
+	gc.GetCapabilitiesParameters.SetQueryParameters(querystring)
+
+	return querystring
+}
+
+// ToXML builds a 'new' XML document 'based' on the 'original' XML document
+func (gc GetCapabilitiesRequest) ToXML() []byte {
+	gc.XMLName = xml.Name{}
+	e := newEncoder(requestPrefixes)
+	doc, _ := e.Marshal(gc)
+	return doc
+}
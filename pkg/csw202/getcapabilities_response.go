@@ -0,0 +1,142 @@
+package csw202
+
+import (
+	"encoding/xml"
+
+	"github.com/pdok/ogc-specifications/pkg/utils"
+	"github.com/pdok/ogc-specifications/pkg/wsc110"
+)
+
+// capabilitiesPrefixes contains the prefixes of the namespaces used in the struct tags of the CSW 2.0.2 capabilities
+var capabilitiesPrefixes = utils.Prefixes{
+	Namespace:        `csw`,
+	OWSNamespace:     `ows`,
+	wsc110.Namespace: `ows`,
+	OGCNamespace:     `ogc`,
+}
+
+// Type function needed for the interface
+func (gc GetCapabilitiesResponse) Type() string {
+	return getcapabilities
+}
+
+// Service function needed for the interface
+func (gc GetCapabilitiesResponse) Service() string {
+	return Service
+}
+
+// Version function needed for the interface
+func (gc GetCapabilitiesResponse) Version() string {
+	return Version
+}
+
+// Validate function of the csw202 spec
+func (gc GetCapabilitiesResponse) Validate() wsc110.Exceptions {
+	return nil
+}
+
+// ParseXML builds a GetCapabilitiesResponse from a CSW 2.0.2 capabilities document,
+// regardless of the namespace prefixes used in the document
+func (gc *GetCapabilitiesResponse) ParseXML(doc []byte) error {
+	return utils.UnmarshalPrefixed(doc, gc, capabilitiesPrefixes)
+}
+
+// ParseYAML builds a GetCapabilitiesResponse from a YAML document, unknown keys are ignored
+func (gc *GetCapabilitiesResponse) ParseYAML(doc []byte) error {
+	return utils.UnmarshalYAML(doc, gc, utils.Lenient)
+}
+
+// ToXML builds a GetCapabilities response object
+func (gc GetCapabilitiesResponse) ToXML() []byte {
+	e := newEncoder(capabilitiesPrefixes)
+	doc, _ := e.Marshal(gc)
+	return doc
+}
+
+// GetCapabilitiesResponse base struct
+type GetCapabilitiesResponse struct {
+	XMLName               xml.Name `xml:"csw:Capabilities" yaml:"capabilities"`
+	Namespaces            `yaml:"namespaces"`
+	ServiceIdentification ServiceIdentification   `xml:"ows:ServiceIdentification" yaml:"serviceIdentification"`
+	ServiceProvider       *wsc110.ServiceProvider `xml:"ows:ServiceProvider,omitempty" yaml:"serviceProvider"`
+	OperationsMetadata    *OperationsMetadata     `xml:"ows:OperationsMetadata,omitempty" yaml:"operationsMetadata"`
+	FilterCapabilities    *FilterCapabilities     `xml:"ogc:Filter_Capabilities,omitempty" yaml:"filterCapabilities"`
+}
+
+// Namespaces struct containing the attributes of the root element, the namespaces are declared when they are used
+type Namespaces struct {
+	Version        string `xml:"version,attr" yaml:"version"`
+	UpdateSequence string `xml:"updateSequence,attr,omitempty" yaml:"updateSequence,omitempty"`
+	SchemaLocation string `xml:"xsi:schemaLocation,attr,omitempty" yaml:"schemaLocation"`
+}
+
+// ServiceIdentification struct should only be fill by the "template" configuration csw202.yaml
+type ServiceIdentification struct {
+	Title              string           `xml:"ows:Title" yaml:"title"`
+	Abstract           string           `xml:"ows:Abstract" yaml:"abstract"`
+	Keywords           *wsc110.Keywords `xml:"ows:Keywords,omitempty" yaml:"keywords"`
+	ServiceType        string           `xml:"ows:ServiceType" yaml:"serviceType"`
+	ServiceTypeVersion string           `xml:"ows:ServiceTypeVersion" yaml:"serviceTypeVersion"`
+	Fees               string           `xml:"ows:Fees" yaml:"fees"`
+	AccessConstraints  string           `xml:"ows:AccessConstraints" yaml:"accessConstraints"`
+}
+
+// OperationsMetadata contains the operations of the catalogue and the parameters and constraints they share
+type OperationsMetadata struct {
+	Operation  []Operation  `xml:"ows:Operation" yaml:"operation"`
+	Parameter  []DomainType `xml:"ows:Parameter" yaml:"parameter,omitempty"`
+	Constraint []DomainType `xml:"ows:Constraint" yaml:"constraint,omitempty"`
+}
+
+// Operation struct for the CSW 2.0.2
+type Operation struct {
+	Name string `xml:"name,attr" yaml:"name"`
+	DCP  struct {
+		HTTP struct {
+			Get  []Method `xml:"ows:Get" yaml:"get,omitempty"`
+			Post []Method `xml:"ows:Post" yaml:"post,omitempty"`
+		} `xml:"ows:HTTP" yaml:"http"`
+	} `xml:"ows:DCP" yaml:"dcp"`
+	Parameter  []DomainType `xml:"ows:Parameter" yaml:"parameter,omitempty"`
+	Constraint []DomainType `xml:"ows:Constraint" yaml:"constraint,omitempty"`
+}
+
+// Method is the endpoint of an operation, the constraints distinguish the endpoints, like the POST encoding
+type Method struct {
+	Type       string       `xml:"xlink:type,attr,omitempty" yaml:"type,omitempty"`
+	Href       string       `xml:"xlink:href,attr" yaml:"href"`
+	Constraint []DomainType `xml:"ows:Constraint" yaml:"constraint,omitempty"`
+}
+
+// DomainType is an OWS Common 1.0 parameter or constraint with its allowed values
+type DomainType struct {
+	Name  string   `xml:"name,attr" yaml:"name"`
+	Value []string `xml:"ows:Value" yaml:"value"`
+}
+
+// FilterCapabilities lists the Filter Encoding 1.1.0 operators the catalogue supports in a constraint
+type FilterCapabilities struct {
+	SpatialCapabilities SpatialCapabilities `xml:"ogc:Spatial_Capabilities" yaml:"spatialCapabilities"`
+	ScalarCapabilities  ScalarCapabilities  `xml:"ogc:Scalar_Capabilities" yaml:"scalarCapabilities"`
+	IDCapabilities      IDCapabilities      `xml:"ogc:Id_Capabilities" yaml:"idCapabilities"`
+}
+
+// SpatialCapabilities lists the geometries and spatial operators of the filters
+type SpatialCapabilities struct {
+	GeometryOperand []string `xml:"ogc:GeometryOperands>ogc:GeometryOperand" yaml:"geometryOperand"`
+	SpatialOperator []struct {
+		Name string `xml:"name,attr" yaml:"name"`
+	} `xml:"ogc:SpatialOperators>ogc:SpatialOperator" yaml:"spatialOperator"`
+}
+
+// ScalarCapabilities lists the logical and comparison operators of the filters
+type ScalarCapabilities struct {
+	LogicalOperators   *struct{} `xml:"ogc:LogicalOperators" yaml:"logicalOperators,omitempty"`
+	ComparisonOperator []string  `xml:"ogc:ComparisonOperators>ogc:ComparisonOperator" yaml:"comparisonOperator"`
+}
+
+// IDCapabilities lists the kinds of identifiers of the filters
+type IDCapabilities struct {
+	EID *struct{} `xml:"ogc:EID" yaml:"eid,omitempty"`
+	FID *struct{} `xml:"ogc:FID" yaml:"fid,omitempty"`
+}
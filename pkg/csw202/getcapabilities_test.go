@@ -0,0 +1,164 @@
+package csw202
+
+import (
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/pdok/ogc-specifications/pkg/wsc110"
+)
+
+func TestGetCapabilitiesParseQueryParameters(t *testing.T) {
+	var tests = []struct {
+		query     url.Values
+		sections  []string
+		versions  []string
+		exception string
+	}{
+		0: {query: url.Values{SERVICE: {`CSW`}, REQUEST: {`GetCapabilities`}}},
+		1: {query: url.Values{`service`: {`csw`}, `request`: {`GetCapabilities`}, `sections`: {`ServiceIdentification,Filter_Capabilities`}, `acceptversions`: {`2.0.2`}},
+			sections: []string{wsc110.ServiceIdentificationSection, FilterCapabilitiesSection}, versions: []string{Version}},
+		2: {query: url.Values{SERVICE: {`CSW`}, REQUEST: {`GetCapabilities`}, wsc110.SECTIONS: {`Contents`}},
+			exception: `InvalidParameterValue`},
+		3: {query: url.Values{SERVICE: {`CSW`}, REQUEST: {`GetCapabilities`}, wsc110.ACCEPTVERSIONS: {`3.0.0`}},
+			exception: `VersionNegotiationFailed`},
+	}
+
+	for k, test := range tests {
+		var gc GetCapabilitiesRequest
+		exceptions := gc.ParseQueryParameters(test.query)
+		if exceptions == nil {
+			exceptions = gc.Validate(nil)
+		}
+		if test.exception != `` {
+			if len(exceptions) != 1 || exceptions[0].Code() != test.exception {
+				t.Errorf("test: %d, expected: %s,\n got: %v", k, test.exception, exceptions)
+			}
+			continue
+		}
+		if len(exceptions) > 0 {
+			t.Errorf("test: %d, expected no exceptions,\n got: %v", k, exceptions)
+			continue
+		}
+		if gc.Service != Service {
+			t.Errorf("test: %d, expected: %s,\n got: %s", k, Service, gc.Service)
+		}
+		if gc.Sections != nil && !reflect.DeepEqual(gc.Sections.Section, test.sections) {
+			t.Errorf("test: %d, expected: %v,\n got: %v", k, test.sections, gc.Sections.Section)
+		}
+		if gc.AcceptVersions != nil && !reflect.DeepEqual(gc.AcceptVersions.Version, test.versions) {
+			t.Errorf("test: %d, expected: %v,\n got: %v", k, test.versions, gc.AcceptVersions.Version)
+		}
+	}
+}
+
+func TestGetCapabilitiesParseXML(t *testing.T) {
+	var tests = []struct {
+		doc      string
+		sections []string
+		query    url.Values
+	}{
+		0: {doc: `<csw:GetCapabilities xmlns:csw="http://www.opengis.net/cat/csw/2.0.2" service="CSW"/>`,
+			query: url.Values{SERVICE: {`CSW`}, REQUEST: {`GetCapabilities`}}},
+		// OWS Common 1.0 and the default namespace for CSW
+		1: {doc: `<GetCapabilities xmlns="http://www.opengis.net/cat/csw/2.0.2" xmlns:o="http://www.opengis.net/ows" service="CSW" updateSequence="3"><o:AcceptVersions><o:Version>2.0.2</o:Version></o:AcceptVersions><o:Sections><o:Section>OperationsMetadata</o:Section></o:Sections></GetCapabilities>`,
+			sections: []string{wsc110.OperationsMetadataSection},
+			query:    url.Values{SERVICE: {`CSW`}, REQUEST: {`GetCapabilities`}, wsc110.ACCEPTVERSIONS: {`2.0.2`}, wsc110.SECTIONS: {`OperationsMetadata`}, wsc110.UPDATESEQUENCE: {`3`}}},
+	}
+
+	for k, test := range tests {
+		var gc GetCapabilitiesRequest
+		if exceptions := gc.ParseXML([]byte(test.doc)); exceptions != nil {
+			t.Errorf("test: %d, expected no exceptions,\n got: %v", k, exceptions)
+			continue
+		}
+		if len(test.sections) > 0 && (gc.Sections == nil || !reflect.DeepEqual(gc.Sections.Section, test.sections)) {
+			t.Errorf("test: %d, expected: %v,\n got: %+v", k, test.sections, gc.Sections)
+		}
+		if query := gc.ToQueryParameters(); !reflect.DeepEqual(query, test.query) {
+			t.Errorf("test: %d, expected: %v,\n got: %v", k, test.query, query)
+		}
+	}
+}
+
+func TestGetCapabilitiesToXML(t *testing.T) {
+	gc := GetCapabilitiesRequest{Service: Service, GetCapabilitiesParameters: wsc110.GetCapabilitiesParameters{
+		Sections: &wsc110.Sections{Section: []string{FilterCapabilitiesSection}}}}
+	expected := `<csw:GetCapabilities xmlns:csw="http://www.opengis.net/cat/csw/2.0.2" xmlns:ows="http://www.opengis.net/ows" service="CSW"><ows:Sections><ows:Section>Filter_Capabilities</ows:Section></ows:Sections></csw:GetCapabilities>`
+	if doc := string(gc.ToXML()); !strings.HasSuffix(doc, expected) {
+		t.Errorf("test: %d, expected: %s,\n got: %s", 0, expected, doc)
+	}
+}
+
+var capabilities = []byte(`<?xml version="1.0" encoding="UTF-8"?>
+<csw:Capabilities xmlns:csw="http://www.opengis.net/cat/csw/2.0.2" xmlns:ows="http://www.opengis.net/ows" xmlns:ogc="http://www.opengis.net/ogc" xmlns:xlink="http://www.w3.org/1999/xlink" version="2.0.2" updateSequence="5">
+  <ows:ServiceIdentification>
+    <ows:Title>Catalogue</ows:Title>
+    <ows:Abstract>The metadata of the services</ows:Abstract>
+    <ows:ServiceType>CSW</ows:ServiceType>
+    <ows:ServiceTypeVersion>2.0.2</ows:ServiceTypeVersion>
+    <ows:Fees>NONE</ows:Fees>
+    <ows:AccessConstraints>NONE</ows:AccessConstraints>
+  </ows:ServiceIdentification>
+  <ows:OperationsMetadata>
+    <ows:Operation name="GetRecords">
+      <ows:DCP><ows:HTTP><ows:Get xlink:href="https://example.com/csw"/><ows:Post xlink:href="https://example.com/csw"/></ows:HTTP></ows:DCP>
+      <ows:Parameter name="resultType"><ows:Value>hits</ows:Value><ows:Value>results</ows:Value></ows:Parameter>
+    </ows:Operation>
+  </ows:OperationsMetadata>
+  <ogc:Filter_Capabilities>
+    <ogc:Spatial_Capabilities/>
+    <ogc:Scalar_Capabilities/>
+    <ogc:Id_Capabilities/>
+  </ogc:Filter_Capabilities>
+</csw:Capabilities>`)
+
+func TestGetCapabilitiesResponse(t *testing.T) {
+	var gc GetCapabilitiesResponse
+	if err := gc.ParseXML(capabilities); err != nil {
+		t.Fatalf("test: %d, expected no error,\n got: %s", 0, err)
+	}
+	if gc.ServiceIdentification.Title != `Catalogue` || gc.Namespaces.UpdateSequence != `5` {
+		t.Errorf("test: %d, expected: %s,\n got: %+v", 0, `Catalogue`, gc)
+	}
+	if gc.OperationsMetadata == nil || len(gc.OperationsMetadata.Operation) != 1 ||
+		gc.OperationsMetadata.Operation[0].DCP.HTTP.Get[0].Href != `https://example.com/csw` {
+		t.Errorf("test: %d, expected a GetRecords operation,\n got: %+v", 0, gc.OperationsMetadata)
+	}
+	if gc.FilterCapabilities == nil {
+		t.Errorf("test: %d, expected the filter capabilities,\n got: %+v", 0, gc)
+	}
+
+	var tests = []struct {
+		request  GetCapabilitiesRequest
+		contains []string
+		missing  []string
+	}{
+		0: {contains: []string{`<ows:Title>Catalogue</ows:Title>`, `<ows:Operation name="GetRecords">`, `<ogc:Filter_Capabilities>`}},
+		1: {request: GetCapabilitiesRequest{GetCapabilitiesParameters: wsc110.GetCapabilitiesParameters{Sections: &wsc110.Sections{Section: []string{FilterCapabilitiesSection}}}},
+			contains: []string{`<ogc:Filter_Capabilities>`, `xmlns:ogc="http://www.opengis.net/ogc"`},
+			missing:  []string{`ServiceIdentification`, `OperationsMetadata`}},
+		2: {request: GetCapabilitiesRequest{GetCapabilitiesParameters: wsc110.GetCapabilitiesParameters{UpdateSequence: `5`}},
+			missing: []string{`ServiceIdentification`, `OperationsMetadata`, `Filter_Capabilities`}},
+	}
+
+	for k, test := range tests {
+		trimmed, exceptions := gc.Trim(test.request)
+		if exceptions != nil {
+			t.Errorf("test: %d, expected no exceptions,\n got: %v", k, exceptions)
+			continue
+		}
+		doc := string(trimmed.ToXML())
+		for _, c := range test.contains {
+			if !strings.Contains(doc, c) {
+				t.Errorf("test: %d, expected: %s,\n got: %s", k, c, doc)
+			}
+		}
+		for _, m := range test.missing {
+			if strings.Contains(doc, m) {
+				t.Errorf("test: %d, expected no: %s,\n got: %s", k, m, doc)
+			}
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package csw202
+
+import (
+	"encoding/xml"
+	"net/url"
+
+	"github.com/pdok/ogc-specifications/pkg/utils"
+	"github.com/pdok/ogc-specifications/pkg/wsc110"
+)
+
+// GetRecordById Keys
+const (
+	ID = `ID`
+)
+
+// GetRecordByIDRequest struct with the needed parameters/attributes needed for making a GetRecordById request
+type GetRecordByIDRequest struct {
+	XMLName xml.Name `xml:"csw:GetRecordById" yaml:"getRecordById"`
+	BaseRequest
+	OutputFormat   *string         `xml:"outputFormat,attr,omitempty" yaml:"outputFormat,omitempty"`
+	OutputSchema   *string         `xml:"outputSchema,attr,omitempty" yaml:"outputSchema,omitempty"`
+	ID             []string        `xml:"csw:Id" yaml:"id"`
+	ElementSetName *ElementSetName `xml:"csw:ElementSetName,omitempty" yaml:"elementSetName,omitempty"`
+}
+
+// Type returns GetRecordById
+func (r GetRecordByIDRequest) Type() string {
+	return getrecordbyid
+}
+
+// Validate checks the values of the GetRecordById request
+func (r GetRecordByIDRequest) Validate(_ wsc110.Capabilities) wsc110.Exceptions {
+	var exceptions wsc110.Exceptions
+	if len(r.ID) == 0 {
+		exceptions = append(exceptions, wsc110.MissingParameterValue(ID))
+	}
+	if r.OutputSchema != nil && !contains(outputSchemas, *r.OutputSchema) {
+		exceptions = append(exceptions, wsc110.InvalidParameterValue(*r.OutputSchema, OUTPUTSCHEMA))
+	}
+	if r.ElementSetName != nil && !contains(elementSetNames, r.ElementSetName.Value) {
+		exceptions = append(exceptions, wsc110.InvalidParameterValue(r.ElementSetName.Value, ELEMENTSETNAME))
+	}
+	if len(exceptions) > 0 {
+		return exceptions
+	}
+	return nil
+}
+
+// ParseXML builds a GetRecordById object based on a XML document
+func (r *GetRecordByIDRequest) ParseXML(doc []byte) wsc110.Exceptions {
+	var xmlattributes utils.XMLAttribute
+	if err := xml.Unmarshal(doc, &xmlattributes); err != nil {
+		return wsc110.Exceptions{wsc110.MissingParameterValue()}
+	}
+	if err := utils.UnmarshalPrefixed(doc, r, requestPrefixes); err != nil {
+		return wsc110.Exceptions{wsc110.NoApplicableCode(err.Error())}
+	}
+	r.Attr = stripAttr(xmlattributes, `service`, `version`, `outputFormat`, `outputSchema`)
+	return nil
+}
+
+// ParseQueryParameters builds a GetRecordById object based on the available query parameters
+func (r *GetRecordByIDRequest) ParseQueryParameters(query url.Values) wsc110.Exceptions {
+	var rpv getRecordByIDRequestParameterValue
+	if exceptions := rpv.parseQueryParameters(query); exceptions != nil {
+		return exceptions
+	}
+	r.parseGetRecordByIDRequestParameterValue(rpv)
+	return nil
+}
+
+// ToQueryParameters builds a new query string that will be proxied
+func (r GetRecordByIDRequest) ToQueryParameters() url.Values {
+	var rpv getRecordByIDRequestParameterValue
+	rpv.parseGetRecordByIDRequest(r)
+	return rpv.toQueryParameters()
+}
+
+// ToXML builds a 'new' XML document 'based' on the 'original' XML document
+func (r GetRecordByIDRequest) ToXML() []byte {
+	r.XMLName = xml.Name{}
+	e := newEncoder(requestPrefixes)
+	doc, _ := e.Marshal(r)
+	return doc
+}
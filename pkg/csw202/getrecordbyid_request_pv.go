@@ -0,0 +1,83 @@
+package csw202
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/pdok/ogc-specifications/pkg/wsc110"
+)
+
+type getRecordByIDRequestParameterValue struct {
+	BaseRequest
+	id             string  `yaml:"id"`
+	elementSetName *string `yaml:"elementSetName"` // default: summary
+	outputFormat   *string `yaml:"outputFormat"`   // default: application/xml
+	outputSchema   *string `yaml:"outputSchema"`   // default: http://www.opengis.net/cat/csw/2.0.2
+}
+
+func (gpv *getRecordByIDRequestParameterValue) parseQueryParameters(query url.Values) wsc110.Exceptions {
+	exceptions := gpv.BaseRequest.parseQueryParameters(query)
+	for k, v := range query {
+		if len(v) != 1 {
+			exceptions = append(exceptions, wsc110.InvalidParameterValue(strings.Join(v, ","), k))
+			continue
+		}
+		vp := v[0]
+		switch strings.ToUpper(k) {
+		case ID:
+			gpv.id = vp
+		case ELEMENTSETNAME:
+			gpv.elementSetName = &vp
+		case OUTPUTFORMAT:
+			gpv.outputFormat = &vp
+		case OUTPUTSCHEMA:
+			gpv.outputSchema = &vp
+		}
+	}
+
+	if gpv.id == `` {
+		exceptions = append(exceptions, wsc110.MissingParameterValue(ID))
+	}
+
+	if len(exceptions) > 0 {
+		return exceptions
+	}
+	return nil
+}
+
+func (r *GetRecordByIDRequest) parseGetRecordByIDRequestParameterValue(gpv getRecordByIDRequestParameterValue) {
+	r.XMLName.Local = getrecordbyid
+	r.BaseRequest = BaseRequest{Service: gpv.Service, Version: gpv.Version}
+	r.ID = list(gpv.id)
+	if gpv.elementSetName != nil {
+		r.ElementSetName = &ElementSetName{Value: *gpv.elementSetName}
+	}
+	r.OutputFormat = gpv.outputFormat
+	r.OutputSchema = gpv.outputSchema
+}
+
+func (gpv *getRecordByIDRequestParameterValue) parseGetRecordByIDRequest(r GetRecordByIDRequest) {
+	gpv.BaseRequest = BaseRequest{Service: r.Service, Version: r.Version}
+	gpv.id = strings.Join(r.ID, `,`)
+	if r.ElementSetName != nil {
+		gpv.elementSetName = &r.ElementSetName.Value
+	}
+	gpv.outputFormat = r.OutputFormat
+	gpv.outputSchema = r.OutputSchema
+}
+
+func (gpv getRecordByIDRequestParameterValue) toQueryParameters() url.Values {
+	query := url.Values{}
+	gpv.BaseRequest.setQueryParameters(query, getrecordbyid)
+	query[ID] = []string{gpv.id}
+	if gpv.elementSetName != nil {
+		query[ELEMENTSETNAME] = []string{*gpv.elementSetName}
+	}
+	if gpv.outputFormat != nil {
+		query[OUTPUTFORMAT] = []string{*gpv.outputFormat}
+	}
+	if gpv.outputSchema != nil {
+		query[OUTPUTSCHEMA] = []string{*gpv.outputSchema}
+	}
+	return query
+}
@@ -0,0 +1,84 @@
+package csw202
+
+import (
+	"encoding/xml"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/pdok/ogc-specifications/pkg/wsc110"
+)
+
+func TestGetRecordByIDParseQueryParameters(t *testing.T) {
+	var tests = []struct {
+		query     url.Values
+		result    GetRecordByIDRequest
+		exception string
+	}{
+		0: {query: url.Values{`service`: {`CSW`}, `version`: {`2.0.2`}, `request`: {`GetRecordById`}, `id`: {`abc-123,def-456`}, `ElementSetName`: {`full`}, `outputSchema`: {GMDNamespace}},
+			result: GetRecordByIDRequest{BaseRequest: BaseRequest{Service: Service, Version: Version}, ID: []string{`abc-123`, `def-456`},
+				ElementSetName: &ElementSetName{Value: Full}, OutputSchema: sp(GMDNamespace)}},
+		1: {query: url.Values{SERVICE: {`CSW`}, VERSION: {`2.0.2`}, REQUEST: {`GetRecordById`}}, exception: `MissingParameterValue`},
+		2: {query: url.Values{SERVICE: {`CSW`}, VERSION: {`2.0.0`}, REQUEST: {`GetRecordById`}, ID: {`abc-123`}}, exception: `InvalidParameterValue`},
+	}
+
+	for k, test := range tests {
+		var r GetRecordByIDRequest
+		exceptions := r.ParseQueryParameters(test.query)
+		if test.exception != `` {
+			if len(exceptions) != 1 || exceptions[0].Code() != test.exception {
+				t.Errorf("test: %d, expected: %s,\n got: %v", k, test.exception, exceptions)
+			}
+			continue
+		}
+		if exceptions != nil {
+			t.Errorf("test: %d, expected no exceptions,\n got: %v", k, exceptions)
+			continue
+		}
+		test.result.XMLName.Local = getrecordbyid
+		if !reflect.DeepEqual(r, test.result) {
+			t.Errorf("test: %d, expected: %+v,\n got: %+v", k, test.result, r)
+		}
+		if query := r.ToQueryParameters(); query.Get(ID) != `abc-123,def-456` || query.Get(ELEMENTSETNAME) != Full {
+			t.Errorf("test: %d, expected: %v,\n got: %v", k, test.query, query)
+		}
+	}
+}
+
+func TestGetRecordByIDParseXML(t *testing.T) {
+	doc := `<GetRecordById xmlns="http://www.opengis.net/cat/csw/2.0.2" service="CSW" version="2.0.2" outputSchema="http://www.opengis.net/cat/csw/2.0.2"><Id>abc-123</Id><ElementSetName>brief</ElementSetName></GetRecordById>`
+	expected := GetRecordByIDRequest{BaseRequest: BaseRequest{Service: Service, Version: Version}, OutputSchema: sp(Namespace),
+		ID: []string{`abc-123`}, ElementSetName: &ElementSetName{Value: Brief}}
+
+	var r GetRecordByIDRequest
+	if exceptions := r.ParseXML([]byte(doc)); exceptions != nil {
+		t.Fatalf("test: %d, expected no exceptions,\n got: %v", 0, exceptions)
+	}
+	r.XMLName, r.Attr = xml.Name{}, nil
+	if !reflect.DeepEqual(r, expected) {
+		t.Errorf("test: %d, expected: %+v,\n got: %+v", 0, expected, r)
+	}
+
+	body := `<csw:GetRecordById xmlns:csw="http://www.opengis.net/cat/csw/2.0.2" service="CSW" version="2.0.2" outputSchema="http://www.opengis.net/cat/csw/2.0.2"><csw:Id>abc-123</csw:Id><csw:ElementSetName>brief</csw:ElementSetName></csw:GetRecordById>`
+	if result := string(r.ToXML()); !strings.HasSuffix(result, body) {
+		t.Errorf("test: %d, expected: %s,\n got: %s", 0, body, result)
+	}
+}
+
+func TestGetRecordByIDValidate(t *testing.T) {
+	var tests = []struct {
+		request    GetRecordByIDRequest
+		exceptions wsc110.Exceptions
+	}{
+		0: {request: GetRecordByIDRequest{ID: []string{`abc-123`}, ElementSetName: &ElementSetName{Value: Summary}}},
+		1: {request: GetRecordByIDRequest{ElementSetName: &ElementSetName{Value: `short`}},
+			exceptions: wsc110.Exceptions{wsc110.MissingParameterValue(ID), wsc110.InvalidParameterValue(`short`, ELEMENTSETNAME)}},
+	}
+
+	for k, test := range tests {
+		if exceptions := test.request.Validate(nil); !reflect.DeepEqual(exceptions, test.exceptions) {
+			t.Errorf("test: %d, expected: %v,\n got: %v", k, test.exceptions, exceptions)
+		}
+	}
+}
@@ -0,0 +1,227 @@
+package csw202
+
+import (
+	"encoding/xml"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pdok/ogc-specifications/pkg/utils"
+	"github.com/pdok/ogc-specifications/pkg/wfs110"
+	"github.com/pdok/ogc-specifications/pkg/wsc110"
+)
+
+// GetRecords Keys
+const (
+	REQUESTID                 = `REQUESTID`
+	RESULTTYPE                = `RESULTTYPE`
+	STARTPOSITION             = `STARTPOSITION`
+	MAXRECORDS                = `MAXRECORDS`
+	TYPENAMES                 = `TYPENAMES`
+	ELEMENTNAME               = `ELEMENTNAME`
+	CONSTRAINTLANGUAGE        = `CONSTRAINTLANGUAGE`
+	CONSTRAINT                = `CONSTRAINT`
+	CONSTRAINTLANGUAGEVERSION = `CONSTRAINT_LANGUAGE_VERSION`
+	SORTBY                    = `SORTBY`
+	DISTRIBUTEDSEARCH         = `DISTRIBUTEDSEARCH`
+	HOPCOUNT                  = `HOPCOUNT`
+	RESPONSEHANDLER           = `RESPONSEHANDLER`
+)
+
+// The result types of a GetRecords request, ValidateOnly only validates the request
+const (
+	Hits         = `hits`
+	Results      = `results`
+	ValidateOnly = `validate`
+)
+
+// The constraint languages of a GetRecords request
+const (
+	FilterLanguage = `FILTER`
+	CQLText        = `CQL_TEXT`
+)
+
+// resultTypes contains the valid result types
+var resultTypes = []string{Hits, Results, ValidateOnly}
+
+// GetRecordsRequest struct with the needed parameters/attributes needed for making a GetRecords request
+type GetRecordsRequest struct {
+	XMLName xml.Name `xml:"csw:GetRecords" yaml:"getRecords"`
+	BaseRequest
+	RequestID         *string            `xml:"requestId,attr,omitempty" yaml:"requestId,omitempty"`
+	ResultType        *string            `xml:"resultType,attr,omitempty" yaml:"resultType,omitempty"`
+	OutputFormat      *string            `xml:"outputFormat,attr,omitempty" yaml:"outputFormat,omitempty"`
+	OutputSchema      *string            `xml:"outputSchema,attr,omitempty" yaml:"outputSchema,omitempty"`
+	StartPosition     *int               `xml:"startPosition,attr,omitempty" yaml:"startPosition,omitempty"`
+	MaxRecords        *int               `xml:"maxRecords,attr,omitempty" yaml:"maxRecords,omitempty"`
+	DistributedSearch *DistributedSearch `xml:"csw:DistributedSearch,omitempty" yaml:"distributedSearch,omitempty"`
+	ResponseHandler   []string           `xml:"csw:ResponseHandler" yaml:"responseHandler,omitempty"`
+	Query             Query              `xml:"csw:Query" yaml:"query"`
+}
+
+// DistributedSearch asks the catalogue to pass the query on to the catalogues it knows, for the number of hops
+type DistributedSearch struct {
+	HopCount *int `xml:"hopCount,attr,omitempty" yaml:"hopCount,omitempty"`
+}
+
+// Query selects the records of the type names, with either an element set name or the element names to return
+type Query struct {
+	TypeNames      string          `xml:"typeNames,attr" yaml:"typeNames"`
+	ElementSetName *ElementSetName `xml:"csw:ElementSetName,omitempty" yaml:"elementSetName,omitempty"`
+	ElementName    []string        `xml:"csw:ElementName" yaml:"elementName,omitempty"`
+	Constraint     *Constraint     `xml:"csw:Constraint,omitempty" yaml:"constraint,omitempty"`
+	SortBy         *SortBy         `xml:"SortBy,omitempty" yaml:"sortBy,omitempty"`
+}
+
+// ElementSetName is brief, summary or full
+type ElementSetName struct {
+	TypeNames string `xml:"typeNames,attr,omitempty" yaml:"typeNames,omitempty"`
+	Value     string `xml:",chardata" yaml:"value"`
+}
+
+// Constraint is either a Filter Encoding 1.1.0 filter or an OGC CQL text, the version is that of the constraint language
+type Constraint struct {
+	Version string         `xml:"version,attr" yaml:"version"`
+	Filter  *wfs110.Filter `xml:"Filter,omitempty" yaml:"filter,omitempty"`
+	CqlText *string        `xml:"csw:CqlText,omitempty" yaml:"cqlText,omitempty"`
+}
+
+// SortBy contains the Filter Encoding 1.1.0 sort properties
+type SortBy struct {
+	SortProperty []SortProperty `xml:"SortProperty" yaml:"sortProperty"`
+}
+
+// SortProperty sorts on a property in ASC or DESC order
+type SortProperty struct {
+	PropertyName string `xml:"PropertyName" yaml:"propertyName"`
+	SortOrder    string `xml:"SortOrder,omitempty" yaml:"sortOrder,omitempty"`
+}
+
+// Type returns GetRecords
+func (r GetRecordsRequest) Type() string {
+	return getrecords
+}
+
+// Validate checks the values of the GetRecords request
+//
+//nolint:cyclop
+func (r GetRecordsRequest) Validate(_ wsc110.Capabilities) wsc110.Exceptions {
+	var exceptions wsc110.Exceptions
+	if r.ResultType != nil && !contains(resultTypes, *r.ResultType) {
+		exceptions = append(exceptions, wsc110.InvalidParameterValue(*r.ResultType, RESULTTYPE))
+	}
+	if r.OutputSchema != nil && !contains(outputSchemas, *r.OutputSchema) {
+		exceptions = append(exceptions, wsc110.InvalidParameterValue(*r.OutputSchema, OUTPUTSCHEMA))
+	}
+	if r.StartPosition != nil && *r.StartPosition < 1 {
+		exceptions = append(exceptions, wsc110.InvalidParameterValue(strconv.Itoa(*r.StartPosition), STARTPOSITION))
+	}
+	if r.MaxRecords != nil && *r.MaxRecords < 0 {
+		exceptions = append(exceptions, wsc110.InvalidParameterValue(strconv.Itoa(*r.MaxRecords), MAXRECORDS))
+	}
+	if r.Query.TypeNames == `` {
+		exceptions = append(exceptions, wsc110.MissingParameterValue(TYPENAMES))
+	}
+	if r.Query.ElementSetName != nil {
+		if len(r.Query.ElementName) > 0 {
+			exceptions = append(exceptions, wsc110.NoApplicableCode(`ELEMENTSETNAME and ELEMENTNAME are mutually exclusive`))
+		}
+		if !contains(elementSetNames, r.Query.ElementSetName.Value) {
+			exceptions = append(exceptions, wsc110.InvalidParameterValue(r.Query.ElementSetName.Value, ELEMENTSETNAME))
+		}
+	}
+	if c := r.Query.Constraint; c != nil {
+		if (c.Filter == nil) == (c.CqlText == nil) {
+			exceptions = append(exceptions, wsc110.NoApplicableCode(`a Constraint contains either a Filter or a CqlText`))
+		}
+		if c.Version == `` {
+			exceptions = append(exceptions, wsc110.MissingParameterValue(CONSTRAINTLANGUAGEVERSION))
+		}
+	}
+	if s := r.Query.SortBy; s != nil {
+		for _, p := range s.SortProperty {
+			if p.SortOrder != `` && p.SortOrder != `ASC` && p.SortOrder != `DESC` {
+				exceptions = append(exceptions, wsc110.InvalidParameterValue(p.SortOrder, SORTBY))
+			}
+		}
+	}
+	if len(exceptions) > 0 {
+		return exceptions
+	}
+	return nil
+}
+
+// ParseXML builds a GetRecords object based on a XML document, regardless of the namespace prefixes used in the document
+func (r *GetRecordsRequest) ParseXML(doc []byte) wsc110.Exceptions {
+	var xmlattributes utils.XMLAttribute
+	if err := xml.Unmarshal(doc, &xmlattributes); err != nil {
+		return wsc110.Exceptions{wsc110.MissingParameterValue()}
+	}
+	if err := utils.UnmarshalPrefixed(doc, r, requestPrefixes); err != nil {
+		return wsc110.Exceptions{wsc110.NoApplicableCode(err.Error())}
+	}
+	r.Attr = stripAttr(xmlattributes, `service`, `version`, `requestId`, `resultType`, `outputFormat`, `outputSchema`, `startPosition`, `maxRecords`)
+	return nil
+}
+
+// ParseQueryParameters builds a GetRecords object based on the available query parameters
+func (r *GetRecordsRequest) ParseQueryParameters(query url.Values) wsc110.Exceptions {
+	var rpv getRecordsRequestParameterValue
+	if exceptions := rpv.parseQueryParameters(query); exceptions != nil {
+		return exceptions
+	}
+	return r.parseGetRecordsRequestParameterValue(rpv)
+}
+
+// ToQueryParameters builds a new query string that will be proxied
+func (r GetRecordsRequest) ToQueryParameters() url.Values {
+	var rpv getRecordsRequestParameterValue
+	rpv.parseGetRecordsRequest(r)
+	return rpv.toQueryParameters()
+}
+
+// ToXML builds a 'new' XML document 'based' on the 'original' XML document,
+// the filter, including its GML geometries, is written in the ogc namespace
+func (r GetRecordsRequest) ToXML() []byte {
+	r.XMLName = xml.Name{}
+	e := newEncoder(requestPrefixes)
+	doc, _ := e.Marshal(r)
+	return doc
+}
+
+// namespaces returns the sorted namespace declarations of the attributes in the xmlns([prefix=]uri) notation
+// of the NAMESPACE parameter
+func namespaces(attr utils.XMLAttribute) string {
+	var declarations []string
+	for _, a := range attr {
+		switch {
+		case a.Name.Space == `xmlns`:
+			declarations = append(declarations, `xmlns(`+a.Name.Local+`=`+a.Value+`)`)
+		case strings.HasPrefix(a.Name.Local, `xmlns:`):
+			declarations = append(declarations, `xmlns(`+strings.TrimPrefix(a.Name.Local, `xmlns:`)+`=`+a.Value+`)`)
+		case a.Name.Space == `` && a.Name.Local == `xmlns`:
+			declarations = append(declarations, `xmlns(`+a.Value+`)`)
+		}
+	}
+	sort.Strings(declarations)
+	return strings.Join(declarations, `,`)
+}
+
+// parseNamespaces builds the namespace declarations of the NAMESPACE parameter
+func parseNamespaces(s string) (utils.XMLAttribute, bool) {
+	var attr utils.XMLAttribute
+	for _, declaration := range list(s) {
+		if !strings.HasPrefix(declaration, `xmlns(`) || !strings.HasSuffix(declaration, `)`) {
+			return nil, false
+		}
+		declaration = strings.TrimSuffix(strings.TrimPrefix(declaration, `xmlns(`), `)`)
+		prefix, uri, ok := strings.Cut(declaration, `=`)
+		if !ok {
+			attr = append(attr, xml.Attr{Name: xml.Name{Local: `xmlns`}, Value: declaration})
+			continue
+		}
+		attr = append(attr, xml.Attr{Name: xml.Name{Space: `xmlns`, Local: prefix}, Value: uri})
+	}
+	return attr, true
+}
@@ -0,0 +1,294 @@
+package csw202
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/pdok/ogc-specifications/pkg/wfs110"
+	"github.com/pdok/ogc-specifications/pkg/wsc110"
+)
+
+type getRecordsRequestParameterValue struct {
+	BaseRequest
+	namespace                 *string `yaml:"namespace"`
+	requestID                 *string `yaml:"requestId"`
+	resultType                *string `yaml:"resultType"`   // default: hits
+	outputFormat              *string `yaml:"outputFormat"` // default: application/xml
+	outputSchema              *string `yaml:"outputSchema"` // default: http://www.opengis.net/cat/csw/2.0.2
+	startPosition             *string `yaml:"startPosition"`
+	maxRecords                *string `yaml:"maxRecords"`
+	typeNames                 string  `yaml:"typeNames"`
+	elementSetName            *string `yaml:"elementSetName"`
+	elementName               *string `yaml:"elementName"`
+	constraintLanguage        *string `yaml:"constraintLanguage"`
+	constraint                *string `yaml:"constraint"`
+	constraintLanguageVersion *string `yaml:"constraintLanguageVersion"`
+	sortBy                    *string `yaml:"sortBy"`
+	distributedSearch         *string `yaml:"distributedSearch"`
+	hopCount                  *string `yaml:"hopCount"`
+	responseHandler           *string `yaml:"responseHandler"`
+}
+
+func (gpv *getRecordsRequestParameterValue) parseQueryParameters(query url.Values) wsc110.Exceptions {
+	exceptions := gpv.BaseRequest.parseQueryParameters(query)
+	for k, v := range query {
+		if len(v) != 1 {
+			exceptions = append(exceptions, wsc110.InvalidParameterValue(strings.Join(v, ","), k))
+			continue
+		}
+		vp := v[0]
+		switch strings.ToUpper(k) {
+		case NAMESPACE:
+			gpv.namespace = &vp
+		case REQUESTID:
+			gpv.requestID = &vp
+		case RESULTTYPE:
+			gpv.resultType = &vp
+		case OUTPUTFORMAT:
+			gpv.outputFormat = &vp
+		case OUTPUTSCHEMA:
+			gpv.outputSchema = &vp
+		case STARTPOSITION:
+			gpv.startPosition = &vp
+		case MAXRECORDS:
+			gpv.maxRecords = &vp
+		case TYPENAMES:
+			gpv.typeNames = vp
+		case ELEMENTSETNAME:
+			gpv.elementSetName = &vp
+		case ELEMENTNAME:
+			gpv.elementName = &vp
+		case CONSTRAINTLANGUAGE:
+			gpv.constraintLanguage = &vp
+		case CONSTRAINT:
+			gpv.constraint = &vp
+		case CONSTRAINTLANGUAGEVERSION:
+			gpv.constraintLanguageVersion = &vp
+		case SORTBY:
+			gpv.sortBy = &vp
+		case DISTRIBUTEDSEARCH:
+			gpv.distributedSearch = &vp
+		case HOPCOUNT:
+			gpv.hopCount = &vp
+		case RESPONSEHANDLER:
+			gpv.responseHandler = &vp
+		}
+	}
+
+	if gpv.typeNames == `` {
+		exceptions = append(exceptions, wsc110.MissingParameterValue(TYPENAMES))
+	}
+	if gpv.constraint != nil {
+		if gpv.constraintLanguage == nil {
+			exceptions = append(exceptions, wsc110.MissingParameterValue(CONSTRAINTLANGUAGE))
+		}
+		if gpv.constraintLanguageVersion == nil {
+			exceptions = append(exceptions, wsc110.MissingParameterValue(CONSTRAINTLANGUAGEVERSION))
+		}
+	}
+
+	if len(exceptions) > 0 {
+		return exceptions
+	}
+	return nil
+}
+
+//nolint:cyclop,funlen
+func (r *GetRecordsRequest) parseGetRecordsRequestParameterValue(gpv getRecordsRequestParameterValue) wsc110.Exceptions {
+	var exceptions wsc110.Exceptions
+	r.XMLName.Local = getrecords
+	r.BaseRequest = BaseRequest{Service: gpv.Service, Version: gpv.Version}
+	if gpv.namespace != nil {
+		attr, ok := parseNamespaces(*gpv.namespace)
+		if !ok {
+			exceptions = append(exceptions, wsc110.InvalidParameterValue(*gpv.namespace, NAMESPACE))
+		}
+		r.Attr = attr
+	}
+	r.RequestID = gpv.requestID
+	r.ResultType = gpv.resultType
+	r.OutputFormat = gpv.outputFormat
+	r.OutputSchema = gpv.outputSchema
+	for _, i := range []struct {
+		key   string
+		value *string
+		field **int
+	}{{STARTPOSITION, gpv.startPosition, &r.StartPosition}, {MAXRECORDS, gpv.maxRecords, &r.MaxRecords}} {
+		if i.value == nil {
+			continue
+		}
+		n, err := strconv.Atoi(*i.value)
+		if err != nil {
+			exceptions = append(exceptions, wsc110.InvalidParameterValue(*i.value, i.key))
+			continue
+		}
+		*i.field = &n
+	}
+
+	if gpv.distributedSearch != nil {
+		distributed, err := strconv.ParseBool(*gpv.distributedSearch)
+		if err != nil {
+			exceptions = append(exceptions, wsc110.InvalidParameterValue(*gpv.distributedSearch, DISTRIBUTEDSEARCH))
+		}
+		if distributed {
+			r.DistributedSearch = &DistributedSearch{}
+			if gpv.hopCount != nil {
+				hopCount, err := strconv.Atoi(*gpv.hopCount)
+				if err != nil {
+					exceptions = append(exceptions, wsc110.InvalidParameterValue(*gpv.hopCount, HOPCOUNT))
+				}
+				r.DistributedSearch.HopCount = &hopCount
+			}
+		}
+	}
+	if gpv.responseHandler != nil {
+		r.ResponseHandler = list(*gpv.responseHandler)
+	}
+
+	r.Query = Query{TypeNames: strings.Join(list(gpv.typeNames), ` `)}
+	if gpv.elementSetName != nil {
+		r.Query.ElementSetName = &ElementSetName{Value: *gpv.elementSetName}
+	}
+	if gpv.elementName != nil {
+		r.Query.ElementName = list(*gpv.elementName)
+	}
+	if gpv.constraint != nil && gpv.constraintLanguage != nil {
+		r.Query.Constraint = &Constraint{}
+		if gpv.constraintLanguageVersion != nil {
+			r.Query.Constraint.Version = *gpv.constraintLanguageVersion
+		}
+		switch strings.ToUpper(*gpv.constraintLanguage) {
+		case FilterLanguage:
+			filter, err := wfs110.ParseFilter([]byte(*gpv.constraint))
+			if err != nil {
+				exceptions = append(exceptions, wsc110.InvalidParameterValue(*gpv.constraint, CONSTRAINT))
+			}
+			r.Query.Constraint.Filter = &filter
+		case CQLText:
+			r.Query.Constraint.CqlText = gpv.constraint
+		default:
+			exceptions = append(exceptions, wsc110.InvalidParameterValue(*gpv.constraintLanguage, CONSTRAINTLANGUAGE))
+		}
+	}
+	if gpv.sortBy != nil {
+		r.Query.SortBy = &SortBy{}
+		// the property names are prefixed, so the sort order is the part after the last colon
+		for _, s := range list(*gpv.sortBy) {
+			p := SortProperty{PropertyName: s}
+			if i := strings.LastIndex(s, `:`); i >= 0 {
+				switch strings.ToUpper(s[i+1:]) {
+				case `A`:
+					p = SortProperty{PropertyName: s[:i], SortOrder: `ASC`}
+				case `D`:
+					p = SortProperty{PropertyName: s[:i], SortOrder: `DESC`}
+				}
+			}
+			if p.PropertyName == `` {
+				exceptions = append(exceptions, wsc110.InvalidParameterValue(*gpv.sortBy, SORTBY))
+			}
+			r.Query.SortBy.SortProperty = append(r.Query.SortBy.SortProperty, p)
+		}
+	}
+
+	if len(exceptions) > 0 {
+		return exceptions
+	}
+	return nil
+}
+
+//nolint:cyclop
+func (gpv *getRecordsRequestParameterValue) parseGetRecordsRequest(r GetRecordsRequest) {
+	gpv.BaseRequest = BaseRequest{Service: r.Service, Version: r.Version}
+	if ns := namespaces(r.Attr); ns != `` {
+		gpv.namespace = &ns
+	}
+	gpv.requestID = r.RequestID
+	gpv.resultType = r.ResultType
+	gpv.outputFormat = r.OutputFormat
+	gpv.outputSchema = r.OutputSchema
+	if r.StartPosition != nil {
+		gpv.startPosition = sp(strconv.Itoa(*r.StartPosition))
+	}
+	if r.MaxRecords != nil {
+		gpv.maxRecords = sp(strconv.Itoa(*r.MaxRecords))
+	}
+	if r.DistributedSearch != nil {
+		gpv.distributedSearch = sp(`TRUE`)
+		if r.DistributedSearch.HopCount != nil {
+			gpv.hopCount = sp(strconv.Itoa(*r.DistributedSearch.HopCount))
+		}
+	}
+	if len(r.ResponseHandler) > 0 {
+		gpv.responseHandler = sp(strings.Join(r.ResponseHandler, `,`))
+	}
+
+	gpv.typeNames = strings.Join(strings.Fields(r.Query.TypeNames), `,`)
+	if r.Query.ElementSetName != nil {
+		gpv.elementSetName = &r.Query.ElementSetName.Value
+	}
+	if len(r.Query.ElementName) > 0 {
+		gpv.elementName = sp(strings.Join(r.Query.ElementName, `,`))
+	}
+	if c := r.Query.Constraint; c != nil {
+		gpv.constraintLanguageVersion = &c.Version
+		switch {
+		case c.Filter != nil:
+			gpv.constraintLanguage = sp(FilterLanguage)
+			f := *c.Filter
+			f.XMLName.Local = `Filter`
+			doc, _ := newEncoder(requestPrefixes).Marshal(f)
+			gpv.constraint = sp(string(doc))
+		case c.CqlText != nil:
+			gpv.constraintLanguage = sp(CQLText)
+			gpv.constraint = c.CqlText
+		}
+	}
+	if s := r.Query.SortBy; s != nil {
+		var properties []string
+		for _, p := range s.SortProperty {
+			switch p.SortOrder {
+			case `ASC`:
+				properties = append(properties, p.PropertyName+`:A`)
+			case `DESC`:
+				properties = append(properties, p.PropertyName+`:D`)
+			default:
+				properties = append(properties, p.PropertyName)
+			}
+		}
+		gpv.sortBy = sp(strings.Join(properties, `,`))
+	}
+}
+
+//nolint:cyclop
+func (gpv getRecordsRequestParameterValue) toQueryParameters() url.Values {
+	query := url.Values{}
+	gpv.BaseRequest.setQueryParameters(query, getrecords)
+	query[TYPENAMES] = []string{gpv.typeNames}
+	for _, p := range []struct {
+		key   string
+		value *string
+	}{
+		{NAMESPACE, gpv.namespace},
+		{REQUESTID, gpv.requestID},
+		{RESULTTYPE, gpv.resultType},
+		{OUTPUTFORMAT, gpv.outputFormat},
+		{OUTPUTSCHEMA, gpv.outputSchema},
+		{STARTPOSITION, gpv.startPosition},
+		{MAXRECORDS, gpv.maxRecords},
+		{ELEMENTSETNAME, gpv.elementSetName},
+		{ELEMENTNAME, gpv.elementName},
+		{CONSTRAINTLANGUAGE, gpv.constraintLanguage},
+		{CONSTRAINT, gpv.constraint},
+		{CONSTRAINTLANGUAGEVERSION, gpv.constraintLanguageVersion},
+		{SORTBY, gpv.sortBy},
+		{DISTRIBUTEDSEARCH, gpv.distributedSearch},
+		{HOPCOUNT, gpv.hopCount},
+		{RESPONSEHANDLER, gpv.responseHandler},
+	} {
+		if p.value != nil {
+			query[p.key] = []string{*p.value}
+		}
+	}
+	return query
+}
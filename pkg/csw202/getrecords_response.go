@@ -0,0 +1,79 @@
+package csw202
+
+import (
+	"encoding/xml"
+
+	"github.com/pdok/ogc-specifications/pkg/utils"
+)
+
+// GetRecordsResponse is the response of a GetRecords request, the records are in the element set and schema of the request
+type GetRecordsResponse struct {
+	XMLName       xml.Name      `xml:"csw:GetRecordsResponse" yaml:"getRecordsResponse"`
+	Version       string        `xml:"version,attr,omitempty" yaml:"version,omitempty"`
+	RequestID     *string       `xml:"csw:RequestId,omitempty" yaml:"requestId,omitempty"`
+	SearchStatus  SearchStatus  `xml:"csw:SearchStatus" yaml:"searchStatus"`
+	SearchResults SearchResults `xml:"csw:SearchResults" yaml:"searchResults"`
+}
+
+// SearchStatus contains the time the search was done
+type SearchStatus struct {
+	Timestamp string `xml:"timestamp,attr,omitempty" yaml:"timestamp,omitempty"`
+}
+
+// SearchResults contains the returned records, the NextRecord is 0 when all the matched records are returned
+type SearchResults struct {
+	ResultSetID             *string `xml:"resultSetId,attr,omitempty" yaml:"resultSetId,omitempty"`
+	ElementSet              *string `xml:"elementSet,attr,omitempty" yaml:"elementSet,omitempty"`
+	RecordSchema            *string `xml:"recordSchema,attr,omitempty" yaml:"recordSchema,omitempty"`
+	NumberOfRecordsMatched  int     `xml:"numberOfRecordsMatched,attr" yaml:"numberOfRecordsMatched"`
+	NumberOfRecordsReturned int     `xml:"numberOfRecordsReturned,attr" yaml:"numberOfRecordsReturned"`
+	NextRecord              int     `xml:"nextRecord,attr" yaml:"nextRecord"`
+	Expires                 *string `xml:"expires,attr,omitempty" yaml:"expires,omitempty"`
+	Records                 `yaml:",inline"`
+}
+
+// Records contains the records of a response, of the element set or output schema that is requested
+type Records struct {
+	BriefRecord   []BriefRecord   `xml:"csw:BriefRecord" yaml:"briefRecord,omitempty"`
+	SummaryRecord []SummaryRecord `xml:"csw:SummaryRecord" yaml:"summaryRecord,omitempty"`
+	Record        []Record        `xml:"csw:Record" yaml:"record,omitempty"`
+	MDMetadata    []MDMetadata    `xml:"gmd:MD_Metadata" yaml:"mdMetadata,omitempty"`
+}
+
+// Type returns GetRecords
+func (r GetRecordsResponse) Type() string {
+	return getrecords
+}
+
+// ParseXML builds a GetRecordsResponse from a XML document, regardless of the namespace prefixes used in the document
+func (r *GetRecordsResponse) ParseXML(doc []byte) error {
+	return utils.UnmarshalPrefixed(doc, r, recordPrefixes)
+}
+
+// ToXML builds the XML document of the GetRecordsResponse
+func (r GetRecordsResponse) ToXML() []byte {
+	doc, _ := newRecordEncoder().Marshal(r)
+	return doc
+}
+
+// GetRecordByIDResponse is the response of a GetRecordById request
+type GetRecordByIDResponse struct {
+	XMLName xml.Name `xml:"csw:GetRecordByIdResponse" yaml:"getRecordByIdResponse"`
+	Records `yaml:",inline"`
+}
+
+// Type returns GetRecordById
+func (r GetRecordByIDResponse) Type() string {
+	return getrecordbyid
+}
+
+// ParseXML builds a GetRecordByIDResponse from a XML document, regardless of the namespace prefixes used in the document
+func (r *GetRecordByIDResponse) ParseXML(doc []byte) error {
+	return utils.UnmarshalPrefixed(doc, r, recordPrefixes)
+}
+
+// ToXML builds the XML document of the GetRecordByIDResponse
+func (r GetRecordByIDResponse) ToXML() []byte {
+	doc, _ := newRecordEncoder().Marshal(r)
+	return doc
+}
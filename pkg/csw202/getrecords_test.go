@@ -0,0 +1,237 @@
+package csw202
+
+import (
+	"encoding/xml"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/pdok/ogc-specifications/pkg/wfs110"
+	"github.com/pdok/ogc-specifications/pkg/wsc110"
+)
+
+func TestGetRecordsType(t *testing.T) {
+	r := GetRecordsRequest{}
+	if r.Type() != `GetRecords` {
+		t.Errorf("test: %d, expected: %s,\n got: %s", 0, `GetRecords`, r.Type())
+	}
+}
+
+var waterFilter = wfs110.Filter{XMLName: xml.Name{Space: OGCNamespace, Local: `Filter`}, Operators: wfs110.Operators{
+	PropertyIsLike: []wfs110.PropertyIsLike{{WildCard: `%`, SingleChar: `_`, EscapeChar: `\`, PropertyName: `AnyText`, Literal: `%water%`}}}}
+
+func TestGetRecordsParseQueryParameters(t *testing.T) {
+	base := func(q url.Values) url.Values {
+		q[SERVICE] = []string{`CSW`}
+		q[VERSION] = []string{`2.0.2`}
+		q[REQUEST] = []string{`GetRecords`}
+		return q
+	}
+
+	var tests = []struct {
+		query     url.Values
+		result    GetRecordsRequest
+		exception string
+	}{
+		0: {query: base(url.Values{TYPENAMES: {`csw:Record`}}),
+			result: GetRecordsRequest{BaseRequest: BaseRequest{Service: Service, Version: Version}, Query: Query{TypeNames: `csw:Record`}}},
+		1: {query: base(url.Values{`typeNames`: {`csw:Record,gmd:MD_Metadata`}, `resultType`: {`results`}, `startPosition`: {`11`}, `maxRecords`: {`10`},
+			`ElementSetName`: {`summary`}, `outputSchema`: {GMDNamespace}, `constraintLanguage`: {`CQL_TEXT`}, `constraint_language_version`: {`1.1.0`},
+			`constraint`: {`AnyText like '%water%'`}, `sortBy`: {`dc:title:A,dct:modified:D`}}),
+			result: GetRecordsRequest{BaseRequest: BaseRequest{Service: Service, Version: Version},
+				ResultType: sp(Results), OutputSchema: sp(GMDNamespace), StartPosition: ip(11), MaxRecords: ip(10),
+				Query: Query{TypeNames: `csw:Record gmd:MD_Metadata`, ElementSetName: &ElementSetName{Value: Summary},
+					Constraint: &Constraint{Version: `1.1.0`, CqlText: sp(`AnyText like '%water%'`)},
+					SortBy:     &SortBy{SortProperty: []SortProperty{{PropertyName: `dc:title`, SortOrder: `ASC`}, {PropertyName: `dct:modified`, SortOrder: `DESC`}}}}}},
+		2: {query: base(url.Values{TYPENAMES: {`csw:Record`}, CONSTRAINTLANGUAGE: {`FILTER`}, CONSTRAINTLANGUAGEVERSION: {`1.1.0`},
+			CONSTRAINT: {`<ogc:Filter xmlns:ogc="http://www.opengis.net/ogc"><ogc:PropertyIsLike wildCard="%" singleChar="_" escapeChar="\"><ogc:PropertyName>AnyText</ogc:PropertyName><ogc:Literal>%water%</ogc:Literal></ogc:PropertyIsLike></ogc:Filter>`}}),
+			result: GetRecordsRequest{BaseRequest: BaseRequest{Service: Service, Version: Version},
+				Query: Query{TypeNames: `csw:Record`, Constraint: &Constraint{Version: `1.1.0`, Filter: &waterFilter}}}},
+		3: {query: base(url.Values{TYPENAMES: {`csw:Record`}, DISTRIBUTEDSEARCH: {`TRUE`}, HOPCOUNT: {`2`}, RESPONSEHANDLER: {`ftp://example.com/results`},
+			NAMESPACE: {`xmlns(csw=http://www.opengis.net/cat/csw/2.0.2)`}}),
+			result: GetRecordsRequest{BaseRequest: BaseRequest{Service: Service, Version: Version,
+				Attr: []xml.Attr{{Name: xml.Name{Space: `xmlns`, Local: `csw`}, Value: Namespace}}},
+				DistributedSearch: &DistributedSearch{HopCount: ip(2)}, ResponseHandler: []string{`ftp://example.com/results`},
+				Query: Query{TypeNames: `csw:Record`}}},
+		4: {query: base(url.Values{}), exception: `MissingParameterValue`},
+		5: {query: base(url.Values{TYPENAMES: {`csw:Record`}, MAXRECORDS: {`ten`}}), exception: `InvalidParameterValue`},
+		6: {query: base(url.Values{TYPENAMES: {`csw:Record`}, CONSTRAINT: {`AnyText like '%water%'`}, CONSTRAINTLANGUAGEVERSION: {`1.1.0`}}),
+			exception: `MissingParameterValue`},
+		7: {query: base(url.Values{TYPENAMES: {`csw:Record`}, CONSTRAINTLANGUAGE: {`SQL`}, CONSTRAINT: {`AnyText = 'water'`}, CONSTRAINTLANGUAGEVERSION: {`1.1.0`}}),
+			exception: `InvalidParameterValue`},
+		8: {query: url.Values{SERVICE: {`WMS`}, VERSION: {`2.0.2`}, REQUEST: {`GetRecords`}, TYPENAMES: {`csw:Record`}},
+			exception: `InvalidParameterValue`},
+	}
+
+	for k, test := range tests {
+		var r GetRecordsRequest
+		exceptions := r.ParseQueryParameters(test.query)
+		if test.exception != `` {
+			if len(exceptions) != 1 || exceptions[0].Code() != test.exception {
+				t.Errorf("test: %d, expected: %s,\n got: %v", k, test.exception, exceptions)
+			}
+			continue
+		}
+		if exceptions != nil {
+			t.Errorf("test: %d, expected no exceptions,\n got: %v", k, exceptions)
+			continue
+		}
+		test.result.XMLName.Local = getrecords
+		if !reflect.DeepEqual(r, test.result) {
+			t.Errorf("test: %d, expected: %+v,\n got: %+v", k, test.result, r)
+		}
+	}
+}
+
+func TestGetRecordsParseXML(t *testing.T) {
+	var tests = []struct {
+		doc       string
+		result    GetRecordsRequest
+		exception string
+	}{
+		0: {doc: `<csw:GetRecords xmlns:csw="http://www.opengis.net/cat/csw/2.0.2" xmlns:ogc="http://www.opengis.net/ogc" service="CSW" version="2.0.2" resultType="results" startPosition="1" maxRecords="5">
+  <csw:Query typeNames="csw:Record">
+    <csw:ElementSetName>brief</csw:ElementSetName>
+    <csw:Constraint version="1.1.0">
+      <ogc:Filter><ogc:PropertyIsLike wildCard="%" singleChar="_" escapeChar="\"><ogc:PropertyName>AnyText</ogc:PropertyName><ogc:Literal>%water%</ogc:Literal></ogc:PropertyIsLike></ogc:Filter>
+    </csw:Constraint>
+    <ogc:SortBy><ogc:SortProperty><ogc:PropertyName>dc:title</ogc:PropertyName><ogc:SortOrder>DESC</ogc:SortOrder></ogc:SortProperty></ogc:SortBy>
+  </csw:Query>
+</csw:GetRecords>`,
+			result: GetRecordsRequest{BaseRequest: BaseRequest{Service: Service, Version: Version},
+				ResultType: sp(Results), StartPosition: ip(1), MaxRecords: ip(5),
+				Query: Query{TypeNames: `csw:Record`, ElementSetName: &ElementSetName{Value: Brief},
+					Constraint: &Constraint{Version: `1.1.0`, Filter: &waterFilter},
+					SortBy:     &SortBy{SortProperty: []SortProperty{{PropertyName: `dc:title`, SortOrder: `DESC`}}}}}},
+		// other prefixes and the CQL text
+		1: {doc: `<cat:GetRecords xmlns:cat="http://www.opengis.net/cat/csw/2.0.2" service="CSW" version="2.0.2" outputSchema="http://www.isotc211.org/2005/gmd">
+  <cat:Query typeNames="gmd:MD_Metadata">
+    <cat:ElementName>dc:identifier</cat:ElementName>
+    <cat:Constraint version="1.1.0"><cat:CqlText>dc:type = 'service'</cat:CqlText></cat:Constraint>
+  </cat:Query>
+</cat:GetRecords>`,
+			result: GetRecordsRequest{BaseRequest: BaseRequest{Service: Service, Version: Version}, OutputSchema: sp(GMDNamespace),
+				Query: Query{TypeNames: `gmd:MD_Metadata`, ElementName: []string{`dc:identifier`},
+					Constraint: &Constraint{Version: `1.1.0`, CqlText: sp(`dc:type = 'service'`)}}}},
+		2: {doc: `no XML document`, exception: `MissingParameterValue`},
+	}
+
+	for k, test := range tests {
+		var r GetRecordsRequest
+		exceptions := r.ParseXML([]byte(test.doc))
+		if test.exception != `` {
+			if len(exceptions) != 1 || exceptions[0].Code() != test.exception {
+				t.Errorf("test: %d, expected: %s,\n got: %v", k, test.exception, exceptions)
+			}
+			continue
+		}
+		if exceptions != nil {
+			t.Errorf("test: %d, expected no exceptions,\n got: %v", k, exceptions)
+			continue
+		}
+		// the namespace declarations are kept as attributes
+		r.XMLName, r.Attr = xml.Name{}, nil
+		if !reflect.DeepEqual(r, test.result) {
+			t.Errorf("test: %d, expected: %+v,\n got: %+v", k, test.result, r)
+		}
+		if exceptions := r.Validate(nil); exceptions != nil {
+			t.Errorf("test: %d, expected no exceptions,\n got: %v", k, exceptions)
+		}
+	}
+}
+
+func TestGetRecordsToQueryParameters(t *testing.T) {
+	var tests = []struct {
+		request GetRecordsRequest
+		query   url.Values
+	}{
+		0: {request: GetRecordsRequest{BaseRequest: BaseRequest{Service: Service, Version: Version,
+			Attr: []xml.Attr{{Name: xml.Name{Space: `xmlns`, Local: `gmd`}, Value: GMDNamespace}, {Name: xml.Name{Space: `xmlns`, Local: `csw`}, Value: Namespace}}},
+			ResultType: sp(Hits), Query: Query{TypeNames: `csw:Record gmd:MD_Metadata`, ElementName: []string{`dc:identifier`, `dc:title`},
+				SortBy: &SortBy{SortProperty: []SortProperty{{PropertyName: `dc:title`}, {PropertyName: `dct:modified`, SortOrder: `DESC`}}}}},
+			query: url.Values{SERVICE: {`CSW`}, VERSION: {`2.0.2`}, REQUEST: {`GetRecords`}, RESULTTYPE: {`hits`},
+				NAMESPACE: {`xmlns(csw=http://www.opengis.net/cat/csw/2.0.2),xmlns(gmd=http://www.isotc211.org/2005/gmd)`},
+				TYPENAMES: {`csw:Record,gmd:MD_Metadata`}, ELEMENTNAME: {`dc:identifier,dc:title`}, SORTBY: {`dc:title,dct:modified:D`}}},
+		1: {request: GetRecordsRequest{BaseRequest: BaseRequest{Service: Service, Version: Version},
+			Query: Query{TypeNames: `csw:Record`, Constraint: &Constraint{Version: `1.1.0`, Filter: &waterFilter}}},
+			query: url.Values{SERVICE: {`CSW`}, VERSION: {`2.0.2`}, REQUEST: {`GetRecords`}, TYPENAMES: {`csw:Record`},
+				CONSTRAINTLANGUAGE: {`FILTER`}, CONSTRAINTLANGUAGEVERSION: {`1.1.0`},
+				CONSTRAINT: {`<?xml version="1.0" encoding="UTF-8"?>` + "\n" + `<Filter xmlns="http://www.opengis.net/ogc"><PropertyIsLike wildCard="%" singleChar="_" escapeChar="\"><PropertyName>AnyText</PropertyName><Literal>%water%</Literal></PropertyIsLike></Filter>`}}},
+	}
+
+	for k, test := range tests {
+		query := test.request.ToQueryParameters()
+		if !reflect.DeepEqual(query, test.query) {
+			t.Errorf("test: %d, expected: %v,\n got: %v", k, test.query, query)
+		}
+		// the query parameters build the same request
+		var r GetRecordsRequest
+		if exceptions := r.ParseQueryParameters(query); exceptions != nil {
+			t.Errorf("test: %d, expected no exceptions,\n got: %v", k, exceptions)
+		} else if !reflect.DeepEqual(r.ToQueryParameters(), query) {
+			t.Errorf("test: %d, expected: %v,\n got: %v", k, query, r.ToQueryParameters())
+		}
+	}
+}
+
+func TestGetRecordsToXML(t *testing.T) {
+	r := GetRecordsRequest{BaseRequest: BaseRequest{Service: Service, Version: Version}, ResultType: sp(Results),
+		Query: Query{TypeNames: `csw:Record`, ElementSetName: &ElementSetName{Value: Full},
+			Constraint: &Constraint{Version: `1.1.0`, CqlText: sp(`dc:type = 'dataset'`)}}}
+	expected := `<csw:GetRecords xmlns:csw="http://www.opengis.net/cat/csw/2.0.2" service="CSW" version="2.0.2" resultType="results"><csw:Query typeNames="csw:Record"><csw:ElementSetName>full</csw:ElementSetName><csw:Constraint version="1.1.0"><csw:CqlText>dc:type = &#39;dataset&#39;</csw:CqlText></csw:Constraint></csw:Query></csw:GetRecords>`
+	doc := r.ToXML()
+	if !strings.HasSuffix(string(doc), expected) {
+		t.Errorf("test: %d, expected: %s,\n got: %s", 0, expected, doc)
+	}
+
+	var parsed GetRecordsRequest
+	if exceptions := parsed.ParseXML(doc); exceptions != nil {
+		t.Errorf("test: %d, expected no exceptions,\n got: %v", 0, exceptions)
+	}
+	parsed.XMLName, parsed.Attr = xml.Name{}, nil
+	if !reflect.DeepEqual(parsed, r) {
+		t.Errorf("test: %d, expected: %+v,\n got: %+v", 0, r, parsed)
+	}
+}
+
+func TestGetRecordsValidate(t *testing.T) {
+	valid := func(f func(r *GetRecordsRequest)) GetRecordsRequest {
+		r := GetRecordsRequest{BaseRequest: BaseRequest{Service: Service, Version: Version}, Query: Query{TypeNames: `csw:Record`}}
+		f(&r)
+		return r
+	}
+
+	var tests = []struct {
+		request    GetRecordsRequest
+		exceptions wsc110.Exceptions
+	}{
+		0: {request: valid(func(r *GetRecordsRequest) {})},
+		1: {request: valid(func(r *GetRecordsRequest) { r.ResultType = sp(`all`) }),
+			exceptions: wsc110.Exceptions{wsc110.InvalidParameterValue(`all`, RESULTTYPE)}},
+		2: {request: valid(func(r *GetRecordsRequest) { r.OutputSchema = sp(`http://www.isotc211.org/2005/gmi`) }),
+			exceptions: wsc110.Exceptions{wsc110.InvalidParameterValue(`http://www.isotc211.org/2005/gmi`, OUTPUTSCHEMA)}},
+		3: {request: valid(func(r *GetRecordsRequest) { r.StartPosition, r.MaxRecords = ip(0), ip(-1) }),
+			exceptions: wsc110.Exceptions{wsc110.InvalidParameterValue(`0`, STARTPOSITION), wsc110.InvalidParameterValue(`-1`, MAXRECORDS)}},
+		4: {request: valid(func(r *GetRecordsRequest) { r.Query.TypeNames = `` }),
+			exceptions: wsc110.Exceptions{wsc110.MissingParameterValue(TYPENAMES)}},
+		5: {request: valid(func(r *GetRecordsRequest) {
+			r.Query.ElementSetName = &ElementSetName{Value: `complete`}
+			r.Query.ElementName = []string{`dc:title`}
+		}),
+			exceptions: wsc110.Exceptions{wsc110.NoApplicableCode(`ELEMENTSETNAME and ELEMENTNAME are mutually exclusive`), wsc110.InvalidParameterValue(`complete`, ELEMENTSETNAME)}},
+		6: {request: valid(func(r *GetRecordsRequest) { r.Query.Constraint = &Constraint{} }),
+			exceptions: wsc110.Exceptions{wsc110.NoApplicableCode(`a Constraint contains either a Filter or a CqlText`), wsc110.MissingParameterValue(CONSTRAINTLANGUAGEVERSION)}},
+		7: {request: valid(func(r *GetRecordsRequest) {
+			r.Query.SortBy = &SortBy{SortProperty: []SortProperty{{PropertyName: `dc:title`, SortOrder: `UP`}}}
+		}),
+			exceptions: wsc110.Exceptions{wsc110.InvalidParameterValue(`UP`, SORTBY)}},
+	}
+
+	for k, test := range tests {
+		exceptions := test.request.Validate(nil)
+		if !reflect.DeepEqual(exceptions, test.exceptions) {
+			t.Errorf("test: %d, expected: %v,\n got: %v", k, test.exceptions, exceptions)
+		}
+	}
+}
@@ -0,0 +1,176 @@
+package csw202
+
+import (
+	"encoding/xml"
+	"strings"
+
+	"github.com/pdok/ogc-specifications/pkg/utils"
+	"github.com/pdok/ogc-specifications/pkg/wsc110"
+)
+
+// Namespaces of the ISO 19139 metadata records
+const (
+	GCONamespace   = `http://www.isotc211.org/2005/gco`
+	GMXNamespace   = `http://www.isotc211.org/2005/gmx`
+	GTSNamespace   = `http://www.isotc211.org/2005/gts`
+	SRVNamespace   = `http://www.isotc211.org/2005/srv`
+	GMLNamespace   = `http://www.opengis.net/gml`
+	GML32Namespace = `http://www.opengis.net/gml/3.2`
+)
+
+// recordPrefixes contains the prefixes of the namespaces used in the records, the elements of the ISO 19139
+// records in other namespaces lose their prefix
+var recordPrefixes = utils.Prefixes{
+	Namespace:        `csw`,
+	DCNamespace:      `dc`,
+	DCTNamespace:     `dct`,
+	OWSNamespace:     `ows`,
+	wsc110.Namespace: `ows`,
+	GMDNamespace:     `gmd`,
+	GCONamespace:     `gco`,
+	GMXNamespace:     `gmx`,
+	GTSNamespace:     `gts`,
+	SRVNamespace:     `srv`,
+	GMLNamespace:     `gml`,
+	GML32Namespace:   `gml`,
+}
+
+// newRecordEncoder returns an Encoder for the records, declaring OWS Common 1.0 for the ows prefix and GML 3.2 for the
+// gml prefix, a MD_Metadata record that declares the GML namespace itself keeps its declaration
+func newRecordEncoder() *utils.Encoder {
+	e := newEncoder(recordPrefixes)
+	e.Register(`gml`, GML32Namespace)
+	return e
+}
+
+// BriefRecord is a csw:BriefRecord, the brief element set of a csw:Record
+type BriefRecord struct {
+	Identifier  []string             `xml:"dc:identifier" yaml:"identifier"`
+	Title       []string             `xml:"dc:title" yaml:"title"`
+	Type        *string              `xml:"dc:type,omitempty" yaml:"type,omitempty"`
+	BoundingBox []wsc110.BoundingBox `xml:"ows:BoundingBox" yaml:"boundingBox,omitempty"`
+}
+
+// SummaryRecord is a csw:SummaryRecord, the summary element set of a csw:Record
+type SummaryRecord struct {
+	Identifier  []string             `xml:"dc:identifier" yaml:"identifier"`
+	Title       []string             `xml:"dc:title" yaml:"title"`
+	Type        *string              `xml:"dc:type,omitempty" yaml:"type,omitempty"`
+	Subject     []string             `xml:"dc:subject" yaml:"subject,omitempty"`
+	Format      []string             `xml:"dc:format" yaml:"format,omitempty"`
+	Relation    []string             `xml:"dc:relation" yaml:"relation,omitempty"`
+	Modified    []string             `xml:"dct:modified" yaml:"modified,omitempty"`
+	Abstract    []string             `xml:"dct:abstract" yaml:"abstract,omitempty"`
+	Spatial     []string             `xml:"dct:spatial" yaml:"spatial,omitempty"`
+	BoundingBox []wsc110.BoundingBox `xml:"ows:BoundingBox" yaml:"boundingBox,omitempty"`
+}
+
+// Record is a csw:Record, the full element set with the Dublin Core elements
+type Record struct {
+	Identifier  []string             `xml:"dc:identifier" yaml:"identifier"`
+	Title       []string             `xml:"dc:title" yaml:"title"`
+	Type        *string              `xml:"dc:type,omitempty" yaml:"type,omitempty"`
+	Subject     []string             `xml:"dc:subject" yaml:"subject,omitempty"`
+	Format      []string             `xml:"dc:format" yaml:"format,omitempty"`
+	Relation    []string             `xml:"dc:relation" yaml:"relation,omitempty"`
+	Date        []string             `xml:"dc:date" yaml:"date,omitempty"`
+	Description []string             `xml:"dc:description" yaml:"description,omitempty"`
+	Creator     []string             `xml:"dc:creator" yaml:"creator,omitempty"`
+	Contributor []string             `xml:"dc:contributor" yaml:"contributor,omitempty"`
+	Publisher   []string             `xml:"dc:publisher" yaml:"publisher,omitempty"`
+	Language    []string             `xml:"dc:language" yaml:"language,omitempty"`
+	Source      []string             `xml:"dc:source" yaml:"source,omitempty"`
+	Rights      []string             `xml:"dc:rights" yaml:"rights,omitempty"`
+	Coverage    []string             `xml:"dc:coverage" yaml:"coverage,omitempty"`
+	Modified    []string             `xml:"dct:modified" yaml:"modified,omitempty"`
+	Abstract    []string             `xml:"dct:abstract" yaml:"abstract,omitempty"`
+	Spatial     []string             `xml:"dct:spatial" yaml:"spatial,omitempty"`
+	References  []URI                `xml:"dct:references" yaml:"references,omitempty"`
+	BoundingBox []wsc110.BoundingBox `xml:"ows:BoundingBox" yaml:"boundingBox,omitempty"`
+}
+
+// URI is a Dublin Core URI with the scheme, like the protocol of an online resource
+type URI struct {
+	Scheme string `xml:"scheme,attr,omitempty" yaml:"scheme,omitempty"`
+	Value  string `xml:",chardata" yaml:"value"`
+}
+
+// MDMetadata is an ISO 19139 gmd:MD_Metadata record. The record is passed through without being interpreted,
+// only the namespace prefixes are rewritten to those of the recordPrefixes. The FileIdentifier is read from the
+// record for convenience, a record without content is written with just the FileIdentifier.
+type MDMetadata struct {
+	FileIdentifier string `yaml:"fileIdentifier"`
+	tokens         []xml.Token
+}
+
+// ParseXML builds a MDMetadata from an ISO 19139 document, regardless of the namespace prefixes used in the document
+func (m *MDMetadata) ParseXML(doc []byte) error {
+	return utils.UnmarshalPrefixed(doc, m, recordPrefixes)
+}
+
+// ToXML builds an ISO 19139 document of the MDMetadata
+func (m MDMetadata) ToXML() []byte {
+	doc, _ := newRecordEncoder().Marshal(m)
+	return doc
+}
+
+// UnmarshalXML keeps the tokens of the record
+func (m *MDMetadata) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	m.tokens = []xml.Token{unqualified(start)}
+	var path []string
+	for depth := 1; depth > 0; {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			depth++
+			path = append(path, t.Name.Local)
+			token = unqualified(t)
+		case xml.EndElement:
+			depth--
+			if len(path) > 0 {
+				path = path[:len(path)-1]
+			}
+			token = xml.EndElement{Name: xml.Name{Local: t.Name.Local}}
+		case xml.CharData:
+			if strings.Join(path, `/`) == `gmd:fileIdentifier/gco:CharacterString` {
+				m.FileIdentifier += strings.TrimSpace(string(t))
+			}
+		}
+		m.tokens = append(m.tokens, xml.CopyToken(token))
+	}
+	return nil
+}
+
+// MarshalXML writes the tokens of the record
+func (m MDMetadata) MarshalXML(e *xml.Encoder, _ xml.StartElement) error {
+	tokens := m.tokens
+	if len(tokens) == 0 {
+		tokens = []xml.Token{
+			xml.StartElement{Name: xml.Name{Local: `gmd:MD_Metadata`}},
+			xml.StartElement{Name: xml.Name{Local: `gmd:fileIdentifier`}},
+			xml.StartElement{Name: xml.Name{Local: `gco:CharacterString`}},
+			xml.CharData(m.FileIdentifier),
+			xml.EndElement{Name: xml.Name{Local: `gco:CharacterString`}},
+			xml.EndElement{Name: xml.Name{Local: `gmd:fileIdentifier`}},
+			xml.EndElement{Name: xml.Name{Local: `gmd:MD_Metadata`}},
+		}
+	}
+	for _, token := range tokens {
+		if err := e.EncodeToken(token); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// unqualified returns the element with the names, that are already prefixed, without their namespace
+func unqualified(start xml.StartElement) xml.StartElement {
+	t := xml.StartElement{Name: xml.Name{Local: start.Name.Local}}
+	for _, a := range start.Attr {
+		t.Attr = append(t.Attr, xml.Attr{Name: xml.Name{Local: a.Name.Local}, Value: a.Value})
+	}
+	return t
+}
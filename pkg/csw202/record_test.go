@@ -0,0 +1,130 @@
+package csw202
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/pdok/ogc-specifications/pkg/wsc110"
+)
+
+var getRecordsResponse = []byte(`<?xml version="1.0" encoding="UTF-8"?>
+<csw:GetRecordsResponse xmlns:csw="http://www.opengis.net/cat/csw/2.0.2" xmlns:dc="http://purl.org/dc/elements/1.1/" xmlns:dct="http://purl.org/dc/terms/" xmlns:ows="http://www.opengis.net/ows" version="2.0.2">
+  <csw:SearchStatus timestamp="2024-05-01T12:00:00Z"/>
+  <csw:SearchResults numberOfRecordsMatched="12" numberOfRecordsReturned="2" nextRecord="3" elementSet="summary">
+    <csw:SummaryRecord>
+      <dc:identifier>abc-123</dc:identifier>
+      <dc:title>Rivers</dc:title>
+      <dc:type>dataset</dc:type>
+      <dc:subject>water</dc:subject>
+      <dct:modified>2024-04-01</dct:modified>
+      <dct:abstract>The rivers of the Netherlands</dct:abstract>
+      <ows:BoundingBox crs="urn:ogc:def:crs:EPSG::28992"><ows:LowerCorner>0 300000</ows:LowerCorner><ows:UpperCorner>280000 625000</ows:UpperCorner></ows:BoundingBox>
+    </csw:SummaryRecord>
+    <csw:SummaryRecord>
+      <dc:identifier>def-456</dc:identifier>
+      <dc:title>Rivers WMS</dc:title>
+      <dc:type>service</dc:type>
+    </csw:SummaryRecord>
+  </csw:SearchResults>
+</csw:GetRecordsResponse>`)
+
+func TestGetRecordsResponse(t *testing.T) {
+	var r GetRecordsResponse
+	if err := r.ParseXML(getRecordsResponse); err != nil {
+		t.Fatalf("test: %d, expected no error,\n got: %s", 0, err)
+	}
+	expected := SummaryRecord{Identifier: []string{`abc-123`}, Title: []string{`Rivers`}, Type: sp(`dataset`), Subject: []string{`water`},
+		Modified: []string{`2024-04-01`}, Abstract: []string{`The rivers of the Netherlands`},
+		BoundingBox: []wsc110.BoundingBox{{Crs: `urn:ogc:def:crs:EPSG::28992`, LowerCorner: wsc110.Position{0, 300000}, UpperCorner: wsc110.Position{280000, 625000}}}}
+	if r.SearchResults.NumberOfRecordsMatched != 12 || r.SearchResults.NextRecord != 3 || len(r.SearchResults.SummaryRecord) != 2 {
+		t.Fatalf("test: %d, expected 2 of 12 records,\n got: %+v", 0, r.SearchResults)
+	}
+	if !reflect.DeepEqual(r.SearchResults.SummaryRecord[0], expected) {
+		t.Errorf("test: %d, expected: %+v,\n got: %+v", 0, expected, r.SearchResults.SummaryRecord[0])
+	}
+
+	doc := string(r.ToXML())
+	for _, c := range []string{`<csw:SearchStatus timestamp="2024-05-01T12:00:00Z"/>`, `numberOfRecordsReturned="2" nextRecord="3"`,
+		`<dct:abstract>The rivers of the Netherlands</dct:abstract>`, `xmlns:dct="http://purl.org/dc/terms/"`, `<ows:BoundingBox crs="urn:ogc:def:crs:EPSG::28992">`} {
+		if !strings.Contains(doc, c) {
+			t.Errorf("test: %d, expected: %s,\n got: %s", 0, c, doc)
+		}
+	}
+}
+
+func TestGetRecordByIDResponse(t *testing.T) {
+	var tests = []struct {
+		doc    string
+		result Records
+	}{
+		0: {doc: `<csw:GetRecordByIdResponse xmlns:csw="http://www.opengis.net/cat/csw/2.0.2" xmlns:dc="http://purl.org/dc/elements/1.1/" xmlns:dct="http://purl.org/dc/terms/"><csw:Record><dc:identifier>abc-123</dc:identifier><dc:title>Rivers</dc:title><dct:references scheme="OGC:WMS">https://example.com/wms</dct:references></csw:Record></csw:GetRecordByIdResponse>`,
+			result: Records{Record: []Record{{Identifier: []string{`abc-123`}, Title: []string{`Rivers`}, References: []URI{{Scheme: `OGC:WMS`, Value: `https://example.com/wms`}}}}}},
+		// other prefixes
+		1: {doc: `<GetRecordByIdResponse xmlns="http://www.opengis.net/cat/csw/2.0.2" xmlns:e="http://purl.org/dc/elements/1.1/"><BriefRecord><e:identifier>abc-123</e:identifier><e:title>Rivers</e:title></BriefRecord></GetRecordByIdResponse>`,
+			result: Records{BriefRecord: []BriefRecord{{Identifier: []string{`abc-123`}, Title: []string{`Rivers`}}}}},
+	}
+
+	for k, test := range tests {
+		var r GetRecordByIDResponse
+		if err := r.ParseXML([]byte(test.doc)); err != nil {
+			t.Errorf("test: %d, expected no error,\n got: %s", k, err)
+			continue
+		}
+		if !reflect.DeepEqual(r.Records, test.result) {
+			t.Errorf("test: %d, expected: %+v,\n got: %+v", k, test.result, r.Records)
+		}
+	}
+}
+
+func TestMDMetadata(t *testing.T) {
+	var tests = []struct {
+		doc            string
+		fileIdentifier string
+		contains       []string
+	}{
+		0: {doc: `<csw:GetRecordByIdResponse xmlns:csw="http://www.opengis.net/cat/csw/2.0.2"><gmd:MD_Metadata xmlns:gmd="http://www.isotc211.org/2005/gmd" xmlns:gco="http://www.isotc211.org/2005/gco" xmlns:gml="http://www.opengis.net/gml"><gmd:fileIdentifier><gco:CharacterString>abc-123</gco:CharacterString></gmd:fileIdentifier><gmd:language><gmd:LanguageCode codeList="http://www.loc.gov/standards/iso639-2/" codeListValue="dut">Nederlands</gmd:LanguageCode></gmd:language><gml:TimePeriod gml:id="t1"/></gmd:MD_Metadata></csw:GetRecordByIdResponse>`,
+			fileIdentifier: `abc-123`,
+			contains: []string{`<gmd:MD_Metadata xmlns:gmd="http://www.isotc211.org/2005/gmd" xmlns:gco="http://www.isotc211.org/2005/gco" xmlns:gml="http://www.opengis.net/gml">`,
+				`<gmd:LanguageCode codeList="http://www.loc.gov/standards/iso639-2/" codeListValue="dut">Nederlands</gmd:LanguageCode>`,
+				`<gml:TimePeriod gml:id="t1"/>`}},
+		// the prefixes are rewritten
+		1: {doc: `<GetRecordByIdResponse xmlns="http://www.opengis.net/cat/csw/2.0.2"><MD_Metadata xmlns="http://www.isotc211.org/2005/gmd" xmlns:c="http://www.isotc211.org/2005/gco"><fileIdentifier><c:CharacterString> def-456 </c:CharacterString></fileIdentifier></MD_Metadata></GetRecordByIdResponse>`,
+			fileIdentifier: `def-456`,
+			contains: []string{`xmlns:gco="http://www.isotc211.org/2005/gco" xmlns:gmd="http://www.isotc211.org/2005/gmd"`,
+				`<gmd:fileIdentifier><gco:CharacterString> def-456 </gco:CharacterString></gmd:fileIdentifier>`}},
+	}
+
+	for k, test := range tests {
+		var r GetRecordByIDResponse
+		if err := r.ParseXML([]byte(test.doc)); err != nil {
+			t.Errorf("test: %d, expected no error,\n got: %s", k, err)
+			continue
+		}
+		if len(r.MDMetadata) != 1 || r.MDMetadata[0].FileIdentifier != test.fileIdentifier {
+			t.Errorf("test: %d, expected: %s,\n got: %+v", k, test.fileIdentifier, r.MDMetadata)
+			continue
+		}
+		doc := string(r.ToXML())
+		for _, c := range test.contains {
+			if !strings.Contains(doc, c) {
+				t.Errorf("test: %d, expected: %s,\n got: %s", k, c, doc)
+			}
+		}
+	}
+}
+
+func TestMDMetadataParseXML(t *testing.T) {
+	var m MDMetadata
+	if err := m.ParseXML([]byte(`<MD_Metadata xmlns="http://www.isotc211.org/2005/gmd"><fileIdentifier><CharacterString xmlns="http://www.isotc211.org/2005/gco">abc-123</CharacterString></fileIdentifier></MD_Metadata>`)); err != nil {
+		t.Fatalf("test: %d, expected no error,\n got: %s", 0, err)
+	}
+	if m.FileIdentifier != `abc-123` {
+		t.Errorf("test: %d, expected: %s,\n got: %s", 0, `abc-123`, m.FileIdentifier)
+	}
+
+	expected := `<gmd:MD_Metadata xmlns:gco="http://www.isotc211.org/2005/gco" xmlns:gmd="http://www.isotc211.org/2005/gmd"><gmd:fileIdentifier><gco:CharacterString>xyz-789</gco:CharacterString></gmd:fileIdentifier></gmd:MD_Metadata>`
+	if doc := string((MDMetadata{FileIdentifier: `xyz-789`}).ToXML()); !strings.HasSuffix(doc, expected) {
+		t.Errorf("test: %d, expected: %s,\n got: %s", 0, expected, doc)
+	}
+}
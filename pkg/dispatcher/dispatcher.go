@@ -0,0 +1,328 @@
+// Package dispatcher parses a http.Request into the typed request of the OGC web service it is meant for,
+// like a *wms130.GetMapRequest or a *wfs200.GetFeatureRequest.
+//
+// KVP requests are dispatched on the SERVICE and REQUEST parameters, XML requests on the root element.
+// The version of the service is negotiated and when a request can't be dispatched
+// an exception report of the negotiated service version is returned.
+package dispatcher
+
+import (
+	"encoding/xml"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pdok/ogc-specifications/pkg/common"
+	"github.com/pdok/ogc-specifications/pkg/utils"
+)
+
+// Parameters the dispatcher uses
+const (
+	SERVICE        = `SERVICE`
+	REQUEST        = `REQUEST`
+	VERSION        = `VERSION`
+	ACCEPTVERSIONS = `ACCEPTVERSIONS`
+	EXCEPTIONS     = `EXCEPTIONS`
+)
+
+const getcapabilities = `GetCapabilities`
+
+// Dispatcher dispatches requests to the registered services
+type Dispatcher struct {
+	// DefaultService is the name of the service for requests without a SERVICE parameter,
+	// when the operation doesn't identify the service
+	DefaultService string
+
+	names    []string
+	services map[string][]Service
+}
+
+// New returns a Dispatcher for the services, the first service is the default service
+func New(services ...Service) *Dispatcher {
+	d := &Dispatcher{services: map[string][]Service{}}
+	for _, s := range services {
+		d.Register(s)
+	}
+	if len(d.names) > 0 {
+		d.DefaultService = d.names[0]
+	}
+	return d
+}
+
+// Register adds the service version to the dispatcher
+func (d *Dispatcher) Register(s Service) {
+	name := strings.ToUpper(s.Name())
+	if _, ok := d.services[name]; !ok {
+		d.names = append(d.names, name)
+	}
+	versions := append(d.services[name], s)
+	sort.SliceStable(versions, func(i, j int) bool {
+		return compareVersions(versions[i].Version(), versions[j].Version()) > 0
+	})
+	d.services[name] = versions
+}
+
+// Default dispatches WMS 1.3.0 and 1.1.1, WFS 2.0.0, WMTS 1.0.0 and WCS 2.0.1 requests, WMS is the default service
+var Default = New(WMS130(), WMS111(), WFS200(), WMTS100(), WCS201())
+
+// Dispatch parses the request with the Default dispatcher
+func Dispatch(r *http.Request) (Request, *Report) {
+	return Default.Dispatch(r)
+}
+
+// Dispatch parses the request for the service and operation it is meant for.
+// When the request can't be parsed the exception report is returned, encoded as requested
+// by the EXCEPTIONS parameter or else the Accept header.
+func (d *Dispatcher) Dispatch(r *http.Request) (Request, *Report) {
+	if len(d.names) == 0 {
+		return nil, &Report{StatusCode: http.StatusNotImplemented, ContentType: `text/plain`, Body: []byte(`no services registered`)}
+	}
+
+	if r.Method == http.MethodPost && !isForm(r) {
+		doc, err := io.ReadAll(r.Body)
+		if err != nil {
+			return nil, newReport(d.fallback().NoApplicableCode(`could not read the request body`), reportFormat(r.URL.Query(), r))
+		}
+		if len(strings.TrimSpace(string(doc))) > 0 {
+			return d.dispatchXML(doc, reportFormat(r.URL.Query(), r))
+		}
+	}
+
+	if err := r.ParseForm(); err != nil {
+		return nil, newReport(d.fallback().NoApplicableCode(`could not parse the request parameters`), reportFormat(r.URL.Query(), r))
+	}
+	return d.dispatchQueryParameters(r.Form, reportFormat(r.Form, r))
+}
+
+// dispatchQueryParameters parses a KVP encoded request
+func (d *Dispatcher) dispatchQueryParameters(query url.Values, format string) (Request, *Report) {
+	query = utils.KeysToUpper(query)
+	operation := first(query, REQUEST)
+
+	name := first(query, SERVICE)
+	if name == `` {
+		name = d.infer(operation)
+		if name != `` {
+			// the parsers expect the SERVICE parameter when it is mandatory
+			query[SERVICE] = []string{name}
+		}
+	}
+
+	var accept []string
+	if v := first(query, ACCEPTVERSIONS); v != `` {
+		accept = strings.Split(v, `,`)
+	}
+
+	s, exceptions := d.negotiate(name, operation, first(query, VERSION), accept)
+	if exceptions != nil {
+		return nil, newReport(exceptions, format)
+	}
+
+	request, exceptions := s.ParseQueryParameters(operation, query)
+	if exceptions != nil {
+		return nil, newReport(exceptions, format)
+	}
+	return request, nil
+}
+
+// root contains the attributes of the root element of a XML encoded request, needed to dispatch it
+type root struct {
+	XMLName        xml.Name
+	Service        string   `xml:"service,attr"`
+	Version        string   `xml:"version,attr"`
+	AcceptVersions []string `xml:"AcceptVersions>Version"`
+}
+
+// dispatchXML parses a XML encoded request
+func (d *Dispatcher) dispatchXML(doc []byte, format string) (Request, *Report) {
+	var r root
+	if err := xml.Unmarshal(doc, &r); err != nil {
+		return nil, newReport(d.fallback().NoApplicableCode(`could not parse the XML document: `+err.Error()), format)
+	}
+	operation := r.XMLName.Local
+
+	name := r.Service
+	if name == `` {
+		name = d.byNamespace(r.XMLName.Space)
+	}
+	if name == `` {
+		name = d.infer(operation)
+	}
+
+	s, exceptions := d.negotiate(name, operation, r.Version, r.AcceptVersions)
+	if exceptions != nil {
+		return nil, newReport(exceptions, format)
+	}
+
+	request, exceptions := s.ParseXML(operation, doc)
+	if exceptions != nil {
+		return nil, newReport(exceptions, format)
+	}
+	return request, nil
+}
+
+// negotiate returns the version of the service for the operation.
+// For a GetCapabilities request the first of the accepted versions that is supported is used,
+// or else the version as negotiated by WMS 1.3.0: the requested version, the highest lower version
+// or the lowest version. Other operations must request a supported version.
+func (d *Dispatcher) negotiate(name, operation, version string, accept []string) (Service, Exceptions) {
+	if name == `` {
+		return nil, d.fallback().MissingParameterValue(SERVICE)
+	}
+	versions, ok := d.services[strings.ToUpper(name)]
+	if !ok {
+		return nil, d.fallback().InvalidParameterValue(name, SERVICE)
+	}
+	if operation == `` {
+		return nil, versions[0].MissingParameterValue(REQUEST)
+	}
+
+	s, exceptions := negotiateVersion(versions, operation, version, accept)
+	if exceptions != nil {
+		return nil, exceptions
+	}
+	if !supports(s, operation) {
+		return nil, s.OperationNotSupported(operation)
+	}
+	return s, nil
+}
+
+func negotiateVersion(versions []Service, operation, version string, accept []string) (Service, Exceptions) {
+	if !strings.EqualFold(operation, getcapabilities) {
+		if version == `` {
+			// the parser reports a missing version when it is mandatory
+			return versions[0], nil
+		}
+		for _, s := range versions {
+			if s.Version() == version {
+				return s, nil
+			}
+		}
+		return nil, versions[0].InvalidParameterValue(version, VERSION)
+	}
+
+	if len(accept) > 0 {
+		for _, a := range accept {
+			for _, s := range versions {
+				if s.Version() == strings.TrimSpace(a) {
+					return s, nil
+				}
+			}
+		}
+		return nil, versions[0].VersionNegotiationFailed(strings.Join(accept, `,`))
+	}
+
+	if version == `` {
+		return versions[0], nil
+	}
+	for _, s := range versions {
+		if compareVersions(s.Version(), version) <= 0 {
+			return s, nil
+		}
+	}
+	return versions[len(versions)-1], nil
+}
+
+// infer returns the name of the service for a request without SERVICE parameter:
+// the only service that supports the operation, or else the default service
+func (d *Dispatcher) infer(operation string) string {
+	var candidates []string
+	for _, name := range d.names {
+		for _, s := range d.services[name] {
+			if supports(s, operation) {
+				candidates = append(candidates, name)
+				break
+			}
+		}
+	}
+
+	switch {
+	case len(candidates) == 1:
+		return candidates[0]
+	case len(candidates) == 0:
+		return d.DefaultService
+	}
+	for _, name := range candidates {
+		if strings.EqualFold(name, d.DefaultService) {
+			return name
+		}
+	}
+	return ``
+}
+
+// byNamespace returns the name of the service with the namespace
+func (d *Dispatcher) byNamespace(namespace string) string {
+	if namespace == `` {
+		return ``
+	}
+	for _, name := range d.names {
+		for _, s := range d.services[name] {
+			if s.Namespace() == namespace {
+				return name
+			}
+		}
+	}
+	return ``
+}
+
+// fallback returns the service that reports the exceptions when the service is unknown
+func (d *Dispatcher) fallback() Service {
+	if versions, ok := d.services[strings.ToUpper(d.DefaultService)]; ok {
+		return versions[0]
+	}
+	return d.services[d.names[0]][0]
+}
+
+func supports(s Service, operation string) bool {
+	for _, o := range s.Operations() {
+		if strings.EqualFold(o, operation) {
+			return true
+		}
+	}
+	return false
+}
+
+func isForm(r *http.Request) bool {
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get(`Content-Type`))
+	return mediaType == `application/x-www-form-urlencoded`
+}
+
+// reportFormat returns the EXCEPTIONS parameter, or else the format negotiated with the Accept header
+func reportFormat(query url.Values, r *http.Request) string {
+	if format := first(utils.KeysToUpper(query), EXCEPTIONS); format != `` {
+		return format
+	}
+	return common.NegotiateReportContentType(r.Header.Get(`Accept`))
+}
+
+func first(query url.Values, key string) string {
+	if len(query[key]) > 0 {
+		return query[key][0]
+	}
+	return ``
+}
+
+// compareVersions compares the version numbers like 1.3.0 part by part
+func compareVersions(a, b string) int {
+	as, bs := strings.Split(a, `.`), strings.Split(b, `.`)
+	for i := 0; i < len(as) || i < len(bs); i++ {
+		var x, y int
+		if i < len(as) {
+			x, _ = strconv.Atoi(as[i])
+		}
+		if i < len(bs) {
+			y, _ = strconv.Atoi(bs[i])
+		}
+		if x != y {
+			if x < y {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
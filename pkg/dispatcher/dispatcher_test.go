@@ -0,0 +1,197 @@
+package dispatcher
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/pdok/ogc-specifications/pkg/common"
+	"github.com/pdok/ogc-specifications/pkg/wcs201"
+	"github.com/pdok/ogc-specifications/pkg/wmts100"
+)
+
+func TestDispatch(t *testing.T) {
+	var tests = []struct {
+		method      string
+		target      string
+		contentType string
+		accept      string
+		body        string
+		request     string
+		statusCode  int
+		reportType  string
+		code        string
+	}{
+		0: {method: http.MethodGet,
+			target:  `/?service=wms&request=GetMap&version=1.3.0&layers=Rivers&styles=&crs=EPSG:4326&bbox=-180.0,-90.0,180.0,90.0&width=1024&height=512&format=image/jpeg`,
+			request: `*wms130.GetMapRequest`},
+		// SERVICE is inferred from the operation
+		1: {method: http.MethodGet,
+			target:  `/?REQUEST=GetFeature&VERSION=2.0.0&TYPENAMES=roads`,
+			request: `*wfs200.GetFeatureRequest`},
+		// SERVICE is the default service
+		2: {method: http.MethodGet,
+			target:  `/?request=GetCapabilities`,
+			request: `*wms130.GetCapabilitiesRequest`},
+		3: {method: http.MethodGet,
+			target:  `/?service=WMS&request=GetCapabilities&version=1.1.1`,
+			request: `*wms111.GetCapabilitiesRequest`},
+		4: {method: http.MethodGet,
+			target:     `/?service=WFS&request=GetCapabilities&acceptversions=1.1.0,1.0.0`,
+			statusCode: http.StatusBadRequest,
+			reportType: `text/xml`,
+			code:       `VersionNegotiationFailed`},
+		5: {method: http.MethodGet,
+			target:     `/?service=WMTS&request=GetTile`,
+			statusCode: http.StatusNotImplemented,
+			reportType: `text/xml`,
+			code:       `OperationNotSupported`},
+		6: {method: http.MethodGet,
+			target:     `/?service=WFS&request=GetFeature&version=1.1.0`,
+			statusCode: http.StatusBadRequest,
+			reportType: `text/xml`,
+			code:       `InvalidParameterValue`},
+		7: {method: http.MethodGet,
+			target:     `/?service=WPS&request=GetCapabilities`,
+			statusCode: http.StatusBadRequest,
+			reportType: `text/xml`,
+			code:       `InvalidParameterValue`},
+		8: {method: http.MethodGet,
+			target:     `/?service=WFS`,
+			accept:     `application/json`,
+			statusCode: http.StatusBadRequest,
+			reportType: `application/json`,
+			code:       `MissingParameterValue`},
+		9: {method: http.MethodGet,
+			target:     `/?service=WMS&request=GetMap&version=1.2.0&exceptions=application/problem%2Bjson`,
+			statusCode: http.StatusBadRequest,
+			reportType: `application/problem+json`,
+			code:       `InvalidParameterValue`},
+		// SERVICE is determined by the namespace of the root element
+		10: {method: http.MethodPost,
+			target:  `/`,
+			body:    `<wfs:GetCapabilities xmlns:wfs="http://www.opengis.net/wfs/2.0"/>`,
+			request: `*wfs200.GetCapabilitiesRequest`},
+		11: {method: http.MethodPost,
+			target:  `/`,
+			body:    `<GetCapabilities service="WMTS"><ows:AcceptVersions xmlns:ows="http://www.opengis.net/ows/1.1"><ows:Version>1.0.0</ows:Version></ows:AcceptVersions></GetCapabilities>`,
+			request: `*wmts100.GetCapabilitiesRequest`},
+		12: {method: http.MethodPost,
+			target:      `/`,
+			contentType: `application/x-www-form-urlencoded`,
+			body:        `SERVICE=WCS&REQUEST=GetCapabilities`,
+			request:     `*wcs201.GetCapabilitiesRequest`},
+		13: {method: http.MethodPost,
+			target:     `/`,
+			body:       `<GetMap`,
+			statusCode: http.StatusInternalServerError,
+			reportType: `text/xml`,
+			code:       `NoApplicableCode`},
+		14: {method: http.MethodGet,
+			target:  `/?service=WMS&request=GetMap&version=1.1.1&layers=Rivers&styles=&srs=EPSG:4326&bbox=-180.0,-90.0,180.0,90.0&width=1024&height=512&format=image/jpeg`,
+			request: `*wms111.GetMapRequest`},
+		15: {method: http.MethodGet,
+			target:     `/?service=WMS&request=GetMap&version=1.1.1&layers=Rivers&styles=&crs=EPSG:4326&bbox=-180.0,-90.0,180.0,90.0&width=1024&height=512&format=image/jpeg`,
+			statusCode: http.StatusBadRequest,
+			reportType: `application/vnd.ogc.se_xml`,
+			code:       `MissingParameterValue`},
+	}
+
+	for k, test := range tests {
+		r := httptest.NewRequest(test.method, test.target, strings.NewReader(test.body))
+		if test.contentType != `` {
+			r.Header.Set(`Content-Type`, test.contentType)
+		}
+		if test.accept != `` {
+			r.Header.Set(`Accept`, test.accept)
+		}
+
+		request, report := Dispatch(r)
+		if test.request != `` {
+			if report != nil {
+				t.Errorf("test: %d, expected no report,\n got: %d %s", k, report.StatusCode, report.Body)
+			} else if fmt.Sprintf(`%T`, request) != test.request {
+				t.Errorf("test: %d, expected: %s,\n got: %T", k, test.request, request)
+			}
+			continue
+		}
+
+		if report == nil {
+			t.Errorf("test: %d, expected a report,\n got: %T", k, request)
+			continue
+		}
+		if report.StatusCode != test.statusCode || report.ContentType != test.reportType || !strings.Contains(string(report.Body), test.code) {
+			t.Errorf("test: %d, expected: %d %s with %s,\n got: %d %s %s", k, test.statusCode, test.reportType, test.code, report.StatusCode, report.ContentType, report.Body)
+		}
+	}
+}
+
+func TestReportProblemStatus(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, `/?service=WFS&request=GetFeature&version=2.0.0&typenames=roads&BBOX=1,2,a,4&EXCEPTIONS=application/problem%2Bjson`, nil)
+	_, dispatched := Dispatch(r)
+
+	var tests = []struct {
+		report     *Report
+		statusCode int
+	}{
+		0: {report: dispatched, statusCode: http.StatusBadRequest},
+		1: {report: newReport(wmts100Exceptions(wmts100.TileOutOfRange(wmts100.TILEROW)), common.ProblemJSONContentType), statusCode: http.StatusBadRequest},
+		2: {report: newReport(wcs201Exceptions(wcs201.NoSuchCoverage(`dem`)), common.ProblemJSONContentType), statusCode: http.StatusNotFound},
+	}
+
+	for k, test := range tests {
+		if test.report == nil {
+			t.Errorf("test: %d, expected a report,\n got: nil", k)
+			continue
+		}
+		var p common.Problem
+		if err := json.Unmarshal(test.report.Body, &p); err != nil {
+			t.Errorf("test: %d, expected a problem,\n got: %s", k, test.report.Body)
+			continue
+		}
+		if test.report.StatusCode != test.statusCode || p.Status != test.statusCode {
+			t.Errorf("test: %d, expected: %d,\n got: %d with body status %d", k, test.statusCode, test.report.StatusCode, p.Status)
+		}
+	}
+}
+
+func TestNegotiate(t *testing.T) {
+	d := New(WMS130(), WMS111())
+
+	var tests = []struct {
+		operation string
+		version   string
+		accept    []string
+		result    string
+	}{
+		0: {operation: `GetCapabilities`, result: `1.3.0`},
+		1: {operation: `GetCapabilities`, version: `1.1.1`, result: `1.1.1`},
+		2: {operation: `GetCapabilities`, version: `1.2.0`, result: `1.1.1`},
+		3: {operation: `GetCapabilities`, version: `1.0.0`, result: `1.1.1`},
+		4: {operation: `GetCapabilities`, version: `2.0.0`, result: `1.3.0`},
+		5: {operation: `GetCapabilities`, accept: []string{`2.0.0`, `1.1.1`, `1.3.0`}, result: `1.1.1`},
+		6: {operation: `GetCapabilities`, accept: []string{`2.0.0`}},
+		7: {operation: `GetMap`, version: `1.1.1`, result: `1.1.1`},
+		8: {operation: `GetMap`, version: `1.2.0`},
+		9: {operation: `GetMap`, result: `1.3.0`},
+	}
+
+	for k, test := range tests {
+		s, exceptions := d.negotiate(`wms`, test.operation, test.version, test.accept)
+		if test.result == `` {
+			if exceptions == nil {
+				t.Errorf("test: %d, expected exceptions,\n got: %s", k, s.Version())
+			}
+			continue
+		}
+		if exceptions != nil {
+			body, _ := exceptions.Encode(`XML`)
+			t.Errorf("test: %d, expected: %s,\n got: %s", k, test.result, body)
+		} else if s.Version() != test.result {
+			t.Errorf("test: %d, expected: %s,\n got: %s", k, test.result, s.Version())
+		}
+	}
+}
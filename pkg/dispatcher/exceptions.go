@@ -0,0 +1,42 @@
+package dispatcher
+
+import (
+	"github.com/pdok/ogc-specifications/pkg/wsc110"
+	"github.com/pdok/ogc-specifications/pkg/wsc200"
+)
+
+// wsc110Exceptions are OWS Common 1.1 exceptions of a service version,
+// with the status codes of that service
+type wsc110Exceptions struct {
+	exceptions wsc110.Exceptions
+	version    string
+	statusCode func(...wsc110.Exception) int
+}
+
+// StatusCode returns the HTTP status code of the exception report
+func (e wsc110Exceptions) StatusCode() int {
+	return e.statusCode(e.exceptions...)
+}
+
+// Encode returns the exception report in the format
+func (e wsc110Exceptions) Encode(format string) ([]byte, string) {
+	return e.exceptions.Encode(format, e.version, e.StatusCode())
+}
+
+// wsc200Exceptions are OWS Common 2.0 exceptions of a service version,
+// with the status codes of that service
+type wsc200Exceptions struct {
+	exceptions wsc200.Exceptions
+	version    string
+	statusCode func(...wsc200.Exception) int
+}
+
+// StatusCode returns the HTTP status code of the exception report
+func (e wsc200Exceptions) StatusCode() int {
+	return e.statusCode(e.exceptions...)
+}
+
+// Encode returns the exception report in the format
+func (e wsc200Exceptions) Encode(format string) ([]byte, string) {
+	return e.exceptions.Encode(format, e.version, e.StatusCode())
+}
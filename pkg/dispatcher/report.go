@@ -0,0 +1,24 @@
+package dispatcher
+
+import (
+	"net/http"
+)
+
+// Report is an encoded exception report
+type Report struct {
+	StatusCode  int
+	ContentType string
+	Body        []byte
+}
+
+func newReport(exceptions Exceptions, format string) *Report {
+	body, contentType := exceptions.Encode(format)
+	return &Report{StatusCode: exceptions.StatusCode(), ContentType: contentType, Body: body}
+}
+
+// Write writes the report as response
+func (r Report) Write(w http.ResponseWriter) {
+	w.Header().Set(`Content-Type`, r.ContentType)
+	w.WriteHeader(r.StatusCode)
+	_, _ = w.Write(r.Body)
+}
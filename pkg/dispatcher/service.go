@@ -0,0 +1,41 @@
+package dispatcher
+
+import (
+	"net/url"
+)
+
+// Request is a parsed operation request, like a *wms130.GetMapRequest or a *wfs200.GetFeatureRequest
+type Request interface {
+	ToQueryParameters() url.Values
+	ToXML() []byte
+}
+
+// Exceptions are the exceptions of a service, that can be encoded as exception report
+type Exceptions interface {
+	StatusCode() int
+	Encode(format string) ([]byte, string)
+}
+
+// Service is a version of an OGC web service the dispatcher can parse requests for
+type Service interface {
+	// Name returns the value of the SERVICE parameter, like WMS
+	Name() string
+	// Version returns the version of the service, like 1.3.0
+	Version() string
+	// Namespace returns the namespace of the XML encoded requests
+	Namespace() string
+	// Operations returns the names of the operations that can be parsed
+	Operations() []string
+
+	// ParseQueryParameters parses the KVP encoded request for the operation
+	ParseQueryParameters(operation string, query url.Values) (Request, Exceptions)
+	// ParseXML parses the XML encoded request for the operation
+	ParseXML(operation string, doc []byte) (Request, Exceptions)
+
+	// The exceptions that are raised by the dispatcher itself
+	OperationNotSupported(operation string) Exceptions
+	MissingParameterValue(parameter string) Exceptions
+	InvalidParameterValue(value, parameter string) Exceptions
+	VersionNegotiationFailed(versions string) Exceptions
+	NoApplicableCode(message string) Exceptions
+}
@@ -0,0 +1,81 @@
+package dispatcher
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/pdok/ogc-specifications/pkg/wcs201"
+	"github.com/pdok/ogc-specifications/pkg/wsc200"
+)
+
+type wcs201Service struct{}
+
+// WCS201 returns the WCS 2.0.1 Service
+func WCS201() Service {
+	return wcs201Service{}
+}
+
+func (wcs201Service) Name() string {
+	return wcs201.Service
+}
+
+func (wcs201Service) Version() string {
+	return wcs201.Version
+}
+
+func (wcs201Service) Namespace() string {
+	return `http://www.opengis.net/wcs/2.0`
+}
+
+// Operations returns GetCapabilities, DescribeCoverage and GetCoverage requests can't be parsed yet
+func (wcs201Service) Operations() []string {
+	return []string{`GetCapabilities`}
+}
+
+func (wcs201Service) ParseQueryParameters(operation string, query url.Values) (Request, Exceptions) {
+	if strings.EqualFold(operation, `GetCapabilities`) {
+		var r wcs201.GetCapabilitiesRequest
+		return wcs201Result(&r, r.QueryParameters(query))
+	}
+	return nil, wcs201Exceptions(wsc200.OperationNotSupported(operation))
+}
+
+func (wcs201Service) ParseXML(operation string, doc []byte) (Request, Exceptions) {
+	if strings.EqualFold(operation, `GetCapabilities`) {
+		var r wcs201.GetCapabilitiesRequest
+		return wcs201Result(&r, r.ParseXML(doc))
+	}
+	return nil, wcs201Exceptions(wsc200.OperationNotSupported(operation))
+}
+
+func (wcs201Service) OperationNotSupported(operation string) Exceptions {
+	return wcs201Exceptions(wsc200.OperationNotSupported(operation))
+}
+
+func (wcs201Service) MissingParameterValue(parameter string) Exceptions {
+	return wcs201Exceptions(wsc200.MissingParameterValue(parameter))
+}
+
+func (wcs201Service) InvalidParameterValue(value, parameter string) Exceptions {
+	return wcs201Exceptions(wsc200.InvalidParameterValue(value, parameter))
+}
+
+func (wcs201Service) VersionNegotiationFailed(versions string) Exceptions {
+	return wcs201Exceptions(wsc200.VersionNegotiationFailed(versions))
+}
+
+func (wcs201Service) NoApplicableCode(message string) Exceptions {
+	return wcs201Exceptions(wsc200.NoApplicableCode(message))
+}
+
+func wcs201Exceptions(exceptions ...wsc200.Exception) Exceptions {
+	return wsc200Exceptions{exceptions: exceptions, version: wcs201.Version, statusCode: wcs201.StatusCode}
+}
+
+// wcs201Result returns the request, or the exceptions when there are any
+func wcs201Result(r Request, exceptions []wsc200.Exception) (Request, Exceptions) {
+	if len(exceptions) > 0 {
+		return nil, wcs201Exceptions(exceptions...)
+	}
+	return r, nil
+}
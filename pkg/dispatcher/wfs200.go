@@ -0,0 +1,94 @@
+package dispatcher
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/pdok/ogc-specifications/pkg/wfs200"
+	"github.com/pdok/ogc-specifications/pkg/wsc110"
+)
+
+type wfs200Service struct{}
+
+// WFS200 returns the WFS 2.0.0 Service
+func WFS200() Service {
+	return wfs200Service{}
+}
+
+func (wfs200Service) Name() string {
+	return wfs200.Service
+}
+
+func (wfs200Service) Version() string {
+	return wfs200.Version
+}
+
+func (wfs200Service) Namespace() string {
+	return `http://www.opengis.net/wfs/2.0`
+}
+
+func (wfs200Service) Operations() []string {
+	return []string{`GetCapabilities`, `DescribeFeatureType`, `GetFeature`}
+}
+
+func (wfs200Service) ParseQueryParameters(operation string, query url.Values) (Request, Exceptions) {
+	switch strings.ToUpper(operation) {
+	case `GETCAPABILITIES`:
+		var r wfs200.GetCapabilitiesRequest
+		return wfs200Result(&r, r.ParseQueryParameters(query))
+	case `DESCRIBEFEATURETYPE`:
+		var r wfs200.DescribeFeatureTypeRequest
+		return wfs200Result(&r, r.ParseQueryParameters(query))
+	case `GETFEATURE`:
+		var r wfs200.GetFeatureRequest
+		return wfs200Result(&r, r.ParseQueryParameters(query))
+	}
+	return nil, wfs200Exceptions(wsc110.OperationNotSupported(operation))
+}
+
+func (wfs200Service) ParseXML(operation string, doc []byte) (Request, Exceptions) {
+	switch strings.ToUpper(operation) {
+	case `GETCAPABILITIES`:
+		var r wfs200.GetCapabilitiesRequest
+		return wfs200Result(&r, r.ParseXML(doc))
+	case `DESCRIBEFEATURETYPE`:
+		var r wfs200.DescribeFeatureTypeRequest
+		return wfs200Result(&r, r.ParseXML(doc))
+	case `GETFEATURE`:
+		var r wfs200.GetFeatureRequest
+		return wfs200Result(&r, r.ParseXML(doc))
+	}
+	return nil, wfs200Exceptions(wsc110.OperationNotSupported(operation))
+}
+
+func (wfs200Service) OperationNotSupported(operation string) Exceptions {
+	return wfs200Exceptions(wsc110.OperationNotSupported(operation))
+}
+
+func (wfs200Service) MissingParameterValue(parameter string) Exceptions {
+	return wfs200Exceptions(wsc110.MissingParameterValue(parameter))
+}
+
+func (wfs200Service) InvalidParameterValue(value, parameter string) Exceptions {
+	return wfs200Exceptions(wsc110.InvalidParameterValue(value, parameter))
+}
+
+func (wfs200Service) VersionNegotiationFailed(versions string) Exceptions {
+	return wfs200Exceptions(wsc110.VersionNegotiationFailed(versions))
+}
+
+func (wfs200Service) NoApplicableCode(message string) Exceptions {
+	return wfs200Exceptions(wsc110.NoApplicableCode(message))
+}
+
+func wfs200Exceptions(exceptions ...wsc110.Exception) Exceptions {
+	return wsc110Exceptions{exceptions: exceptions, version: wfs200.Version, statusCode: wfs200.StatusCode}
+}
+
+// wfs200Result returns the request, or the exceptions when there are any
+func wfs200Result(r Request, exceptions []wsc110.Exception) (Request, Exceptions) {
+	if len(exceptions) > 0 {
+		return nil, wfs200Exceptions(exceptions...)
+	}
+	return r, nil
+}
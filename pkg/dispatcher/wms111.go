@@ -0,0 +1,91 @@
+package dispatcher
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/pdok/ogc-specifications/pkg/wms111"
+)
+
+type wms111Service struct{}
+
+// WMS111 returns the WMS 1.1.1 Service
+func WMS111() Service {
+	return wms111Service{}
+}
+
+func (wms111Service) Name() string {
+	return wms111.Service
+}
+
+func (wms111Service) Version() string {
+	return wms111.Version
+}
+
+// Namespace is empty, WMS 1.1.1 requests and documents have no namespace
+func (wms111Service) Namespace() string {
+	return ``
+}
+
+func (wms111Service) Operations() []string {
+	return []string{`GetCapabilities`, `GetMap`, `GetFeatureInfo`}
+}
+
+func (wms111Service) ParseQueryParameters(operation string, query url.Values) (Request, Exceptions) {
+	switch strings.ToUpper(operation) {
+	case `GETCAPABILITIES`:
+		var r wms111.GetCapabilitiesRequest
+		return wms111Result(&r, r.ParseQueryParameters(query))
+	case `GETMAP`:
+		var r wms111.GetMapRequest
+		return wms111Result(&r, r.ParseQueryParameters(query))
+	case `GETFEATUREINFO`:
+		var r wms111.GetFeatureInfoRequest
+		return wms111Result(&r, r.ParseQueryParameters(query))
+	}
+	return nil, wms111.OperationNotSupported(operation).ToExceptions()
+}
+
+func (wms111Service) ParseXML(operation string, doc []byte) (Request, Exceptions) {
+	switch strings.ToUpper(operation) {
+	case `GETCAPABILITIES`:
+		var r wms111.GetCapabilitiesRequest
+		return wms111Result(&r, r.ParseXML(doc))
+	case `GETMAP`:
+		var r wms111.GetMapRequest
+		return wms111Result(&r, r.ParseXML(doc))
+	case `GETFEATUREINFO`:
+		var r wms111.GetFeatureInfoRequest
+		return wms111Result(&r, r.ParseXML(doc))
+	}
+	return nil, wms111.OperationNotSupported(operation).ToExceptions()
+}
+
+func (wms111Service) OperationNotSupported(operation string) Exceptions {
+	return wms111.OperationNotSupported(operation).ToExceptions()
+}
+
+func (wms111Service) MissingParameterValue(parameter string) Exceptions {
+	return wms111.MissingParameterValue(parameter).ToExceptions()
+}
+
+func (wms111Service) InvalidParameterValue(value, parameter string) Exceptions {
+	return wms111.InvalidParameterValue(value, parameter).ToExceptions()
+}
+
+// VersionNegotiationFailed is an InvalidParameterValue, WMS 1.1.1 has no version negotiation exception
+func (wms111Service) VersionNegotiationFailed(versions string) Exceptions {
+	return wms111.InvalidParameterValue(versions, wms111.VERSION).ToExceptions()
+}
+
+func (wms111Service) NoApplicableCode(message string) Exceptions {
+	return wms111.NoApplicableCode(message).ToExceptions()
+}
+
+// wms111Result returns the request, or the exceptions when there are any
+func wms111Result(r Request, exceptions wms111.Exceptions) (Request, Exceptions) {
+	if len(exceptions) > 0 {
+		return nil, exceptions
+	}
+	return r, nil
+}
@@ -0,0 +1,90 @@
+package dispatcher
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/pdok/ogc-specifications/pkg/wms130"
+)
+
+type wms130Service struct{}
+
+// WMS130 returns the WMS 1.3.0 Service
+func WMS130() Service {
+	return wms130Service{}
+}
+
+func (wms130Service) Name() string {
+	return wms130.Service
+}
+
+func (wms130Service) Version() string {
+	return wms130.Version
+}
+
+func (wms130Service) Namespace() string {
+	return `http://www.opengis.net/wms`
+}
+
+func (wms130Service) Operations() []string {
+	return []string{`GetCapabilities`, `GetMap`, `GetFeatureInfo`}
+}
+
+func (wms130Service) ParseQueryParameters(operation string, query url.Values) (Request, Exceptions) {
+	switch strings.ToUpper(operation) {
+	case `GETCAPABILITIES`:
+		var r wms130.GetCapabilitiesRequest
+		return wms130Result(&r, r.ParseQueryParameters(query))
+	case `GETMAP`:
+		var r wms130.GetMapRequest
+		return wms130Result(&r, r.ParseQueryParameters(query))
+	case `GETFEATUREINFO`:
+		var r wms130.GetFeatureInfoRequest
+		return wms130Result(&r, r.ParseQueryParameters(query))
+	}
+	return nil, wms130.OperationNotSupported(operation).ToExceptions()
+}
+
+func (wms130Service) ParseXML(operation string, doc []byte) (Request, Exceptions) {
+	switch strings.ToUpper(operation) {
+	case `GETCAPABILITIES`:
+		var r wms130.GetCapabilitiesRequest
+		return wms130Result(&r, r.ParseXML(doc))
+	case `GETMAP`:
+		var r wms130.GetMapRequest
+		return wms130Result(&r, r.ParseXML(doc))
+	case `GETFEATUREINFO`:
+		var r wms130.GetFeatureInfoRequest
+		return wms130Result(&r, r.ParseXML(doc))
+	}
+	return nil, wms130.OperationNotSupported(operation).ToExceptions()
+}
+
+func (wms130Service) OperationNotSupported(operation string) Exceptions {
+	return wms130.OperationNotSupported(operation).ToExceptions()
+}
+
+func (wms130Service) MissingParameterValue(parameter string) Exceptions {
+	return wms130.MissingParameterValue(parameter).ToExceptions()
+}
+
+func (wms130Service) InvalidParameterValue(value, parameter string) Exceptions {
+	return wms130.InvalidParameterValue(value, parameter).ToExceptions()
+}
+
+// VersionNegotiationFailed is an InvalidParameterValue, WMS 1.3.0 has no version negotiation exception
+func (wms130Service) VersionNegotiationFailed(versions string) Exceptions {
+	return wms130.InvalidParameterValue(versions, wms130.VERSION).ToExceptions()
+}
+
+func (wms130Service) NoApplicableCode(message string) Exceptions {
+	return wms130.NoApplicableCode(message).ToExceptions()
+}
+
+// wms130Result returns the request, or the exceptions when there are any
+func wms130Result(r Request, exceptions wms130.Exceptions) (Request, Exceptions) {
+	if len(exceptions) > 0 {
+		return nil, exceptions
+	}
+	return r, nil
+}
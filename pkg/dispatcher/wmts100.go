@@ -0,0 +1,81 @@
+package dispatcher
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/pdok/ogc-specifications/pkg/wmts100"
+	"github.com/pdok/ogc-specifications/pkg/wsc110"
+)
+
+type wmts100Service struct{}
+
+// WMTS100 returns the WMTS 1.0.0 Service
+func WMTS100() Service {
+	return wmts100Service{}
+}
+
+func (wmts100Service) Name() string {
+	return wmts100.Service
+}
+
+func (wmts100Service) Version() string {
+	return wmts100.Version
+}
+
+func (wmts100Service) Namespace() string {
+	return `http://www.opengis.net/wmts/1.0`
+}
+
+// Operations returns GetCapabilities, GetTile and GetFeatureInfo requests can't be parsed yet
+func (wmts100Service) Operations() []string {
+	return []string{`GetCapabilities`}
+}
+
+func (wmts100Service) ParseQueryParameters(operation string, query url.Values) (Request, Exceptions) {
+	if strings.EqualFold(operation, `GetCapabilities`) {
+		var r wmts100.GetCapabilitiesRequest
+		return wmts100Result(&r, r.ParseQueryParameters(query))
+	}
+	return nil, wmts100Exceptions(wsc110.OperationNotSupported(operation))
+}
+
+func (wmts100Service) ParseXML(operation string, doc []byte) (Request, Exceptions) {
+	if strings.EqualFold(operation, `GetCapabilities`) {
+		var r wmts100.GetCapabilitiesRequest
+		return wmts100Result(&r, r.ParseXML(doc))
+	}
+	return nil, wmts100Exceptions(wsc110.OperationNotSupported(operation))
+}
+
+func (wmts100Service) OperationNotSupported(operation string) Exceptions {
+	return wmts100Exceptions(wsc110.OperationNotSupported(operation))
+}
+
+func (wmts100Service) MissingParameterValue(parameter string) Exceptions {
+	return wmts100Exceptions(wsc110.MissingParameterValue(parameter))
+}
+
+func (wmts100Service) InvalidParameterValue(value, parameter string) Exceptions {
+	return wmts100Exceptions(wsc110.InvalidParameterValue(value, parameter))
+}
+
+func (wmts100Service) VersionNegotiationFailed(versions string) Exceptions {
+	return wmts100Exceptions(wsc110.VersionNegotiationFailed(versions))
+}
+
+func (wmts100Service) NoApplicableCode(message string) Exceptions {
+	return wmts100Exceptions(wsc110.NoApplicableCode(message))
+}
+
+func wmts100Exceptions(exceptions ...wsc110.Exception) Exceptions {
+	return wsc110Exceptions{exceptions: exceptions, version: wmts100.Version, statusCode: wmts100.StatusCode}
+}
+
+// wmts100Result returns the request, or the exceptions when there are any
+func wmts100Result(r Request, exceptions wsc110.Exceptions) (Request, Exceptions) {
+	if len(exceptions) > 0 {
+		return nil, wmts100Exceptions(exceptions...)
+	}
+	return r, nil
+}
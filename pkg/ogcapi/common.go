@@ -0,0 +1,88 @@
+// Package ogcapi translates OGC API requests into the requests of the OGC web services that serve them, and back,
+// so clients can migrate to the OGC API while the backends keep speaking the web service protocols.
+//
+// The OGC API identifies the CRSs by their URI, like http://www.opengis.net/def/crs/EPSG/0/28992,
+// the coordinates are in the axis order of the CRS and CRS84 is the default.
+package ogcapi
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/pdok/ogc-specifications/pkg/crs"
+)
+
+// CRS84 is the URI of the default CRS of the OGC API, WGS84 with the longitude/latitude axis order
+const CRS84 = `http://www.opengis.net/def/crs/OGC/1.3/CRS84`
+
+// crsURI returns the OGC API URI of a CRS in one of the notations supported by crs.Parse
+func crsURI(s string) (string, bool) {
+	authority, code, ok := crs.Parse(s)
+	if !ok {
+		return ``, false
+	}
+	return crs.Definition{Authority: authority, Code: code}.URI(), true
+}
+
+// crsURN returns the URN of a CRS in one of the notations supported by crs.Parse, as used by WFS 2.0.0
+func crsURN(s string) (string, bool) {
+	authority, code, ok := crs.Parse(s)
+	if !ok {
+		return ``, false
+	}
+	return crs.Definition{Authority: authority, Code: code}.URN(), true
+}
+
+// sameCRS returns whether both notations identify the same CRS
+func sameCRS(a, b string) bool {
+	aa, ac, aok := crs.Parse(a)
+	ba, bc, bok := crs.Parse(b)
+	return aok && bok && aa == ba && ac == bc
+}
+
+// list splits a comma separated list, leaving out the empty items
+func list(s string) []string {
+	var items []string
+	for _, item := range strings.Split(s, `,`) {
+		if item = strings.TrimSpace(item); item != `` {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func sp(s string) *string {
+	return &s
+}
+
+func ip(i int) *int {
+	return &i
+}
+
+// collectionSegments returns the unescaped path segments after the last collections segment, starting with the
+// collection id. The returned bool is false when there is no collection id or a segment is empty or badly escaped.
+func collectionSegments(path string) ([]string, bool) {
+	segments := strings.Split(strings.Trim(path, `/`), `/`)
+	start := -1
+	for i := len(segments) - 1; i >= 0; i-- {
+		if segments[i] == collections {
+			start = i
+			break
+		}
+	}
+	if start < 0 {
+		return nil, false
+	}
+	segments = segments[start+1:]
+	if len(segments) == 0 {
+		return nil, false
+	}
+	for i, segment := range segments {
+		unescaped, err := url.PathUnescape(segment)
+		if err != nil || unescaped == `` {
+			return nil, false
+		}
+		segments[i] = unescaped
+	}
+	return segments, true
+}
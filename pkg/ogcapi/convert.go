@@ -0,0 +1,41 @@
+package ogcapi
+
+import (
+	"github.com/pdok/ogc-specifications/pkg/common"
+	"github.com/pdok/ogc-specifications/pkg/wms130"
+	"github.com/pdok/ogc-specifications/pkg/wsc110"
+)
+
+// FromWSC110 maps the exceptions of a web service backend to the Exceptions reported to an OGC API client
+func FromWSC110(exceptions ...wsc110.Exception) Exceptions {
+	var result Exceptions
+	for _, e := range exceptions {
+		result = append(result, Exception{ExceptionDetails: common.ExceptionDetails{
+			ExceptionText: e.Error(),
+			ExceptionCode: e.Code(),
+			LocatorCode:   e.Locator(),
+		}})
+	}
+	return result
+}
+
+// FromWMS130 maps the exceptions of a WMS 1.3.0 backend to the Exceptions reported to an OGC API client,
+// the WMS specific exception codes are translated to the parameters of the OGC API Maps
+func FromWMS130(exceptions ...wms130.Exception) Exceptions {
+	var result Exceptions
+	for _, e := range exceptions {
+		details := e.ExceptionDetails
+		switch e.Code() {
+		case `LayerNotDefined`, `StyleNotDefined`:
+			details.ExceptionCode = `NotFound`
+		case `InvalidFormat`:
+			details.ExceptionCode, details.LocatorCode = `InvalidParameterValue`, F
+		case `InvalidCRS`:
+			details.ExceptionCode, details.LocatorCode = `InvalidParameterValue`, CRS
+		case `InvalidDimensionValue`, `MissingDimensionValue`:
+			details.ExceptionCode, details.LocatorCode = `InvalidParameterValue`, DATETIME
+		}
+		result = append(result, Exception{ExceptionDetails: details})
+	}
+	return result
+}
@@ -0,0 +1,523 @@
+package ogcapi
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/pdok/ogc-specifications/pkg/wfs200"
+)
+
+// CQL2Text is the filter-lang of the CQL2 text encoding, the only filter language supported.
+//
+// The supported subset of CQL2 are the basic comparisons, LIKE, BETWEEN, CASEI and the logical operators,
+// as far as the WFS 2.0.0 filter can hold them. The spatial filtering goes through the bbox parameter.
+const CQL2Text = `cql2-text`
+
+// Wildcards of the CQL2 LIKE operator
+const (
+	cqlWildcard   = `%`
+	cqlSingleChar = `_`
+	cqlEscape     = `\`
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdentifier
+	tokenQuotedIdentifier
+	tokenString
+	tokenNumber
+	tokenOperator
+	tokenLeftParen
+	tokenRightParen
+)
+
+type token struct {
+	kind tokenKind
+	text string
+}
+
+// keyword checks case insensitively if the token is the keyword
+func (t token) keyword(k string) bool {
+	return t.kind == tokenIdentifier && strings.EqualFold(t.text, k)
+}
+
+//nolint:cyclop
+func tokenize(s string) ([]token, error) {
+	var tokens []token
+	runes := []rune(s)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(':
+			tokens = append(tokens, token{tokenLeftParen, `(`})
+			i++
+		case r == ')':
+			tokens = append(tokens, token{tokenRightParen, `)`})
+			i++
+		case r == '\'' || r == '"':
+			kind := tokenString
+			if r == '"' {
+				kind = tokenQuotedIdentifier
+			}
+			var b strings.Builder
+			j := i + 1
+			for ; j < len(runes); j++ {
+				if runes[j] == r {
+					// a doubled quote is an escaped quote
+					if j+1 < len(runes) && runes[j+1] == r {
+						b.WriteRune(r)
+						j++
+						continue
+					}
+					break
+				}
+				b.WriteRune(runes[j])
+			}
+			if j >= len(runes) {
+				return nil, fmt.Errorf("unterminated %c quote", r)
+			}
+			tokens = append(tokens, token{kind, b.String()})
+			i = j + 1
+		case strings.ContainsRune(`=<>`, r):
+			j := i + 1
+			if j < len(runes) && (runes[j] == '=' || (r == '<' && runes[j] == '>')) {
+				j++
+			}
+			tokens = append(tokens, token{tokenOperator, string(runes[i:j])})
+			i = j
+		case r == '-' || r == '+' || r == '.' || unicode.IsDigit(r):
+			j := i + 1
+			for j < len(runes) && (unicode.IsDigit(runes[j]) || strings.ContainsRune(`.eE`, runes[j]) ||
+				(strings.ContainsRune(`+-`, runes[j]) && strings.ContainsRune(`eE`, runes[j-1]))) {
+				j++
+			}
+			text := string(runes[i:j])
+			if _, err := strconv.ParseFloat(text, 64); err != nil {
+				return nil, fmt.Errorf("invalid number: %s", text)
+			}
+			tokens = append(tokens, token{tokenNumber, text})
+			i = j
+		case unicode.IsLetter(r) || r == '_':
+			j := i + 1
+			for j < len(runes) && (unicode.IsLetter(runes[j]) || unicode.IsDigit(runes[j]) || strings.ContainsRune(`_.:`, runes[j])) {
+				j++
+			}
+			tokens = append(tokens, token{tokenIdentifier, string(runes[i:j])})
+			i = j
+		default:
+			return nil, fmt.Errorf("unexpected character: %c", r)
+		}
+	}
+	return append(tokens, token{kind: tokenEOF}), nil
+}
+
+// cqlParser is a recursive descent parser of CQL2 text building the wfs200 filter operators
+type cqlParser struct {
+	tokens []token
+	pos    int
+}
+
+// ParseCQL2 parses a CQL2 text filter into a WFS 2.0.0 filter
+func ParseCQL2(s string) (*wfs200.Filter, error) {
+	c, err := parseCQL2(s)
+	if err != nil {
+		return nil, err
+	}
+	return toFilter(c), nil
+}
+
+func parseCQL2(s string) (wfs200.AND, error) {
+	tokens, err := tokenize(s)
+	if err != nil {
+		return wfs200.AND{}, err
+	}
+	p := cqlParser{tokens: tokens}
+	c, err := p.or()
+	if err != nil {
+		return wfs200.AND{}, err
+	}
+	if t := p.peek(); t.kind != tokenEOF {
+		return wfs200.AND{}, fmt.Errorf("unexpected token: %s", t.text)
+	}
+	return c, nil
+}
+
+func (p *cqlParser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *cqlParser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+// accept consumes the next token when it is the keyword
+func (p *cqlParser) accept(keyword string) bool {
+	if p.peek().keyword(keyword) {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *cqlParser) expect(kind tokenKind, text string) error {
+	if t := p.next(); t.kind != kind {
+		return fmt.Errorf("expected %s, got: %s", text, t.text)
+	}
+	return nil
+}
+
+func (p *cqlParser) or() (wfs200.AND, error) {
+	c, err := p.and()
+	if err != nil {
+		return c, err
+	}
+	terms := []wfs200.AND{c}
+	for p.accept(`OR`) {
+		if c, err = p.and(); err != nil {
+			return c, err
+		}
+		terms = append(terms, c)
+	}
+	if len(terms) == 1 {
+		return terms[0], nil
+	}
+	return disjunction(terms...)
+}
+
+func (p *cqlParser) and() (wfs200.AND, error) {
+	c, err := p.not()
+	if err != nil {
+		return c, err
+	}
+	terms := []wfs200.AND{c}
+	for p.accept(`AND`) {
+		if c, err = p.not(); err != nil {
+			return c, err
+		}
+		terms = append(terms, c)
+	}
+	return conjunction(terms...)
+}
+
+func (p *cqlParser) not() (wfs200.AND, error) {
+	if p.accept(`NOT`) {
+		c, err := p.not()
+		if err != nil {
+			return c, err
+		}
+		return negation(c), nil
+	}
+	if p.peek().kind == tokenLeftParen {
+		p.next()
+		c, err := p.or()
+		if err != nil {
+			return c, err
+		}
+		return c, p.expect(tokenRightParen, `)`)
+	}
+	return p.predicate()
+}
+
+//nolint:cyclop
+func (p *cqlParser) predicate() (wfs200.AND, error) {
+	name, caseless, err := p.property()
+	if err != nil {
+		return wfs200.AND{}, err
+	}
+	var matchCase *string
+	if caseless {
+		matchCase = sp(`false`)
+	}
+
+	negated := p.accept(`NOT`)
+	var c wfs200.AND
+	switch t := p.next(); {
+	case t.kind == tokenOperator && !negated:
+		literal, err := p.literal(caseless)
+		if err != nil {
+			return c, err
+		}
+		a := attribute(name, literal)
+		a.MatchCase = matchCase
+		switch t.text {
+		case `=`:
+			c.PropertyIsEqualTo = &[]wfs200.PropertyIsEqualTo{{ComparisonOperatorAttribute: a}}
+		case `<>`:
+			c.PropertyIsNotEqualTo = &[]wfs200.PropertyIsNotEqualTo{{ComparisonOperatorAttribute: a}}
+		case `<`:
+			c.PropertyIsLessThan = &[]wfs200.PropertyIsLessThan{{ComparisonOperatorAttribute: a}}
+		case `>`:
+			c.PropertyIsGreaterThan = &[]wfs200.PropertyIsGreaterThan{{ComparisonOperatorAttribute: a}}
+		case `<=`:
+			c.PropertyIsLessThanOrEqualTo = &[]wfs200.PropertyIsLessThanOrEqualTo{{ComparisonOperatorAttribute: a}}
+		case `>=`:
+			c.PropertyIsGreaterThanOrEqualTo = &[]wfs200.PropertyIsGreaterThanOrEqualTo{{ComparisonOperatorAttribute: a}}
+		default:
+			return c, fmt.Errorf("unknown operator: %s", t.text)
+		}
+		return c, nil
+	case t.keyword(`LIKE`):
+		literal, err := p.literal(caseless)
+		if err != nil {
+			return c, err
+		}
+		a := attribute(name, literal)
+		a.MatchCase = matchCase
+		c.PropertyIsLike = &[]wfs200.PropertyIsLike{{Wildcard: cqlWildcard, SingleChar: cqlSingleChar, Escape: cqlEscape, ComparisonOperatorAttribute: a}}
+	case t.keyword(`BETWEEN`):
+		lower, err := p.literal(false)
+		if err != nil {
+			return c, err
+		}
+		if !p.accept(`AND`) {
+			return c, errors.New(`expected AND in BETWEEN`)
+		}
+		upper, err := p.literal(false)
+		if err != nil {
+			return c, err
+		}
+		c.PropertyIsBetween = &[]wfs200.PropertyIsBetween{{PropertyName: name, LowerBoundary: lower, UpperBoundary: upper}}
+	case t.keyword(`IS`) && !negated:
+		negated = p.accept(`NOT`)
+		if !p.accept(`NULL`) {
+			return c, errors.New(`expected NULL after IS`)
+		}
+		c.PropertyIsNull = &[]wfs200.PropertyIsNull{{ValueReference: sp(name)}}
+	default:
+		return c, fmt.Errorf("expected a comparison after %s, got: %s", name, t.text)
+	}
+	if negated {
+		return negation(c), nil
+	}
+	return c, nil
+}
+
+// property parses a property name, optionally wrapped in CASEI
+func (p *cqlParser) property() (string, bool, error) {
+	if p.peek().keyword(`CASEI`) {
+		p.next()
+		if err := p.expect(tokenLeftParen, `(`); err != nil {
+			return ``, false, err
+		}
+		name, _, err := p.property()
+		if err != nil {
+			return ``, false, err
+		}
+		return name, true, p.expect(tokenRightParen, `)`)
+	}
+	t := p.next()
+	if t.kind != tokenQuotedIdentifier && (t.kind != tokenIdentifier || isKeyword(t.text)) {
+		return ``, false, fmt.Errorf("expected a property, got: %s", t.text)
+	}
+	return t.text, false, nil
+}
+
+// literal parses a string, number, boolean, TIMESTAMP or DATE literal, a CASEI wrapped literal is accepted when the property is
+func (p *cqlParser) literal(caseless bool) (string, error) {
+	t := p.next()
+	switch {
+	case t.kind == tokenString || t.kind == tokenNumber:
+		return t.text, nil
+	case t.keyword(`TRUE`) || t.keyword(`FALSE`):
+		return strings.ToLower(t.text), nil
+	case t.keyword(`TIMESTAMP`) || t.keyword(`DATE`) || (caseless && t.keyword(`CASEI`)):
+		if err := p.expect(tokenLeftParen, `(`); err != nil {
+			return ``, err
+		}
+		s := p.next()
+		if s.kind != tokenString {
+			return ``, fmt.Errorf("expected a string in %s, got: %s", t.text, s.text)
+		}
+		return s.text, p.expect(tokenRightParen, `)`)
+	}
+	return ``, fmt.Errorf("expected a literal, got: %s", t.text)
+}
+
+func isKeyword(s string) bool {
+	switch strings.ToUpper(s) {
+	case `AND`, `OR`, `NOT`, `LIKE`, `BETWEEN`, `IS`, `NULL`, `TRUE`, `FALSE`:
+		return true
+	}
+	return false
+}
+
+// ToCQL2 writes the operators of a WFS 2.0.0 filter as CQL2 text, the resource ids and spatial operators can't be expressed
+func ToCQL2(f wfs200.Filter) (string, error) {
+	if f.ResourceID != nil {
+		return ``, errors.New(`resource ids can not be expressed in CQL2`)
+	}
+	return writeCQL2(fromFilter(f), ` AND `)
+}
+
+// writeCQL2 writes the operators of the container joined by the logical operator
+//
+//nolint:cyclop
+func writeCQL2(c wfs200.AND, join string) (string, error) {
+	// the nested And and Or are only parenthesised when joined with other operators
+	var parts, nested []string
+	if c.AND != nil {
+		s, err := writeCQL2(*c.AND, ` AND `)
+		if err != nil {
+			return ``, err
+		}
+		nested = append(nested, s)
+	}
+	if c.OR != nil {
+		s, err := writeCQL2(wfs200.AND(*c.OR), ` OR `)
+		if err != nil {
+			return ``, err
+		}
+		nested = append(nested, s)
+	}
+	if c.NOT != nil {
+		s, err := writeCQL2(wfs200.AND(*c.NOT), ` AND `)
+		if err != nil {
+			return ``, err
+		}
+		parts = append(parts, `NOT (`+s+`)`)
+	}
+
+	o := c.ComparisonOperator
+	for _, comparison := range []struct {
+		operator   string
+		attributes []wfs200.ComparisonOperatorAttribute
+	}{
+		{`=`, attributes(o.PropertyIsEqualTo)},
+		{`<>`, attributes(o.PropertyIsNotEqualTo)},
+		{`<`, attributes(o.PropertyIsLessThan)},
+		{`>`, attributes(o.PropertyIsGreaterThan)},
+		{`<=`, attributes(o.PropertyIsLessThanOrEqualTo)},
+		{`>=`, attributes(o.PropertyIsGreaterThanOrEqualTo)},
+	} {
+		for _, a := range comparison.attributes {
+			parts = append(parts, writeProperty(a)+` `+comparison.operator+` `+writeLiteral(a))
+		}
+	}
+	if o.PropertyIsBetween != nil {
+		for _, b := range *o.PropertyIsBetween {
+			parts = append(parts, writeIdentifier(b.PropertyName)+` BETWEEN `+literal(b.LowerBoundary)+` AND `+literal(b.UpperBoundary))
+		}
+	}
+	if o.PropertyIsNull != nil {
+		for _, n := range *o.PropertyIsNull {
+			a := wfs200.ComparisonOperatorAttribute{PropertyName: n.PropertyName, ValueReference: n.ValueReference}
+			parts = append(parts, writeIdentifier(property(a))+` IS NULL`)
+		}
+	}
+	if o.PropertyIsLike != nil {
+		for _, l := range *o.PropertyIsLike {
+			a := l.ComparisonOperatorAttribute
+			a.Literal = likePattern(l)
+			parts = append(parts, writeProperty(a)+` LIKE `+writeLiteral(a))
+		}
+	}
+
+	if !reflect.ValueOf(c.SpatialOperator).IsZero() {
+		return ``, errors.New(`spatial operators other than the bbox can not be expressed`)
+	}
+	if len(nested) == 1 && len(parts) == 0 {
+		return nested[0], nil
+	}
+	for i := len(nested) - 1; i >= 0; i-- {
+		parts = append([]string{`(` + nested[i] + `)`}, parts...)
+	}
+	if len(parts) == 0 {
+		return ``, errors.New(`empty filter`)
+	}
+	return strings.Join(parts, join), nil
+}
+
+// attributes returns the ComparisonOperatorAttribute of the comparisons of one kind
+func attributes(comparisons any) []wfs200.ComparisonOperatorAttribute {
+	v := reflect.ValueOf(comparisons)
+	if v.IsNil() {
+		return nil
+	}
+	var result []wfs200.ComparisonOperatorAttribute
+	for i := 0; i < v.Elem().Len(); i++ {
+		result = append(result, v.Elem().Index(i).Field(0).Interface().(wfs200.ComparisonOperatorAttribute))
+	}
+	return result
+}
+
+func writeProperty(a wfs200.ComparisonOperatorAttribute) string {
+	if a.MatchCase != nil && strings.EqualFold(*a.MatchCase, `false`) {
+		return `CASEI(` + writeIdentifier(property(a)) + `)`
+	}
+	return writeIdentifier(property(a))
+}
+
+func writeLiteral(a wfs200.ComparisonOperatorAttribute) string {
+	if a.MatchCase != nil && strings.EqualFold(*a.MatchCase, `false`) {
+		return `CASEI(` + quote(a.Literal) + `)`
+	}
+	return literal(a.Literal)
+}
+
+// writeIdentifier double quotes the property names that are no plain identifiers
+func writeIdentifier(s string) string {
+	if tokens, err := tokenize(s); err == nil && len(tokens) == 2 && tokens[0].kind == tokenIdentifier && tokens[0].text == s && !isKeyword(s) {
+		return s
+	}
+	return `"` + strings.ReplaceAll(s, `"`, `""`) + `"`
+}
+
+// literal writes numbers as they are, timestamps and dates as TIMESTAMP and DATE and the other values as string
+func literal(s string) string {
+	if _, err := strconv.ParseFloat(s, 64); err == nil {
+		return s
+	}
+	if _, err := time.Parse(time.RFC3339, s); err == nil {
+		return `TIMESTAMP(` + quote(s) + `)`
+	}
+	if _, err := time.Parse(time.DateOnly, s); err == nil {
+		return `DATE(` + quote(s) + `)`
+	}
+	return quote(s)
+}
+
+func quote(s string) string {
+	return `'` + strings.ReplaceAll(s, `'`, `''`) + `'`
+}
+
+// likePattern rewrites the pattern of the PropertyIsLike to the CQL2 wildcards
+func likePattern(l wfs200.PropertyIsLike) string {
+	special := func(s string) bool { return s == cqlWildcard || s == cqlSingleChar || s == cqlEscape }
+	var b strings.Builder
+	escaped := false
+	for _, r := range l.Literal {
+		s := string(r)
+		switch {
+		case escaped:
+			escaped = false
+			if special(s) {
+				b.WriteString(cqlEscape)
+			}
+			b.WriteString(s)
+		case s == l.Escape:
+			escaped = true
+		case s == l.Wildcard:
+			b.WriteString(cqlWildcard)
+		case s == l.SingleChar:
+			b.WriteString(cqlSingleChar)
+		case special(s):
+			b.WriteString(cqlEscape + s)
+		default:
+			b.WriteString(s)
+		}
+	}
+	return b.String()
+}
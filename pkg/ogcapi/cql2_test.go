@@ -0,0 +1,118 @@
+package ogcapi
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/pdok/ogc-specifications/pkg/wfs200"
+)
+
+func TestParseCQL2(t *testing.T) {
+	var tests = []struct {
+		cql    string
+		result *wfs200.Filter
+		err    bool
+	}{
+		0: {cql: `name = 'Rhine'`,
+			result: &wfs200.Filter{ComparisonOperator: wfs200.ComparisonOperator{
+				PropertyIsEqualTo: &[]wfs200.PropertyIsEqualTo{{ComparisonOperatorAttribute: attribute(`name`, `Rhine`)}}}}},
+		1: {cql: `length > 100 and "river name" <> 'Meuse' AND length<=1.5e3`,
+			result: &wfs200.Filter{AND: &wfs200.AND{ComparisonOperator: wfs200.ComparisonOperator{
+				PropertyIsNotEqualTo:        &[]wfs200.PropertyIsNotEqualTo{{ComparisonOperatorAttribute: attribute(`river name`, `Meuse`)}},
+				PropertyIsGreaterThan:       &[]wfs200.PropertyIsGreaterThan{{ComparisonOperatorAttribute: attribute(`length`, `100`)}},
+				PropertyIsLessThanOrEqualTo: &[]wfs200.PropertyIsLessThanOrEqualTo{{ComparisonOperatorAttribute: attribute(`length`, `1.5e3`)}}}}}},
+		2: {cql: `name = 'Rhine' OR name = 'Meuse'`,
+			result: &wfs200.Filter{OR: &wfs200.OR{ComparisonOperator: wfs200.ComparisonOperator{
+				PropertyIsEqualTo: &[]wfs200.PropertyIsEqualTo{{ComparisonOperatorAttribute: attribute(`name`, `Rhine`)}, {ComparisonOperatorAttribute: attribute(`name`, `Meuse`)}}}}}},
+		3: {cql: `(name LIKE 'R%' OR length BETWEEN 10 AND 20) AND NOT navigable = true`,
+			result: &wfs200.Filter{AND: &wfs200.AND{
+				OR: &wfs200.OR{ComparisonOperator: wfs200.ComparisonOperator{
+					PropertyIsBetween: &[]wfs200.PropertyIsBetween{{PropertyName: `length`, LowerBoundary: `10`, UpperBoundary: `20`}},
+					PropertyIsLike:    &[]wfs200.PropertyIsLike{{Wildcard: `%`, SingleChar: `_`, Escape: `\`, ComparisonOperatorAttribute: attribute(`name`, `R%`)}}}},
+				NOT: &wfs200.NOT{ComparisonOperator: wfs200.ComparisonOperator{
+					PropertyIsEqualTo: &[]wfs200.PropertyIsEqualTo{{ComparisonOperatorAttribute: attribute(`navigable`, `true`)}}}}}}},
+		4: {cql: `CASEI(name) NOT LIKE CASEI('o''neill%') AND updated > TIMESTAMP('2020-01-01T00:00:00Z')`,
+			result: &wfs200.Filter{AND: &wfs200.AND{
+				NOT: &wfs200.NOT{ComparisonOperator: wfs200.ComparisonOperator{
+					PropertyIsLike: &[]wfs200.PropertyIsLike{{Wildcard: `%`, SingleChar: `_`, Escape: `\`, ComparisonOperatorAttribute: wfs200.ComparisonOperatorAttribute{
+						MatchCase: sp(`false`), ValueReference: sp(`name`), Literal: `o'neill%`}}}}},
+				ComparisonOperator: wfs200.ComparisonOperator{
+					PropertyIsGreaterThan: &[]wfs200.PropertyIsGreaterThan{{ComparisonOperatorAttribute: attribute(`updated`, `2020-01-01T00:00:00Z`)}}}}}},
+		5: {cql: `name IS NULL AND length IS NOT NULL`,
+			result: &wfs200.Filter{AND: &wfs200.AND{
+				NOT: &wfs200.NOT{ComparisonOperator: wfs200.ComparisonOperator{
+					PropertyIsNull: &[]wfs200.PropertyIsNull{{ValueReference: sp(`length`)}}}},
+				ComparisonOperator: wfs200.ComparisonOperator{
+					PropertyIsNull: &[]wfs200.PropertyIsNull{{ValueReference: sp(`name`)}}}}}},
+		6: {cql: `name = 'Rhine`, err: true},
+		7: {cql: `(name = 'Rhine'`, err: true},
+		// the wfs200 Or can hold a single And
+		8:  {cql: `(a = 1 AND b = 2) OR (c = 3 AND d = 4)`, err: true},
+		9:  {cql: `name = 'Rhine' length = 1`, err: true},
+		10: {cql: `name IS 'Rhine'`, err: true},
+		11: {cql: `name NOT IS NULL`, err: true},
+	}
+
+	for k, test := range tests {
+		result, err := ParseCQL2(test.cql)
+		if test.err {
+			if err == nil {
+				t.Errorf("test: %d, expected an error,\n got: %+v", k, result)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("test: %d, expected no error,\n got: %s", k, err)
+		} else if !reflect.DeepEqual(result, test.result) {
+			t.Errorf("test: %d, expected: %+v,\n got: %+v", k, test.result, result)
+		}
+	}
+}
+
+func TestToCQL2(t *testing.T) {
+	var tests = []struct {
+		filter wfs200.Filter
+		cql    string
+		err    bool
+	}{
+		0: {filter: wfs200.Filter{ComparisonOperator: wfs200.ComparisonOperator{
+			PropertyIsEqualTo: &[]wfs200.PropertyIsEqualTo{{ComparisonOperatorAttribute: wfs200.ComparisonOperatorAttribute{PropertyName: sp(`ns:name`), Literal: `Rhine`}}}}},
+			cql: `ns:name = 'Rhine'`},
+		1: {filter: wfs200.Filter{AND: &wfs200.AND{
+			OR: &wfs200.OR{ComparisonOperator: wfs200.ComparisonOperator{
+				PropertyIsEqualTo: &[]wfs200.PropertyIsEqualTo{{ComparisonOperatorAttribute: attribute(`name`, `Rhine`)}, {ComparisonOperatorAttribute: attribute(`name`, `Meuse`)}}}},
+			ComparisonOperator: wfs200.ComparisonOperator{
+				PropertyIsBetween: &[]wfs200.PropertyIsBetween{{PropertyName: `updated`, LowerBoundary: `2020-01-01`, UpperBoundary: `2020-12-31T00:00:00Z`}}}}},
+			cql: `(name = 'Rhine' OR name = 'Meuse') AND updated BETWEEN DATE('2020-01-01') AND TIMESTAMP('2020-12-31T00:00:00Z')`},
+		// the FES wildcards are rewritten to the CQL2 wildcards
+		2: {filter: wfs200.Filter{NOT: &wfs200.NOT{ComparisonOperator: wfs200.ComparisonOperator{
+			PropertyIsLike: &[]wfs200.PropertyIsLike{{Wildcard: `*`, SingleChar: `.`, Escape: `!`, ComparisonOperatorAttribute: wfs200.ComparisonOperatorAttribute{
+				MatchCase: sp(`false`), ValueReference: sp(`river name`), Literal: `R*_!*.`}}}}}},
+			cql: `NOT (CASEI("river name") LIKE CASEI('R%\_*_'))`},
+		3: {filter: wfs200.Filter{ResourceID: &wfs200.ResourceIDs{{Rid: `rivers.1`}}}, err: true},
+		4: {filter: wfs200.Filter{SpatialOperator: wfs200.SpatialOperator{Intersects: &wfs200.Intersects{PropertyName: `geom`}}}, err: true},
+		5: {filter: wfs200.Filter{NOT: &wfs200.NOT{ComparisonOperator: wfs200.ComparisonOperator{
+			PropertyIsNull: &[]wfs200.PropertyIsNull{{PropertyName: sp(`name`)}}}}},
+			cql: `NOT (name IS NULL)`},
+	}
+
+	for k, test := range tests {
+		cql, err := ToCQL2(test.filter)
+		if test.err {
+			if err == nil {
+				t.Errorf("test: %d, expected an error,\n got: %s", k, cql)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("test: %d, expected no error,\n got: %s", k, err)
+			continue
+		}
+		if cql != test.cql {
+			t.Errorf("test: %d, expected: %s,\n got: %s", k, test.cql, cql)
+		}
+		if _, err := ParseCQL2(cql); err != nil {
+			t.Errorf("test: %d, expected parsable CQL2,\n got: %s", k, err)
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package ogcapi
+
+import (
+	"github.com/pdok/ogc-specifications/pkg/common"
+)
+
+// Exception
+//
+//nolint:errname
+type Exception struct {
+	common.ExceptionDetails
+}
+
+// Exceptions is an array of the Exception interface
+type Exceptions []Exception
+
+// Encode returns the Exceptions as the RFC 7807 problem+json report of the OGC API, together with its content type
+func (e Exceptions) Encode() ([]byte, string) {
+	details := make([]common.ExceptionDetails, 0, len(e))
+	for _, exception := range e {
+		details = append(details, exception.ExceptionDetails)
+	}
+	p := common.NewProblem(details)
+	p.Status = e.StatusCode()
+	return p.ToBytes(), common.ProblemJSONContentType
+}
+
+// ToExceptions promotes a single Exception to an array of one
+func (e Exception) ToExceptions() Exceptions {
+	return Exceptions{e}
+}
+
+// Error returns available ExceptionText
+func (e Exception) Error() string {
+	return e.ExceptionText
+}
+
+// Code returns available ExceptionCode
+func (e Exception) Code() string {
+	return e.ExceptionCode
+}
+
+// Locator returns available ExceptionCode
+func (e Exception) Locator() string {
+	return e.LocatorCode
+}
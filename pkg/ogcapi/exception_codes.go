@@ -0,0 +1,41 @@
+package ogcapi
+
+import (
+	"fmt"
+
+	"github.com/pdok/ogc-specifications/pkg/common"
+)
+
+// NotFound Exception
+func NotFound(path string) Exception {
+	return Exception{ExceptionDetails: common.ExceptionDetails{
+		ExceptionText: "The requested resource does not exist: " + path,
+		ExceptionCode: `NotFound`,
+		LocatorCode:   path,
+	}}
+}
+
+// InvalidParameterValue Exception
+func InvalidParameterValue(value, locator string) Exception {
+	return Exception{ExceptionDetails: common.ExceptionDetails{
+		ExceptionText: fmt.Sprintf("%s contains a invalid value: %s", locator, value),
+		ExceptionCode: `InvalidParameterValue`,
+		LocatorCode:   locator,
+	}}
+}
+
+// OperationNotSupported Exception
+func OperationNotSupported(message string) Exception {
+	return Exception{ExceptionDetails: common.ExceptionDetails{
+		ExceptionText: "This API can not express: " + message,
+		ExceptionCode: `OperationNotSupported`,
+	}}
+}
+
+// NoApplicableCode Exception
+func NoApplicableCode(message string) Exception {
+	return Exception{ExceptionDetails: common.ExceptionDetails{
+		ExceptionText: message,
+		ExceptionCode: `NoApplicableCode`,
+	}}
+}
@@ -0,0 +1,23 @@
+package ogcapi
+
+import (
+	"net/http"
+
+	"github.com/pdok/ogc-specifications/pkg/common"
+)
+
+// statusCodes contains the HTTP status codes for the OGC API specific exception codes
+var statusCodes = map[string]int{
+	`NotFound`: http.StatusNotFound,
+}
+
+// StatusCode returns the HTTP status code for the Exception, next to NotFound the exception codes are the OWS Common codes
+// and unknown exception codes are considered to be server errors
+func (e Exception) StatusCode() int {
+	return common.StatusCode(statusCodes, e)
+}
+
+// StatusCode returns the HTTP status code for a report with these Exceptions
+func (e Exceptions) StatusCode() int {
+	return common.StatusCode(statusCodes, e...)
+}
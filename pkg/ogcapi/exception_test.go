@@ -0,0 +1,39 @@
+package ogcapi
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/pdok/ogc-specifications/pkg/wsc110"
+)
+
+func TestExceptionsEncode(t *testing.T) {
+	body, contentType := Exceptions{NotFound(`/collections/lakes/items`)}.Encode()
+	if contentType != `application/problem+json` {
+		t.Errorf("test: %d, expected: %s,\n got: %s", 0, `application/problem+json`, contentType)
+	}
+	for _, b := range []string{`"title": "NotFound"`, `"status": 404`, `"locator": "/collections/lakes/items"`} {
+		if !strings.Contains(string(body), b) {
+			t.Errorf("test: %d, expected: %s,\n got: %s", 0, b, body)
+		}
+	}
+}
+
+func TestExceptionsStatusCode(t *testing.T) {
+	var tests = []struct {
+		exceptions Exceptions
+		statusCode int
+	}{
+		0: {exceptions: Exceptions{NotFound(`/collections/lakes/items`)}, statusCode: http.StatusNotFound},
+		1: {exceptions: Exceptions{InvalidParameterValue(`ten`, LIMIT), InvalidParameterValue(`a`, BBOX)}, statusCode: http.StatusBadRequest},
+		2: {exceptions: Exceptions{OperationNotSupported(`resultType hits`)}, statusCode: http.StatusNotImplemented},
+		3: {exceptions: FromWSC110(wsc110.NoApplicableCode(`failure`)), statusCode: http.StatusInternalServerError},
+	}
+
+	for k, test := range tests {
+		if statusCode := test.exceptions.StatusCode(); statusCode != test.statusCode {
+			t.Errorf("test: %d, expected: %d,\n got: %d", k, test.statusCode, statusCode)
+		}
+	}
+}
@@ -0,0 +1,372 @@
+package ogcapi
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+
+	"github.com/pdok/ogc-specifications/pkg/wfs200"
+)
+
+// wgs84 is the CRS of a WFS 2.0.0 BBOX without srsName, in a query without srsName on a feature type without DefaultCRS
+const wgs84 = `urn:ogc:def:crs:EPSG::4326`
+
+// Collection maps an OGC API collection onto a WFS 2.0.0 feature type
+type Collection struct {
+	ID string `yaml:"id"`
+	// TypeName is the name of the feature type, by default the collection id
+	TypeName string `yaml:"typeName,omitempty"`
+	// DatetimeProperty is the property the datetime parameter filters on, without it the datetime parameter is rejected
+	DatetimeProperty string `yaml:"datetimeProperty,omitempty"`
+}
+
+// Features translates between OGC API Features items requests and WFS 2.0.0 GetFeature requests,
+// both directions are validated against the Capabilities of the WFS.
+// The feature types without a Collection are published with the local part of their name as collection id.
+type Features struct {
+	Capabilities wfs200.Capabilities
+	Collections  []Collection
+}
+
+// collection resolves a collection id to its feature type
+func (f Features) collection(id string) (Collection, wfs200.FeatureType, bool) {
+	c := Collection{ID: id}
+	mapped := map[string]bool{}
+	for _, candidate := range f.Collections {
+		if candidate.ID == id {
+			c = candidate
+		}
+		if candidate.TypeName != `` {
+			mapped[candidate.TypeName] = true
+		} else {
+			mapped[candidate.ID] = true
+		}
+	}
+
+	typename := c.TypeName
+	if typename == `` {
+		typename = c.ID
+	}
+	for _, ft := range f.Capabilities.FeatureTypeList.FeatureType {
+		// a feature type with a Collection is only published under the id of that collection
+		if c.TypeName == `` && mapped[ft.Name] {
+			continue
+		}
+		if ft.Name == typename || (c.TypeName == `` && localName(ft.Name) == typename) {
+			c.TypeName = ft.Name
+			return c, ft, true
+		}
+	}
+	return c, wfs200.FeatureType{}, false
+}
+
+// collectionOf returns the collection of a feature type
+func (f Features) collectionOf(typename string) (Collection, wfs200.FeatureType, bool) {
+	for _, c := range f.Collections {
+		if c.TypeName == typename || (c.TypeName == `` && c.ID == typename) {
+			return f.collection(c.ID)
+		}
+	}
+	// the local name is the collection id unless it resolves to another feature type
+	if c, ft, ok := f.collection(localName(typename)); ok && ft.Name == typename {
+		return c, ft, true
+	}
+	return f.collection(typename)
+}
+
+func localName(typename string) string {
+	return typename[strings.LastIndex(typename, `:`)+1:]
+}
+
+// supportedCRS checks if the feature type is offered in the CRS, CRS84 is always supported by the OGC API
+func supportedCRS(ft wfs200.FeatureType, uri string) bool {
+	if sameCRS(uri, CRS84) {
+		return true
+	}
+	if ft.DefaultCRS != nil && sameCRS(uri, ft.DefaultCRS.Identifier()) {
+		return true
+	}
+	for _, other := range ft.OtherCRS {
+		if other != nil && sameCRS(uri, other.Identifier()) {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate checks the ItemsRequest against the Capabilities: the collection must be a feature type,
+// the crs and bbox-crs supported by it and the bbox valid
+//
+//nolint:cyclop
+func (f Features) Validate(r ItemsRequest) Exceptions {
+	c, ft, ok := f.collection(r.CollectionID)
+	if !ok {
+		return NotFound(r.Path()).ToExceptions()
+	}
+
+	var exceptions Exceptions
+	if r.Limit != nil && *r.Limit < 1 {
+		exceptions = append(exceptions, InvalidParameterValue(fmt.Sprint(*r.Limit), LIMIT))
+	}
+	if r.Offset != nil && *r.Offset < 0 {
+		exceptions = append(exceptions, InvalidParameterValue(fmt.Sprint(*r.Offset), OFFSET))
+	}
+	for _, p := range []struct {
+		key   string
+		value *string
+	}{{BBOXCRS, r.BBoxCRS}, {CRS, r.CRS}} {
+		if p.value != nil && !supportedCRS(ft, *p.value) {
+			exceptions = append(exceptions, InvalidParameterValue(*p.value, p.key))
+		}
+	}
+	if len(r.BBox) > 0 {
+		query := r.ToQueryParameters()
+		if len(r.BBox) != 4 && len(r.BBox) != 6 {
+			exceptions = append(exceptions, InvalidParameterValue(query.Get(BBOX), BBOX))
+		} else if lower, upper := r.corners(); !(wfs200.Envelope{LowerCorner: lower, UpperCorner: upper}).Valid() {
+			exceptions = append(exceptions, InvalidParameterValue(query.Get(BBOX), BBOX))
+		}
+	}
+	if r.Datetime != nil {
+		if _, _, err := parseDatetime(*r.Datetime); err != nil || c.DatetimeProperty == `` {
+			exceptions = append(exceptions, InvalidParameterValue(*r.Datetime, DATETIME))
+		}
+	}
+	for _, s := range r.SortBy {
+		if _, _, ok := sortProperty(s); !ok {
+			exceptions = append(exceptions, InvalidParameterValue(s, SORTBY))
+		}
+	}
+	if r.FilterLang != nil && *r.FilterLang != CQL2Text {
+		exceptions = append(exceptions, InvalidParameterValue(*r.FilterLang, FILTERLANG))
+	}
+	if r.Filter != nil {
+		if _, err := parseCQL2(*r.Filter); err != nil {
+			exceptions = append(exceptions, InvalidParameterValue(err.Error(), FILTER))
+		}
+	}
+
+	if len(exceptions) > 0 {
+		return exceptions
+	}
+	return nil
+}
+
+// ToWFS200 translates the ItemsRequest into a GetFeature request for the feature type of the collection.
+// The coordinates are returned in CRS84 unless another crs is requested and the bbox, datetime and filter are combined in an And.
+func (f Features) ToWFS200(r ItemsRequest) (wfs200.GetFeatureRequest, Exceptions) {
+	if exceptions := f.Validate(r); exceptions != nil {
+		return wfs200.GetFeatureRequest{}, exceptions
+	}
+	c, _, _ := f.collection(r.CollectionID)
+
+	g := wfs200.GetFeatureRequest{
+		XMLName:                        xml.Name{Local: `GetFeature`},
+		BaseRequest:                    wfs200.BaseRequest{Service: wfs200.Service, Version: wfs200.Version},
+		StandardPresentationParameters: wfs200.StandardPresentationParameters{Count: r.Limit, StartIndex: r.Offset},
+		Query:                          wfs200.Query{TypeNames: c.TypeName},
+	}
+
+	srsName := CRS84
+	if r.CRS != nil {
+		srsName = *r.CRS
+	}
+	urn, _ := crsURN(srsName)
+	g.Query.SrsName = &urn
+
+	if len(r.Properties) > 0 {
+		properties := append([]string(nil), r.Properties...)
+		g.Query.PropertyName = &properties
+	}
+	if len(r.SortBy) > 0 {
+		var sortBy wfs200.SortBy
+		for _, s := range r.SortBy {
+			name, order, _ := sortProperty(s)
+			sortBy.SortProperty = append(sortBy.SortProperty, wfs200.SortProperty{ValueReference: name, SortOrder: order})
+		}
+		g.Query.SortBy = &sortBy
+	}
+
+	// a single feature is selected by its id, the other selections don't apply
+	if r.FeatureID != nil {
+		g.Query.Filter = &wfs200.Filter{ResourceID: &wfs200.ResourceIDs{{Rid: *r.FeatureID}}}
+		return g, nil
+	}
+
+	filter, err := r.filter(c)
+	if err != nil {
+		return wfs200.GetFeatureRequest{}, OperationNotSupported(err.Error()).ToExceptions()
+	}
+	g.Query.Filter = toFilter(filter)
+	return g, nil
+}
+
+// filter combines the bbox, datetime and filter parameters
+func (r ItemsRequest) filter(c Collection) (wfs200.AND, error) {
+	var terms []wfs200.AND
+	if len(r.BBox) > 0 {
+		bboxCRS := CRS84
+		if r.BBoxCRS != nil {
+			bboxCRS = *r.BBoxCRS
+		}
+		urn, _ := crsURN(bboxCRS)
+		lower, upper := r.corners()
+		terms = append(terms, wfs200.AND{SpatialOperator: wfs200.SpatialOperator{BBOX: &wfs200.GEOBBOX{
+			SrsName:  &urn,
+			Envelope: wfs200.Envelope{LowerCorner: lower, UpperCorner: upper},
+		}}})
+	}
+	if r.Datetime != nil {
+		start, end, _ := parseDatetime(*r.Datetime)
+		terms = append(terms, datetimeFilter(c.DatetimeProperty, start, end))
+	}
+	if r.Filter != nil {
+		cql, err := parseCQL2(*r.Filter)
+		if err != nil {
+			return wfs200.AND{}, err
+		}
+		terms = append(terms, cql)
+	}
+	return conjunction(terms...)
+}
+
+// datetimeFilter compares the property with the instant or the, possibly open, interval
+func datetimeFilter(property, start, end string) wfs200.AND {
+	var c wfs200.AND
+	switch {
+	case start != `` && start == end:
+		c.PropertyIsEqualTo = &[]wfs200.PropertyIsEqualTo{{ComparisonOperatorAttribute: attribute(property, start)}}
+	case start != `` && end != ``:
+		c.PropertyIsBetween = &[]wfs200.PropertyIsBetween{{PropertyName: property, LowerBoundary: start, UpperBoundary: end}}
+	case start != ``:
+		c.PropertyIsGreaterThanOrEqualTo = &[]wfs200.PropertyIsGreaterThanOrEqualTo{{ComparisonOperatorAttribute: attribute(property, start)}}
+	case end != ``:
+		c.PropertyIsLessThanOrEqualTo = &[]wfs200.PropertyIsLessThanOrEqualTo{{ComparisonOperatorAttribute: attribute(property, end)}}
+	}
+	return c
+}
+
+// FromWFS200 translates a GetFeature request for a single feature type into the ItemsRequest of its collection.
+// A BBOX in the root of the filter, or in a root And, becomes the bbox parameter and the rest of the filter is written as CQL2 text.
+//
+//nolint:cyclop
+func (f Features) FromWFS200(g wfs200.GetFeatureRequest) (ItemsRequest, Exceptions) {
+	typenames := list(g.Query.TypeNames)
+	if len(typenames) != 1 {
+		return ItemsRequest{}, OperationNotSupported(`a request for other than one feature type: ` + g.Query.TypeNames).ToExceptions()
+	}
+	c, ft, ok := f.collectionOf(typenames[0])
+	if !ok {
+		return ItemsRequest{}, NotFound(typenames[0]).ToExceptions()
+	}
+	if g.ResultType != nil && *g.ResultType == `hits` {
+		return ItemsRequest{}, OperationNotSupported(`the resultType hits`).ToExceptions()
+	}
+
+	r := ItemsRequest{CollectionID: c.ID, Limit: g.Count, Offset: g.StartIndex}
+
+	// without srsName the WFS returns the default CRS of the feature type
+	srsName := g.Query.SrsName
+	if srsName == nil && ft.DefaultCRS != nil {
+		srsName = sp(ft.DefaultCRS.Identifier())
+	}
+	if srsName != nil {
+		uri, ok := crsURI(*srsName)
+		if !ok {
+			return ItemsRequest{}, InvalidParameterValue(*srsName, CRS).ToExceptions()
+		}
+		if !sameCRS(uri, CRS84) {
+			r.CRS = &uri
+		}
+	}
+
+	if g.Query.PropertyName != nil {
+		r.Properties = append([]string(nil), *g.Query.PropertyName...)
+	}
+	if g.Query.SortBy != nil {
+		for _, s := range g.Query.SortBy.SortProperty {
+			prefix := ``
+			if s.SortOrder != nil {
+				switch strings.ToUpper(*s.SortOrder) {
+				case `ASC`:
+					prefix = `+`
+				case `DESC`:
+					prefix = `-`
+				}
+			}
+			r.SortBy = append(r.SortBy, prefix+s.ValueReference)
+		}
+	}
+
+	if g.Query.Filter != nil {
+		if exception := r.fromFilter(*g.Query.Filter, srsName); exception != nil {
+			return ItemsRequest{}, exception.ToExceptions()
+		}
+	}
+
+	if exceptions := f.Validate(r); exceptions != nil {
+		return ItemsRequest{}, exceptions
+	}
+	return r, nil
+}
+
+// fromFilter sets the feature id, bbox or CQL2 filter of the ItemsRequest from a WFS 2.0.0 filter,
+// a BBOX without srsName is in the CRS of the query
+func (r *ItemsRequest) fromFilter(filter wfs200.Filter, querySrsName *string) *Exception {
+	c := fromFilter(filter)
+	if filter.ResourceID != nil {
+		if len(*filter.ResourceID) != 1 || count(c) > 0 {
+			exception := OperationNotSupported(`a selection of several resource ids`)
+			return &exception
+		}
+		r.FeatureID = sp((*filter.ResourceID)[0].Rid)
+		return nil
+	}
+
+	bbox, rest := extractBBox(c)
+	if bbox != nil {
+		srsName := wgs84
+		if bbox.SrsName != nil {
+			srsName = *bbox.SrsName
+		} else if querySrsName != nil {
+			srsName = *querySrsName
+		}
+		uri, ok := crsURI(srsName)
+		if !ok {
+			exception := InvalidParameterValue(srsName, BBOXCRS)
+			return &exception
+		}
+		e := bbox.Envelope
+		r.BBox = []float64{e.LowerCorner[0], e.LowerCorner[1], e.UpperCorner[0], e.UpperCorner[1]}
+		if !sameCRS(uri, CRS84) {
+			r.BBoxCRS = &uri
+		}
+	}
+
+	if count(rest) > 0 {
+		cql, err := writeCQL2(rest, ` AND `)
+		if err != nil {
+			exception := OperationNotSupported(err.Error())
+			return &exception
+		}
+		r.Filter = &cql
+		r.FilterLang = sp(CQL2Text)
+	}
+	return nil
+}
+
+// extractBBox takes the BBOX from the root of the filter or from a root And
+func extractBBox(c wfs200.AND) (*wfs200.GEOBBOX, wfs200.AND) {
+	if c.BBOX != nil {
+		bbox := c.BBOX
+		c.BBOX = nil
+		return bbox, c
+	}
+	if count(c) == 1 && c.AND != nil && c.AND.BBOX != nil {
+		and := *c.AND
+		bbox := and.BBOX
+		and.BBOX = nil
+		return bbox, and
+	}
+	return nil, c
+}
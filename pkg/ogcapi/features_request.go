@@ -0,0 +1,290 @@
+package ogcapi
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Query parameters of the OGC API Features items, unlike the OGC web services these are case sensitive
+const (
+	BBOX       = `bbox`
+	BBOXCRS    = `bbox-crs`
+	CRS        = `crs`
+	DATETIME   = `datetime`
+	LIMIT      = `limit`
+	OFFSET     = `offset`
+	PROPERTIES = `properties`
+	SORTBY     = `sortby`
+	FILTER     = `filter`
+	FILTERLANG = `filter-lang`
+	// F is the format parameter, the format of the response is left to the caller
+	F = `f`
+)
+
+const (
+	collections = `collections`
+	items       = `items`
+	// openInterval is the open start or end of a datetime interval
+	openInterval = `..`
+)
+
+// ItemsRequest is a request for the features of a collection, /collections/{collectionId}/items,
+// or for a single feature, /collections/{collectionId}/items/{featureId}
+type ItemsRequest struct {
+	CollectionID string
+	FeatureID    *string
+	Limit        *int
+	Offset       *int
+	// BBox is the lower and upper corner, with 4 or 6 coordinates, in the axis order of the BBoxCRS
+	BBox       []float64
+	BBoxCRS    *string
+	CRS        *string
+	Datetime   *string
+	Properties []string
+	// SortBy are the property names, prefixed by a + or - for an ascending or descending sort order
+	SortBy     []string
+	Filter     *string
+	FilterLang *string
+}
+
+// Path returns the path of the request, relative to the landing page
+func (r ItemsRequest) Path() string {
+	path := `/` + collections + `/` + url.PathEscape(r.CollectionID) + `/` + items
+	if r.FeatureID != nil {
+		path += `/` + url.PathEscape(*r.FeatureID)
+	}
+	return path
+}
+
+// ParseURL builds a ItemsRequest from the path and query parameters of a request, the path may
+// start with the path of the landing page
+func (r *ItemsRequest) ParseURL(path string, query url.Values) Exceptions {
+	if exception := r.parsePath(path); exception != nil {
+		return exception.ToExceptions()
+	}
+
+	var exceptions Exceptions
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		switch key {
+		case BBOX, BBOXCRS, CRS, DATETIME, LIMIT, OFFSET, PROPERTIES, SORTBY, FILTER, FILTERLANG, F:
+		default:
+			exceptions = append(exceptions, InvalidParameterValue(query.Get(key), key))
+		}
+	}
+
+	if limit := query.Get(LIMIT); limit != `` {
+		l, err := strconv.Atoi(limit)
+		if err != nil || l < 1 {
+			exceptions = append(exceptions, InvalidParameterValue(limit, LIMIT))
+		}
+		r.Limit = &l
+	}
+	if offset := query.Get(OFFSET); offset != `` {
+		o, err := strconv.Atoi(offset)
+		if err != nil || o < 0 {
+			exceptions = append(exceptions, InvalidParameterValue(offset, OFFSET))
+		}
+		r.Offset = &o
+	}
+	if bbox := query.Get(BBOX); bbox != `` {
+		var err error
+		if r.BBox, err = parseBBox(bbox); err != nil {
+			exceptions = append(exceptions, InvalidParameterValue(bbox, BBOX))
+		}
+	}
+	for _, c := range []struct {
+		key   string
+		field **string
+	}{{BBOXCRS, &r.BBoxCRS}, {CRS, &r.CRS}} {
+		if value := query.Get(c.key); value != `` {
+			uri, ok := crsURI(value)
+			if !ok {
+				exceptions = append(exceptions, InvalidParameterValue(value, c.key))
+			}
+			*c.field = &uri
+		}
+	}
+	if datetime := query.Get(DATETIME); datetime != `` {
+		if _, _, err := parseDatetime(datetime); err != nil {
+			exceptions = append(exceptions, InvalidParameterValue(datetime, DATETIME))
+		}
+		r.Datetime = &datetime
+	}
+	r.Properties = list(query.Get(PROPERTIES))
+	if sortby := query.Get(SORTBY); sortby != `` {
+		r.SortBy = list(sortby)
+		for _, s := range r.SortBy {
+			if _, _, ok := sortProperty(s); !ok {
+				exceptions = append(exceptions, InvalidParameterValue(sortby, SORTBY))
+				break
+			}
+		}
+	}
+	if lang := query.Get(FILTERLANG); lang != `` {
+		r.FilterLang = &lang
+		if lang != CQL2Text {
+			exceptions = append(exceptions, InvalidParameterValue(lang, FILTERLANG))
+		}
+	}
+	if filter := query.Get(FILTER); filter != `` {
+		r.Filter = &filter
+		if _, err := parseCQL2(filter); err != nil {
+			exceptions = append(exceptions, InvalidParameterValue(err.Error(), FILTER))
+		}
+	}
+
+	if len(exceptions) > 0 {
+		return exceptions
+	}
+	return nil
+}
+
+// parsePath finds the collection and optional feature in the path
+func (r *ItemsRequest) parsePath(path string) *Exception {
+	notFound := NotFound(path)
+
+	segments, ok := collectionSegments(path)
+	if !ok || len(segments) < 2 || len(segments) > 3 || segments[1] != items {
+		return &notFound
+	}
+
+	r.CollectionID = segments[0]
+	if len(segments) == 3 {
+		r.FeatureID = &segments[2]
+	}
+	return nil
+}
+
+// parseBBox parses the 2D or 3D bbox, the lower corner followed by the upper corner
+func parseBBox(s string) ([]float64, error) {
+	values := strings.Split(s, `,`)
+	if len(values) != 4 && len(values) != 6 {
+		return nil, fmt.Errorf("bbox needs 4 or 6 numbers, got: %d", len(values))
+	}
+	bbox := make([]float64, 0, len(values))
+	for _, value := range values {
+		f, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil {
+			return nil, err
+		}
+		bbox = append(bbox, f)
+	}
+	return bbox, nil
+}
+
+// corners returns the 2D lower and upper corner of the BBox
+func (r ItemsRequest) corners() ([2]float64, [2]float64) {
+	if len(r.BBox) == 6 {
+		return [2]float64{r.BBox[0], r.BBox[1]}, [2]float64{r.BBox[3], r.BBox[4]}
+	}
+	return [2]float64{r.BBox[0], r.BBox[1]}, [2]float64{r.BBox[2], r.BBox[3]}
+}
+
+// sortProperty splits a sortby item in the property name and the ASC or DESC sort order, a + in the query string
+// decodes to a space so an item without prefix is ascending as well
+func sortProperty(s string) (string, *string, bool) {
+	var order *string
+	switch {
+	case strings.HasPrefix(s, `+`):
+		order = sp(`ASC`)
+	case strings.HasPrefix(s, `-`):
+		order = sp(`DESC`)
+	}
+	name := s
+	if order != nil {
+		name = s[1:]
+	}
+	return name, order, name != `` && !strings.ContainsAny(name[:1], `+-`)
+}
+
+// parseDatetime parses an instant or an interval of RFC 3339 timestamps or dates, an instant is returned as both start and end.
+// The start or end of an interval is empty when it's open.
+func parseDatetime(s string) (string, string, error) {
+	parts := strings.Split(s, `/`)
+	switch len(parts) {
+	case 1:
+		if !validTime(parts[0]) {
+			return ``, ``, fmt.Errorf("invalid datetime: %s", s)
+		}
+		return parts[0], parts[0], nil
+	case 2:
+		var bounds [2]string
+		for i, part := range parts {
+			if part == `` || part == openInterval {
+				continue
+			}
+			if !validTime(part) {
+				return ``, ``, fmt.Errorf("invalid datetime: %s", s)
+			}
+			bounds[i] = part
+		}
+		return bounds[0], bounds[1], nil
+	}
+	return ``, ``, fmt.Errorf("invalid datetime: %s", s)
+}
+
+func validTime(s string) bool {
+	if _, err := time.Parse(time.RFC3339, s); err == nil {
+		return true
+	}
+	_, err := time.Parse(time.DateOnly, s)
+	return err == nil
+}
+
+// ToQueryParameters builds the query parameters of the ItemsRequest
+func (r ItemsRequest) ToQueryParameters() url.Values {
+	query := url.Values{}
+	if r.Limit != nil {
+		query.Set(LIMIT, strconv.Itoa(*r.Limit))
+	}
+	if r.Offset != nil {
+		query.Set(OFFSET, strconv.Itoa(*r.Offset))
+	}
+	if len(r.BBox) > 0 {
+		values := make([]string, 0, len(r.BBox))
+		for _, f := range r.BBox {
+			values = append(values, strconv.FormatFloat(f, 'f', -1, 64))
+		}
+		query.Set(BBOX, strings.Join(values, `,`))
+	}
+	if r.BBoxCRS != nil {
+		query.Set(BBOXCRS, *r.BBoxCRS)
+	}
+	if r.CRS != nil {
+		query.Set(CRS, *r.CRS)
+	}
+	if r.Datetime != nil {
+		query.Set(DATETIME, *r.Datetime)
+	}
+	if len(r.Properties) > 0 {
+		query.Set(PROPERTIES, strings.Join(r.Properties, `,`))
+	}
+	if len(r.SortBy) > 0 {
+		query.Set(SORTBY, strings.Join(r.SortBy, `,`))
+	}
+	if r.Filter != nil {
+		query.Set(FILTER, *r.Filter)
+	}
+	if r.FilterLang != nil {
+		query.Set(FILTERLANG, *r.FilterLang)
+	}
+	return query
+}
+
+// ToURL builds the URL of the ItemsRequest on the landing page of the API
+func (r ItemsRequest) ToURL(landingPage string) string {
+	u := strings.TrimRight(landingPage, `/`) + r.Path()
+	if query := r.ToQueryParameters(); len(query) > 0 {
+		u += `?` + query.Encode()
+	}
+	return u
+}
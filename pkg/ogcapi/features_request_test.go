@@ -0,0 +1,116 @@
+package ogcapi
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestItemsRequestParseURL(t *testing.T) {
+	var tests = []struct {
+		path       string
+		query      url.Values
+		result     ItemsRequest
+		exceptions []string
+	}{
+		0: {path: `/collections/rivers/items`,
+			query: url.Values{LIMIT: {`10`}, OFFSET: {`20`}, BBOX: {`5,50,6,52`}, CRS: {`EPSG:28992`}, PROPERTIES: {`name,length`}, SORTBY: {`name,-length`}, F: {`json`}},
+			result: ItemsRequest{CollectionID: `rivers`, Limit: ip(10), Offset: ip(20), BBox: []float64{5, 50, 6, 52},
+				CRS: sp(`http://www.opengis.net/def/crs/EPSG/0/28992`), Properties: []string{`name`, `length`}, SortBy: []string{`name`, `-length`}}},
+		// the path can start with the path of the landing page
+		1: {path: `/ogc/v1/collections/my%20rivers/items/rivers.1`,
+			result: ItemsRequest{CollectionID: `my rivers`, FeatureID: sp(`rivers.1`)}},
+		2: {path: `/collections/rivers/items`,
+			query: url.Values{BBOX: {`50,5,0,52,6,10`}, BBOXCRS: {`http://www.opengis.net/def/crs/EPSG/0/4326`}, DATETIME: {`2020-01-01/..`},
+				FILTER: {`name = 'Rhine'`}, FILTERLANG: {`cql2-text`}},
+			result: ItemsRequest{CollectionID: `rivers`, BBox: []float64{50, 5, 0, 52, 6, 10}, BBoxCRS: sp(`http://www.opengis.net/def/crs/EPSG/0/4326`),
+				Datetime: sp(`2020-01-01/..`), Filter: sp(`name = 'Rhine'`), FilterLang: sp(`cql2-text`)}},
+		3: {path: `/collections/rivers`,
+			exceptions: []string{`NotFound`}},
+		4: {path: `/collections/rivers/items/rivers.1/extra`,
+			exceptions: []string{`NotFound`}},
+		5: {path: `/collections/rivers/items`,
+			query:      url.Values{LIMIT: {`ten`}, BBOX: {`5,50,6`}, CRS: {`EPSG4326`}, DATETIME: {`yesterday`}},
+			exceptions: []string{`InvalidParameterValue`, `InvalidParameterValue`, `InvalidParameterValue`, `InvalidParameterValue`}},
+		6: {path: `/collections/rivers/items`,
+			query:      url.Values{`name`: {`Rhine`}, FILTER: {`name = `}, FILTERLANG: {`cql2-json`}},
+			exceptions: []string{`InvalidParameterValue`, `InvalidParameterValue`, `InvalidParameterValue`}},
+		7: {path: `/collections`,
+			exceptions: []string{`NotFound`}},
+		8: {path: `/collections/rivers/items/%zz`,
+			exceptions: []string{`NotFound`}},
+	}
+
+	for k, test := range tests {
+		var r ItemsRequest
+		exceptions := r.ParseURL(test.path, test.query)
+		if len(test.exceptions) > 0 {
+			if len(exceptions) != len(test.exceptions) {
+				t.Errorf("test: %d, expected: %v,\n got: %v", k, test.exceptions, exceptions)
+				continue
+			}
+			for i, exception := range exceptions {
+				if exception.Code() != test.exceptions[i] {
+					t.Errorf("test: %d, expected: %s,\n got: %s", k, test.exceptions[i], exception.Code())
+				}
+			}
+			continue
+		}
+		if exceptions != nil {
+			t.Errorf("test: %d, expected no exceptions,\n got: %v", k, exceptions)
+		} else if !reflect.DeepEqual(r, test.result) {
+			t.Errorf("test: %d, expected: %+v,\n got: %+v", k, test.result, r)
+		}
+	}
+}
+
+func TestItemsRequestToURL(t *testing.T) {
+	var tests = []struct {
+		request ItemsRequest
+		url     string
+	}{
+		0: {request: ItemsRequest{CollectionID: `rivers`},
+			url: `https://example.org/ogc/collections/rivers/items`},
+		1: {request: ItemsRequest{CollectionID: `my rivers`, FeatureID: sp(`rivers/1`), CRS: sp(`http://www.opengis.net/def/crs/EPSG/0/28992`)},
+			url: `https://example.org/ogc/collections/my%20rivers/items/rivers%2F1?crs=http%3A%2F%2Fwww.opengis.net%2Fdef%2Fcrs%2FEPSG%2F0%2F28992`},
+		2: {request: ItemsRequest{CollectionID: `rivers`, Limit: ip(10), BBox: []float64{5.5, 50, 6, 52.25}, SortBy: []string{`+name`, `-length`}},
+			url: `https://example.org/ogc/collections/rivers/items?bbox=5.5%2C50%2C6%2C52.25&limit=10&sortby=%2Bname%2C-length`},
+	}
+
+	for k, test := range tests {
+		if u := test.request.ToURL(`https://example.org/ogc/`); u != test.url {
+			t.Errorf("test: %d, expected: %s,\n got: %s", k, test.url, u)
+		}
+	}
+}
+
+func TestParseDatetime(t *testing.T) {
+	var tests = []struct {
+		datetime string
+		start    string
+		end      string
+		err      bool
+	}{
+		0: {datetime: `2020-01-01T12:00:00Z`, start: `2020-01-01T12:00:00Z`, end: `2020-01-01T12:00:00Z`},
+		1: {datetime: `2020-01-01/2020-12-31`, start: `2020-01-01`, end: `2020-12-31`},
+		2: {datetime: `../2020-12-31`, end: `2020-12-31`},
+		3: {datetime: `2020-01-01T00:00:00+01:00/`, start: `2020-01-01T00:00:00+01:00`},
+		4: {datetime: `2020-13-01`, err: true},
+		5: {datetime: `2020-01-01/2020-02-01/2020-03-01`, err: true},
+	}
+
+	for k, test := range tests {
+		start, end, err := parseDatetime(test.datetime)
+		if test.err {
+			if err == nil {
+				t.Errorf("test: %d, expected an error,\n got: %s %s", k, start, end)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("test: %d, expected no error,\n got: %s", k, err)
+		} else if start != test.start || end != test.end {
+			t.Errorf("test: %d, expected: %s %s,\n got: %s %s", k, test.start, test.end, start, end)
+		}
+	}
+}
@@ -0,0 +1,160 @@
+package ogcapi
+
+import (
+	"encoding/xml"
+	"reflect"
+	"testing"
+
+	"github.com/pdok/ogc-specifications/pkg/wfs200"
+	"github.com/pdok/ogc-specifications/pkg/wsc110"
+)
+
+func testFeatures() Features {
+	var f Features
+	f.Capabilities.FeatureTypeList.FeatureType = []wfs200.FeatureType{
+		{Name: `ns:rivers`, DefaultCRS: &wfs200.CRS{Namespace: `urn:ogc:def:crs:EPSG:`, Code: 28992},
+			OtherCRS: []*wfs200.CRS{{Namespace: `urn:ogc:def:crs:EPSG:`, Code: 4326}}},
+		{Name: `ns:roads`},
+	}
+	f.Collections = []Collection{{ID: `waterways`, TypeName: `ns:rivers`, DatetimeProperty: `updated`}}
+	return f
+}
+
+func testGetFeature(query wfs200.Query) wfs200.GetFeatureRequest {
+	return wfs200.GetFeatureRequest{XMLName: xml.Name{Local: `GetFeature`},
+		BaseRequest: wfs200.BaseRequest{Service: wfs200.Service, Version: wfs200.Version},
+		Query:       query}
+}
+
+func TestFeaturesToWFS200(t *testing.T) {
+	crs84 := `urn:ogc:def:crs:OGC:1.3:CRS84`
+
+	var tests = []struct {
+		request    ItemsRequest
+		result     wfs200.GetFeatureRequest
+		exceptions []string
+	}{
+		0: {request: ItemsRequest{CollectionID: `roads`, Limit: ip(10), Offset: ip(20), Properties: []string{`name`}, SortBy: []string{`name`, `-length`}},
+			result: func() wfs200.GetFeatureRequest {
+				g := testGetFeature(wfs200.Query{TypeNames: `ns:roads`, SrsName: &crs84, PropertyName: &[]string{`name`},
+					SortBy: &wfs200.SortBy{SortProperty: []wfs200.SortProperty{{ValueReference: `name`}, {ValueReference: `length`, SortOrder: sp(`DESC`)}}}})
+				g.Count, g.StartIndex = ip(10), ip(20)
+				return g
+			}()},
+		1: {request: ItemsRequest{CollectionID: `waterways`, FeatureID: sp(`rivers.1`), BBox: []float64{5, 50, 6, 52}, CRS: sp(`http://www.opengis.net/def/crs/EPSG/0/28992`)},
+			result: testGetFeature(wfs200.Query{TypeNames: `ns:rivers`, SrsName: sp(`urn:ogc:def:crs:EPSG::28992`),
+				Filter: &wfs200.Filter{ResourceID: &wfs200.ResourceIDs{{Rid: `rivers.1`}}}})},
+		// the bbox is in the axis order of the bbox-crs, like the WFS 2.0.0 BBOX
+		2: {request: ItemsRequest{CollectionID: `waterways`, BBox: []float64{50, 5, 52, 6}, BBoxCRS: sp(`http://www.opengis.net/def/crs/EPSG/0/4326`),
+			Datetime: sp(`2020-01-01/2020-12-31`), Filter: sp(`name = 'Rhine'`)},
+			result: testGetFeature(wfs200.Query{TypeNames: `ns:rivers`, SrsName: &crs84,
+				Filter: &wfs200.Filter{AND: &wfs200.AND{
+					ComparisonOperator: wfs200.ComparisonOperator{
+						PropertyIsEqualTo: &[]wfs200.PropertyIsEqualTo{{ComparisonOperatorAttribute: attribute(`name`, `Rhine`)}},
+						PropertyIsBetween: &[]wfs200.PropertyIsBetween{{PropertyName: `updated`, LowerBoundary: `2020-01-01`, UpperBoundary: `2020-12-31`}}},
+					SpatialOperator: wfs200.SpatialOperator{BBOX: &wfs200.GEOBBOX{SrsName: sp(`urn:ogc:def:crs:EPSG::4326`),
+						Envelope: wfs200.Envelope{LowerCorner: wsc110.Position{50, 5}, UpperCorner: wsc110.Position{52, 6}}}}}}})},
+		3: {request: ItemsRequest{CollectionID: `waterways`, BBox: []float64{5, 50, 0, 6, 52, 100}, Datetime: sp(`../2020-12-31`)},
+			result: testGetFeature(wfs200.Query{TypeNames: `ns:rivers`, SrsName: &crs84,
+				Filter: &wfs200.Filter{AND: &wfs200.AND{
+					ComparisonOperator: wfs200.ComparisonOperator{
+						PropertyIsLessThanOrEqualTo: &[]wfs200.PropertyIsLessThanOrEqualTo{{ComparisonOperatorAttribute: attribute(`updated`, `2020-12-31`)}}},
+					SpatialOperator: wfs200.SpatialOperator{BBOX: &wfs200.GEOBBOX{SrsName: &crs84,
+						Envelope: wfs200.Envelope{LowerCorner: wsc110.Position{5, 50}, UpperCorner: wsc110.Position{6, 52}}}}}}})},
+		4: {request: ItemsRequest{CollectionID: `rivers`},
+			exceptions: []string{`NotFound`}},
+		// ns:roads has no datetime property and is only offered in CRS84
+		5: {request: ItemsRequest{CollectionID: `roads`, CRS: sp(`http://www.opengis.net/def/crs/EPSG/0/28992`), BBox: []float64{6, 50, 5, 52}, Datetime: sp(`2020-01-01`)},
+			exceptions: []string{`InvalidParameterValue`, `InvalidParameterValue`, `InvalidParameterValue`}},
+		6: {request: ItemsRequest{CollectionID: `roads`, Limit: ip(0), SortBy: []string{`+-name`}, FilterLang: sp(`cql2-json`)},
+			exceptions: []string{`InvalidParameterValue`, `InvalidParameterValue`, `InvalidParameterValue`}},
+		7: {request: ItemsRequest{CollectionID: `roads`, BBox: []float64{5, 50, 6, 52}, Filter: sp(`BBOX = 1 OR NOT (a = 1 AND b = 2)`)},
+			result: testGetFeature(wfs200.Query{TypeNames: `ns:roads`, SrsName: &crs84,
+				Filter: &wfs200.Filter{AND: &wfs200.AND{
+					OR: &wfs200.OR{
+						NOT: &wfs200.NOT{AND: &wfs200.AND{ComparisonOperator: wfs200.ComparisonOperator{
+							PropertyIsEqualTo: &[]wfs200.PropertyIsEqualTo{{ComparisonOperatorAttribute: attribute(`a`, `1`)}, {ComparisonOperatorAttribute: attribute(`b`, `2`)}}}}},
+						ComparisonOperator: wfs200.ComparisonOperator{
+							PropertyIsEqualTo: &[]wfs200.PropertyIsEqualTo{{ComparisonOperatorAttribute: attribute(`BBOX`, `1`)}}}},
+					SpatialOperator: wfs200.SpatialOperator{BBOX: &wfs200.GEOBBOX{SrsName: &crs84,
+						Envelope: wfs200.Envelope{LowerCorner: wsc110.Position{5, 50}, UpperCorner: wsc110.Position{6, 52}}}}}}})},
+	}
+
+	f := testFeatures()
+	for k, test := range tests {
+		result, exceptions := f.ToWFS200(test.request)
+		if len(test.exceptions) > 0 {
+			if len(exceptions) != len(test.exceptions) {
+				t.Errorf("test: %d, expected: %v,\n got: %v", k, test.exceptions, exceptions)
+				continue
+			}
+			for i, exception := range exceptions {
+				if exception.Code() != test.exceptions[i] {
+					t.Errorf("test: %d, expected: %s,\n got: %s", k, test.exceptions[i], exception.Code())
+				}
+			}
+			continue
+		}
+		if exceptions != nil {
+			t.Errorf("test: %d, expected no exceptions,\n got: %v", k, exceptions)
+		} else if !reflect.DeepEqual(result, test.result) {
+			t.Errorf("test: %d, expected: %+v,\n got: %+v", k, test.result, result)
+		}
+	}
+}
+
+func TestFeaturesFromWFS200(t *testing.T) {
+	var tests = []struct {
+		request    wfs200.GetFeatureRequest
+		result     ItemsRequest
+		exceptions []string
+	}{
+		// without srsName the WFS returns the default CRS of the feature type
+		0: {request: testGetFeature(wfs200.Query{TypeNames: `ns:rivers`,
+			SortBy: &wfs200.SortBy{SortProperty: []wfs200.SortProperty{{ValueReference: `name`, SortOrder: sp(`ASC`)}, {ValueReference: `length`, SortOrder: sp(`DESC`)}}}}),
+			result: ItemsRequest{CollectionID: `waterways`, CRS: sp(`http://www.opengis.net/def/crs/EPSG/0/28992`), SortBy: []string{`+name`, `-length`}}},
+		1: {request: testGetFeature(wfs200.Query{TypeNames: `ns:roads`, SrsName: sp(`urn:ogc:def:crs:OGC:1.3:CRS84`),
+			Filter: &wfs200.Filter{ResourceID: &wfs200.ResourceIDs{{Rid: `roads.1`}}}}),
+			result: ItemsRequest{CollectionID: `roads`, FeatureID: sp(`roads.1`)}},
+		2: {request: testGetFeature(wfs200.Query{TypeNames: `ns:rivers`, SrsName: sp(`EPSG:4326`),
+			Filter: &wfs200.Filter{AND: &wfs200.AND{
+				ComparisonOperator: wfs200.ComparisonOperator{
+					PropertyIsEqualTo: &[]wfs200.PropertyIsEqualTo{{ComparisonOperatorAttribute: attribute(`name`, `Rhine`)}}},
+				SpatialOperator: wfs200.SpatialOperator{BBOX: &wfs200.GEOBBOX{
+					Envelope: wfs200.Envelope{LowerCorner: wsc110.Position{50, 5}, UpperCorner: wsc110.Position{52, 6}}}}}}}),
+			result: ItemsRequest{CollectionID: `waterways`, CRS: sp(`http://www.opengis.net/def/crs/EPSG/0/4326`),
+				BBox: []float64{50, 5, 52, 6}, BBoxCRS: sp(`http://www.opengis.net/def/crs/EPSG/0/4326`),
+				Filter: sp(`name = 'Rhine'`), FilterLang: sp(`cql2-text`)}},
+		3: {request: testGetFeature(wfs200.Query{TypeNames: `ns:rivers,ns:roads`}),
+			exceptions: []string{`OperationNotSupported`}},
+		4: {request: testGetFeature(wfs200.Query{TypeNames: `ns:lakes`}),
+			exceptions: []string{`NotFound`}},
+		5: {request: testGetFeature(wfs200.Query{TypeNames: `ns:roads`,
+			Filter: &wfs200.Filter{ResourceID: &wfs200.ResourceIDs{{Rid: `roads.1`}, {Rid: `roads.2`}}}}),
+			exceptions: []string{`OperationNotSupported`}},
+		6: {request: testGetFeature(wfs200.Query{TypeNames: `ns:roads`, SrsName: sp(`EPSG:28992`)}),
+			exceptions: []string{`InvalidParameterValue`}},
+		// a BBOX without srsName is in the default CRS of the feature type when the query has no srsName either
+		7: {request: testGetFeature(wfs200.Query{TypeNames: `ns:rivers`,
+			Filter: &wfs200.Filter{SpatialOperator: wfs200.SpatialOperator{BBOX: &wfs200.GEOBBOX{
+				Envelope: wfs200.Envelope{LowerCorner: wsc110.Position{110000, 476000}, UpperCorner: wsc110.Position{135000, 496000}}}}}}),
+			result: ItemsRequest{CollectionID: `waterways`, CRS: sp(`http://www.opengis.net/def/crs/EPSG/0/28992`),
+				BBox: []float64{110000, 476000, 135000, 496000}, BBoxCRS: sp(`http://www.opengis.net/def/crs/EPSG/0/28992`)}},
+	}
+
+	f := testFeatures()
+	for k, test := range tests {
+		result, exceptions := f.FromWFS200(test.request)
+		if len(test.exceptions) > 0 {
+			if len(exceptions) != 1 || exceptions[0].Code() != test.exceptions[0] {
+				t.Errorf("test: %d, expected: %v,\n got: %v", k, test.exceptions, exceptions)
+			}
+			continue
+		}
+		if exceptions != nil {
+			t.Errorf("test: %d, expected no exceptions,\n got: %v", k, exceptions)
+		} else if !reflect.DeepEqual(result, test.result) {
+			t.Errorf("test: %d, expected: %+v,\n got: %+v", k, test.result, result)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package ogcapi
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/pdok/ogc-specifications/pkg/wfs200"
+)
+
+// The wfs200 And, Or and Not share the same operator container, so the filters are built with
+// the wfs200.AND as container and converted to the other types. The container holds a conjunction
+// of its operators, like the children of an And.
+
+// merge adds the operators of src to dst, the comparisons are appended but wfs200 has room for a single
+// And, Or, Not and spatial operator of a kind in a container
+func merge(dst *wfs200.AND, src wfs200.AND) error {
+	return mergeValue(reflect.ValueOf(dst).Elem(), reflect.ValueOf(src))
+}
+
+func mergeValue(dst, src reflect.Value) error {
+	for i := 0; i < dst.NumField(); i++ {
+		d, s := dst.Field(i), src.Field(i)
+		switch {
+		case d.Kind() == reflect.Struct:
+			if err := mergeValue(d, s); err != nil {
+				return err
+			}
+		case s.IsNil():
+		case s.Elem().Kind() == reflect.Slice:
+			merged := reflect.MakeSlice(s.Elem().Type(), 0, s.Elem().Len())
+			if !d.IsNil() {
+				merged = reflect.AppendSlice(merged, d.Elem())
+			}
+			merged = reflect.AppendSlice(merged, s.Elem())
+			p := reflect.New(merged.Type())
+			p.Elem().Set(merged)
+			d.Set(p)
+		case d.IsNil():
+			d.Set(s)
+		default:
+			return fmt.Errorf("a WFS 2.0.0 filter can contain one %s per level", dst.Type().Field(i).Name)
+		}
+	}
+	return nil
+}
+
+// count returns the number of operators in the container
+func count(c wfs200.AND) int {
+	return countValue(reflect.ValueOf(c))
+}
+
+func countValue(v reflect.Value) int {
+	n := 0
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Field(i)
+		switch {
+		case f.Kind() == reflect.Struct:
+			n += countValue(f)
+		case f.IsNil():
+		case f.Elem().Kind() == reflect.Slice:
+			n += f.Elem().Len()
+		default:
+			n++
+		}
+	}
+	return n
+}
+
+// single returns a container with a single operator, a conjunction of several operators is wrapped in an And
+func single(c wfs200.AND) wfs200.AND {
+	if count(c) <= 1 {
+		return c
+	}
+	return wfs200.AND{AND: &c}
+}
+
+// conjunction combines the containers into one, like the children of an And
+func conjunction(containers ...wfs200.AND) (wfs200.AND, error) {
+	var result wfs200.AND
+	for _, c := range containers {
+		if err := merge(&result, c); err != nil {
+			return wfs200.AND{}, err
+		}
+	}
+	return result, nil
+}
+
+// disjunction combines the containers into an Or
+func disjunction(containers ...wfs200.AND) (wfs200.AND, error) {
+	var result wfs200.AND
+	for _, c := range containers {
+		if err := merge(&result, single(c)); err != nil {
+			return wfs200.AND{}, err
+		}
+	}
+	or := wfs200.OR(result)
+	return wfs200.AND{OR: &or}, nil
+}
+
+// negation wraps the container in a Not
+func negation(c wfs200.AND) wfs200.AND {
+	not := wfs200.NOT(single(c))
+	return wfs200.AND{NOT: &not}
+}
+
+// toFilter returns the container as the root of a Filter, or nil when it is empty
+func toFilter(c wfs200.AND) *wfs200.Filter {
+	if count(c) == 0 {
+		return nil
+	}
+	s := single(c)
+	return &wfs200.Filter{AND: s.AND, OR: s.OR, NOT: s.NOT, ComparisonOperator: s.ComparisonOperator, SpatialOperator: s.SpatialOperator}
+}
+
+// fromFilter returns the root operators of the Filter as container, leaving out the resource ids
+func fromFilter(f wfs200.Filter) wfs200.AND {
+	return wfs200.AND{AND: f.AND, OR: f.OR, NOT: f.NOT, ComparisonOperator: f.ComparisonOperator, SpatialOperator: f.SpatialOperator}
+}
+
+func attribute(property, literal string) wfs200.ComparisonOperatorAttribute {
+	return wfs200.ComparisonOperatorAttribute{ValueReference: sp(property), Literal: literal}
+}
+
+// property returns the name of the property a comparison operates on
+func property(a wfs200.ComparisonOperatorAttribute) string {
+	if a.ValueReference != nil {
+		return *a.ValueReference
+	}
+	if a.PropertyName != nil {
+		return *a.PropertyName
+	}
+	return ``
+}
@@ -0,0 +1,210 @@
+package ogcapi
+
+import (
+	"encoding/xml"
+	"math"
+
+	"github.com/pdok/ogc-specifications/pkg/crs"
+	"github.com/pdok/ogc-specifications/pkg/wms130"
+)
+
+// defaultSize is the size in pixels of the longest side of a map when neither width nor height are requested
+const defaultSize = 1024
+
+// Maps exposes the named layers of the WMS 1.3.0 Capabilities as collections of the OGC API Maps,
+// the layer name is the collection id and the layer styles are the styles of the collection
+type Maps struct {
+	Capabilities wms130.Capabilities
+}
+
+// ToWMS130 converts the MapRequest into a WMS 1.3.0 GetMap request. The parameters that aren't requested are taken
+// from the layer: the map covers the extent of the layer in CRS84, or the first CRS of the layer when it doesn't offer
+// CRS84, in the first GetMap format. A missing width or height follows the aspect ratio of the bbox.
+//
+//nolint:cyclop
+func (m Maps) ToWMS130(r MapRequest) (wms130.GetMapRequest, Exceptions) {
+	l, layerExceptions := m.Capabilities.GetEffectiveLayer(r.CollectionID)
+	if layerExceptions != nil {
+		return wms130.GetMapRequest{}, NotFound(r.Path()).ToExceptions()
+	}
+	namedLayer := wms130.NamedLayer{Name: r.CollectionID}
+	if r.StyleID != nil {
+		if !m.Capabilities.StyleDefined(r.CollectionID, *r.StyleID) {
+			return wms130.GetMapRequest{}, NotFound(r.Path()).ToExceptions()
+		}
+		namedLayer.NamedStyle = &wms130.NamedStyle{Name: *r.StyleID}
+	}
+
+	c, exceptions := outputCRS(r, l)
+	if exceptions != nil {
+		return wms130.GetMapRequest{}, exceptions
+	}
+	bbox, exceptions := boundingBox(r, l, c)
+	if exceptions != nil {
+		return wms130.GetMapRequest{}, exceptions
+	}
+	width, height := size(bbox.EastNorth(c), r.Width, r.Height)
+
+	g := wms130.GetMapRequest{
+		XMLName:               xml.Name{Local: `GetMap`},
+		BaseRequest:           wms130.BaseRequest{Service: wms130.Service, Version: wms130.Version},
+		StyledLayerDescriptor: wms130.StyledLayerDescriptor{Version: `1.1.0`, NamedLayer: []wms130.NamedLayer{namedLayer}},
+		CRS:                   c,
+		BoundingBox:           bbox,
+		Output:                wms130.Output{Size: wms130.Size{Width: width, Height: height}, Transparent: r.Transparent},
+	}
+	if r.Format != nil {
+		g.Output.Format = mediaType(*r.Format)
+	} else if formats := m.Capabilities.WMSCapabilities.Request.GetMap.Format; len(formats) > 0 {
+		g.Output.Format = formats[0]
+	}
+	if r.BGColor != nil {
+		g.Output.BGcolor = sp(`0x` + *r.BGColor)
+	}
+	if r.Datetime != nil {
+		time, exceptions := wmsTime(*r.Datetime, l)
+		if exceptions != nil {
+			return wms130.GetMapRequest{}, exceptions
+		}
+		g.Time = &time
+	}
+
+	if exceptions := g.Validate(m.Capabilities); exceptions != nil {
+		return wms130.GetMapRequest{}, FromWMS130(exceptions...)
+	}
+	return g, nil
+}
+
+// FromWMS130 converts a WMS 1.3.0 GetMap request for a single layer into a MapRequest, the first GetMap format
+// is left out as it is the default
+func (m Maps) FromWMS130(g wms130.GetMapRequest) (MapRequest, Exceptions) {
+	if len(g.StyledLayerDescriptor.NamedLayer) != 1 {
+		return MapRequest{}, OperationNotSupported(`a map of more than one layer`).ToExceptions()
+	}
+	if exceptions := g.Validate(m.Capabilities); exceptions != nil {
+		return MapRequest{}, FromWMS130(exceptions...)
+	}
+
+	namedLayer := g.StyledLayerDescriptor.NamedLayer[0]
+	r := MapRequest{
+		CollectionID: namedLayer.Name,
+		BBox:         []float64{g.BoundingBox.LowerCorner[0], g.BoundingBox.LowerCorner[1], g.BoundingBox.UpperCorner[0], g.BoundingBox.UpperCorner[1]},
+		Width:        ip(g.Output.Size.Width),
+		Height:       ip(g.Output.Size.Height),
+		Transparent:  g.Output.Transparent,
+	}
+	if namedLayer.NamedStyle != nil && namedLayer.NamedStyle.Name != `` {
+		r.StyleID = sp(namedLayer.NamedStyle.Name)
+	}
+	if uri, ok := crsURI(g.CRS.String()); ok && !sameCRS(uri, CRS84) {
+		r.CRS, r.BBoxCRS = sp(uri), sp(uri)
+	}
+	if formats := m.Capabilities.WMSCapabilities.Request.GetMap.Format; len(formats) == 0 || formats[0] != g.Output.Format {
+		r.Format = sp(shortName(g.Output.Format))
+	}
+	if g.Output.BGcolor != nil {
+		r.BGColor = sp((*g.Output.BGcolor)[2:])
+	}
+	if g.Time != nil {
+		if _, _, err := parseDatetime(*g.Time); err != nil {
+			return MapRequest{}, OperationNotSupported(`the TIME ` + *g.Time).ToExceptions()
+		}
+		r.Datetime = g.Time
+	}
+	return r, nil
+}
+
+// wmsCRS returns the WMS 1.3.0 CRS of a CRS notation
+func wmsCRS(s string) (wms130.CRS, bool) {
+	authority, code, ok := crs.Parse(s)
+	return wms130.CRS{Namespace: authority, Code: code}, ok
+}
+
+// outputCRS returns the requested CRS of the map, CRS84 by default or the first CRS of a layer that doesn't offer CRS84
+func outputCRS(r MapRequest, l wms130.Layer) (wms130.CRS, Exceptions) {
+	if r.CRS != nil {
+		c, ok := wmsCRS(*r.CRS)
+		if !ok || !containsCRS(l.CRS, c) {
+			return wms130.CRS{}, InvalidParameterValue(*r.CRS, CRS).ToExceptions()
+		}
+		return c, nil
+	}
+	c, _ := wmsCRS(CRS84)
+	if len(l.CRS) == 0 || containsCRS(l.CRS, c) {
+		return c, nil
+	}
+	return l.CRS[0], nil
+}
+
+func containsCRS(crss []wms130.CRS, c wms130.CRS) bool {
+	for _, defined := range crss {
+		if defined == c {
+			return true
+		}
+	}
+	return false
+}
+
+// boundingBox returns the requested bbox in the CRS of the map, or the extent of the layer by default
+func boundingBox(r MapRequest, l wms130.Layer, c wms130.CRS) (wms130.BoundingBox, Exceptions) {
+	if len(r.BBox) == 0 {
+		extent, ok := l.Extent(c)
+		if !ok {
+			return wms130.BoundingBox{}, NoApplicableCode(`the extent of collection ` + r.CollectionID + ` is unknown in ` + c.String()).ToExceptions()
+		}
+		return extent, nil
+	}
+
+	bboxCRS := CRS84
+	if r.BBoxCRS != nil {
+		bboxCRS = *r.BBoxCRS
+	}
+	from, ok := wmsCRS(bboxCRS)
+	if !ok {
+		return wms130.BoundingBox{}, InvalidParameterValue(bboxCRS, BBOXCRS).ToExceptions()
+	}
+	bbox := wms130.BoundingBox{LowerCorner: wms130.Position{r.BBox[0], r.BBox[1]}, UpperCorner: wms130.Position{r.BBox[2], r.BBox[3]}}
+	if !bbox.Valid() {
+		return wms130.BoundingBox{}, InvalidParameterValue(r.ToQueryParameters().Get(BBOX), BBOX).ToExceptions()
+	}
+	if from == c {
+		return bbox, nil
+	}
+	transformed, err := bbox.Transform(from, c)
+	if err != nil {
+		return wms130.BoundingBox{}, InvalidParameterValue(bboxCRS, BBOXCRS).ToExceptions()
+	}
+	return transformed, nil
+}
+
+// size returns the width and height of the map, a missing side follows the aspect ratio of the bbox in east/north order
+func size(bbox wms130.BoundingBox, width, height *int) (int, int) {
+	ratio := (bbox.UpperCorner[0] - bbox.LowerCorner[0]) / (bbox.UpperCorner[1] - bbox.LowerCorner[1])
+	scale := func(f float64) int {
+		return int(math.Max(1, math.Round(f)))
+	}
+	switch {
+	case width != nil && height != nil:
+		return *width, *height
+	case width != nil:
+		return *width, scale(float64(*width) / ratio)
+	case height != nil:
+		return scale(float64(*height) * ratio), *height
+	case ratio >= 1:
+		return defaultSize, scale(defaultSize / ratio)
+	}
+	return scale(defaultSize * ratio), defaultSize
+}
+
+// wmsTime returns the WMS TIME of a datetime, the layer needs a time dimension and WMS has no open intervals.
+// Whether the datetime is within the extent of the time dimension is checked by the validation of the GetMap request.
+func wmsTime(datetime string, l wms130.Layer) (string, Exceptions) {
+	if _, ok := l.TimeDimension(); !ok {
+		return ``, InvalidParameterValue(datetime, DATETIME).ToExceptions()
+	}
+	start, end, _ := parseDatetime(datetime)
+	if start == `` || end == `` {
+		return ``, OperationNotSupported(`an open datetime interval`).ToExceptions()
+	}
+	return datetime, nil
+}
@@ -0,0 +1,205 @@
+package ogcapi
+
+import (
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Query parameters of the OGC API Maps, next to the bbox, bbox-crs, crs, datetime and f parameters
+const (
+	WIDTH       = `width`
+	HEIGHT      = `height`
+	TRANSPARENT = `transparent`
+	BGCOLOR     = `bgcolor`
+)
+
+const mapResource = `map`
+
+// MapRequest is a request for a map of a collection, /collections/{collectionId}/map,
+// or of a style of the collection, /collections/{collectionId}/styles/{styleId}/map
+type MapRequest struct {
+	CollectionID string
+	StyleID      *string
+	// BBox is the lower and upper corner in the axis order of the BBoxCRS
+	BBox        []float64
+	BBoxCRS     *string
+	CRS         *string
+	Width       *int
+	Height      *int
+	Datetime    *string
+	Transparent *bool
+	// BGColor is the hexadecimal RRGGBB background color
+	BGColor *string
+	// Format is the value of the f parameter, a short name like png or a media type
+	Format *string
+}
+
+// Path returns the path of the request, relative to the landing page
+func (r MapRequest) Path() string {
+	path := `/` + collections + `/` + url.PathEscape(r.CollectionID)
+	if r.StyleID != nil {
+		path += `/` + styles + `/` + url.PathEscape(*r.StyleID)
+	}
+	return path + `/` + mapResource
+}
+
+// ParseURL builds a MapRequest from the path and query parameters of a request, the path may
+// start with the path of the landing page
+//
+//nolint:cyclop
+func (r *MapRequest) ParseURL(path string, query url.Values) Exceptions {
+	if exception := r.parsePath(path); exception != nil {
+		return exception.ToExceptions()
+	}
+
+	var exceptions Exceptions
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		switch key {
+		case BBOX, BBOXCRS, CRS, WIDTH, HEIGHT, DATETIME, TRANSPARENT, BGCOLOR, F:
+		default:
+			exceptions = append(exceptions, InvalidParameterValue(query.Get(key), key))
+		}
+	}
+
+	if bbox := query.Get(BBOX); bbox != `` {
+		var err error
+		if r.BBox, err = parseBBox(bbox); err != nil || len(r.BBox) != 4 {
+			exceptions = append(exceptions, InvalidParameterValue(bbox, BBOX))
+		}
+	}
+	for _, c := range []struct {
+		key   string
+		field **string
+	}{{BBOXCRS, &r.BBoxCRS}, {CRS, &r.CRS}} {
+		if value := query.Get(c.key); value != `` {
+			uri, ok := crsURI(value)
+			if !ok {
+				exceptions = append(exceptions, InvalidParameterValue(value, c.key))
+			}
+			*c.field = &uri
+		}
+	}
+	for _, s := range []struct {
+		key   string
+		field **int
+	}{{WIDTH, &r.Width}, {HEIGHT, &r.Height}} {
+		if value := query.Get(s.key); value != `` {
+			i, err := strconv.Atoi(value)
+			if err != nil || i < 1 {
+				exceptions = append(exceptions, InvalidParameterValue(value, s.key))
+			}
+			*s.field = &i
+		}
+	}
+	if datetime := query.Get(DATETIME); datetime != `` {
+		if _, _, err := parseDatetime(datetime); err != nil {
+			exceptions = append(exceptions, InvalidParameterValue(datetime, DATETIME))
+		}
+		r.Datetime = &datetime
+	}
+	if transparent := query.Get(TRANSPARENT); transparent != `` {
+		switch transparent {
+		case `true`, `false`:
+			t := transparent == `true`
+			r.Transparent = &t
+		default:
+			exceptions = append(exceptions, InvalidParameterValue(transparent, TRANSPARENT))
+		}
+	}
+	if bgcolor := query.Get(BGCOLOR); bgcolor != `` {
+		if !validColor(bgcolor) {
+			exceptions = append(exceptions, InvalidParameterValue(bgcolor, BGCOLOR))
+		}
+		r.BGColor = &bgcolor
+	}
+	if f := query.Get(F); f != `` {
+		r.Format = &f
+	}
+
+	if len(exceptions) > 0 {
+		return exceptions
+	}
+	return nil
+}
+
+// parsePath finds the collection and optional style in the path
+func (r *MapRequest) parsePath(path string) *Exception {
+	notFound := NotFound(path)
+
+	segments, ok := collectionSegments(path)
+	if !ok {
+		return &notFound
+	}
+
+	switch {
+	case len(segments) == 2 && segments[1] == mapResource:
+	case len(segments) == 4 && segments[1] == styles && segments[3] == mapResource:
+		r.StyleID = &segments[2]
+	default:
+		return &notFound
+	}
+	r.CollectionID = segments[0]
+	return nil
+}
+
+// validColor checks the hexadecimal RRGGBB notation of a color
+func validColor(s string) bool {
+	if len(s) != 6 {
+		return false
+	}
+	_, err := strconv.ParseUint(s, 16, 32)
+	return err == nil
+}
+
+// ToQueryParameters builds the query parameters of the MapRequest
+func (r MapRequest) ToQueryParameters() url.Values {
+	query := url.Values{}
+	if len(r.BBox) > 0 {
+		values := make([]string, 0, len(r.BBox))
+		for _, f := range r.BBox {
+			values = append(values, strconv.FormatFloat(f, 'f', -1, 64))
+		}
+		query.Set(BBOX, strings.Join(values, `,`))
+	}
+	if r.BBoxCRS != nil {
+		query.Set(BBOXCRS, *r.BBoxCRS)
+	}
+	if r.CRS != nil {
+		query.Set(CRS, *r.CRS)
+	}
+	if r.Width != nil {
+		query.Set(WIDTH, strconv.Itoa(*r.Width))
+	}
+	if r.Height != nil {
+		query.Set(HEIGHT, strconv.Itoa(*r.Height))
+	}
+	if r.Datetime != nil {
+		query.Set(DATETIME, *r.Datetime)
+	}
+	if r.Transparent != nil {
+		query.Set(TRANSPARENT, strconv.FormatBool(*r.Transparent))
+	}
+	if r.BGColor != nil {
+		query.Set(BGCOLOR, *r.BGColor)
+	}
+	if r.Format != nil {
+		query.Set(F, *r.Format)
+	}
+	return query
+}
+
+// ToURL builds the URL of the MapRequest on the landing page of the API
+func (r MapRequest) ToURL(landingPage string) string {
+	u := strings.TrimRight(landingPage, `/`) + r.Path()
+	if query := r.ToQueryParameters(); len(query) > 0 {
+		u += `?` + query.Encode()
+	}
+	return u
+}
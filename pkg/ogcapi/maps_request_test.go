@@ -0,0 +1,74 @@
+package ogcapi
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestMapRequestParseURL(t *testing.T) {
+	var tests = []struct {
+		path       string
+		query      url.Values
+		result     MapRequest
+		exceptions []string
+	}{
+		0: {path: `/collections/rivers/map`,
+			query: url.Values{BBOX: {`5,50,6,52`}, WIDTH: {`800`}, HEIGHT: {`600`}, F: {`png`}, DATETIME: {`2020-01-01`},
+				TRANSPARENT: {`true`}, BGCOLOR: {`7F7F7F`}},
+			result: MapRequest{CollectionID: `rivers`, BBox: []float64{5, 50, 6, 52}, Width: ip(800), Height: ip(600), Format: sp(`png`),
+				Datetime: sp(`2020-01-01`), Transparent: bp(true), BGColor: sp(`7F7F7F`)}},
+		1: {path: `/ogc/v1/collections/rivers/styles/blue/map`,
+			query:  url.Values{BBOXCRS: {`EPSG:28992`}, CRS: {`EPSG:28992`}},
+			result: MapRequest{CollectionID: `rivers`, StyleID: sp(`blue`), BBoxCRS: sp(`http://www.opengis.net/def/crs/EPSG/0/28992`), CRS: sp(`http://www.opengis.net/def/crs/EPSG/0/28992`)}},
+		2: {path: `/collections/rivers/styles/map`,
+			exceptions: []string{`NotFound`}},
+		3: {path: `/collections/rivers/items`,
+			exceptions: []string{`NotFound`}},
+		4: {path: `/collections/rivers/map`,
+			query:      url.Values{BBOX: {`5,50,0,6,52,10`}, WIDTH: {`0`}, TRANSPARENT: {`yes`}, BGCOLOR: {`blue`}, `layers`: {`roads`}},
+			exceptions: []string{`InvalidParameterValue`, `InvalidParameterValue`, `InvalidParameterValue`, `InvalidParameterValue`, `InvalidParameterValue`}},
+		5: {path: `/api/collections/`,
+			exceptions: []string{`NotFound`}},
+	}
+
+	for k, test := range tests {
+		var r MapRequest
+		exceptions := r.ParseURL(test.path, test.query)
+		if len(test.exceptions) > 0 {
+			if len(exceptions) != len(test.exceptions) {
+				t.Errorf("test: %d, expected: %v,\n got: %v", k, test.exceptions, exceptions)
+				continue
+			}
+			for i, exception := range exceptions {
+				if exception.Code() != test.exceptions[i] {
+					t.Errorf("test: %d, expected: %s,\n got: %s", k, test.exceptions[i], exception.Code())
+				}
+			}
+			continue
+		}
+		if exceptions != nil {
+			t.Errorf("test: %d, expected no exceptions,\n got: %v", k, exceptions)
+		} else if !reflect.DeepEqual(r, test.result) {
+			t.Errorf("test: %d, expected: %+v,\n got: %+v", k, test.result, r)
+		}
+	}
+}
+
+func TestMapRequestToURL(t *testing.T) {
+	var tests = []struct {
+		request MapRequest
+		result  string
+	}{
+		0: {request: MapRequest{CollectionID: `rivers`},
+			result: `https://example.org/ogc/collections/rivers/map`},
+		1: {request: MapRequest{CollectionID: `rivers`, StyleID: sp(`blue`), BBox: []float64{5, 50, 6, 52}, Width: ip(800), Transparent: bp(false)},
+			result: `https://example.org/ogc/collections/rivers/styles/blue/map?bbox=5%2C50%2C6%2C52&transparent=false&width=800`},
+	}
+
+	for k, test := range tests {
+		if result := test.request.ToURL(`https://example.org/ogc`); result != test.result {
+			t.Errorf("test: %d, expected: %s,\n got: %s", k, test.result, result)
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package ogcapi
+
+import (
+	"encoding/xml"
+	"reflect"
+	"testing"
+
+	"github.com/pdok/ogc-specifications/pkg/wms130"
+)
+
+var riverMaps = Maps{Capabilities: wms130.Capabilities{WMSCapabilities: wms130.WMSCapabilities{
+	Request: wms130.Request{GetMap: wms130.RequestType{Format: []string{`image/png`, `image/jpeg`}}},
+	Layer: []wms130.Layer{{
+		Name:                    sp(`top`),
+		CRS:                     []wms130.CRS{{Namespace: `CRS`, Code: 84}, {Namespace: `EPSG`, Code: 28992}, {Namespace: `EPSG`, Code: 4326}},
+		EXGeographicBoundingBox: &wms130.EXGeographicBoundingBox{WestBoundLongitude: 3, EastBoundLongitude: 8, SouthBoundLatitude: 50, NorthBoundLatitude: 54},
+		Layer: []*wms130.Layer{
+			{Name: sp(`rivers`), Style: []*wms130.Style{{Name: `blue`}},
+				Dimension: []*wms130.Dimension{{Name: sp(`time`), Units: sp(`ISO8601`), Default: sp(`2020-12-31`), Value: sp(`2020-01-01/2020-12-31/P1D`)}}},
+			{Name: sp(`roads`)},
+		},
+	}},
+}}}
+
+func getMap(layer string, style *string, c wms130.CRS, bbox wms130.BoundingBox, width, height int, format string) wms130.GetMapRequest {
+	namedLayer := wms130.NamedLayer{Name: layer}
+	if style != nil {
+		namedLayer.NamedStyle = &wms130.NamedStyle{Name: *style}
+	}
+	return wms130.GetMapRequest{
+		XMLName:               xml.Name{Local: `GetMap`},
+		BaseRequest:           wms130.BaseRequest{Service: `WMS`, Version: `1.3.0`},
+		StyledLayerDescriptor: wms130.StyledLayerDescriptor{Version: `1.1.0`, NamedLayer: []wms130.NamedLayer{namedLayer}},
+		CRS:                   c,
+		BoundingBox:           bbox,
+		Output:                wms130.Output{Size: wms130.Size{Width: width, Height: height}, Format: format},
+	}
+}
+
+var (
+	crs84 = wms130.CRS{Namespace: `CRS`, Code: 84}
+	rd    = wms130.CRS{Namespace: `EPSG`, Code: 28992}
+	rdURI = `http://www.opengis.net/def/crs/EPSG/0/28992`
+)
+
+func TestMapsToWMS130(t *testing.T) {
+	styled := getMap(`rivers`, sp(`blue`), rd, wms130.BoundingBox{LowerCorner: wms130.Position{100000, 400000}, UpperCorner: wms130.Position{200000, 500000}}, 512, 512, `image/jpeg`)
+	styled.Output.Transparent, styled.Output.BGcolor, styled.Time = bp(true), sp(`0x7F7F7F`), sp(`2020-01-01/2020-06-30`)
+
+	var tests = []struct {
+		request    MapRequest
+		result     wms130.GetMapRequest
+		exceptions []string
+	}{
+		0: {request: MapRequest{CollectionID: `rivers`, BBox: []float64{4, 51, 6, 52}, Width: ip(800)},
+			result: getMap(`rivers`, nil, crs84, wms130.BoundingBox{LowerCorner: wms130.Position{4, 51}, UpperCorner: wms130.Position{6, 52}}, 800, 400, `image/png`)},
+		1: {request: MapRequest{CollectionID: `rivers`, StyleID: sp(`blue`), BBox: []float64{100000, 400000, 200000, 500000}, BBoxCRS: sp(rdURI), CRS: sp(rdURI),
+			Height: ip(512), Format: sp(`jpg`), Transparent: bp(true), BGColor: sp(`7F7F7F`), Datetime: sp(`2020-01-01/2020-06-30`)},
+			result: styled},
+		// the map covers the extent of the layer by default
+		2: {request: MapRequest{CollectionID: `roads`},
+			result: getMap(`roads`, nil, crs84, wms130.BoundingBox{LowerCorner: wms130.Position{3, 50}, UpperCorner: wms130.Position{8, 54}}, 1024, 819, `image/png`)},
+		3: {request: MapRequest{CollectionID: `railways`},
+			exceptions: []string{`NotFound`}},
+		4: {request: MapRequest{CollectionID: `rivers`, StyleID: sp(`red`)},
+			exceptions: []string{`NotFound`}},
+		5: {request: MapRequest{CollectionID: `rivers`, CRS: sp(`http://www.opengis.net/def/crs/EPSG/0/3857`)},
+			exceptions: []string{`InvalidParameterValue`}},
+		6: {request: MapRequest{CollectionID: `rivers`, Format: sp(`webp`)},
+			exceptions: []string{`InvalidParameterValue`}},
+		7: {request: MapRequest{CollectionID: `roads`, Datetime: sp(`2020-01-01`)},
+			exceptions: []string{`InvalidParameterValue`}},
+		8: {request: MapRequest{CollectionID: `rivers`, Datetime: sp(`2020-01-01/..`)},
+			exceptions: []string{`OperationNotSupported`}},
+		9: {request: MapRequest{CollectionID: `rivers`, BBox: []float64{6, 52, 4, 51}},
+			exceptions: []string{`InvalidParameterValue`}},
+		10: {request: MapRequest{CollectionID: `rivers`, Datetime: sp(`2021-06-01`)},
+			exceptions: []string{`InvalidParameterValue`}},
+	}
+
+	for k, test := range tests {
+		result, exceptions := riverMaps.ToWMS130(test.request)
+		if len(test.exceptions) > 0 {
+			if len(exceptions) != len(test.exceptions) {
+				t.Errorf("test: %d, expected: %v,\n got: %v", k, test.exceptions, exceptions)
+				continue
+			}
+			for i, exception := range exceptions {
+				if exception.Code() != test.exceptions[i] {
+					t.Errorf("test: %d, expected: %s,\n got: %s", k, test.exceptions[i], exception.Code())
+				}
+			}
+			continue
+		}
+		if exceptions != nil {
+			t.Errorf("test: %d, expected no exceptions,\n got: %v", k, exceptions)
+		} else if !reflect.DeepEqual(result, test.result) {
+			t.Errorf("test: %d, expected: %+v,\n got: %+v", k, test.result, result)
+		}
+	}
+}
+
+func TestMapsFromWMS130(t *testing.T) {
+	styled := getMap(`rivers`, sp(`blue`), rd, wms130.BoundingBox{LowerCorner: wms130.Position{100000, 400000}, UpperCorner: wms130.Position{200000, 500000}}, 512, 512, `image/jpeg`)
+	styled.Output.Transparent, styled.Output.BGcolor, styled.Time = bp(true), sp(`0x7F7F7F`), sp(`2020-01-01/2020-06-30`)
+	period := getMap(`rivers`, nil, crs84, wms130.BoundingBox{LowerCorner: wms130.Position{4, 51}, UpperCorner: wms130.Position{6, 52}}, 800, 400, `image/png`)
+	period.Time = sp(`2020-01-01/2020-06-30/P1D`)
+	layers := getMap(`rivers`, nil, crs84, wms130.BoundingBox{LowerCorner: wms130.Position{4, 51}, UpperCorner: wms130.Position{6, 52}}, 800, 400, `image/png`)
+	layers.StyledLayerDescriptor.NamedLayer = append(layers.StyledLayerDescriptor.NamedLayer, wms130.NamedLayer{Name: `roads`})
+
+	var tests = []struct {
+		request    wms130.GetMapRequest
+		result     MapRequest
+		exceptions []string
+	}{
+		0: {request: getMap(`rivers`, nil, crs84, wms130.BoundingBox{LowerCorner: wms130.Position{4, 51}, UpperCorner: wms130.Position{6, 52}}, 800, 400, `image/png`),
+			result: MapRequest{CollectionID: `rivers`, BBox: []float64{4, 51, 6, 52}, Width: ip(800), Height: ip(400)}},
+		1: {request: styled,
+			result: MapRequest{CollectionID: `rivers`, StyleID: sp(`blue`), BBox: []float64{100000, 400000, 200000, 500000}, BBoxCRS: sp(rdURI), CRS: sp(rdURI),
+				Width: ip(512), Height: ip(512), Format: sp(`jpg`), Transparent: bp(true), BGColor: sp(`7F7F7F`), Datetime: sp(`2020-01-01/2020-06-30`)}},
+		2: {request: period,
+			exceptions: []string{`OperationNotSupported`}},
+		3: {request: layers,
+			exceptions: []string{`OperationNotSupported`}},
+		4: {request: getMap(`railways`, nil, crs84, wms130.BoundingBox{LowerCorner: wms130.Position{4, 51}, UpperCorner: wms130.Position{6, 52}}, 800, 400, `image/png`),
+			exceptions: []string{`NotFound`, `NotFound`}},
+	}
+
+	for k, test := range tests {
+		result, exceptions := riverMaps.FromWMS130(test.request)
+		if len(test.exceptions) > 0 {
+			if len(exceptions) != len(test.exceptions) {
+				t.Errorf("test: %d, expected: %v,\n got: %v", k, test.exceptions, exceptions)
+				continue
+			}
+			for i, exception := range exceptions {
+				if exception.Code() != test.exceptions[i] {
+					t.Errorf("test: %d, expected: %s,\n got: %s", k, test.exceptions[i], exception.Code())
+				}
+			}
+			continue
+		}
+		if exceptions != nil {
+			t.Errorf("test: %d, expected no exceptions,\n got: %v", k, exceptions)
+		} else if !reflect.DeepEqual(result, test.result) {
+			t.Errorf("test: %d, expected: %+v,\n got: %+v", k, test.result, result)
+		}
+	}
+}
@@ -0,0 +1,163 @@
+package ogcapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/pdok/ogc-specifications/pkg/crs"
+	"github.com/pdok/ogc-specifications/pkg/wmts100"
+)
+
+// pixelSize is the standardized rendering pixel size of 0.28 mm that relates the scale denominator to the cell size
+const pixelSize = 0.00028
+
+// metresPerDegree is the length of a degree along the equator of WGS84, as used by the well known scale sets
+const metresPerDegree = 2 * math.Pi * 6378137 / 360
+
+// topLeft is the cornerOfOrigin of the WMTS tile matrices
+const topLeft = `topLeft`
+
+// TileMatrixSet is the JSON encoding of the OGC Two Dimensional Tile Matrix Set 2.0
+type TileMatrixSet struct {
+	ID           string       `json:"id"`
+	Title        string       `json:"title,omitempty"`
+	URI          string       `json:"uri,omitempty"`
+	CRS          string       `json:"crs"`
+	OrderedAxes  []string     `json:"orderedAxes,omitempty"`
+	TileMatrices []TileMatrix `json:"tileMatrices"`
+}
+
+// TileMatrix of a TileMatrixSet, the pointOfOrigin is in the axis order of the CRS like the WMTS TopLeftCorner
+type TileMatrix struct {
+	ID               string     `json:"id"`
+	ScaleDenominator float64    `json:"scaleDenominator"`
+	CellSize         float64    `json:"cellSize"`
+	CornerOfOrigin   string     `json:"cornerOfOrigin,omitempty"`
+	PointOfOrigin    [2]float64 `json:"pointOfOrigin"`
+	TileWidth        int        `json:"tileWidth"`
+	TileHeight       int        `json:"tileHeight"`
+	MatrixWidth      int        `json:"matrixWidth"`
+	MatrixHeight     int        `json:"matrixHeight"`
+}
+
+// ParseJSON builds a TileMatrixSet from a JSON document
+func (t *TileMatrixSet) ParseJSON(doc []byte) error {
+	return json.Unmarshal(doc, t)
+}
+
+// ToJSON builds the JSON document of the TileMatrixSet
+func (t TileMatrixSet) ToJSON() []byte {
+	doc, _ := json.MarshalIndent(t, "", " ")
+	return doc
+}
+
+// metresPerUnit returns the length of a unit of the CRS in metres, the CRS needs to be registered
+func metresPerUnit(s string) (float64, crs.Definition, error) {
+	d, ok := crs.LookupString(s)
+	if !ok {
+		return 0, d, fmt.Errorf("unknown CRS: %s", s)
+	}
+	if d.Units == crs.Degree {
+		return metresPerDegree, d, nil
+	}
+	return 1, d, nil
+}
+
+// orderedAxes returns the abbreviations of the axes in the axis order of the CRS
+func orderedAxes(d crs.Definition) []string {
+	axes := []string{`X`, `Y`}
+	if d.Geographic {
+		axes = []string{`Lon`, `Lat`}
+	}
+	if d.NorthEast() {
+		axes[0], axes[1] = axes[1], axes[0]
+	}
+	return axes
+}
+
+// NewTileMatrixSet converts a WMTS 1.0.0 TileMatrixSet into the JSON encoded TileMatrixSet,
+// the cell sizes are derived from the scale denominators
+func NewTileMatrixSet(t wmts100.TileMatrixSet) (TileMatrixSet, error) {
+	uri, ok := crsURI(t.SupportedCRS)
+	if !ok {
+		return TileMatrixSet{}, fmt.Errorf("unknown CRS: %s", t.SupportedCRS)
+	}
+	unit, d, err := metresPerUnit(t.SupportedCRS)
+	if err != nil {
+		return TileMatrixSet{}, err
+	}
+
+	result := TileMatrixSet{ID: t.Identifier, CRS: uri, OrderedAxes: orderedAxes(d)}
+	for _, tm := range t.TileMatrix {
+		var m TileMatrix
+		var errs []error
+		m.ID = tm.Identifier
+		m.ScaleDenominator, err = strconv.ParseFloat(tm.ScaleDenominator, 64)
+		errs = append(errs, err)
+		m.PointOfOrigin, err = parsePosition(tm.TopLeftCorner)
+		errs = append(errs, err)
+		for _, v := range []struct {
+			field *int
+			value string
+		}{{&m.TileWidth, tm.TileWidth}, {&m.TileHeight, tm.TileHeight}, {&m.MatrixWidth, tm.MatrixWidth}, {&m.MatrixHeight, tm.MatrixHeight}} {
+			*v.field, err = strconv.Atoi(v.value)
+			errs = append(errs, err)
+		}
+		if err := errors.Join(errs...); err != nil {
+			return TileMatrixSet{}, fmt.Errorf("invalid TileMatrix %s: %w", tm.Identifier, err)
+		}
+		m.CellSize = m.ScaleDenominator * pixelSize / unit
+		result.TileMatrices = append(result.TileMatrices, m)
+	}
+	return result, nil
+}
+
+// ToWMTS100 converts the TileMatrixSet into a WMTS 1.0.0 TileMatrixSet, WMTS only knows the top left corner of origin
+func (t TileMatrixSet) ToWMTS100() (wmts100.TileMatrixSet, error) {
+	urn, ok := crsURN(t.CRS)
+	if !ok {
+		return wmts100.TileMatrixSet{}, fmt.Errorf("unknown CRS: %s", t.CRS)
+	}
+
+	result := wmts100.TileMatrixSet{Identifier: t.ID, SupportedCRS: urn}
+	for _, m := range t.TileMatrices {
+		if m.CornerOfOrigin != `` && m.CornerOfOrigin != topLeft {
+			return wmts100.TileMatrixSet{}, fmt.Errorf("the cornerOfOrigin of TileMatrix %s is not supported by WMTS: %s", m.ID, m.CornerOfOrigin)
+		}
+		result.TileMatrix = append(result.TileMatrix, wmts100.TileMatrix{
+			Identifier:       m.ID,
+			ScaleDenominator: formatFloat(m.ScaleDenominator),
+			TopLeftCorner:    formatFloat(m.PointOfOrigin[0]) + ` ` + formatFloat(m.PointOfOrigin[1]),
+			TileWidth:        strconv.Itoa(m.TileWidth),
+			TileHeight:       strconv.Itoa(m.TileHeight),
+			MatrixWidth:      strconv.Itoa(m.MatrixWidth),
+			MatrixHeight:     strconv.Itoa(m.MatrixHeight),
+		})
+	}
+	return result, nil
+}
+
+// parsePosition parses the space separated coordinates of a WMTS TopLeftCorner
+func parsePosition(s string) ([2]float64, error) {
+	fields := strings.Fields(s)
+	if len(fields) != 2 {
+		return [2]float64{}, fmt.Errorf("expected 2 coordinates, got: %s", s)
+	}
+	var p [2]float64
+	for i, f := range fields {
+		v, err := strconv.ParseFloat(f, 64)
+		if err != nil {
+			return [2]float64{}, err
+		}
+		p[i] = v
+	}
+	return p, nil
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
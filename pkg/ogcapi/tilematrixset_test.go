@@ -0,0 +1,120 @@
+package ogcapi
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/pdok/ogc-specifications/pkg/wmts100"
+)
+
+var rdTileMatrixSet = wmts100.TileMatrixSet{
+	Identifier:   `EPSG:28992`,
+	SupportedCRS: `urn:ogc:def:crs:EPSG::28992`,
+	TileMatrix: []wmts100.TileMatrix{
+		{Identifier: `00`, ScaleDenominator: `12288000`, TopLeftCorner: `-285401.92 903401.92`,
+			TileWidth: `256`, TileHeight: `256`, MatrixWidth: `1`, MatrixHeight: `1`},
+		{Identifier: `01`, ScaleDenominator: `6144000`, TopLeftCorner: `-285401.92 903401.92`,
+			TileWidth: `256`, TileHeight: `256`, MatrixWidth: `2`, MatrixHeight: `2`},
+	},
+}
+
+func TestNewTileMatrixSet(t *testing.T) {
+	var tests = []struct {
+		tms      wmts100.TileMatrixSet
+		result   TileMatrixSet
+		cellSize []float64
+		err      bool
+	}{
+		0: {tms: rdTileMatrixSet,
+			result: TileMatrixSet{ID: `EPSG:28992`, CRS: `http://www.opengis.net/def/crs/EPSG/0/28992`, OrderedAxes: []string{`X`, `Y`},
+				TileMatrices: []TileMatrix{
+					{ID: `00`, ScaleDenominator: 12288000, PointOfOrigin: [2]float64{-285401.92, 903401.92}, TileWidth: 256, TileHeight: 256, MatrixWidth: 1, MatrixHeight: 1},
+					{ID: `01`, ScaleDenominator: 6144000, PointOfOrigin: [2]float64{-285401.92, 903401.92}, TileWidth: 256, TileHeight: 256, MatrixWidth: 2, MatrixHeight: 2},
+				}},
+			cellSize: []float64{3440.64, 1720.32}},
+		// the pointOfOrigin keeps the latitude/longitude axis order of EPSG:4326
+		1: {tms: wmts100.TileMatrixSet{Identifier: `WorldQuad`, SupportedCRS: `EPSG:4326`,
+			TileMatrix: []wmts100.TileMatrix{{Identifier: `0`, ScaleDenominator: `279541132.0143589`, TopLeftCorner: `90 -180`,
+				TileWidth: `256`, TileHeight: `256`, MatrixWidth: `2`, MatrixHeight: `1`}}},
+			result: TileMatrixSet{ID: `WorldQuad`, CRS: `http://www.opengis.net/def/crs/EPSG/0/4326`, OrderedAxes: []string{`Lat`, `Lon`},
+				TileMatrices: []TileMatrix{
+					{ID: `0`, ScaleDenominator: 279541132.0143589, PointOfOrigin: [2]float64{90, -180}, TileWidth: 256, TileHeight: 256, MatrixWidth: 2, MatrixHeight: 1},
+				}},
+			cellSize: []float64{0.703125}},
+		2: {tms: wmts100.TileMatrixSet{Identifier: `unknown`, SupportedCRS: `EPSG:999999`}, err: true},
+		3: {tms: wmts100.TileMatrixSet{Identifier: `invalid`, SupportedCRS: `EPSG:28992`,
+			TileMatrix: []wmts100.TileMatrix{{Identifier: `00`, ScaleDenominator: `large`, TopLeftCorner: `0`}}}, err: true},
+	}
+
+	for k, test := range tests {
+		result, err := NewTileMatrixSet(test.tms)
+		if test.err {
+			if err == nil {
+				t.Errorf("test: %d, expected an error,\n got: %+v", k, result)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("test: %d, expected no error,\n got: %s", k, err)
+			continue
+		}
+		for i := range result.TileMatrices {
+			if math.Abs(result.TileMatrices[i].CellSize-test.cellSize[i]) > 1e-9 {
+				t.Errorf("test: %d, expected: %v,\n got: %v", k, test.cellSize[i], result.TileMatrices[i].CellSize)
+			}
+			result.TileMatrices[i].CellSize = 0
+		}
+		if !reflect.DeepEqual(result, test.result) {
+			t.Errorf("test: %d, expected: %+v,\n got: %+v", k, test.result, result)
+		}
+	}
+}
+
+func TestTileMatrixSetToWMTS100(t *testing.T) {
+	var tests = []struct {
+		tms    TileMatrixSet
+		result wmts100.TileMatrixSet
+		err    bool
+	}{
+		0: {tms: TileMatrixSet{ID: `EPSG:28992`, CRS: `http://www.opengis.net/def/crs/EPSG/0/28992`,
+			TileMatrices: []TileMatrix{
+				{ID: `00`, ScaleDenominator: 12288000, CellSize: 3440.64, CornerOfOrigin: `topLeft`, PointOfOrigin: [2]float64{-285401.92, 903401.92}, TileWidth: 256, TileHeight: 256, MatrixWidth: 1, MatrixHeight: 1},
+				{ID: `01`, ScaleDenominator: 6144000, CellSize: 1720.32, PointOfOrigin: [2]float64{-285401.92, 903401.92}, TileWidth: 256, TileHeight: 256, MatrixWidth: 2, MatrixHeight: 2},
+			}},
+			result: rdTileMatrixSet},
+		1: {tms: TileMatrixSet{ID: `EPSG:28992`, CRS: `http://www.opengis.net/def/crs/EPSG/0/28992`,
+			TileMatrices: []TileMatrix{{ID: `00`, CornerOfOrigin: `bottomLeft`}}},
+			err: true},
+		2: {tms: TileMatrixSet{ID: `unknown`, CRS: `unknown`}, err: true},
+	}
+
+	for k, test := range tests {
+		result, err := test.tms.ToWMTS100()
+		if test.err {
+			if err == nil {
+				t.Errorf("test: %d, expected an error,\n got: %+v", k, result)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("test: %d, expected no error,\n got: %s", k, err)
+		} else if !reflect.DeepEqual(result, test.result) {
+			t.Errorf("test: %d, expected: %+v,\n got: %+v", k, test.result, result)
+		}
+	}
+}
+
+func TestTileMatrixSetJSON(t *testing.T) {
+	tms, err := NewTileMatrixSet(rdTileMatrixSet)
+	if err != nil {
+		t.Fatalf("expected no error,\n got: %s", err)
+	}
+	var parsed TileMatrixSet
+	if err := parsed.ParseJSON(tms.ToJSON()); err != nil {
+		t.Fatalf("expected no error,\n got: %s", err)
+	}
+	if !reflect.DeepEqual(parsed, tms) {
+		t.Errorf("expected: %+v,\n got: %+v", tms, parsed)
+	}
+}
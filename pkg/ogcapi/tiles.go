@@ -0,0 +1,151 @@
+package ogcapi
+
+import (
+	"github.com/pdok/ogc-specifications/pkg/wmts100"
+)
+
+// formats are the short names of the f parameter for the tile formats
+var formats = map[string]string{
+	`png`:  `image/png`,
+	`jpg`:  `image/jpeg`,
+	`jpeg`: `image/jpeg`,
+	`webp`: `image/webp`,
+	`mvt`:  `application/vnd.mapbox-vector-tile`,
+}
+
+// mediaType returns the media type of a f parameter, which is either a short name or a media type
+func mediaType(f string) string {
+	if t, ok := formats[f]; ok {
+		return t
+	}
+	return f
+}
+
+// shortName returns the f parameter for a media type, the media type itself when it has no short name
+func shortName(mediaType string) string {
+	switch mediaType {
+	case `image/png`:
+		return `png`
+	case `image/jpeg`:
+		return `jpg`
+	case `image/webp`:
+		return `webp`
+	case `application/vnd.mapbox-vector-tile`:
+		return `mvt`
+	}
+	return mediaType
+}
+
+// Tiles exposes the layers of the WMTS 1.0.0 Contents as collections of the OGC API Tiles,
+// the layer identifier is the collection id and the TileMatrixSets are shared
+type Tiles struct {
+	Contents wmts100.Contents
+}
+
+// layer returns the WMTS layer of the collection
+func (t Tiles) layer(id string) (wmts100.Layer, bool) {
+	for _, l := range t.Contents.Layer {
+		if l.Identifier == id {
+			return l, true
+		}
+	}
+	return wmts100.Layer{}, false
+}
+
+// defaultStyle returns the identifier of the default style of the layer, or the first style when none is marked default
+func defaultStyle(l wmts100.Layer) string {
+	for _, s := range l.Style {
+		if s.IsDefault != nil && *s.IsDefault {
+			return s.Identifier
+		}
+	}
+	if len(l.Style) > 0 {
+		return l.Style[0].Identifier
+	}
+	return ``
+}
+
+// ToWMTS100 converts the TileRequest into a WMTS 1.0.0 GetTile request, a missing style or format falls back on the
+// default style and first format of the layer. A tile that isn't offered by the layer is not found.
+func (t Tiles) ToWMTS100(r TileRequest) (wmts100.GetTileRequest, Exceptions) {
+	l, ok := t.layer(r.CollectionID)
+	if !ok {
+		return wmts100.GetTileRequest{}, NotFound(r.Path()).ToExceptions()
+	}
+
+	g := wmts100.GetTileRequest{
+		Service:       wmts100.Service,
+		Version:       wmts100.Version,
+		Layer:         l.Identifier,
+		Style:         defaultStyle(l),
+		TileMatrixSet: r.TileMatrixSetID,
+		TileMatrix:    r.TileMatrix,
+		TileRow:       r.TileRow,
+		TileCol:       r.TileCol,
+	}
+	if r.StyleID != nil {
+		g.Style = *r.StyleID
+	}
+	if r.Format != nil {
+		g.Format = mediaType(*r.Format)
+		if !contains(l.Format, g.Format) {
+			return wmts100.GetTileRequest{}, InvalidParameterValue(*r.Format, F).ToExceptions()
+		}
+	} else if len(l.Format) > 0 {
+		g.Format = l.Format[0]
+	}
+
+	// the remaining parameters are all part of the path
+	if exceptions := g.Validate(t.Contents); exceptions != nil {
+		return wmts100.GetTileRequest{}, NotFound(r.Path()).ToExceptions()
+	}
+	return g, nil
+}
+
+// FromWMTS100 converts a WMTS 1.0.0 GetTile request into a TileRequest, the default style and the first
+// format of the layer are left out
+func (t Tiles) FromWMTS100(g wmts100.GetTileRequest) (TileRequest, Exceptions) {
+	if exceptions := g.Validate(t.Contents); exceptions != nil {
+		return TileRequest{}, FromWSC110(exceptions...)
+	}
+	l, _ := t.layer(g.Layer)
+
+	r := TileRequest{
+		CollectionID:    g.Layer,
+		TileMatrixSetID: g.TileMatrixSet,
+		TileMatrix:      g.TileMatrix,
+		TileRow:         g.TileRow,
+		TileCol:         g.TileCol,
+	}
+	if g.Style != defaultStyle(l) {
+		r.StyleID = sp(g.Style)
+	}
+	if g.Format != l.Format[0] {
+		r.Format = sp(shortName(g.Format))
+	}
+	return r, nil
+}
+
+// TileMatrixSet returns the TileMatrixSet of /tileMatrixSets/{tileMatrixSetId} converted from the WMTS 1.0.0 Contents
+func (t Tiles) TileMatrixSet(id string) (TileMatrixSet, Exceptions) {
+	for _, tms := range t.Contents.TileMatrixSet {
+		if tms.Identifier != id {
+			continue
+		}
+		result, err := NewTileMatrixSet(tms)
+		if err != nil {
+			return TileMatrixSet{}, NoApplicableCode(err.Error()).ToExceptions()
+		}
+		return result, nil
+	}
+	return TileMatrixSet{}, NotFound(`/` + tileMatrixSets + `/` + id).ToExceptions()
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
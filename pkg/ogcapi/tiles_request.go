@@ -0,0 +1,114 @@
+package ogcapi
+
+import (
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	styles         = `styles`
+	tiles          = `tiles`
+	tileMatrixSets = `tileMatrixSets`
+)
+
+// TileRequest is a request for a tile of a collection, /collections/{collectionId}/tiles/{tileMatrixSetId}/{tileMatrix}/{tileRow}/{tileCol},
+// or of a style of the collection, /collections/{collectionId}/styles/{styleId}/tiles/...
+type TileRequest struct {
+	CollectionID    string
+	StyleID         *string
+	TileMatrixSetID string
+	TileMatrix      string
+	TileRow         int
+	TileCol         int
+	// Format is the value of the f parameter, a short name like png or a media type
+	Format *string
+}
+
+// Path returns the path of the request, relative to the landing page
+func (r TileRequest) Path() string {
+	path := `/` + collections + `/` + url.PathEscape(r.CollectionID)
+	if r.StyleID != nil {
+		path += `/` + styles + `/` + url.PathEscape(*r.StyleID)
+	}
+	return path + `/` + tiles + `/` + url.PathEscape(r.TileMatrixSetID) + `/` + url.PathEscape(r.TileMatrix) +
+		`/` + strconv.Itoa(r.TileRow) + `/` + strconv.Itoa(r.TileCol)
+}
+
+// ParseURL builds a TileRequest from the path and query parameters of a request, the path may
+// start with the path of the landing page
+func (r *TileRequest) ParseURL(path string, query url.Values) Exceptions {
+	if exception := r.parsePath(path); exception != nil {
+		return exception.ToExceptions()
+	}
+
+	var exceptions Exceptions
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if key != F {
+			exceptions = append(exceptions, InvalidParameterValue(query.Get(key), key))
+		}
+	}
+	if f := query.Get(F); f != `` {
+		r.Format = &f
+	}
+
+	if len(exceptions) > 0 {
+		return exceptions
+	}
+	return nil
+}
+
+// parsePath finds the collection, optional style and tile in the path
+func (r *TileRequest) parsePath(path string) *Exception {
+	notFound := NotFound(path)
+
+	segments, ok := collectionSegments(path)
+	if !ok {
+		return &notFound
+	}
+
+	r.CollectionID = segments[0]
+	segments = segments[1:]
+	if len(segments) == 7 && segments[0] == styles {
+		r.StyleID = &segments[1]
+		segments = segments[2:]
+	}
+	if len(segments) != 5 || segments[0] != tiles {
+		return &notFound
+	}
+	r.TileMatrixSetID = segments[1]
+	r.TileMatrix = segments[2]
+
+	var err error
+	if r.TileRow, err = strconv.Atoi(segments[3]); err != nil {
+		return &notFound
+	}
+	if r.TileCol, err = strconv.Atoi(segments[4]); err != nil {
+		return &notFound
+	}
+	return nil
+}
+
+// ToQueryParameters builds the query parameters of the TileRequest
+func (r TileRequest) ToQueryParameters() url.Values {
+	query := url.Values{}
+	if r.Format != nil {
+		query.Set(F, *r.Format)
+	}
+	return query
+}
+
+// ToURL builds the URL of the TileRequest on the landing page of the API
+func (r TileRequest) ToURL(landingPage string) string {
+	u := strings.TrimRight(landingPage, `/`) + r.Path()
+	if query := r.ToQueryParameters(); len(query) > 0 {
+		u += `?` + query.Encode()
+	}
+	return u
+}
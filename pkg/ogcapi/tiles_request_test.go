@@ -0,0 +1,76 @@
+package ogcapi
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestTileRequestParseURL(t *testing.T) {
+	var tests = []struct {
+		path       string
+		query      url.Values
+		result     TileRequest
+		exceptions []string
+	}{
+		0: {path: `/collections/brt/tiles/EPSG:28992/01/1/0`,
+			query:  url.Values{F: {`png`}},
+			result: TileRequest{CollectionID: `brt`, TileMatrixSetID: `EPSG:28992`, TileMatrix: `01`, TileRow: 1, TileCol: 0, Format: sp(`png`)}},
+		1: {path: `/ogc/v1/collections/brt/styles/grey/tiles/EPSG%3A28992/00/0/0`,
+			result: TileRequest{CollectionID: `brt`, StyleID: sp(`grey`), TileMatrixSetID: `EPSG:28992`, TileMatrix: `00`}},
+		2: {path: `/collections/brt/tiles/EPSG:28992/01/1`,
+			exceptions: []string{`NotFound`}},
+		3: {path: `/collections/brt/tiles/EPSG:28992/01/one/0`,
+			exceptions: []string{`NotFound`}},
+		4: {path: `/collections/brt/styles/grey/maps/EPSG:28992/01/1/0`,
+			exceptions: []string{`NotFound`}},
+		5: {path: `/collections/brt/tiles/EPSG:28992/01/1/0`,
+			query:      url.Values{`style`: {`grey`}},
+			exceptions: []string{`InvalidParameterValue`}},
+		// a path without collection id
+		6: {path: `/collections`,
+			exceptions: []string{`NotFound`}},
+		7: {path: `/api/collections/`,
+			exceptions: []string{`NotFound`}},
+	}
+
+	for k, test := range tests {
+		var r TileRequest
+		exceptions := r.ParseURL(test.path, test.query)
+		if len(test.exceptions) > 0 {
+			if len(exceptions) != len(test.exceptions) {
+				t.Errorf("test: %d, expected: %v,\n got: %v", k, test.exceptions, exceptions)
+				continue
+			}
+			for i, exception := range exceptions {
+				if exception.Code() != test.exceptions[i] {
+					t.Errorf("test: %d, expected: %s,\n got: %s", k, test.exceptions[i], exception.Code())
+				}
+			}
+			continue
+		}
+		if exceptions != nil {
+			t.Errorf("test: %d, expected no exceptions,\n got: %v", k, exceptions)
+		} else if !reflect.DeepEqual(r, test.result) {
+			t.Errorf("test: %d, expected: %+v,\n got: %+v", k, test.result, r)
+		}
+	}
+}
+
+func TestTileRequestToURL(t *testing.T) {
+	var tests = []struct {
+		request TileRequest
+		result  string
+	}{
+		0: {request: TileRequest{CollectionID: `brt`, TileMatrixSetID: `EPSG:28992`, TileMatrix: `01`, TileRow: 1, TileCol: 0},
+			result: `https://example.org/ogc/collections/brt/tiles/EPSG:28992/01/1/0`},
+		1: {request: TileRequest{CollectionID: `brt`, StyleID: sp(`grey`), TileMatrixSetID: `EPSG:28992`, TileMatrix: `00`, Format: sp(`jpg`)},
+			result: `https://example.org/ogc/collections/brt/styles/grey/tiles/EPSG:28992/00/0/0?f=jpg`},
+	}
+
+	for k, test := range tests {
+		if result := test.request.ToURL(`https://example.org/ogc/`); result != test.result {
+			t.Errorf("test: %d, expected: %s,\n got: %s", k, test.result, result)
+		}
+	}
+}
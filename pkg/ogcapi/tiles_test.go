@@ -0,0 +1,117 @@
+package ogcapi
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/pdok/ogc-specifications/pkg/wmts100"
+)
+
+func bp(b bool) *bool {
+	return &b
+}
+
+var brtTiles = Tiles{Contents: wmts100.Contents{
+	Layer: []wmts100.Layer{{
+		Identifier: `brt`,
+		Style:      []wmts100.Style{{Identifier: `grey`}, {Identifier: `default`, IsDefault: bp(true)}},
+		Format:     []string{`image/png`, `image/jpeg`},
+		TileMatrixSetLink: []wmts100.TileMatrixSetLink{
+			{TileMatrixSet: `EPSG:28992`},
+		},
+	}},
+	TileMatrixSet: []wmts100.TileMatrixSet{rdTileMatrixSet},
+}}
+
+func TestTilesToWMTS100(t *testing.T) {
+	var tests = []struct {
+		request    TileRequest
+		result     wmts100.GetTileRequest
+		exceptions []string
+	}{
+		0: {request: TileRequest{CollectionID: `brt`, TileMatrixSetID: `EPSG:28992`, TileMatrix: `01`, TileRow: 1, TileCol: 0},
+			result: wmts100.GetTileRequest{Service: `WMTS`, Version: `1.0.0`, Layer: `brt`, Style: `default`, Format: `image/png`,
+				TileMatrixSet: `EPSG:28992`, TileMatrix: `01`, TileRow: 1, TileCol: 0}},
+		1: {request: TileRequest{CollectionID: `brt`, StyleID: sp(`grey`), TileMatrixSetID: `EPSG:28992`, TileMatrix: `00`, Format: sp(`jpg`)},
+			result: wmts100.GetTileRequest{Service: `WMTS`, Version: `1.0.0`, Layer: `brt`, Style: `grey`, Format: `image/jpeg`,
+				TileMatrixSet: `EPSG:28992`, TileMatrix: `00`}},
+		2: {request: TileRequest{CollectionID: `top10`, TileMatrixSetID: `EPSG:28992`, TileMatrix: `00`},
+			exceptions: []string{`NotFound`}},
+		3: {request: TileRequest{CollectionID: `brt`, TileMatrixSetID: `EPSG:28992`, TileMatrix: `00`, Format: sp(`webp`)},
+			exceptions: []string{`InvalidParameterValue`}},
+		4: {request: TileRequest{CollectionID: `brt`, StyleID: sp(`pastel`), TileMatrixSetID: `EPSG:28992`, TileMatrix: `00`},
+			exceptions: []string{`NotFound`}},
+		// a tile outside the tile matrix is not found
+		5: {request: TileRequest{CollectionID: `brt`, TileMatrixSetID: `EPSG:28992`, TileMatrix: `01`, TileRow: 2, TileCol: 0},
+			exceptions: []string{`NotFound`}},
+		6: {request: TileRequest{CollectionID: `brt`, TileMatrixSetID: `WebMercatorQuad`, TileMatrix: `00`},
+			exceptions: []string{`NotFound`}},
+	}
+
+	for k, test := range tests {
+		result, exceptions := brtTiles.ToWMTS100(test.request)
+		if len(test.exceptions) > 0 {
+			if len(exceptions) != len(test.exceptions) {
+				t.Errorf("test: %d, expected: %v,\n got: %v", k, test.exceptions, exceptions)
+				continue
+			}
+			for i, exception := range exceptions {
+				if exception.Code() != test.exceptions[i] {
+					t.Errorf("test: %d, expected: %s,\n got: %s", k, test.exceptions[i], exception.Code())
+				}
+			}
+			continue
+		}
+		if exceptions != nil {
+			t.Errorf("test: %d, expected no exceptions,\n got: %v", k, exceptions)
+		} else if !reflect.DeepEqual(result, test.result) {
+			t.Errorf("test: %d, expected: %+v,\n got: %+v", k, test.result, result)
+		}
+	}
+}
+
+func TestTilesFromWMTS100(t *testing.T) {
+	var tests = []struct {
+		request    wmts100.GetTileRequest
+		result     TileRequest
+		exceptions []string
+	}{
+		0: {request: wmts100.GetTileRequest{Layer: `brt`, Style: `default`, Format: `image/png`, TileMatrixSet: `EPSG:28992`, TileMatrix: `01`, TileRow: 1},
+			result: TileRequest{CollectionID: `brt`, TileMatrixSetID: `EPSG:28992`, TileMatrix: `01`, TileRow: 1}},
+		1: {request: wmts100.GetTileRequest{Layer: `brt`, Style: `grey`, Format: `image/jpeg`, TileMatrixSet: `EPSG:28992`, TileMatrix: `01`, TileCol: 1},
+			result: TileRequest{CollectionID: `brt`, StyleID: sp(`grey`), TileMatrixSetID: `EPSG:28992`, TileMatrix: `01`, TileCol: 1, Format: sp(`jpg`)}},
+		2: {request: wmts100.GetTileRequest{Layer: `brt`, Style: `default`, Format: `image/png`, TileMatrixSet: `EPSG:28992`, TileMatrix: `00`, TileRow: 1},
+			exceptions: []string{`TileOutOfRange`}},
+	}
+
+	for k, test := range tests {
+		result, exceptions := brtTiles.FromWMTS100(test.request)
+		if len(test.exceptions) > 0 {
+			if len(exceptions) != len(test.exceptions) {
+				t.Errorf("test: %d, expected: %v,\n got: %v", k, test.exceptions, exceptions)
+				continue
+			}
+			for i, exception := range exceptions {
+				if exception.Code() != test.exceptions[i] {
+					t.Errorf("test: %d, expected: %s,\n got: %s", k, test.exceptions[i], exception.Code())
+				}
+			}
+			continue
+		}
+		if exceptions != nil {
+			t.Errorf("test: %d, expected no exceptions,\n got: %v", k, exceptions)
+		} else if !reflect.DeepEqual(result, test.result) {
+			t.Errorf("test: %d, expected: %+v,\n got: %+v", k, test.result, result)
+		}
+	}
+}
+
+func TestTilesTileMatrixSet(t *testing.T) {
+	tms, exceptions := brtTiles.TileMatrixSet(`EPSG:28992`)
+	if exceptions != nil || tms.ID != `EPSG:28992` || len(tms.TileMatrices) != 2 {
+		t.Errorf("expected: EPSG:28992 with 2 tile matrices,\n got: %+v %v", tms, exceptions)
+	}
+	if _, exceptions := brtTiles.TileMatrixSet(`WebMercatorQuad`); len(exceptions) != 1 || exceptions[0].Code() != `NotFound` {
+		t.Errorf("expected: NotFound,\n got: %v", exceptions)
+	}
+}
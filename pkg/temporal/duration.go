@@ -0,0 +1,114 @@
+package temporal
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// durationPattern matches the ISO 8601 durations PnYnMnDTnHnMnS and PnW, the seconds may have a fraction
+var durationPattern = regexp.MustCompile(`^P(?:(\d+)Y)?(?:(\d+)M)?(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+(?:[.,]\d+)?)S)?)?$`)
+
+// Duration is an ISO 8601 duration, the years, months and days are calendar units and the rest is a fixed length
+type Duration struct {
+	Years  int
+	Months int
+	Days   int
+	Time   time.Duration
+}
+
+// ParseDuration parses an ISO 8601 duration like P1D, PT30M or P1Y2M10DT2H30M, weeks are turned into days
+func ParseDuration(s string) (Duration, error) {
+	m := durationPattern.FindStringSubmatch(s)
+	if m == nil || s == `P` || strings.HasSuffix(s, `T`) {
+		return Duration{}, fmt.Errorf("invalid duration: %s", s)
+	}
+
+	var values [6]int
+	for i, v := range m[1:7] {
+		if v == `` {
+			continue
+		}
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return Duration{}, fmt.Errorf("invalid duration: %s", s)
+		}
+		values[i] = n
+	}
+	d := Duration{Years: values[0], Months: values[1], Days: values[2]*7 + values[3]}
+	d.Time = time.Duration(values[4])*time.Hour + time.Duration(values[5])*time.Minute
+	if m[7] != `` {
+		seconds, err := strconv.ParseFloat(strings.Replace(m[7], `,`, `.`, 1), 64)
+		if err != nil {
+			return Duration{}, fmt.Errorf("invalid duration: %s", s)
+		}
+		d.Time += time.Duration(seconds * float64(time.Second))
+	}
+	return d, nil
+}
+
+// IsZero returns whether the duration has no length
+func (d Duration) IsZero() bool {
+	return d == Duration{}
+}
+
+// String returns the normalised duration, without the zero units and with the time in hours, minutes and seconds
+func (d Duration) String() string {
+	if d.IsZero() {
+		return `PT0S`
+	}
+
+	var sb strings.Builder
+	sb.WriteString(`P`)
+	for _, u := range []struct {
+		value  int
+		design string
+	}{{d.Years, `Y`}, {d.Months, `M`}, {d.Days, `D`}} {
+		if u.value != 0 {
+			sb.WriteString(strconv.Itoa(u.value) + u.design)
+		}
+	}
+	if d.Time == 0 {
+		return sb.String()
+	}
+
+	sb.WriteString(`T`)
+	hours, rest := d.Time/time.Hour, d.Time%time.Hour
+	minutes, rest := rest/time.Minute, rest%time.Minute
+	if hours != 0 {
+		sb.WriteString(strconv.FormatInt(int64(hours), 10) + `H`)
+	}
+	if minutes != 0 {
+		sb.WriteString(strconv.FormatInt(int64(minutes), 10) + `M`)
+	}
+	if rest != 0 {
+		sb.WriteString(strconv.FormatFloat(rest.Seconds(), 'f', -1, 64) + `S`)
+	}
+	return sb.String()
+}
+
+// AddTo returns the time after n times the duration. Adding years and months keeps the day of the month, clamped to
+// the last day of a shorter month, so 2020-01-31 plus P1M is 2020-02-29 instead of the 2020-03-02 of time.AddDate.
+func (d Duration) AddTo(t time.Time, n int) time.Time {
+	if months := n * (d.Years*12 + d.Months); months != 0 {
+		year, month, day := t.Date()
+		first := time.Date(year, month+time.Month(months), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+		last := first.AddDate(0, 1, -1).Day()
+		t = first.AddDate(0, 0, min(day, last)-1)
+	}
+	return t.AddDate(0, 0, n*d.Days).Add(time.Duration(n) * d.Time)
+}
+
+// fixed returns whether the duration has a fixed length, in UTC a day always lasts 24 hours
+func (d Duration) fixed() bool {
+	return d.Years == 0 && d.Months == 0
+}
+
+// approximate returns the average length of the duration
+func (d Duration) approximate() time.Duration {
+	const day = 24 * time.Hour
+	return time.Duration(float64(d.Years)*365.2425*float64(day)+float64(d.Months)*30.436875*float64(day)) +
+		time.Duration(d.Days)*day + d.Time
+}
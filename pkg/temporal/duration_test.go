@@ -0,0 +1,61 @@
+package temporal
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseDuration(t *testing.T) {
+	var tests = []struct {
+		input    string
+		duration Duration
+		result   string
+		err      bool
+	}{
+		0:  {input: `P1D`, duration: Duration{Days: 1}, result: `P1D`},
+		1:  {input: `P2W`, duration: Duration{Days: 14}, result: `P14D`},
+		2:  {input: `PT30M`, duration: Duration{Time: 30 * time.Minute}, result: `PT30M`},
+		3:  {input: `P1Y2M10DT2H30M`, duration: Duration{Years: 1, Months: 2, Days: 10, Time: 2*time.Hour + 30*time.Minute}, result: `P1Y2M10DT2H30M`},
+		4:  {input: `PT90M`, duration: Duration{Time: 90 * time.Minute}, result: `PT1H30M`},
+		5:  {input: `PT0,5S`, duration: Duration{Time: 500 * time.Millisecond}, result: `PT0.5S`},
+		6:  {input: `P0D`, duration: Duration{}, result: `PT0S`},
+		7:  {input: `P`, err: true},
+		8:  {input: `P1DT`, err: true},
+		9:  {input: `1D`, err: true},
+		10: {input: `P1H`, err: true},
+	}
+
+	for k, test := range tests {
+		d, err := ParseDuration(test.input)
+		if test.err {
+			if err == nil {
+				t.Errorf("test: %d, expected an error,\n got: %s", k, d)
+			}
+			continue
+		}
+		if err != nil || d != test.duration || d.String() != test.result {
+			t.Errorf("test: %d, expected: %+v %s,\n got: %+v %s %v", k, test.duration, test.result, d, d, err)
+		}
+	}
+}
+
+func TestDurationAddTo(t *testing.T) {
+	start := time.Date(2020, 1, 31, 0, 0, 0, 0, time.UTC)
+	var tests = []struct {
+		duration Duration
+		n        int
+		result   time.Time
+	}{
+		0: {duration: Duration{Days: 1}, n: 3, result: time.Date(2020, 2, 3, 0, 0, 0, 0, time.UTC)},
+		1: {duration: Duration{Months: 1}, n: 2, result: time.Date(2020, 3, 31, 0, 0, 0, 0, time.UTC)},
+		2: {duration: Duration{Months: 1}, n: 1, result: time.Date(2020, 2, 29, 0, 0, 0, 0, time.UTC)},
+		3: {duration: Duration{Years: 1, Months: 1}, n: 1, result: time.Date(2021, 2, 28, 0, 0, 0, 0, time.UTC)},
+		4: {duration: Duration{Years: 1, Time: time.Hour}, n: 2, result: time.Date(2022, 1, 31, 2, 0, 0, 0, time.UTC)},
+	}
+
+	for k, test := range tests {
+		if result := test.duration.AddTo(start, test.n); !result.Equal(test.result) {
+			t.Errorf("test: %d, expected: %s,\n got: %s", k, test.result, result)
+		}
+	}
+}
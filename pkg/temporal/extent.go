@@ -0,0 +1,258 @@
+package temporal
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Period is a single instant, when the End equals the Start, or an interval from the Start to the End.
+// An interval with a Resolution has the discrete values Start, Start+Resolution, ... up to the End,
+// without a Resolution every instant in the interval is valid.
+type Period struct {
+	Start      Instant
+	End        Instant
+	Resolution *Duration
+}
+
+// Extent is a list of periods, like the value of a WMS time Dimension or a TIME parameter
+type Extent []Period
+
+// ParseExtent parses a comma separated list of instants and start/end/resolution intervals as defined in WMS 1.3.0
+// Annex C, like 2020-01-01,2020-06-01/2020-12-01/P1M. A resolution of 0 or an interval without one is continuous,
+// a resolution without length, like P0D, is rejected.
+func ParseExtent(s string) (Extent, error) {
+	if strings.TrimSpace(s) == `` {
+		return nil, errors.New(`empty extent`)
+	}
+
+	var e Extent
+	for _, value := range strings.Split(s, `,`) {
+		p, err := ParsePeriod(strings.TrimSpace(value))
+		if err != nil {
+			return nil, err
+		}
+		e = append(e, p)
+	}
+	return e, nil
+}
+
+// ParsePeriod parses a single instant or a start/end or start/end/resolution interval
+func ParsePeriod(s string) (Period, error) {
+	parts := strings.Split(s, `/`)
+	if len(parts) > 3 {
+		return Period{}, fmt.Errorf("invalid period: %s", s)
+	}
+
+	start, err := ParseInstant(parts[0])
+	if err != nil {
+		return Period{}, err
+	}
+	if len(parts) == 1 {
+		return Period{Start: start, End: start}, nil
+	}
+
+	end, err := ParseInstant(parts[1])
+	if err != nil {
+		return Period{}, err
+	}
+	if end.Resolve().Before(start.Resolve()) {
+		return Period{}, fmt.Errorf("the end is before the start of the period: %s", s)
+	}
+	p := Period{Start: start, End: end}
+	if len(parts) == 3 && parts[2] != `0` {
+		resolution, err := ParseDuration(parts[2])
+		if err != nil {
+			return Period{}, err
+		}
+		if resolution.IsZero() {
+			return Period{}, fmt.Errorf("the resolution of the period has no length: %s", s)
+		}
+		p.Resolution = &resolution
+	}
+	return p, nil
+}
+
+// String returns the normalised extent
+func (e Extent) String() string {
+	values := make([]string, 0, len(e))
+	for _, p := range e {
+		values = append(values, p.String())
+	}
+	return strings.Join(values, `,`)
+}
+
+// String returns the normalised period, an instant is written without the end
+func (p Period) String() string {
+	if p.instant() {
+		return p.Start.String()
+	}
+	if p.Resolution == nil {
+		return p.Start.String() + `/` + p.End.String()
+	}
+	return p.Start.String() + `/` + p.End.String() + `/` + p.Resolution.String()
+}
+
+// instant returns whether the period is a single instant
+func (p Period) instant() bool {
+	return p.Start == p.End
+}
+
+// Contains returns whether the instant is one of the values of the extent
+func (e Extent) Contains(i Instant) bool {
+	for _, p := range e {
+		if p.Contains(i) {
+			return true
+		}
+	}
+	return false
+}
+
+// Contains returns whether the instant is within the period and, for a period with a resolution, one of its values
+func (p Period) Contains(i Instant) bool {
+	t, start, end := i.Resolve(), p.Start.Resolve(), p.End.Resolve()
+	if t.Before(start) || t.After(end) {
+		return false
+	}
+	if p.Resolution == nil {
+		return true
+	}
+	return p.Resolution.AddTo(start, p.index(t)).Equal(t)
+}
+
+// Covers returns whether every period of the requested extent is valid: an instant has to be one of the values and
+// an interval has to lie within one of the periods
+func (e Extent) Covers(r Extent) bool {
+	for _, requested := range r {
+		if requested.instant() {
+			if !e.Contains(requested.Start) {
+				return false
+			}
+			continue
+		}
+
+		covered := false
+		for _, p := range e {
+			covered = covered || (!p.instant() &&
+				!requested.Start.Resolve().Before(p.Start.Resolve()) && !requested.End.Resolve().After(p.End.Resolve()))
+		}
+		if !covered {
+			return false
+		}
+	}
+	return true
+}
+
+// index returns the number of resolutions from the start of the period to the last value at or before t
+func (p Period) index(t time.Time) int {
+	start := p.Start.Resolve()
+	d := *p.Resolution
+	if d.fixed() {
+		return int(t.Sub(start) / d.approximate())
+	}
+
+	n := int(t.Sub(start) / d.approximate())
+	for n > 0 && d.AddTo(start, n).After(t) {
+		n--
+	}
+	for !d.AddTo(start, n+1).After(t) {
+		n++
+	}
+	return n
+}
+
+// Values enumerates the values of the extent in chronological order, an error is returned when the extent has a
+// continuous interval or more than limit values
+func (e Extent) Values(limit int) ([]Instant, error) {
+	var values []Instant
+	for _, p := range e {
+		switch {
+		case p.instant():
+			values = append(values, p.Start)
+		case p.Resolution == nil:
+			return nil, fmt.Errorf("the period %s is continuous", p)
+		default:
+			start, end := p.Start.Resolve(), p.End.Resolve()
+			precision := max(p.Start.Precision, p.Resolution.precision())
+			for n := 0; ; n++ {
+				t := p.Resolution.AddTo(start, n)
+				if t.After(end) {
+					break
+				}
+				values = append(values, Instant{Time: t, Precision: precision})
+				if len(values) > limit {
+					return nil, fmt.Errorf("the extent has more than %d values", limit)
+				}
+			}
+		}
+		if len(values) > limit {
+			return nil, fmt.Errorf("the extent has more than %d values", limit)
+		}
+	}
+	sort.SliceStable(values, func(i, j int) bool {
+		return values[i].Resolve().Before(values[j].Resolve())
+	})
+	return values, nil
+}
+
+// Nearest returns the value of the extent that is nearest to the instant, on a tie the earlier value is returned.
+// False is returned for an empty extent.
+func (e Extent) Nearest(i Instant) (Instant, bool) {
+	var nearest Instant
+	var distance time.Duration
+	found := false
+	for _, p := range e {
+		for _, candidate := range p.candidates(i) {
+			d := candidate.Resolve().Sub(i.Resolve()).Abs()
+			if !found || d < distance || (d == distance && candidate.Resolve().Before(nearest.Resolve())) {
+				nearest, distance, found = candidate, d, true
+			}
+		}
+	}
+	return nearest, found
+}
+
+// candidates returns the values of the period that can be the nearest to the instant
+func (p Period) candidates(i Instant) []Instant {
+	t, start, end := i.Resolve(), p.Start.Resolve(), p.End.Resolve()
+	switch {
+	case p.instant() || !t.After(start):
+		return []Instant{p.Start}
+	case p.Resolution == nil && !t.Before(end):
+		return []Instant{p.End}
+	case p.Resolution == nil:
+		return []Instant{i}
+	}
+
+	precision := max(p.Start.Precision, p.Resolution.precision())
+	if t.After(end) {
+		t = end
+	}
+	n := p.index(t)
+	candidates := []Instant{{Time: p.Resolution.AddTo(start, n), Precision: precision}}
+	if next := p.Resolution.AddTo(start, n+1); !next.After(end) {
+		candidates = append(candidates, Instant{Time: next, Precision: precision})
+	}
+	return candidates
+}
+
+// precision returns the precision needed to write the values of a period with this resolution
+func (d Duration) precision() Precision {
+	switch {
+	case d.Time%time.Second != 0:
+		return Fraction
+	case d.Time%time.Minute != 0:
+		return Second
+	case d.Time%time.Hour != 0:
+		return Minute
+	case d.Time != 0:
+		return Hour
+	case d.Days != 0:
+		return Day
+	case d.Months != 0:
+		return Month
+	}
+	return Year
+}
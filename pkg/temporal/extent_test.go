@@ -0,0 +1,154 @@
+package temporal
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseExtent(t *testing.T) {
+	var tests = []struct {
+		input  string
+		result string
+		err    bool
+	}{
+		0:  {input: `2020-01-01`, result: `2020-01-01`},
+		1:  {input: `2020-01-01, 2020-02-01T00:00+01:00`, result: `2020-01-01,2020-01-31T23:00Z`},
+		2:  {input: `2020-01-01/2020-12-01/P1M`, result: `2020-01-01/2020-12-01/P1M`},
+		3:  {input: `2020-01-01T00:00Z/2020-01-02T00:00Z/PT60M`, result: `2020-01-01T00:00Z/2020-01-02T00:00Z/PT1H`},
+		4:  {input: `2020-01-01/2020-12-31/0`, result: `2020-01-01/2020-12-31`},
+		5:  {input: `2000/current`, result: `2000/current`},
+		6:  {input: `2020-12-31/2020-01-01`, err: true},
+		7:  {input: `2020-01-01/2020-12-31/P1M/P1D`, err: true},
+		8:  {input: `2020-01-01/2020-12-31/1M`, err: true},
+		9:  {input: ``, err: true},
+		10: {input: `2020-01-01/2020-12-31/P0D`, err: true},
+	}
+
+	for k, test := range tests {
+		e, err := ParseExtent(test.input)
+		if test.err {
+			if err == nil {
+				t.Errorf("test: %d, expected an error,\n got: %s", k, e)
+			}
+			continue
+		}
+		if err != nil || e.String() != test.result {
+			t.Errorf("test: %d, expected: %s,\n got: %s %v", k, test.result, e, err)
+		}
+	}
+}
+
+func TestExtentContains(t *testing.T) {
+	now = func() time.Time { return time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC) }
+	defer func() { now = time.Now }()
+
+	extent, _ := ParseExtent(`1999-12-31,2020-01-31/2020-12-31/P1M,2021-01-01T00:00Z/2021-01-02T00:00Z/PT6H,2024/current`)
+	var tests = []struct {
+		input    string
+		contains bool
+	}{
+		0:  {input: `1999-12-31`, contains: true},
+		1:  {input: `1999-12-31T00:00:00Z`, contains: true},
+		2:  {input: `2020-03-31`, contains: true},
+		3:  {input: `2020-03-30`, contains: false},
+		4:  {input: `2020-12-31`, contains: true},
+		5:  {input: `2021-01-01T18:00Z`, contains: true},
+		6:  {input: `2021-01-01T17:00Z`, contains: false},
+		7:  {input: `2025-07-01T10:11:12.5Z`, contains: true},
+		8:  {input: `current`, contains: true},
+		9:  {input: `2026-10-19T12:00:01Z`, contains: false},
+		10: {input: `2010`, contains: false},
+	}
+
+	for k, test := range tests {
+		i, _ := ParseInstant(test.input)
+		if contains := extent.Contains(i); contains != test.contains {
+			t.Errorf("test: %d, expected: %t,\n got: %t", k, test.contains, contains)
+		}
+	}
+}
+
+func TestExtentCovers(t *testing.T) {
+	extent, _ := ParseExtent(`2019-06-01,2020-01-01/2020-12-31/P1D`)
+	var tests = []struct {
+		input  string
+		covers bool
+	}{
+		0: {input: `2019-06-01,2020-02-02`, covers: true},
+		1: {input: `2020-02-01/2020-03-01`, covers: true},
+		2: {input: `2019-06-01/2020-03-01`, covers: false},
+		3: {input: `2020-06-01/2021-01-01`, covers: false},
+		4: {input: `2020-02-02,2021-01-01`, covers: false},
+	}
+
+	for k, test := range tests {
+		requested, _ := ParseExtent(test.input)
+		if covers := extent.Covers(requested); covers != test.covers {
+			t.Errorf("test: %d, expected: %t,\n got: %t", k, test.covers, covers)
+		}
+	}
+}
+
+func TestExtentValues(t *testing.T) {
+	var tests = []struct {
+		input  string
+		limit  int
+		result []string
+		err    bool
+	}{
+		0: {input: `2020-03-01/2020-06-01/P1M,2020-01-01`, limit: 10, result: []string{`2020-01-01`, `2020-03-01`, `2020-04-01`, `2020-05-01`, `2020-06-01`}},
+		1: {input: `2020-01-01/2020-01-01T12:00Z/PT6H`, limit: 10, result: []string{`2020-01-01T00Z`, `2020-01-01T06Z`, `2020-01-01T12Z`}},
+		2: {input: `2020/2023/P1Y`, limit: 10, result: []string{`2020`, `2021`, `2022`, `2023`}},
+		3: {input: `2020-01-01/2020-12-31/P1D`, limit: 100, err: true},
+		4: {input: `2020-01-01/2020-12-31`, limit: 100, err: true},
+	}
+
+	for k, test := range tests {
+		extent, _ := ParseExtent(test.input)
+		values, err := extent.Values(test.limit)
+		if test.err {
+			if err == nil {
+				t.Errorf("test: %d, expected an error,\n got: %v", k, values)
+			}
+			continue
+		}
+		if err != nil || len(values) != len(test.result) {
+			t.Errorf("test: %d, expected: %v,\n got: %v %v", k, test.result, values, err)
+			continue
+		}
+		for i, v := range values {
+			if v.String() != test.result[i] {
+				t.Errorf("test: %d, expected: %v,\n got: %v", k, test.result, values)
+				break
+			}
+		}
+	}
+}
+
+func TestExtentNearest(t *testing.T) {
+	extent, _ := ParseExtent(`2019-06-01,2020-01-31/2020-12-31/P1M,2021-01-01T00:00Z/2021-01-02T00:00Z`)
+	var tests = []struct {
+		input  string
+		result string
+	}{
+		0: {input: `2019-01-01`, result: `2019-06-01`},
+		1: {input: `2019-09-15`, result: `2019-06-01`},
+		2: {input: `2020-03-10`, result: `2020-02-29`},
+		3: {input: `2020-04-14`, result: `2020-03-31`},
+		4: {input: `2020-04-16`, result: `2020-04-30`},
+		5: {input: `2020-12-31T20:00Z`, result: `2021-01-01T00:00Z`},
+		6: {input: `2021-01-01T10:30:45Z`, result: `2021-01-01T10:30:45Z`},
+		7: {input: `2022`, result: `2021-01-02T00:00Z`},
+	}
+
+	for k, test := range tests {
+		i, _ := ParseInstant(test.input)
+		if result, ok := extent.Nearest(i); !ok || result.String() != test.result {
+			t.Errorf("test: %d, expected: %s,\n got: %s %t", k, test.result, result, ok)
+		}
+	}
+
+	if _, ok := (Extent{}).Nearest(Instant{}); ok {
+		t.Errorf("test: %d, expected: %t,\n got: %t", len(tests), false, ok)
+	}
+}
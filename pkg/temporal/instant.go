@@ -0,0 +1,136 @@
+// Package temporal contains the ISO 8601 instants, durations and extents used by the time parameters of the services,
+// like the WMS TIME dimension, the WMTS Time dimension and the WCS time subsets. The extents follow the notation of
+// WMS 1.3.0 Annex C, a list of instants and start/end/period intervals.
+package temporal
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Precision is the smallest unit given in an instant, ISO 8601 allows reduced precision like 2020 or 2020-06
+type Precision int
+
+// Precisions, from reduced to full
+const (
+	Year Precision = iota
+	Month
+	Day
+	Hour
+	Minute
+	Second
+	Fraction
+)
+
+// Current is the keyword for the current time in an extent, ISO 8601 uses present and some services now
+const Current = `current`
+
+// now returns the current time, it is replaced in the tests
+var now = time.Now
+
+// layouts contains the layouts of the instants with an explicit time zone, by precision,
+// time.Parse accepts fractional seconds after the seconds of a layout
+var layouts = []struct {
+	layout    string
+	precision Precision
+}{
+	{`2006-01-02T15:04:05Z07:00`, Second},
+	{`2006-01-02T15:04Z07:00`, Minute},
+	{`2006-01-02T15Z07:00`, Hour},
+	{`2006-01-02`, Day},
+	{`2006-01`, Month},
+	{`2006`, Year},
+}
+
+// formats contains the layouts of the normalised instants, in UTC
+var formats = map[Precision]string{
+	Year:     `2006`,
+	Month:    `2006-01`,
+	Day:      `2006-01-02`,
+	Hour:     `2006-01-02T15Z`,
+	Minute:   `2006-01-02T15:04Z`,
+	Second:   `2006-01-02T15:04:05Z`,
+	Fraction: `2006-01-02T15:04:05.999999999Z`,
+}
+
+// Instant is a point in time with the precision it was given in, or the current time
+type Instant struct {
+	Time      time.Time
+	Precision Precision
+	// Current is set for the current, present or now keyword, the Time is resolved when the instant is used
+	Current bool
+}
+
+// ParseInstant parses an ISO 8601 date or date time, with reduced precision or fractional seconds.
+// A time without a time zone is in UTC, as WMS 1.3.0 Annex D prescribes.
+func ParseInstant(s string) (Instant, error) {
+	switch strings.ToLower(s) {
+	case Current, `present`, `now`:
+		return Instant{Precision: Fraction, Current: true}, nil
+	}
+
+	value := s
+	if strings.Contains(value, `T`) && !hasZone(value) {
+		value += `Z`
+	}
+	for _, l := range layouts {
+		t, err := time.Parse(l.layout, value)
+		if err != nil {
+			continue
+		}
+		if l.precision == Second && strings.ContainsAny(value, `.,`) {
+			return Instant{Time: t.UTC(), Precision: Fraction}, nil
+		}
+		return Instant{Time: t.UTC(), Precision: l.precision}, nil
+	}
+	return Instant{}, fmt.Errorf("invalid instant: %s", s)
+}
+
+// hasZone returns whether the time part of the date time has a time zone designator
+func hasZone(s string) bool {
+	clock := s[strings.Index(s, `T`)+1:]
+	return strings.HasSuffix(clock, `Z`) || strings.ContainsAny(clock, `+-`)
+}
+
+// Resolve returns the time of the instant, the current time for the current keyword
+func (i Instant) Resolve() time.Time {
+	if i.Current {
+		return now().UTC()
+	}
+	return i.Time
+}
+
+// String returns the normalised instant: in UTC, in the precision it was given in
+func (i Instant) String() string {
+	if i.Current {
+		return Current
+	}
+	return i.Time.UTC().Format(formats[i.Precision])
+}
+
+// End returns the last moment covered by the instant, an instant with reduced precision covers the whole year, month,
+// day, hour, minute or second
+func (i Instant) End() time.Time {
+	t := i.Resolve()
+	switch i.Precision {
+	case Year:
+		return t.AddDate(1, 0, 0).Add(-time.Nanosecond)
+	case Month:
+		return t.AddDate(0, 1, 0).Add(-time.Nanosecond)
+	case Day:
+		return t.AddDate(0, 0, 1).Add(-time.Nanosecond)
+	case Hour:
+		return t.Add(time.Hour - time.Nanosecond)
+	case Minute:
+		return t.Add(time.Minute - time.Nanosecond)
+	case Second:
+		return t.Add(time.Second - time.Nanosecond)
+	}
+	return t
+}
+
+// Equal returns whether both instants are the same point in time
+func (i Instant) Equal(o Instant) bool {
+	return i.Resolve().Equal(o.Resolve())
+}
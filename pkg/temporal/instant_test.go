@@ -0,0 +1,60 @@
+package temporal
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseInstant(t *testing.T) {
+	var tests = []struct {
+		input     string
+		precision Precision
+		result    string
+		err       bool
+	}{
+		0:  {input: `2020`, precision: Year, result: `2020`},
+		1:  {input: `2020-06`, precision: Month, result: `2020-06`},
+		2:  {input: `2020-06-15`, precision: Day, result: `2020-06-15`},
+		3:  {input: `2020-06-15T12Z`, precision: Hour, result: `2020-06-15T12Z`},
+		4:  {input: `2020-06-15T12:30`, precision: Minute, result: `2020-06-15T12:30Z`},
+		5:  {input: `2020-06-15T12:30:15+02:00`, precision: Second, result: `2020-06-15T10:30:15Z`},
+		6:  {input: `2020-06-15T12:30:15.250Z`, precision: Fraction, result: `2020-06-15T12:30:15.25Z`},
+		7:  {input: `2020-06-15T00:30:00-01:00`, precision: Second, result: `2020-06-15T01:30:00Z`},
+		8:  {input: `present`, precision: Fraction, result: Current},
+		9:  {input: `2020-13`, err: true},
+		10: {input: `15-06-2020`, err: true},
+		11: {input: ``, err: true},
+	}
+
+	for k, test := range tests {
+		i, err := ParseInstant(test.input)
+		if test.err {
+			if err == nil {
+				t.Errorf("test: %d, expected an error,\n got: %s", k, i)
+			}
+			continue
+		}
+		if err != nil || i.Precision != test.precision || i.String() != test.result {
+			t.Errorf("test: %d, expected: %s %d,\n got: %s %d %v", k, test.result, test.precision, i, i.Precision, err)
+		}
+	}
+}
+
+func TestInstantEnd(t *testing.T) {
+	var tests = []struct {
+		input  string
+		result string
+	}{
+		0: {input: `2020`, result: `2020-12-31T23:59:59.999999999Z`},
+		1: {input: `2020-02`, result: `2020-02-29T23:59:59.999999999Z`},
+		2: {input: `2020-02-10T10:15Z`, result: `2020-02-10T10:15:59.999999999Z`},
+		3: {input: `2020-02-10T10:15:30.5Z`, result: `2020-02-10T10:15:30.5Z`},
+	}
+
+	for k, test := range tests {
+		i, _ := ParseInstant(test.input)
+		if result := i.End().Format(time.RFC3339Nano); result != test.result {
+			t.Errorf("test: %d, expected: %s,\n got: %s", k, test.result, result)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+// Document formats reported by a ParseError
+const (
+	XMLFormat  = `xml`
+	YAMLFormat = `yaml`
+)
+
+// ErrUnknownField is wrapped by the ParseError for a key that doesn't exist in the target struct
+var ErrUnknownField = errors.New(`unknown field`)
+
+// ParseError describes why, and where, a document could not be parsed
+type ParseError struct {
+	Format string
+	// Path to the offending element or key, like WMS_Capabilities/Capability/Layer or wmsCapabilities.layer[0].title
+	Path   string
+	Line   int
+	Column int
+	Err    error
+}
+
+// Error returns the message including the position of the offending element or key
+func (e *ParseError) Error() string {
+	position := ``
+	switch {
+	case e.Line > 0 && e.Column > 0:
+		position = fmt.Sprintf(` at line %d, column %d`, e.Line, e.Column)
+	case e.Line > 0:
+		position = fmt.Sprintf(` at line %d`, e.Line)
+	}
+	if e.Path != `` {
+		return fmt.Sprintf(`%s: %s%s: %v`, e.Format, e.Path, position, e.Err)
+	}
+	return fmt.Sprintf(`%s%s: %v`, e.Format, position, e.Err)
+}
+
+// Unwrap returns the underlying error
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// lineMessage matches the line that is reported in the yaml messages, like: line 3: cannot unmarshal ...
+var lineMessage = regexp.MustCompile(`^(?:yaml: )?line (\d+): (.*)$`)
+
+// splitLineMessage returns the line and the remaining message from a yaml message
+func splitLineMessage(message string) (int, string) {
+	m := lineMessage.FindStringSubmatch(message)
+	if m == nil {
+		return 0, message
+	}
+	line, _ := strconv.Atoi(m[1])
+	return line, m[2]
+}
@@ -0,0 +1,286 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Encoder marshals XML documents with self-closing empty elements and
+// the namespace declarations for the prefixes used in the struct tags
+type Encoder struct {
+	// namespaces maps the prefixes to their namespace URI, the empty prefix is the default namespace
+	namespaces map[string]string
+	// declared contains the prefixes that are declared whether they are used or not
+	declared map[string]bool
+	indent   string
+}
+
+// NewEncoder returns an Encoder for the given prefixes, the xlink and xsi prefixes are always registered.
+// When a prefix is used for more than one namespace the last URI, in sorted order, is registered,
+// use Register to choose another one.
+func NewEncoder(prefixes Prefixes) *Encoder {
+	e := &Encoder{namespaces: map[string]string{}, declared: map[string]bool{}}
+	for _, p := range []Prefixes{defaultPrefixes, prefixes} {
+		uris := make([]string, 0, len(p))
+		for uri := range p {
+			uris = append(uris, uri)
+		}
+		sort.Strings(uris)
+		for _, uri := range uris {
+			e.Register(p[uri], uri)
+		}
+	}
+	return e
+}
+
+// Register sets the namespace URI that is declared for the prefix
+func (e *Encoder) Register(prefix, uri string) {
+	// the xml prefix is bound by definition and must not be declared
+	if prefix == `xml` {
+		return
+	}
+	e.namespaces[prefix] = uri
+}
+
+// Declare registers the namespace URI for the prefix and declares it on the root element, even when the prefix
+// isn't used by an element or attribute but only in the content, like the prefixed names of feature types
+func (e *Encoder) Declare(prefix, uri string) {
+	e.Register(prefix, uri)
+	if prefix != `xml` {
+		e.declared[prefix] = true
+	}
+}
+
+// Indent sets the indentation of the nested elements, by default the document is written on a single line
+func (e *Encoder) Indent(indent string) {
+	e.indent = indent
+}
+
+// Marshal returns the XML document, including the XML header, of v.
+// The root element gets a declaration for every registered prefix that is used in the document and isn't declared yet,
+// empty declarations are removed.
+func (e *Encoder) Marshal(v interface{}) ([]byte, error) {
+	doc, err := xml.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, used, err := rawTokens(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	w := &tokenWriter{indent: e.indent}
+	w.buf.WriteString(xml.Header)
+	root := true
+	for i, token := range tokens {
+		switch t := token.(type) {
+		case xml.StartElement:
+			if root {
+				t.Attr = e.declare(t.Attr, used)
+				root = false
+			}
+			if i+1 < len(tokens) {
+				if _, ok := tokens[i+1].(xml.EndElement); ok {
+					w.writeEmpty(t)
+					continue
+				}
+			}
+			w.writeStart(t)
+		case xml.EndElement:
+			if i > 0 {
+				if _, ok := tokens[i-1].(xml.StartElement); ok {
+					continue
+				}
+			}
+			w.writeEnd(t)
+		case xml.CharData:
+			_ = xml.EscapeText(&w.buf, t)
+		case xml.Comment:
+			w.buf.WriteString(`<!--`)
+			w.buf.Write(t)
+			w.buf.WriteString(`-->`)
+		case xml.ProcInst:
+			// the header is already written
+			if t.Target == `xml` {
+				continue
+			}
+			w.buf.WriteString(`<?` + t.Target + ` `)
+			w.buf.Write(t.Inst)
+			w.buf.WriteString(`?>`)
+		case xml.Directive:
+			w.buf.WriteString(`<!`)
+			w.buf.Write(t)
+			w.buf.WriteString(`>`)
+		}
+	}
+	return w.buf.Bytes(), nil
+}
+
+// declare returns the attributes of the root element with the missing namespace declarations
+func (e *Encoder) declare(attr []xml.Attr, used map[string]bool) []xml.Attr {
+	// encoding/xml writes the declarations in a xml.Attr slice, like utils.XMLAttribute, as attributes in
+	// the xmlns namespace that gets a prefix of its own, these are turned into declarations again
+	aliases := map[string]bool{}
+	for _, a := range attr {
+		if prefix, ok := declaration(a); ok && a.Value == `xmlns` {
+			aliases[prefix] = true
+		}
+	}
+
+	declared := map[string]bool{}
+	var result []xml.Attr
+	for _, a := range attr {
+		if aliases[a.Name.Space] {
+			a.Name.Space = `xmlns`
+		}
+		prefix, ok := declaration(a)
+		if !ok {
+			result = append(result, a)
+			continue
+		}
+		if a.Value == `` || a.Value == `xmlns` || declared[prefix] {
+			continue
+		}
+		declared[prefix] = true
+		result = append(result, a)
+	}
+
+	var missing []string
+	for prefix := range e.namespaces {
+		if (used[prefix] || e.declared[prefix]) && !declared[prefix] {
+			missing = append(missing, prefix)
+		}
+	}
+	sort.Strings(missing)
+
+	var declarations []xml.Attr
+	for _, prefix := range missing {
+		if prefix == `` {
+			declarations = append(declarations, xml.Attr{Name: xml.Name{Local: `xmlns`}, Value: e.namespaces[prefix]})
+			continue
+		}
+		declarations = append(declarations, xml.Attr{Name: xml.Name{Space: `xmlns`, Local: prefix}, Value: e.namespaces[prefix]})
+	}
+	return append(declarations, result...)
+}
+
+// declaration returns the declared prefix when the attribute is a namespace declaration
+func declaration(a xml.Attr) (string, bool) {
+	switch {
+	case a.Name.Space == `xmlns`:
+		return a.Name.Local, true
+	case a.Name.Space == `` && a.Name.Local == `xmlns`:
+		return ``, true
+	}
+	return ``, false
+}
+
+// rawTokens returns the tokens of the document, without resolving the namespaces,
+// and the prefixes used by the elements and attributes
+func rawTokens(doc []byte) ([]xml.Token, map[string]bool, error) {
+	d := xml.NewDecoder(bytes.NewReader(doc))
+	var tokens []xml.Token
+	used := map[string]bool{}
+	for {
+		token, err := d.RawToken()
+		if errors.Is(err, io.EOF) {
+			return tokens, used, nil
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		if t, ok := token.(xml.StartElement); ok {
+			used[t.Name.Space] = true
+			for _, a := range t.Attr {
+				if _, ok := declaration(a); !ok && a.Name.Space != `` {
+					used[a.Name.Space] = true
+				}
+			}
+		}
+		tokens = append(tokens, xml.CopyToken(token))
+	}
+}
+
+// tokenWriter writes the tokens with the same indentation as xml.MarshalIndent
+type tokenWriter struct {
+	buf        bytes.Buffer
+	indent     string
+	depth      int
+	indentedIn bool
+	putNewline bool
+}
+
+func (w *tokenWriter) writeStart(t xml.StartElement) {
+	w.writeIndent(1)
+	w.writeTag(t)
+	w.buf.WriteString(`>`)
+}
+
+func (w *tokenWriter) writeEmpty(t xml.StartElement) {
+	w.writeIndent(0)
+	w.writeTag(t)
+	w.buf.WriteString(`/>`)
+}
+
+func (w *tokenWriter) writeEnd(t xml.EndElement) {
+	w.writeIndent(-1)
+	w.buf.WriteString(`</` + name(t.Name) + `>`)
+}
+
+func (w *tokenWriter) writeTag(t xml.StartElement) {
+	w.buf.WriteString(`<` + name(t.Name))
+	for _, a := range t.Attr {
+		w.buf.WriteString(` ` + name(a.Name) + `="`)
+		_ = xml.EscapeText(&w.buf, []byte(a.Value))
+		w.buf.WriteString(`"`)
+	}
+}
+
+func (w *tokenWriter) writeIndent(depthDelta int) {
+	if w.indent == `` {
+		return
+	}
+	if depthDelta < 0 {
+		w.depth--
+		if w.indentedIn {
+			w.indentedIn = false
+			return
+		}
+		w.indentedIn = false
+	}
+	if w.putNewline {
+		w.buf.WriteByte('\n')
+	} else {
+		w.putNewline = true
+	}
+	w.buf.WriteString(strings.Repeat(w.indent, w.depth))
+	if depthDelta > 0 {
+		w.depth++
+		w.indentedIn = true
+	} else if depthDelta == 0 {
+		w.indentedIn = false
+	}
+}
+
+// name returns the raw name, with its prefix
+func name(n xml.Name) string {
+	if n.Space == `` {
+		return n.Local
+	}
+	return n.Space + `:` + n.Local
+}
+
+// EncodeNonZero encodes v as the element start, unless v is the zero value. It is used by the MarshalXML
+// methods of the capabilities sections, so a section that isn't requested is left out of the document.
+func EncodeNonZero(e *xml.Encoder, v interface{}, start xml.StartElement) error {
+	if reflect.ValueOf(v).IsZero() {
+		return nil
+	}
+	return e.EncodeElement(v, start)
+}
@@ -0,0 +1,91 @@
+package utils
+
+import (
+	"encoding/xml"
+	"testing"
+)
+
+type encodedLayer struct {
+	Identifier string `xml:"ows:Identifier"`
+	Style      string `xml:"Style"`
+	Href       string `xml:"xlink:href,attr,omitempty"`
+}
+
+type encodedDocument struct {
+	XMLName  xml.Name       `xml:"Capabilities"`
+	XmlnsOWS string         `xml:"xmlns:ows,attr"`
+	XmlnsGML string         `xml:"xmlns:gml,attr"`
+	Version  string         `xml:"version,attr"`
+	Title    string         `xml:"ows:Title"`
+	Layer    []encodedLayer `xml:"Layer"`
+}
+
+func TestEncoderMarshal(t *testing.T) {
+	prefixes := Prefixes{`http://www.opengis.net/wmts/1.0`: ``, `http://www.opengis.net/ows/1.1`: `ows`}
+	doc := encodedDocument{Version: `1.0.0`, Title: `Tiles`, Layer: []encodedLayer{{Identifier: `roads`, Href: `https://example.com?a=1&b=2`}, {}}}
+
+	var tests = []struct {
+		prefixes Prefixes
+		indent   string
+		declare  map[string]string
+		result   string
+	}{
+		0: {prefixes: prefixes,
+			result: `<?xml version="1.0" encoding="UTF-8"?>
+<Capabilities xmlns="http://www.opengis.net/wmts/1.0" xmlns:ows="http://www.opengis.net/ows/1.1" xmlns:xlink="http://www.w3.org/1999/xlink" version="1.0.0"><ows:Title>Tiles</ows:Title><Layer xlink:href="https://example.com?a=1&amp;b=2"><ows:Identifier>roads</ows:Identifier><Style/></Layer><Layer><ows:Identifier/><Style/></Layer></Capabilities>`},
+		1: {prefixes: prefixes, indent: ` `, declare: map[string]string{`app`: `http://example.com/app`},
+			result: `<?xml version="1.0" encoding="UTF-8"?>
+<Capabilities xmlns="http://www.opengis.net/wmts/1.0" xmlns:app="http://example.com/app" xmlns:ows="http://www.opengis.net/ows/1.1" xmlns:xlink="http://www.w3.org/1999/xlink" version="1.0.0">
+ <ows:Title>Tiles</ows:Title>
+ <Layer xlink:href="https://example.com?a=1&amp;b=2">
+  <ows:Identifier>roads</ows:Identifier>
+  <Style/>
+ </Layer>
+ <Layer>
+  <ows:Identifier/>
+  <Style/>
+ </Layer>
+</Capabilities>`},
+		2: {prefixes: nil,
+			result: `<?xml version="1.0" encoding="UTF-8"?>
+<Capabilities xmlns:xlink="http://www.w3.org/1999/xlink" version="1.0.0"><ows:Title>Tiles</ows:Title><Layer xlink:href="https://example.com?a=1&amp;b=2"><ows:Identifier>roads</ows:Identifier><Style/></Layer><Layer><ows:Identifier/><Style/></Layer></Capabilities>`},
+	}
+
+	for k, test := range tests {
+		e := NewEncoder(test.prefixes)
+		e.Indent(test.indent)
+		for prefix, uri := range test.declare {
+			e.Declare(prefix, uri)
+		}
+		b, err := e.Marshal(doc)
+		if err != nil {
+			t.Errorf("test: %d, expected no error,\n got: %v", k, err)
+			continue
+		}
+		if string(b) != test.result {
+			t.Errorf("test: %d, expected: %s,\n got: %s", k, test.result, b)
+		}
+	}
+}
+
+type encodedRequest struct {
+	XMLName xml.Name     `xml:"GetCapabilities"`
+	Attr    XMLAttribute `xml:",attr"`
+	Version string       `xml:"ows:AcceptVersions>ows:Version"`
+}
+
+func TestEncoderMarshalAttrDeclarations(t *testing.T) {
+	r := encodedRequest{Version: `2.0.0`, Attr: XMLAttribute{
+		{Name: xml.Name{Space: `xmlns`, Local: `ows`}, Value: `http://www.opengis.net/ows/1.1`},
+		{Name: xml.Name{Space: `xmlns`, Local: `app`}, Value: `http://example.com/app`},
+	}}
+	expected := `<?xml version="1.0" encoding="UTF-8"?>
+<GetCapabilities xmlns:ows="http://www.opengis.net/ows/1.1" xmlns:app="http://example.com/app"><ows:AcceptVersions><ows:Version>2.0.0</ows:Version></ows:AcceptVersions></GetCapabilities>`
+
+	b, err := NewEncoder(Prefixes{`http://www.opengis.net/ows/1.1`: `ows`}).Marshal(r)
+	if err != nil {
+		t.Errorf("test: %d, expected no error,\n got: %v", 0, err)
+	} else if string(b) != expected {
+		t.Errorf("test: %d, expected: %s,\n got: %s", 0, expected, b)
+	}
+}
@@ -0,0 +1,180 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"strings"
+)
+
+// Namespace URIs that are used by all services
+const (
+	XMLNamespace   = `http://www.w3.org/XML/1998/namespace`
+	XlinkNamespace = `http://www.w3.org/1999/xlink`
+	XSINamespace   = `http://www.w3.org/2001/XMLSchema-instance`
+)
+
+// Prefixes maps namespace URIs to the prefixes used in the xml struct tags, like ows for ows:Title.
+// The empty prefix is used for the namespace of the struct tags without a prefix.
+type Prefixes map[string]string
+
+// defaultPrefixes contains the prefixes of the namespaces that are used by all services
+var defaultPrefixes = Prefixes{
+	XMLNamespace:   `xml`,
+	XlinkNamespace: `xlink`,
+	XSINamespace:   `xsi`,
+}
+
+// prefix returns the prefix of a namespace URI
+func (p Prefixes) prefix(uri string) (string, bool) {
+	if prefix, ok := p[uri]; ok {
+		return prefix, true
+	}
+	prefix, ok := defaultPrefixes[uri]
+	return prefix, ok
+}
+
+// UnmarshalPrefixed decodes a XML document into v regardless of the namespace prefixes used in the document.
+// The element and attribute names are rewritten to the prefix of their namespace before they are decoded,
+// so struct tags like ows:Title match a document that uses any other prefix, or a default namespace, for OWS.
+// Namespace declarations are rewritten the same way, names in an unknown namespace lose their prefix.
+// The returned errors are ParseErrors with the line, column and path of the offending element.
+func UnmarshalPrefixed(doc []byte, v interface{}, prefixes Prefixes) error {
+	r := &prefixReader{decoder: xml.NewDecoder(bytes.NewReader(doc)), prefixes: prefixes}
+	if err := xml.NewTokenDecoder(r).Decode(v); err != nil {
+		line, column := r.decoder.InputPos()
+		var syntaxError *xml.SyntaxError
+		if errors.As(err, &syntaxError) {
+			line = syntaxError.Line
+			err = errors.New(syntaxError.Msg)
+		}
+		return &ParseError{Format: XMLFormat, Path: r.path, Line: line, Column: column, Err: err}
+	}
+	return nil
+}
+
+// RootNamespaces returns the namespaces, by prefix, that are declared on the root element of a XML document
+func RootNamespaces(doc []byte) (map[string]string, error) {
+	d := xml.NewDecoder(bytes.NewReader(doc))
+	for {
+		token, err := d.RawToken()
+		if err != nil {
+			return nil, err
+		}
+		if t, ok := token.(xml.StartElement); ok {
+			namespaces := map[string]string{}
+			for _, a := range t.Attr {
+				if prefix, ok := declaration(a); ok {
+					namespaces[prefix] = a.Value
+				}
+			}
+			return namespaces, nil
+		}
+	}
+}
+
+// prefixReader is a xml.TokenReader that rewrites the names of the namespace resolved tokens
+// and keeps track of the path of the last read element
+type prefixReader struct {
+	decoder  *xml.Decoder
+	prefixes Prefixes
+	stack    []string
+	path     string
+}
+
+// Token returns the next token with the rewritten names
+func (r *prefixReader) Token() (xml.Token, error) {
+	token, err := r.decoder.Token()
+	if err != nil {
+		return token, err
+	}
+
+	switch t := token.(type) {
+	case xml.StartElement:
+		t.Name = r.name(t.Name)
+		attr := make([]xml.Attr, 0, len(t.Attr))
+		for _, a := range t.Attr {
+			attr = append(attr, xml.Attr{Name: r.attrName(a), Value: a.Value})
+		}
+		t.Attr = attr
+		r.stack = append(r.stack, t.Name.Local)
+		r.path = strings.Join(r.stack, `/`)
+		return t, nil
+	case xml.EndElement:
+		t.Name = r.name(t.Name)
+		// the path keeps pointing to the closed element, its content is decoded when the end is read
+		r.path = strings.Join(r.stack, `/`)
+		if len(r.stack) > 0 {
+			r.stack = r.stack[:len(r.stack)-1]
+		}
+		return t, nil
+	}
+	return token, nil
+}
+
+// name returns the name with the prefix for its namespace as part of the local name
+func (r *prefixReader) name(n xml.Name) xml.Name {
+	if n.Space == `` {
+		return n
+	}
+	prefix, ok := r.prefixes.prefix(n.Space)
+	if !ok || prefix == `` {
+		return xml.Name{Local: n.Local}
+	}
+	return xml.Name{Local: prefix + `:` + n.Local}
+}
+
+// attrName returns the name of an attribute, namespace declarations are
+// rewritten to the prefix for the declared namespace
+func (r *prefixReader) attrName(a xml.Attr) xml.Name {
+	switch {
+	case a.Name.Space == `xmlns`, a.Name.Space == `` && a.Name.Local == `xmlns`:
+		prefix, ok := r.prefixes.prefix(a.Value)
+		switch {
+		case !ok && a.Name.Space == ``:
+			return a.Name
+		case !ok:
+			return xml.Name{Local: `xmlns:` + a.Name.Local}
+		case prefix == ``:
+			return xml.Name{Local: `xmlns`}
+		default:
+			return xml.Name{Local: `xmlns:` + prefix}
+		}
+	default:
+		return r.name(a.Name)
+	}
+}
+
+// InnerXML returns the content of the element, for the UnmarshalXML methods of types that keep their content as is.
+// The ,innerxml struct tag doesn't work for the documents decoded by UnmarshalPrefixed, so the content is written
+// from the tokens instead, with the rewritten names and without the namespace declarations of the ancestors.
+func InnerXML(d *xml.Decoder, start xml.StartElement) (string, error) {
+	var buf bytes.Buffer
+	e := xml.NewEncoder(&buf)
+	for depth := 1; ; {
+		token, err := d.Token()
+		if err != nil {
+			return ``, err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			depth++
+			s := xml.StartElement{Name: xml.Name{Local: t.Name.Local}}
+			for _, a := range t.Attr {
+				s.Attr = append(s.Attr, xml.Attr{Name: xml.Name{Local: a.Name.Local}, Value: a.Value})
+			}
+			token = s
+		case xml.EndElement:
+			if depth--; depth == 0 {
+				err := e.Flush()
+				return buf.String(), err
+			}
+			token = xml.EndElement{Name: xml.Name{Local: t.Name.Local}}
+		case xml.ProcInst, xml.Directive:
+			continue
+		}
+		if err := e.EncodeToken(token); err != nil {
+			return ``, err
+		}
+	}
+}
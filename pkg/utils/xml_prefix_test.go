@@ -0,0 +1,133 @@
+package utils
+
+import (
+	"encoding/xml"
+	"errors"
+	"testing"
+)
+
+type prefixedDocument struct {
+	XMLName  xml.Name `xml:"Capabilities"`
+	XmlnsOWS string   `xml:"xmlns:ows,attr"`
+	Xmlns    string   `xml:"xmlns,attr"`
+	Lang     string   `xml:"xml:lang,attr"`
+	Title    string   `xml:"ows:Title"`
+	Layer    []struct {
+		Identifier string `xml:"ows:Identifier"`
+		Href       string `xml:"xlink:href,attr"`
+	} `xml:"Layer"`
+}
+
+func TestUnmarshalPrefixed(t *testing.T) {
+	prefixes := Prefixes{`http://www.opengis.net/wmts/1.0`: ``, `http://www.opengis.net/ows/1.1`: `ows`}
+
+	var tests = []struct {
+		doc string
+	}{
+		0: {doc: `<Capabilities xmlns="http://www.opengis.net/wmts/1.0" xmlns:ows="http://www.opengis.net/ows/1.1" xmlns:xlink="http://www.w3.org/1999/xlink" xml:lang="en">
+ <ows:Title>Tiles</ows:Title>
+ <Layer xlink:href="https://example.com"><ows:Identifier>roads</ows:Identifier></Layer>
+</Capabilities>`},
+		1: {doc: `<wmts:Capabilities xmlns:wmts="http://www.opengis.net/wmts/1.0" xmlns:o="http://www.opengis.net/ows/1.1" xmlns:xl="http://www.w3.org/1999/xlink" xml:lang="en">
+ <o:Title>Tiles</o:Title>
+ <wmts:Layer xl:href="https://example.com"><o:Identifier>roads</o:Identifier></wmts:Layer>
+</wmts:Capabilities>`},
+		2: {doc: `<Capabilities xmlns="http://www.opengis.net/wmts/1.0" xml:lang="en">
+ <Title xmlns="http://www.opengis.net/ows/1.1">Tiles</Title>
+ <Layer xmlns:a="http://www.w3.org/1999/xlink" a:href="https://example.com"><Identifier xmlns="http://www.opengis.net/ows/1.1">roads</Identifier></Layer>
+</Capabilities>`},
+	}
+
+	for k, test := range tests {
+		var d prefixedDocument
+		if err := UnmarshalPrefixed([]byte(test.doc), &d, prefixes); err != nil {
+			t.Errorf("test: %d, expected no error,\n got: %v", k, err)
+			continue
+		}
+		if d.Title != `Tiles` || d.Lang != `en` || d.Xmlns != `http://www.opengis.net/wmts/1.0` {
+			t.Errorf("test: %d, expected: Tiles en http://www.opengis.net/wmts/1.0,\n got: %s %s %s", k, d.Title, d.Lang, d.Xmlns)
+		}
+		if len(d.Layer) != 1 || d.Layer[0].Identifier != `roads` || d.Layer[0].Href != `https://example.com` {
+			t.Errorf("test: %d, expected: roads https://example.com,\n got: %v", k, d.Layer)
+		}
+		if k < 2 && d.XmlnsOWS != `http://www.opengis.net/ows/1.1` {
+			t.Errorf("test: %d, expected: http://www.opengis.net/ows/1.1,\n got: %s", k, d.XmlnsOWS)
+		}
+	}
+
+	var d prefixedDocument
+	if err := UnmarshalPrefixed([]byte(`<Capabilities><ows:Title>`), &d, prefixes); err == nil {
+		t.Errorf("expected an error for an invalid document")
+	}
+}
+
+func TestUnmarshalPrefixedError(t *testing.T) {
+	type limits struct {
+		LayerLimit int `xml:"LayerLimit"`
+	}
+	type document struct {
+		XMLName xml.Name `xml:"Capabilities"`
+		Limits  limits   `xml:"Limits"`
+	}
+
+	var tests = []struct {
+		doc    string
+		path   string
+		line   int
+		column int
+	}{
+		0: {doc: "<Capabilities>\n <Limits>\n  <LayerLimit>many</LayerLimit>\n </Limits>\n</Capabilities>", path: `Capabilities/Limits/LayerLimit`, line: 3, column: 32},
+		1: {doc: "<Capabilities>\n <Limits>\n  <LayerLimit>3</Limit>\n </Limits>\n</Capabilities>", path: `Capabilities/Limits/LayerLimit`, line: 3},
+	}
+
+	for k, test := range tests {
+		var d document
+		err := UnmarshalPrefixed([]byte(test.doc), &d, nil)
+
+		var pe *ParseError
+		if !errors.As(err, &pe) {
+			t.Errorf("test: %d, expected a ParseError,\n got: %v", k, err)
+			continue
+		}
+		if pe.Format != XMLFormat || pe.Path != test.path || pe.Line != test.line || (test.column > 0 && pe.Column != test.column) {
+			t.Errorf("test: %d, expected: %s %d %d,\n got: %s %d %d (%v)", k, test.path, test.line, test.column, pe.Path, pe.Line, pe.Column, pe)
+		}
+	}
+}
+
+type innerDocument struct {
+	Content string
+}
+
+func (i *innerDocument) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	content, err := InnerXML(d, start)
+	i.Content = content
+	return err
+}
+
+func TestInnerXML(t *testing.T) {
+	prefixes := Prefixes{`http://www.opengis.net/gml`: `gml`}
+
+	var tests = []struct {
+		doc     string
+		content string
+	}{
+		0: {doc: `<Data xmlns:g="http://www.opengis.net/gml"><g:Point srsName="EPSG:28992"><g:pos>1 2</g:pos></g:Point></Data>`,
+			content: `<gml:Point srsName="EPSG:28992"><gml:pos>1 2</gml:pos></gml:Point>`},
+		1: {doc: `<Data><![CDATA[a < b]]></Data>`, content: `a &lt; b`},
+		// elements in an unknown namespace lose their prefix
+		2: {doc: `<Data xmlns:x="urn:x"><x:value xlink:href="#a" xmlns:xlink="http://www.w3.org/1999/xlink">3</x:value></Data>`,
+			content: `<value xlink:href="#a" xmlns:xlink="http://www.w3.org/1999/xlink">3</value>`},
+	}
+
+	for k, test := range tests {
+		var d innerDocument
+		if err := UnmarshalPrefixed([]byte(test.doc), &d, prefixes); err != nil {
+			t.Errorf("test: %d, expected no error,\n got: %v", k, err)
+			continue
+		}
+		if d.Content != test.content {
+			t.Errorf("test: %d, expected: %s,\n got: %s", k, test.content, d.Content)
+		}
+	}
+}
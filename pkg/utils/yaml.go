@@ -0,0 +1,99 @@
+package utils
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ParseMode controls how keys are handled that don't exist in the target struct
+type ParseMode int
+
+// The parse modes, in Lenient mode unknown keys are ignored and in Strict mode they are rejected
+const (
+	Lenient ParseMode = iota
+	Strict
+)
+
+// unknownFieldMessage matches the message yaml.v3 uses for an unknown key
+var unknownFieldMessage = regexp.MustCompile(`^field (\S+) not found in type `)
+
+// UnmarshalYAML decodes a YAML document into v, the returned errors are ParseErrors
+// with the line, column and path of the offending key.
+func UnmarshalYAML(doc []byte, v interface{}, mode ParseMode) error {
+	var root yaml.Node
+	if err := yaml.Unmarshal(doc, &root); err != nil {
+		line, message := splitLineMessage(err.Error())
+		return &ParseError{Format: YAMLFormat, Line: line, Err: errors.New(message)}
+	}
+
+	d := yaml.NewDecoder(bytes.NewReader(doc))
+	d.KnownFields(mode == Strict)
+	err := d.Decode(v)
+	if err == nil || errors.Is(err, io.EOF) {
+		return nil
+	}
+
+	var typeError *yaml.TypeError
+	if !errors.As(err, &typeError) {
+		return &ParseError{Format: YAMLFormat, Err: err}
+	}
+
+	var errs []error
+	for _, e := range typeError.Errors {
+		line, message := splitLineMessage(e)
+		pe := &ParseError{Format: YAMLFormat, Line: line, Err: errors.New(message)}
+		if m := unknownFieldMessage.FindStringSubmatch(message); m != nil {
+			pe.Err = fmt.Errorf(`%w: %s`, ErrUnknownField, m[1])
+		}
+		if path, column, ok := nodeAtLine(&root, ``, line); ok {
+			pe.Path, pe.Column = path, column
+		}
+		errs = append(errs, pe)
+	}
+	if len(errs) == 1 {
+		return errs[0]
+	}
+	return errors.Join(errs...)
+}
+
+// nodeAtLine returns the path and column of the first key, or value, in the node tree that starts at the given line
+func nodeAtLine(n *yaml.Node, path string, line int) (string, int, bool) {
+	switch n.Kind {
+	case yaml.DocumentNode:
+		for _, c := range n.Content {
+			if p, column, ok := nodeAtLine(c, path, line); ok {
+				return p, column, true
+			}
+		}
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			key, value := n.Content[i], n.Content[i+1]
+			p := key.Value
+			if path != `` {
+				p = path + `.` + key.Value
+			}
+			if key.Line == line {
+				return p, key.Column, true
+			}
+			if p, column, ok := nodeAtLine(value, p, line); ok {
+				return p, column, true
+			}
+		}
+	case yaml.SequenceNode:
+		for i, c := range n.Content {
+			if p, column, ok := nodeAtLine(c, fmt.Sprintf(`%s[%d]`, path, i), line); ok {
+				return p, column, true
+			}
+		}
+	default:
+		if n.Line == line {
+			return path, n.Column, true
+		}
+	}
+	return ``, 0, false
+}
@@ -0,0 +1,55 @@
+package utils
+
+import (
+	"errors"
+	"testing"
+)
+
+type yamlDocument struct {
+	Title string `yaml:"title"`
+	Layer []struct {
+		Name  string `yaml:"name"`
+		Limit int    `yaml:"limit"`
+	} `yaml:"layer"`
+}
+
+func TestUnmarshalYAML(t *testing.T) {
+	var tests = []struct {
+		doc     string
+		mode    ParseMode
+		path    string
+		line    int
+		column  int
+		unknown bool
+	}{
+		0: {doc: "title: Tiles\nlayer:\n  - name: roads\n    limit: 3\n", mode: Strict},
+		1: {doc: "title: Tiles\nlayer:\n  - name: roads\n    style: default\n", mode: Lenient},
+		2: {doc: "title: Tiles\nlayer:\n  - name: roads\n    style: default\n", mode: Strict, path: `layer[0].style`, line: 4, column: 5, unknown: true},
+		3: {doc: "title: Tiles\nlayer:\n  - name: roads\n    limit: many\n", mode: Lenient, path: `layer[0].limit`, line: 4, column: 5},
+		4: {doc: "title: Tiles\nlayer: roads\n  limit: 3\n", mode: Lenient, line: 3},
+		5: {doc: ``, mode: Strict},
+	}
+
+	for k, test := range tests {
+		var d yamlDocument
+		err := UnmarshalYAML([]byte(test.doc), &d, test.mode)
+		if test.line == 0 {
+			if err != nil {
+				t.Errorf("test: %d, expected no error,\n got: %v", k, err)
+			}
+			continue
+		}
+
+		var pe *ParseError
+		if !errors.As(err, &pe) {
+			t.Errorf("test: %d, expected a ParseError,\n got: %v", k, err)
+			continue
+		}
+		if pe.Format != YAMLFormat || pe.Path != test.path || pe.Line != test.line || pe.Column != test.column {
+			t.Errorf("test: %d, expected: %s %d %d,\n got: %s %d %d (%v)", k, test.path, test.line, test.column, pe.Path, pe.Line, pe.Column, pe)
+		}
+		if errors.Is(err, ErrUnknownField) != test.unknown {
+			t.Errorf("test: %d, expected unknown field: %t,\n got: %v", k, test.unknown, err)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package validation
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/pdok/ogc-specifications/pkg/wms130"
+	"github.com/pdok/ogc-specifications/pkg/wmts100"
+)
+
+func TestValidateCapabilitiesToXML(t *testing.T) {
+	var wms wms130.GetCapabilitiesResponse
+	if err := wms.ParseXML([]byte(fmt.Sprintf(wmsCapabilities, `2`, `1`, `0`))); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	var wmts wmts100.GetCapabilitiesResponse
+	if err := wmts.ParseXML([]byte(`<Capabilities xmlns="http://www.opengis.net/wmts/1.0" xmlns:ows="http://www.opengis.net/ows/1.1" version="1.0.0">
+<ows:ServiceIdentification><ows:Title>Tiles</ows:Title><ows:ServiceType>OGC WMTS</ows:ServiceType><ows:ServiceTypeVersion>1.0.0</ows:ServiceTypeVersion></ows:ServiceIdentification>
+<Contents>
+<Layer><ows:Title>Roads</ows:Title><ows:Identifier>roads</ows:Identifier><Style isDefault="true"><ows:Identifier>default</ows:Identifier></Style><Format>image/png</Format><TileMatrixSetLink><TileMatrixSet>EPSG:28992</TileMatrixSet></TileMatrixSetLink></Layer>
+<TileMatrixSet><ows:Identifier>EPSG:28992</ows:Identifier><ows:SupportedCRS>EPSG:28992</ows:SupportedCRS>
+<TileMatrix><ows:Identifier>0</ows:Identifier><ScaleDenominator>12288000</ScaleDenominator><TopLeftCorner>-285401.92 903401.92</TopLeftCorner><TileWidth>256</TileWidth><TileHeight>256</TileHeight><MatrixWidth>1</MatrixWidth><MatrixHeight>1</MatrixHeight></TileMatrix>
+</TileMatrixSet>
+</Contents>
+</Capabilities>`)); err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+
+	var tests = []struct {
+		doc []byte
+	}{
+		0: {doc: wms.ToXML()},
+		1: {doc: wmts.ToXML()},
+	}
+
+	for k, test := range tests {
+		violations, err := Validate(test.doc)
+		if err != nil || len(violations) > 0 {
+			t.Errorf("test: %d, expected no violations,\n got: %v %v", k, violations, err)
+		}
+	}
+}
+
+func TestValidatorFunc(t *testing.T) {
+	var v Validator = ValidatorFunc(func(doc []byte) (Violations, error) {
+		return Violations{{XPath: `/`, Message: string(doc)}}, nil
+	})
+	violations, _ := v.Validate([]byte(`plugged`))
+	if len(violations) != 1 || violations[0].Message != `plugged` {
+		t.Errorf("expected the violation of the plugged validator,\n got: %v", violations)
+	}
+}
@@ -0,0 +1,84 @@
+package validation
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"io"
+	"strings"
+)
+
+// node is an element of a parsed document
+type node struct {
+	Name     xml.Name
+	Attr     []xml.Attr
+	Children []*node
+	Text     strings.Builder
+	Line     int
+}
+
+// parse returns the root element of a document
+func parse(doc []byte) (*node, error) {
+	d := xml.NewDecoder(bytes.NewReader(doc))
+	var root *node
+	var stack []*node
+	for {
+		token, err := d.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			line, _ := d.InputPos()
+			n := &node{Name: t.Name, Attr: t.Attr, Line: line}
+			if len(stack) == 0 {
+				root = n
+			} else {
+				parent := stack[len(stack)-1]
+				parent.Children = append(parent.Children, n)
+			}
+			stack = append(stack, n)
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+		case xml.CharData:
+			if len(stack) > 0 {
+				stack[len(stack)-1].Text.Write(t)
+			}
+		}
+	}
+	if root == nil {
+		return nil, errors.New(`document has no root element`)
+	}
+	return root, nil
+}
+
+// prefixes are used in the XPath of a violation
+var prefixes = map[string]string{
+	namespaceOWS110:  `ows`,
+	namespaceOWS200:  `ows`,
+	namespaceWMS130:  `wms`,
+	namespaceOGC:     `ogc`,
+	namespaceWFS200:  `wfs`,
+	namespaceFES200:  `fes`,
+	namespaceGML32:   `gml`,
+	namespaceWMTS100: `wmts`,
+	namespaceWCS201:  `wcs`,
+	namespaceXlink:   `xlink`,
+	namespaceXSI:     `xsi`,
+	namespaceXML:     `xml`,
+}
+
+// step returns the name in a XPath step
+func step(n xml.Name) string {
+	if n.Space == `` {
+		return n.Local
+	}
+	if prefix, ok := prefixes[n.Space]; ok {
+		return prefix + `:` + n.Local
+	}
+	return `Q{` + n.Space + `}` + n.Local
+}
@@ -0,0 +1,111 @@
+package validation
+
+// Namespaces of OWS Common and the namespaces used by all schemas
+const (
+	namespaceOWS110 = `http://www.opengis.net/ows/1.1`
+	namespaceOWS200 = `http://www.opengis.net/ows/2.0`
+	namespaceXlink  = `http://www.w3.org/1999/xlink`
+	namespaceXSI    = `http://www.w3.org/2001/XMLSchema-instance`
+	namespaceXML    = `http://www.w3.org/XML/1998/namespace`
+)
+
+// owsCommon contains the OWS Common declarations that are used by the service schemas,
+// the versions 1.1.0 and 2.0.2 are the same in this subset
+type owsCommon struct {
+	Title                 *Element
+	Abstract              *Element
+	Keywords              *Element
+	Identifier            *Element
+	Metadata              *Element
+	WGS84BoundingBox      *Element
+	BoundingBox           *Element
+	SupportedCRS          *Element
+	ServiceIdentification *Element
+	ServiceProvider       *Element
+	OperationsMetadata    *Element
+	Languages             *Element
+	ExceptionReport       *Element
+}
+
+func newOWSCommon(ns string) owsCommon {
+	c := owsCommon{}
+	c.Title = element(ns, `Title`)
+	c.Abstract = element(ns, `Abstract`)
+	c.Keywords = element(ns, `Keywords`).with(
+		some(element(ns, `Keyword`)),
+		optional(element(ns, `Type`).attributes(attribute(`codeSpace`, nil))))
+	c.Identifier = element(ns, `Identifier`).attributes(attribute(`codeSpace`, nil)).text(NonEmpty)
+	c.Metadata = element(ns, `Metadata`).attributes(attribute(`about`, nil)).lax()
+	c.WGS84BoundingBox = boundingBox(ns, `WGS84BoundingBox`)
+	c.BoundingBox = boundingBox(ns, `BoundingBox`)
+	c.SupportedCRS = element(ns, `SupportedCRS`).text(NonEmpty)
+
+	c.ServiceIdentification = element(ns, `ServiceIdentification`).with(
+		many(c.Title),
+		many(c.Abstract),
+		many(c.Keywords),
+		one(element(ns, `ServiceType`).attributes(attribute(`codeSpace`, nil)).text(NonEmpty)),
+		some(element(ns, `ServiceTypeVersion`).text(NonEmpty)),
+		many(element(ns, `Profile`)),
+		optional(element(ns, `Fees`)),
+		many(element(ns, `AccessConstraints`)))
+
+	c.ServiceProvider = element(ns, `ServiceProvider`).with(
+		one(element(ns, `ProviderName`)),
+		optional(element(ns, `ProviderSite`)),
+		one(element(ns, `ServiceContact`).lax()))
+
+	domain := func(local string) *Element {
+		return element(ns, local).attributes(required(`name`, NonEmpty)).lax()
+	}
+	method := func(local string) *Element {
+		return element(ns, local).with(many(domain(`Constraint`)))
+	}
+	http := element(ns, `HTTP`).with(some(method(`Get`), method(`Post`)))
+	operation := element(ns, `Operation`).attributes(required(`name`, NonEmpty)).with(
+		some(element(ns, `DCP`).with(one(http))),
+		many(domain(`Parameter`)),
+		many(domain(`Constraint`)),
+		many(c.Metadata))
+	c.OperationsMetadata = element(ns, `OperationsMetadata`).with(
+		some(operation),
+		many(domain(`Parameter`)),
+		many(domain(`Constraint`)),
+		optional(element(ns, `ExtendedCapabilities`).lax()))
+
+	c.Languages = element(ns, `Languages`).with(some(element(ns, `Language`).text(NonEmpty)))
+
+	exception := element(ns, `Exception`).
+		attributes(required(`exceptionCode`, NonEmpty), attribute(`locator`, nil)).
+		with(many(element(ns, `ExceptionText`)))
+	c.ExceptionReport = element(ns, `ExceptionReport`).
+		attributes(required(`version`, NonEmpty)).
+		with(some(exception))
+	return c
+}
+
+// boundingBox declares a ows:BoundingBox or ows:WGS84BoundingBox
+func boundingBox(ns, local string) *Element {
+	return element(ns, local).
+		attributes(attribute(`crs`, nil), attribute(`dimensions`, PositiveInteger)).
+		with(one(element(ns, `LowerCorner`).text(DoubleList)), one(element(ns, `UpperCorner`).text(DoubleList)))
+}
+
+// schema returns the global elements as a Schema
+func (c owsCommon) schema(ns string) *Schema {
+	return &Schema{Namespace: ns, Elements: []*Element{
+		c.ExceptionReport, c.ServiceIdentification, c.ServiceProvider, c.OperationsMetadata, c.Languages,
+		c.WGS84BoundingBox, c.BoundingBox, c.Title, c.Abstract, c.Keywords, c.Identifier, c.Metadata,
+	}}
+}
+
+var (
+	ows110 = newOWSCommon(namespaceOWS110)
+	ows200 = newOWSCommon(namespaceOWS200)
+)
+
+// OWS110 is the subset of OWS Common 1.1.0, used by WFS 2.0.0 and WMTS 1.0.0
+var OWS110 = ows110.schema(namespaceOWS110)
+
+// OWS200 is the subset of OWS Common 2.0.2, used by WCS 2.0.1
+var OWS200 = ows200.schema(namespaceOWS200)
@@ -0,0 +1,292 @@
+package validation
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+)
+
+// Unbounded is the MaxOccurs of a particle without an upper limit
+const Unbounded = -1
+
+// Schema is a set of global element declarations of a namespace
+type Schema struct {
+	Namespace string
+	Elements  []*Element
+}
+
+// Element declares an element
+type Element struct {
+	Name       xml.Name
+	Attributes []Attribute
+	// Content is the sequence of the child elements, when empty the element has simple content
+	Content []Particle
+	// Text is the type of simple content, nil allows any text
+	Text SimpleType
+	// Lax allows undeclared children and attributes, the children with a global declaration are validated
+	Lax bool
+}
+
+// Particle in the sequence of child elements
+type Particle struct {
+	// Elements contains the element, or the choice between elements
+	Elements []*Element
+	// Any allows elements from other namespaces, these are validated when there is a global declaration
+	Any       bool
+	MinOccurs int
+	MaxOccurs int
+}
+
+// Attribute declares an attribute
+type Attribute struct {
+	Name     string
+	Required bool
+	// Type of the value, nil allows any value
+	Type SimpleType
+}
+
+// element returns a declaration for a namespace and local name
+func element(namespace, local string) *Element {
+	return &Element{Name: xml.Name{Space: namespace, Local: local}}
+}
+
+// with sets the content of an element
+func (e *Element) with(content ...Particle) *Element {
+	e.Content = content
+	return e
+}
+
+// attributes sets the attributes of an element
+func (e *Element) attributes(attributes ...Attribute) *Element {
+	e.Attributes = attributes
+	return e
+}
+
+// text sets the type of the simple content of an element
+func (e *Element) text(t SimpleType) *Element {
+	e.Text = t
+	return e
+}
+
+// lax allows undeclared children and attributes
+func (e *Element) lax() *Element {
+	e.Lax = true
+	return e
+}
+
+// one is a particle that occurs exactly once
+func one(elements ...*Element) Particle {
+	return Particle{Elements: elements, MinOccurs: 1, MaxOccurs: 1}
+}
+
+// optional is a particle that occurs at most once
+func optional(elements ...*Element) Particle {
+	return Particle{Elements: elements, MinOccurs: 0, MaxOccurs: 1}
+}
+
+// many is a particle that occurs any number of times
+func many(elements ...*Element) Particle {
+	return Particle{Elements: elements, MinOccurs: 0, MaxOccurs: Unbounded}
+}
+
+// some is a particle that occurs at least once
+func some(elements ...*Element) Particle {
+	return Particle{Elements: elements, MinOccurs: 1, MaxOccurs: Unbounded}
+}
+
+// other is a particle for any number of elements from other namespaces
+func other() Particle {
+	return Particle{Any: true, MinOccurs: 0, MaxOccurs: Unbounded}
+}
+
+// required is a required attribute
+func required(name string, t SimpleType) Attribute {
+	return Attribute{Name: name, Required: true, Type: t}
+}
+
+// attribute is an optional attribute
+func attribute(name string, t SimpleType) Attribute {
+	return Attribute{Name: name, Type: t}
+}
+
+// match returns the declaration of the particle for the child element of parent
+func (p Particle) match(parent, child xml.Name, global map[xml.Name]*Element) (*Element, bool) {
+	for _, e := range p.Elements {
+		if e.Name == child {
+			return e, true
+		}
+	}
+	if p.Any && child.Space != parent.Space {
+		return global[child], true
+	}
+	return nil, false
+}
+
+// names returns the names of the elements of the particle
+func (p Particle) names() string {
+	if p.Any {
+		return `any element from another namespace`
+	}
+	names := make([]string, 0, len(p.Elements))
+	for _, e := range p.Elements {
+		names = append(names, step(e.Name))
+	}
+	return strings.Join(names, ` or `)
+}
+
+// SchemaValidator validates documents against a set of schemas, the root element selects the declaration
+type SchemaValidator struct {
+	global map[xml.Name]*Element
+}
+
+// NewSchemaValidator returns a SchemaValidator for the global elements of the schemas
+func NewSchemaValidator(schemas ...*Schema) *SchemaValidator {
+	v := &SchemaValidator{global: map[xml.Name]*Element{}}
+	for _, s := range schemas {
+		for _, e := range s.Elements {
+			v.global[e.Name] = e
+		}
+	}
+	return v
+}
+
+// Validate validates a XML document, the error is returned for a document that isn't well-formed
+func (v *SchemaValidator) Validate(doc []byte) (Violations, error) {
+	root, err := parse(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	path := `/` + step(root.Name)
+	e, ok := v.global[root.Name]
+	if !ok {
+		return Violations{{XPath: path, Line: root.Line, Message: `no declaration for the root element`}}, nil
+	}
+
+	var violations Violations
+	v.validate(e, root, path, &violations)
+	return violations, nil
+}
+
+// validate validates the node and its children against the declaration
+func (v *SchemaValidator) validate(e *Element, n *node, path string, violations *Violations) {
+	report := func(path, format string, a ...interface{}) {
+		*violations = append(*violations, Violation{XPath: path, Line: n.Line, Message: fmt.Sprintf(format, a...)})
+	}
+
+	v.validateAttributes(e, n, path, report)
+
+	text := strings.TrimSpace(n.Text.String())
+	if len(e.Content) == 0 && !e.Lax {
+		if len(n.Children) > 0 {
+			report(path, `element %s can't contain elements`, step(n.Name))
+		}
+		if e.Text != nil {
+			if err := e.Text(text); err != nil {
+				report(path, `invalid value %q: %v`, text, err)
+			}
+		}
+		return
+	}
+	if len(e.Content) > 0 && text != `` && !e.Lax {
+		report(path, `element %s can't contain text`, step(n.Name))
+	}
+
+	positions := map[xml.Name]int{}
+	childPath := func(c *node) string {
+		positions[c.Name]++
+		return fmt.Sprintf(`%s/%s[%d]`, path, step(c.Name), positions[c.Name])
+	}
+
+	pi, count := 0, 0
+	for _, c := range n.Children {
+		cpath := childPath(c)
+
+		// the first particle, from the current one, that matches the child
+		j, decl := -1, (*Element)(nil)
+		for k := pi; k < len(e.Content); k++ {
+			if k == pi && e.Content[k].MaxOccurs != Unbounded && count >= e.Content[k].MaxOccurs {
+				continue
+			}
+			if d, ok := e.Content[k].match(n.Name, c.Name, v.global); ok {
+				j, decl = k, d
+				break
+			}
+		}
+
+		if j < 0 {
+			if !e.Lax {
+				report(cpath, `element %s is not expected here`, step(c.Name))
+			} else if d, ok := v.global[c.Name]; ok {
+				v.validate(d, c, cpath, violations)
+			}
+			continue
+		}
+
+		for k := pi; k < j; k++ {
+			occurs := 0
+			if k == pi {
+				occurs = count
+			}
+			if occurs < e.Content[k].MinOccurs {
+				report(cpath, `missing %s before element %s`, e.Content[k].names(), step(c.Name))
+			}
+		}
+		if j != pi {
+			pi, count = j, 0
+		}
+		count++
+		if decl != nil {
+			v.validate(decl, c, cpath, violations)
+		}
+	}
+
+	for k := pi; k < len(e.Content); k++ {
+		occurs := 0
+		if k == pi {
+			occurs = count
+		}
+		if occurs < e.Content[k].MinOccurs {
+			report(path, `missing %s`, e.Content[k].names())
+		}
+	}
+}
+
+// validateAttributes checks the declared attributes, attributes from other namespaces are always allowed
+func (v *SchemaValidator) validateAttributes(e *Element, n *node, path string, report func(string, string, ...interface{})) {
+	values := map[string]string{}
+	for _, a := range n.Attr {
+		if a.Name.Space == `xmlns` || (a.Name.Space == `` && a.Name.Local == `xmlns`) {
+			continue
+		}
+		if a.Name.Space != `` {
+			continue
+		}
+		values[a.Name.Local] = a.Value
+		if !e.Lax && !e.declares(a.Name.Local) {
+			report(path+`/@`+a.Name.Local, `attribute %s is not allowed on element %s`, a.Name.Local, step(n.Name))
+		}
+	}
+
+	for _, a := range e.Attributes {
+		value, ok := values[a.Name]
+		switch {
+		case !ok && a.Required:
+			report(path, `missing attribute %s`, a.Name)
+		case ok && a.Type != nil:
+			if err := a.Type(strings.TrimSpace(value)); err != nil {
+				report(path+`/@`+a.Name, `invalid value %q: %v`, value, err)
+			}
+		}
+	}
+}
+
+// declares reports whether the element declares the attribute
+func (e *Element) declares(name string) bool {
+	for _, a := range e.Attributes {
+		if a.Name == name {
+			return true
+		}
+	}
+	return false
+}
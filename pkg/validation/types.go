@@ -0,0 +1,90 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// SimpleType checks the (whitespace collapsed) value of an attribute or an element with simple content
+type SimpleType func(value string) error
+
+// Boolean is a xs:boolean
+func Boolean(value string) error {
+	switch value {
+	case `true`, `false`, `1`, `0`:
+		return nil
+	}
+	return errors.New(`not a boolean`)
+}
+
+// Integer is a xs:integer
+func Integer(value string) error {
+	if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+		return errors.New(`not an integer`)
+	}
+	return nil
+}
+
+// NonNegativeInteger is a xs:nonNegativeInteger
+func NonNegativeInteger(value string) error {
+	if i, err := strconv.ParseInt(value, 10, 64); err != nil || i < 0 {
+		return errors.New(`not a non-negative integer`)
+	}
+	return nil
+}
+
+// PositiveInteger is a xs:positiveInteger
+func PositiveInteger(value string) error {
+	if i, err := strconv.ParseInt(value, 10, 64); err != nil || i < 1 {
+		return errors.New(`not a positive integer`)
+	}
+	return nil
+}
+
+// Double is a xs:double
+func Double(value string) error {
+	switch value {
+	case `INF`, `-INF`, `NaN`:
+		return nil
+	}
+	if _, err := strconv.ParseFloat(value, 64); err != nil {
+		return errors.New(`not a double`)
+	}
+	return nil
+}
+
+// DoubleList is a whitespace separated list of xs:double, like the ows:LowerCorner
+func DoubleList(value string) error {
+	values := strings.Fields(value)
+	if len(values) == 0 {
+		return errors.New(`empty list of doubles`)
+	}
+	for _, v := range values {
+		if err := Double(v); err != nil {
+			return fmt.Errorf(`%q is %w`, v, err)
+		}
+	}
+	return nil
+}
+
+// NonEmpty is a string with at least one character
+func NonEmpty(value string) error {
+	if value == `` {
+		return errors.New(`empty value`)
+	}
+	return nil
+}
+
+// Enumeration returns a SimpleType that only allows the given values
+func Enumeration(values ...string) SimpleType {
+	return func(value string) error {
+		for _, v := range values {
+			if value == v {
+				return nil
+			}
+		}
+		return fmt.Errorf(`not one of: %s`, strings.Join(values, `, `))
+	}
+}
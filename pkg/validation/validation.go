@@ -0,0 +1,61 @@
+// Package validation checks XML documents against (subsets of) the OGC schemas.
+//
+// The schemas are declared in Go, so they are available without network access. They cover the documents this
+// module reads and writes: the capabilities of WMS 1.3.0, WFS 2.0.0, WMTS 1.0.0 and WCS 2.0.1, the OWS 1.1.0 and 2.0.2
+// exception reports, the WMS 1.3.0 service exception report and the WFS 2.0.0 requests with their FES 2.0 filters
+// and GML 3.2 envelopes. Content outside this subset, like extended capabilities, is processed laxly.
+// A validator for the complete official schemas can be plugged in with the Validator interface.
+package validation
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Validator validates a XML document, the error is reserved for documents that can't be read at all
+type Validator interface {
+	Validate(doc []byte) (Violations, error)
+}
+
+// ValidatorFunc is a function that is used as a Validator
+type ValidatorFunc func(doc []byte) (Violations, error)
+
+// Validate calls f(doc)
+func (f ValidatorFunc) Validate(doc []byte) (Violations, error) {
+	return f(doc)
+}
+
+// Violation of a schema, located by the XPath of the offending element or attribute
+type Violation struct {
+	XPath   string
+	Line    int
+	Message string
+}
+
+// String returns the violation with its location
+func (v Violation) String() string {
+	if v.Line > 0 {
+		return fmt.Sprintf(`%s (line %d): %s`, v.XPath, v.Line, v.Message)
+	}
+	return fmt.Sprintf(`%s: %s`, v.XPath, v.Message)
+}
+
+// Violations is a list of Violation
+type Violations []Violation
+
+// Error returns all violations, one per line
+func (v Violations) Error() string {
+	s := make([]string, 0, len(v))
+	for _, violation := range v {
+		s = append(s, violation.String())
+	}
+	return strings.Join(s, "\n")
+}
+
+// Default validates the documents against all the bundled schemas
+var Default Validator = NewSchemaValidator(OWS110, OWS200, WMS130, WFS200, FES200, GML32, WMTS100, WCS201)
+
+// Validate validates a XML document with the Default validator
+func Validate(doc []byte) (Violations, error) {
+	return Default.Validate(doc)
+}
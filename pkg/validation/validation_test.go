@@ -0,0 +1,104 @@
+package validation
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+const wmsCapabilities = `<?xml version="1.0" encoding="UTF-8"?>
+<WMS_Capabilities xmlns="http://www.opengis.net/wms" xmlns:xlink="http://www.w3.org/1999/xlink" xmlns:inspire_vs="http://inspire.ec.europa.eu/schemas/inspire_vs/1.0" version="1.3.0">
+ <Service>
+  <Name>WMS</Name>
+  <Title>Roads</Title>
+  <OnlineResource xlink:href="https://example.com/wms"/>
+  <LayerLimit>%s</LayerLimit>
+ </Service>
+ <Capability>
+  <Request>
+   <GetCapabilities><Format>text/xml</Format><DCPType><HTTP><Get><OnlineResource xlink:href="https://example.com/wms"/></Get></HTTP></DCPType></GetCapabilities>
+   <GetMap><Format>image/png</Format><DCPType><HTTP><Get><OnlineResource xlink:href="https://example.com/wms"/></Get></HTTP></DCPType></GetMap>
+  </Request>
+  <Exception><Format>XML</Format></Exception>
+  <inspire_vs:ExtendedCapabilities><inspire_vs:Anything/></inspire_vs:ExtendedCapabilities>
+  <Layer>
+   <Title>Roads</Title>
+   <Layer queryable="%s">
+    <Name>roads</Name>
+    <Title>Roads</Title>
+    <BoundingBox CRS="EPSG:28992" minx="%s" miny="300000" maxx="300000" maxy="650000"/>
+   </Layer>
+  </Layer>
+ </Capability>
+</WMS_Capabilities>`
+
+func TestValidate(t *testing.T) {
+	var tests = []struct {
+		doc        string
+		violations Violations
+	}{
+		0: {doc: `<ows:ExceptionReport xmlns:ows="http://www.opengis.net/ows/1.1" version="2.0.0" xml:lang="en"><ows:Exception exceptionCode="NoApplicableCode" locator="x"><ows:ExceptionText>x</ows:ExceptionText></ows:Exception></ows:ExceptionReport>`},
+		1: {doc: `<ows:ExceptionReport xmlns:ows="http://www.opengis.net/ows/2.0">
+<ows:Exception code="NoApplicableCode">x</ows:Exception>
+</ows:ExceptionReport>`,
+			violations: Violations{
+				{XPath: `/ows:ExceptionReport`, Line: 1, Message: `missing attribute version`},
+				{XPath: `/ows:ExceptionReport/ows:Exception[1]/@code`, Line: 2, Message: `attribute code is not allowed on element ows:Exception`},
+				{XPath: `/ows:ExceptionReport/ows:Exception[1]`, Line: 2, Message: `missing attribute exceptionCode`},
+				{XPath: `/ows:ExceptionReport/ows:Exception[1]`, Line: 2, Message: `element ows:Exception can't contain text`},
+			}},
+		2: {doc: fmt.Sprintf(wmsCapabilities, `2`, `1`, `0`)},
+		3: {doc: fmt.Sprintf(wmsCapabilities, `none`, `yes`, `west`),
+			violations: Violations{
+				{XPath: `/wms:WMS_Capabilities/wms:Service[1]/wms:LayerLimit[1]`, Line: 7, Message: `invalid value "none": not a positive integer`},
+				{XPath: `/wms:WMS_Capabilities/wms:Capability[1]/wms:Layer[1]/wms:Layer[1]/@queryable`, Line: 18, Message: `invalid value "yes": not a boolean`},
+				{XPath: `/wms:WMS_Capabilities/wms:Capability[1]/wms:Layer[1]/wms:Layer[1]/wms:BoundingBox[1]/@minx`, Line: 21, Message: `invalid value "west": not a double`},
+			}},
+		4: {doc: `<Capabilities xmlns="http://www.opengis.net/wmts/1.0" xmlns:ows="http://www.opengis.net/ows/1.1" version="1.0.0">
+<Contents>
+<Layer><ows:Identifier>roads</ows:Identifier><Style><ows:Identifier>default</ows:Identifier></Style><Format>image/png</Format></Layer>
+<TileMatrixSet><ows:SupportedCRS>EPSG:28992</ows:SupportedCRS><ows:Identifier>EPSG:28992</ows:Identifier>
+<TileMatrix><ows:Identifier>0</ows:Identifier><ScaleDenominator>12288000</ScaleDenominator><TopLeftCorner>-285401.92 903401.92</TopLeftCorner><TileWidth>256</TileWidth><TileHeight>256</TileHeight><MatrixWidth>1</MatrixWidth><MatrixHeight>1</MatrixHeight></TileMatrix>
+</TileMatrixSet>
+</Contents>
+</Capabilities>`,
+			violations: Violations{
+				{XPath: `/wmts:Capabilities/wmts:Contents[1]/wmts:Layer[1]`, Line: 3, Message: `missing wmts:TileMatrixSetLink`},
+				{XPath: `/wmts:Capabilities/wmts:Contents[1]/wmts:TileMatrixSet[1]/ows:SupportedCRS[1]`, Line: 4, Message: `missing ows:Identifier before element ows:SupportedCRS`},
+				{XPath: `/wmts:Capabilities/wmts:Contents[1]/wmts:TileMatrixSet[1]/ows:Identifier[1]`, Line: 4, Message: `element ows:Identifier is not expected here`},
+			}},
+		5: {doc: `<GetFeature xmlns="http://www.opengis.net/wfs/2.0" xmlns:fes="http://www.opengis.net/fes/2.0" xmlns:gml="http://www.opengis.net/gml/3.2" service="WFS" version="2.0.0" count="-1">
+<Query typeNames="app:roads">
+<fes:Filter><fes:BBOX><gml:Envelope><gml:lowerCorner>1 2</gml:lowerCorner><gml:upperCorner>3 north</gml:upperCorner></gml:Envelope></fes:BBOX></fes:Filter>
+</Query>
+</GetFeature>`,
+			violations: Violations{
+				{XPath: `/wfs:GetFeature/@count`, Line: 1, Message: `invalid value "-1": not a non-negative integer`},
+				{XPath: `/wfs:GetFeature/wfs:Query[1]/fes:Filter[1]/fes:BBOX[1]/gml:Envelope[1]/gml:upperCorner[1]`, Line: 3, Message: `invalid value "3 north": "north" is not a double`},
+			}},
+		6: {doc: `<GetFeature/>`, violations: Violations{{XPath: `/GetFeature`, Line: 1, Message: `no declaration for the root element`}}},
+	}
+
+	for k, test := range tests {
+		violations, err := Validate([]byte(test.doc))
+		if err != nil {
+			t.Errorf("test: %d, expected no error,\n got: %v", k, err)
+			continue
+		}
+		if !reflect.DeepEqual(violations, test.violations) {
+			t.Errorf("test: %d, expected: %v,\n got: %v", k, test.violations, violations)
+		}
+	}
+
+	if _, err := Validate([]byte(`<WMS_Capabilities>`)); err == nil {
+		t.Errorf("expected an error for a document that isn't well-formed")
+	}
+}
+
+func TestViolationsError(t *testing.T) {
+	v := Violations{{XPath: `/wfs:GetFeature`, Line: 1, Message: `missing attribute service`}, {XPath: `/wfs:GetFeature/@count`, Message: `invalid value`}}
+	expected := "/wfs:GetFeature (line 1): missing attribute service\n/wfs:GetFeature/@count: invalid value"
+	if v.Error() != expected {
+		t.Errorf("expected: %s,\n got: %s", expected, v.Error())
+	}
+}
@@ -0,0 +1,46 @@
+package validation
+
+const namespaceWCS201 = `http://www.opengis.net/wcs/2.0`
+
+// WCS201 is the subset of the WCS 2.0.1 capabilities and requests
+var WCS201 = newWCS201()
+
+func newWCS201() *Schema {
+	ns := namespaceWCS201
+	service := required(`service`, Enumeration(`WCS`))
+	version := required(`version`, Enumeration(`2.0.1`))
+	extension := element(ns, `Extension`).lax()
+	coverageID := element(ns, `CoverageId`).text(NonEmpty)
+
+	coverageSummary := element(ns, `CoverageSummary`).with(
+		many(ows200.Title),
+		many(ows200.Abstract),
+		many(ows200.Keywords),
+		many(ows200.WGS84BoundingBox),
+		one(coverageID),
+		one(element(ns, `CoverageSubtype`).text(NonEmpty)),
+		optional(element(ns, `CoverageSubtypeParent`).lax()),
+		many(ows200.BoundingBox),
+		many(ows200.Metadata))
+
+	capabilities := element(ns, `Capabilities`).
+		attributes(required(`version`, Enumeration(`2.0.1`)), attribute(`updateSequence`, nil)).
+		with(
+			optional(ows200.ServiceIdentification),
+			optional(ows200.ServiceProvider),
+			optional(ows200.OperationsMetadata),
+			optional(ows200.Languages),
+			optional(element(ns, `ServiceMetadata`).with(
+				many(element(ns, `formatSupported`).text(NonEmpty)),
+				many(extension))),
+			optional(element(ns, `Contents`).with(many(coverageSummary), optional(extension))))
+
+	getCapabilities := element(ns, `GetCapabilities`).
+		attributes(service, attribute(`updateSequence`, nil)).
+		lax()
+	describeCoverage := element(ns, `DescribeCoverage`).
+		attributes(service, version).
+		with(many(extension), some(coverageID))
+
+	return &Schema{Namespace: ns, Elements: []*Element{capabilities, getCapabilities, describeCoverage}}
+}
@@ -0,0 +1,109 @@
+package validation
+
+// Namespaces of WFS 2.0.0, FES 2.0 and GML 3.2
+const (
+	namespaceWFS200 = `http://www.opengis.net/wfs/2.0`
+	namespaceFES200 = `http://www.opengis.net/fes/2.0`
+	namespaceGML32  = `http://www.opengis.net/gml/3.2`
+)
+
+// GML32 is the subset of GML 3.2 that is used in the FES 2.0 filters
+var GML32 = &Schema{Namespace: namespaceGML32, Elements: []*Element{
+	element(namespaceGML32, `Envelope`).
+		attributes(attribute(`srsName`, nil), attribute(`srsDimension`, PositiveInteger), attribute(`axisLabels`, nil), attribute(`uomLabels`, nil)).
+		with(one(element(namespaceGML32, `lowerCorner`).text(DoubleList)), one(element(namespaceGML32, `upperCorner`).text(DoubleList))),
+	element(namespaceGML32, `Point`).
+		attributes(attribute(`srsName`, nil), attribute(`srsDimension`, PositiveInteger)).
+		with(one(element(namespaceGML32, `pos`).text(DoubleList))),
+}}
+
+// The FES 2.0 declarations that are used by the WFS 2.0.0 schema
+var (
+	fesValueReference = element(namespaceFES200, `ValueReference`).text(NonEmpty)
+	fesFilter         = element(namespaceFES200, `Filter`).lax()
+	fesSortBy         = element(namespaceFES200, `SortBy`).with(some(element(namespaceFES200, `SortProperty`).with(
+		one(fesValueReference),
+		optional(element(namespaceFES200, `SortOrder`).text(Enumeration(`ASC`, `DESC`))))))
+	fesFilterCapabilities = element(namespaceFES200, `Filter_Capabilities`).with(
+		optional(element(namespaceFES200, `Conformance`).lax()),
+		optional(element(namespaceFES200, `Id_Capabilities`).lax()),
+		optional(element(namespaceFES200, `Scalar_Capabilities`).lax()),
+		optional(element(namespaceFES200, `Spatial_Capabilities`).lax()),
+		optional(element(namespaceFES200, `Temporal_Capabilities`).lax()),
+		optional(element(namespaceFES200, `Functions`).lax()),
+		optional(element(namespaceFES200, `Extended_Capabilities`).lax()))
+)
+
+// FES200 is the subset of the FES 2.0 filters and filter capabilities
+var FES200 = &Schema{Namespace: namespaceFES200, Elements: []*Element{
+	fesFilter,
+	element(namespaceFES200, `BBOX`).with(optional(fesValueReference), other()),
+	element(namespaceFES200, `ResourceId`).attributes(required(`rid`, NonEmpty), attribute(`previousRid`, nil), attribute(`version`, nil), attribute(`startDate`, nil), attribute(`endDate`, nil)),
+	fesValueReference,
+	fesSortBy,
+	fesFilterCapabilities,
+}}
+
+// WFS200 is the subset of the WFS 2.0.0 capabilities and requests
+var WFS200 = newWFS200()
+
+func newWFS200() *Schema {
+	ns := namespaceWFS200
+	service := required(`service`, Enumeration(`WFS`))
+	version := required(`version`, Enumeration(`2.0.0`))
+
+	featureType := element(ns, `FeatureType`).with(
+		one(element(ns, `Name`).text(NonEmpty)),
+		many(element(ns, `Title`)),
+		many(element(ns, `Abstract`)),
+		many(ows110.Keywords),
+		optional(element(ns, `DefaultCRS`).text(NonEmpty)),
+		many(element(ns, `OtherCRS`).text(NonEmpty)),
+		optional(element(ns, `NoCRS`)),
+		optional(element(ns, `OutputFormats`).with(some(element(ns, `Format`).text(NonEmpty)))),
+		many(ows110.WGS84BoundingBox),
+		many(element(ns, `MetadataURL`).attributes(attribute(`about`, nil))),
+		optional(element(ns, `ExtendedDescription`).lax()))
+
+	capabilities := element(ns, `WFS_Capabilities`).
+		attributes(version, attribute(`updateSequence`, nil)).
+		with(
+			optional(ows110.ServiceIdentification),
+			optional(ows110.ServiceProvider),
+			optional(ows110.OperationsMetadata),
+			optional(element(ns, `WSDL`).lax()),
+			optional(element(ns, `FeatureTypeList`).with(some(featureType))),
+			optional(fesFilterCapabilities))
+
+	query := element(ns, `Query`).
+		attributes(required(`typeNames`, NonEmpty), attribute(`aliases`, nil), attribute(`srsName`, nil), attribute(`featureVersion`, nil), attribute(`handle`, nil)).
+		with(
+			many(element(ns, `PropertyName`).attributes(attribute(`resolve`, nil), attribute(`resolveDepth`, nil), attribute(`resolveTimeout`, nil), attribute(`resolvePath`, nil))),
+			optional(fesFilter),
+			optional(fesSortBy))
+	storedQuery := element(ns, `StoredQuery`).
+		attributes(required(`id`, NonEmpty), attribute(`handle`, nil)).
+		with(many(element(ns, `Parameter`).attributes(required(`name`, NonEmpty)).lax()))
+
+	getCapabilities := element(ns, `GetCapabilities`).
+		attributes(service, attribute(`updateSequence`, nil), attribute(`handle`, nil)).
+		lax()
+	describeFeatureType := element(ns, `DescribeFeatureType`).
+		attributes(service, version, attribute(`handle`, nil), attribute(`outputFormat`, nil)).
+		with(many(element(ns, `TypeName`).text(NonEmpty)))
+	getFeature := element(ns, `GetFeature`).
+		attributes(
+			service,
+			version,
+			attribute(`handle`, nil),
+			attribute(`startIndex`, NonNegativeInteger),
+			attribute(`count`, NonNegativeInteger),
+			attribute(`outputFormat`, nil),
+			attribute(`resultType`, Enumeration(`results`, `hits`)),
+			attribute(`resolve`, Enumeration(`local`, `remote`, `all`, `none`)),
+			attribute(`resolveDepth`, nil),
+			attribute(`resolveTimeout`, NonNegativeInteger)).
+		with(some(query, storedQuery))
+
+	return &Schema{Namespace: ns, Elements: []*Element{capabilities, getCapabilities, describeFeatureType, getFeature}}
+}
@@ -0,0 +1,137 @@
+package validation
+
+// Namespaces of WMS 1.3.0 and its service exception report
+const (
+	namespaceWMS130 = `http://www.opengis.net/wms`
+	namespaceOGC    = `http://www.opengis.net/ogc`
+)
+
+// WMS130 is the subset of the WMS 1.3.0 capabilities and service exception report schemas
+var WMS130 = newWMS130()
+
+func newWMS130() *Schema {
+	ns := namespaceWMS130
+	onlineResource := element(ns, `OnlineResource`)
+	format := element(ns, `Format`).text(NonEmpty)
+	resource := func(local string) *Element {
+		return element(ns, local).with(one(format), one(onlineResource))
+	}
+	keywordList := element(ns, `KeywordList`).with(many(element(ns, `Keyword`).attributes(attribute(`vocabulary`, nil))))
+
+	contactInformation := element(ns, `ContactInformation`).with(
+		optional(element(ns, `ContactPersonPrimary`).with(
+			one(element(ns, `ContactPerson`)),
+			one(element(ns, `ContactOrganization`)))),
+		optional(element(ns, `ContactPosition`)),
+		optional(element(ns, `ContactAddress`).with(
+			one(element(ns, `AddressType`)),
+			one(element(ns, `Address`)),
+			one(element(ns, `City`)),
+			one(element(ns, `StateOrProvince`)),
+			one(element(ns, `PostCode`)),
+			one(element(ns, `Country`)))),
+		optional(element(ns, `ContactVoiceTelephone`)),
+		optional(element(ns, `ContactFacsimileTelephone`)),
+		optional(element(ns, `ContactElectronicMailAddress`)))
+
+	service := element(ns, `Service`).with(
+		one(element(ns, `Name`).text(Enumeration(`WMS`))),
+		one(element(ns, `Title`)),
+		optional(element(ns, `Abstract`)),
+		optional(keywordList),
+		one(onlineResource),
+		optional(contactInformation),
+		optional(element(ns, `Fees`)),
+		optional(element(ns, `AccessConstraints`)),
+		optional(element(ns, `LayerLimit`).text(PositiveInteger)),
+		optional(element(ns, `MaxWidth`).text(PositiveInteger)),
+		optional(element(ns, `MaxHeight`).text(PositiveInteger)))
+
+	http := element(ns, `HTTP`).with(
+		one(element(ns, `Get`).with(one(onlineResource))),
+		optional(element(ns, `Post`).with(one(onlineResource))))
+	operation := func(local string) *Element {
+		return element(ns, local).with(some(format), some(element(ns, `DCPType`).with(one(http))))
+	}
+	request := element(ns, `Request`).with(
+		one(operation(`GetCapabilities`)),
+		one(operation(`GetMap`)),
+		optional(operation(`GetFeatureInfo`)),
+		other())
+
+	layer := element(ns, `Layer`).attributes(
+		attribute(`queryable`, Boolean),
+		attribute(`cascaded`, NonNegativeInteger),
+		attribute(`opaque`, Boolean),
+		attribute(`noSubsets`, Boolean),
+		attribute(`fixedWidth`, NonNegativeInteger),
+		attribute(`fixedHeight`, NonNegativeInteger))
+	title := element(ns, `Title`)
+	style := element(ns, `Style`).with(
+		one(element(ns, `Name`).text(NonEmpty)),
+		one(title),
+		optional(element(ns, `Abstract`)),
+		many(element(ns, `LegendURL`).
+			attributes(attribute(`width`, PositiveInteger), attribute(`height`, PositiveInteger)).
+			with(one(format), one(onlineResource))),
+		optional(resource(`StyleSheetURL`)),
+		optional(resource(`StyleURL`)))
+	layer.with(
+		optional(element(ns, `Name`).text(NonEmpty)),
+		one(title),
+		optional(element(ns, `Abstract`)),
+		optional(keywordList),
+		many(element(ns, `CRS`).text(NonEmpty)),
+		optional(element(ns, `EX_GeographicBoundingBox`).with(
+			one(element(ns, `westBoundLongitude`).text(Double)),
+			one(element(ns, `eastBoundLongitude`).text(Double)),
+			one(element(ns, `southBoundLatitude`).text(Double)),
+			one(element(ns, `northBoundLatitude`).text(Double)))),
+		many(element(ns, `BoundingBox`).attributes(
+			required(`CRS`, NonEmpty),
+			required(`minx`, Double),
+			required(`miny`, Double),
+			required(`maxx`, Double),
+			required(`maxy`, Double),
+			attribute(`resx`, Double),
+			attribute(`resy`, Double))),
+		many(element(ns, `Dimension`).attributes(
+			required(`name`, NonEmpty),
+			required(`units`, nil),
+			attribute(`unitSymbol`, nil),
+			attribute(`default`, nil),
+			attribute(`multipleValues`, Boolean),
+			attribute(`nearestValue`, Boolean),
+			attribute(`current`, Boolean))),
+		optional(element(ns, `Attribution`).with(
+			optional(title),
+			optional(onlineResource),
+			optional(element(ns, `LogoURL`).
+				attributes(attribute(`width`, PositiveInteger), attribute(`height`, PositiveInteger)).
+				with(one(format), one(onlineResource))))),
+		many(element(ns, `AuthorityURL`).attributes(required(`name`, NonEmpty)).with(one(onlineResource))),
+		many(element(ns, `Identifier`).attributes(required(`authority`, NonEmpty))),
+		many(element(ns, `MetadataURL`).attributes(required(`type`, NonEmpty)).with(one(format), one(onlineResource))),
+		many(resource(`DataURL`)),
+		many(resource(`FeatureListURL`)),
+		many(style),
+		optional(element(ns, `MinScaleDenominator`).text(Double)),
+		optional(element(ns, `MaxScaleDenominator`).text(Double)),
+		many(layer))
+
+	capability := element(ns, `Capability`).with(
+		one(request),
+		one(element(ns, `Exception`).with(some(format))),
+		other(),
+		optional(layer))
+
+	capabilities := element(ns, `WMS_Capabilities`).
+		attributes(required(`version`, Enumeration(`1.3.0`)), attribute(`updateSequence`, nil)).
+		with(one(service), one(capability))
+
+	report := element(namespaceOGC, `ServiceExceptionReport`).
+		attributes(attribute(`version`, Enumeration(`1.3.0`))).
+		with(many(element(namespaceOGC, `ServiceException`).attributes(attribute(`code`, nil), attribute(`locator`, nil))))
+
+	return &Schema{Namespace: ns, Elements: []*Element{capabilities, report}}
+}
@@ -0,0 +1,83 @@
+package validation
+
+const namespaceWMTS100 = `http://www.opengis.net/wmts/1.0`
+
+// WMTS100 is the subset of the WMTS 1.0.0 capabilities schema
+var WMTS100 = newWMTS100()
+
+func newWMTS100() *Schema {
+	ns := namespaceWMTS100
+	format := element(ns, `Format`).text(NonEmpty)
+
+	style := element(ns, `Style`).attributes(attribute(`isDefault`, Boolean)).with(
+		many(ows110.Title),
+		many(ows110.Abstract),
+		many(ows110.Keywords),
+		one(ows110.Identifier),
+		many(element(ns, `LegendURL`).attributes(
+			attribute(`format`, nil),
+			attribute(`minScaleDenominator`, Double),
+			attribute(`maxScaleDenominator`, Double),
+			attribute(`width`, PositiveInteger),
+			attribute(`height`, PositiveInteger))))
+
+	tileMatrixSetLink := element(ns, `TileMatrixSetLink`).with(
+		one(element(ns, `TileMatrixSet`).text(NonEmpty)),
+		optional(element(ns, `TileMatrixSetLimits`).with(some(element(ns, `TileMatrixLimits`).with(
+			one(element(ns, `TileMatrix`).text(NonEmpty)),
+			one(element(ns, `MinTileRow`).text(NonNegativeInteger)),
+			one(element(ns, `MaxTileRow`).text(NonNegativeInteger)),
+			one(element(ns, `MinTileCol`).text(NonNegativeInteger)),
+			one(element(ns, `MaxTileCol`).text(NonNegativeInteger)))))))
+
+	layer := element(ns, `Layer`).with(
+		many(ows110.Title),
+		many(ows110.Abstract),
+		many(ows110.Keywords),
+		many(ows110.WGS84BoundingBox),
+		one(ows110.Identifier),
+		many(ows110.BoundingBox),
+		many(ows110.Metadata),
+		some(style),
+		some(format),
+		many(element(ns, `InfoFormat`).text(NonEmpty)),
+		many(element(ns, `Dimension`).lax()),
+		some(tileMatrixSetLink),
+		many(element(ns, `ResourceURL`).attributes(
+			required(`format`, NonEmpty),
+			required(`resourceType`, Enumeration(`tile`, `FeatureInfo`)),
+			required(`template`, NonEmpty))))
+
+	tileMatrixSet := element(ns, `TileMatrixSet`).with(
+		many(ows110.Title),
+		many(ows110.Abstract),
+		many(ows110.Keywords),
+		one(ows110.Identifier),
+		optional(ows110.BoundingBox),
+		one(ows110.SupportedCRS),
+		optional(element(ns, `WellKnownScaleSet`).text(NonEmpty)),
+		some(element(ns, `TileMatrix`).with(
+			many(ows110.Title),
+			many(ows110.Abstract),
+			many(ows110.Keywords),
+			one(ows110.Identifier),
+			one(element(ns, `ScaleDenominator`).text(Double)),
+			one(element(ns, `TopLeftCorner`).text(DoubleList)),
+			one(element(ns, `TileWidth`).text(PositiveInteger)),
+			one(element(ns, `TileHeight`).text(PositiveInteger)),
+			one(element(ns, `MatrixWidth`).text(PositiveInteger)),
+			one(element(ns, `MatrixHeight`).text(PositiveInteger)))))
+
+	capabilities := element(ns, `Capabilities`).
+		attributes(required(`version`, Enumeration(`1.0.0`)), attribute(`updateSequence`, nil)).
+		with(
+			optional(ows110.ServiceIdentification),
+			optional(ows110.ServiceProvider),
+			optional(ows110.OperationsMetadata),
+			optional(element(ns, `Contents`).with(many(layer), many(tileMatrixSet))),
+			many(element(ns, `Themes`).lax()),
+			many(element(ns, `WSDL`).lax()),
+			many(element(ns, `ServiceMetadataURL`)))
+
+	return &Schema{Namespace: ns, Elements: []*Element{capabilities}}
+}
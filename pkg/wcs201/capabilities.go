@@ -1,13 +1,29 @@
 package wcs201
 
-// ParseXML func
-func (c *Capabilities) ParseXML(_ []byte) error {
+import (
+	"github.com/pdok/ogc-specifications/pkg/utils"
+	"github.com/pdok/ogc-specifications/pkg/wsc200"
+)
+
+// ParseXML builds the Capabilities from a WCS 2.0.1 capabilities document
+func (c *Capabilities) ParseXML(doc []byte) error {
+	var gc GetCapabilitiesResponse
+	if err := gc.ParseXML(doc); err != nil {
+		return err
+	}
+	*c = Capabilities{OperationsMetadata: gc.OperationsMetadata, ServiceMetadata: gc.ServiceMetadata, Contents: gc.Contents}
 	return nil
 }
 
-// ParseYAML func
-func (c *Capabilities) ParseYAML(_ []byte) error {
-	return nil
+// ParseYAML builds the Capabilities from a YAML document, unknown keys are ignored
+func (c *Capabilities) ParseYAML(doc []byte) error {
+	return c.ParseYAMLWithMode(doc, utils.Lenient)
+}
+
+// ParseYAMLWithMode builds the Capabilities from a YAML document,
+// in utils.Strict mode unknown keys are rejected with an error wrapping utils.ErrUnknownField
+func (c *Capabilities) ParseYAMLWithMode(doc []byte, mode utils.ParseMode) error {
+	return utils.UnmarshalYAML(doc, c, mode)
 }
 
 // Capabilities struct
@@ -17,51 +33,10 @@ type Capabilities struct {
 	Contents           Contents           `xml:"wcs:Contents" yaml:"contents"`
 }
 
-// OperationsMetadata struct for the WCS 2.0.1
+// OperationsMetadata contains the OWS Common 2.0 operations metadata and the INSPIRE extended capabilities
 type OperationsMetadata struct {
-	Operation            []Operation           `xml:"ows:Operation" yaml:"operation"`
-	ExtendedCapabilities *ExtendedCapabilities `xml:"ows:ExtendedCapabilities" yaml:"extendedCapabilities"`
-}
-
-// Operation in struct for repeatability
-type Operation struct {
-	Name string `xml:"name,attr" yaml:"name"`
-	DCP  DCP    `xml:"ows:DCP" yaml:"dcp"`
-}
-
-// DCP struct for the WCS 2.0.1
-type DCP struct {
-	HTTP HTTP `xml:"ows:HTTP"  yaml:"http"`
-}
-
-// HTTP struct for the WCS 2.0.1
-type HTTP struct {
-	Get  Get   `xml:"ows:Get" yaml:"get"`
-	Post *Post `xml:"ows:Post" yaml:"post"`
-}
-
-// Get struct for the WCS 2.0.1
-type Get struct {
-	Type string `xml:"xlink:type,attr" yaml:"type"`
-	Href string `xml:"xlink:href,attr" yaml:"href"`
-}
-
-// Post in separated struct so to use it as a Pointer
-type Post struct {
-	Type       string     `xml:"xlink:type,attr" yaml:"type"`
-	Href       string     `xml:"xlink:href,attr" yaml:"href"`
-	Constraint Constraint `xml:"ows:Constraint" yaml:"constraint"`
-}
-
-// Constraint struct for the WCS 2.0.1
-type Constraint struct {
-	Name          string        `xml:"name,attr" yaml:"name"`
-	AllowedValues AllowedValues `xml:"ows:AllowedValues" yaml:"allowedValues"`
-}
-
-// AllowedValues struct for the WCS 2.0.1
-type AllowedValues struct {
-	Value []string `xml:"ows:Value" yaml:"value"`
+	wsc200.OperationsMetadata `yaml:",inline"`
+	ExtendedCapabilities      *ExtendedCapabilities `xml:"ows:ExtendedCapabilities" yaml:"extendedCapabilities"`
 }
 
 // ExtendedCapabilities struct for the WCS 2.0.1
@@ -95,7 +70,7 @@ type Language struct {
 
 // SpatialDataSetIdentifier struct for the WCS 2.0.1
 type SpatialDataSetIdentifier struct {
-	Code string `xml:"Code" yaml:"code"`
+	Code string `xml:"inspire_common:Code" yaml:"code"`
 }
 
 // ServiceMetadata struct for the WCS 2.0.1
@@ -127,6 +102,8 @@ type Contents struct {
 
 // CoverageSummary in struct for repeatability
 type CoverageSummary struct {
-	CoverageID      string `xml:"wcs:CoverageId" yaml:"coverageId"`
-	CoverageSubtype string `xml:"wcs:CoverageSubtype" yaml:"coverageSubtype"`
+	WGS84BoundingBox []wsc200.WGS84BoundingBox `xml:"ows:WGS84BoundingBox" yaml:"wgs84BoundingBox,omitempty"`
+	CoverageID       string                    `xml:"wcs:CoverageId" yaml:"coverageId"`
+	CoverageSubtype  string                    `xml:"wcs:CoverageSubtype" yaml:"coverageSubtype"`
+	BoundingBox      []wsc200.BoundingBox      `xml:"ows:BoundingBox" yaml:"boundingBox,omitempty"`
 }
@@ -0,0 +1,41 @@
+package wcs201
+
+import (
+	"github.com/pdok/ogc-specifications/pkg/common"
+	"github.com/pdok/ogc-specifications/pkg/wsc200"
+)
+
+// NoSuchCoverage exception, the locator is the CoverageId that is not offered by the server
+func NoSuchCoverage(coverageID string) wsc200.Exception {
+	return exception{ExceptionDetails: common.ExceptionDetails{
+		ExceptionCode: `NoSuchCoverage`,
+		ExceptionText: "One of the identifiers passed does not match with any of the coverages offered by this server: " + coverageID,
+		LocatorCode:   coverageID,
+	}}
+}
+
+// EmptyCoverageIdList exception
+func EmptyCoverageIdList() wsc200.Exception {
+	return exception{ExceptionDetails: common.ExceptionDetails{
+		ExceptionCode: `EmptyCoverageIdList`,
+		ExceptionText: "Operation request contains an empty list of coverage identifiers",
+	}}
+}
+
+// InvalidAxisLabel exception, the locator is the invalid axis label
+func InvalidAxisLabel(axis string) wsc200.Exception {
+	return exception{ExceptionDetails: common.ExceptionDetails{
+		ExceptionCode: `InvalidAxisLabel`,
+		ExceptionText: "The dimension subsetting operation specified an axis label that does not exist in the Envelope or has been used more than once: " + axis,
+		LocatorCode:   axis,
+	}}
+}
+
+// InvalidSubsetting exception, the locator is the axis of the invalid subset
+func InvalidSubsetting(axis string) wsc200.Exception {
+	return exception{ExceptionDetails: common.ExceptionDetails{
+		ExceptionCode: `InvalidSubsetting`,
+		ExceptionText: "Operation request contains an invalid subsetting value for axis: " + axis,
+		LocatorCode:   axis,
+	}}
+}
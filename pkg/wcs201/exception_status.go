@@ -0,0 +1,22 @@
+package wcs201
+
+import (
+	"net/http"
+
+	"github.com/pdok/ogc-specifications/pkg/common"
+	"github.com/pdok/ogc-specifications/pkg/wsc200"
+)
+
+// statusCodes contains the HTTP status codes for the WCS 2.0.1 specific exception codes of WCS 2.0 Core Table 18
+var statusCodes = map[string]int{
+	`NoSuchCoverage`:      http.StatusNotFound,
+	`EmptyCoverageIdList`: http.StatusNotFound,
+	`InvalidAxisLabel`:    http.StatusNotFound,
+	`InvalidSubsetting`:   http.StatusNotFound,
+}
+
+// StatusCode returns the HTTP status code for a report with the given exceptions,
+// exception codes that are not defined by WCS 2.0.1 are looked up in the shared OWS Common codes
+func StatusCode(exceptions ...wsc200.Exception) int {
+	return common.StatusCode(statusCodes, exceptions...)
+}
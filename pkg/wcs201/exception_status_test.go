@@ -0,0 +1,27 @@
+package wcs201
+
+import (
+	"testing"
+
+	"github.com/pdok/ogc-specifications/pkg/wsc200"
+)
+
+func TestStatusCode(t *testing.T) {
+	var tests = []struct {
+		exceptions []wsc200.Exception
+		status     int
+	}{
+		0: {exceptions: nil, status: 200},
+		1: {exceptions: []wsc200.Exception{NoSuchCoverage(`dtm`)}, status: 404},
+		2: {exceptions: []wsc200.Exception{NoSuchCoverage(`dtm`), InvalidSubsetting(`x`)}, status: 404},
+		3: {exceptions: []wsc200.Exception{wsc200.MissingParameterValue(`VERSION`)}, status: 400},
+		4: {exceptions: []wsc200.Exception{EmptyCoverageIdList(), wsc200.MissingParameterValue(`COVERAGEID`)}, status: 400},
+		5: {exceptions: []wsc200.Exception{InvalidAxisLabel(`z`), wsc200.NoApplicableCode(`Oops`)}, status: 500},
+	}
+
+	for k, test := range tests {
+		if status := StatusCode(test.exceptions...); status != test.status {
+			t.Errorf("test: %d, expected: %d,\n got: %d", k, test.status, status)
+		}
+	}
+}
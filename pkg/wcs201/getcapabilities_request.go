@@ -3,7 +3,6 @@ package wcs201
 import (
 	"encoding/xml"
 	"net/url"
-	"regexp"
 	"strings"
 
 	"github.com/pdok/ogc-specifications/pkg/utils"
@@ -22,9 +21,14 @@ func (gc *GetCapabilitiesRequest) Type() string {
 	return getcapabilities
 }
 
-// Validate validates the GetCapabilities struct
+// Validate validates the OWS Common parameters of the GetCapabilities request
 func (gc *GetCapabilitiesRequest) Validate(_ Capabilities) []wsc200.Exception {
-	return nil
+	var exceptions []wsc200.Exception
+	if _, exception := gc.NegotiateVersion(Version); exception != nil {
+		exceptions = append(exceptions, exception)
+	}
+	exceptions = append(exceptions, gc.ValidateSections(sections...)...)
+	return exceptions
 }
 
 // ParseXML builds a GetCapabilities object based on a XML document
@@ -36,11 +40,15 @@ func (gc *GetCapabilitiesRequest) ParseXML(body []byte) []wsc200.Exception {
 	if err := xml.Unmarshal(body, &gc); err != nil {
 		return wsc200.MissingParameterValue(REQUEST).ToExceptions()
 	}
+	if err := gc.GetCapabilitiesParameters.ParseXML(body); err != nil {
+		return wsc200.NoApplicableCode(err.Error()).ToExceptions()
+	}
 	var n []xml.Attr
 	for _, a := range xmlattributes {
 		switch strings.ToUpper(a.Name.Local) {
 		case VERSION:
 		case SERVICE:
+		case wsc200.UPDATESEQUENCE:
 		default:
 			n = append(n, a)
 		}
@@ -64,7 +72,7 @@ func (gc *GetCapabilitiesRequest) QueryParameters(query url.Values) []wsc200.Exc
 			gc.Version = strings.ToUpper(v[0])
 		}
 	}
-	return nil
+	return gc.GetCapabilitiesParameters.ParseQueryParameters(query)
 }
 
 // ToQueryParameters builds a new query string that will be proxied
@@ -73,21 +81,23 @@ func (gc *GetCapabilitiesRequest) ToQueryParameters() url.Values {
 	querystring[REQUEST] = []string{gc.XMLName.Local}
 	querystring[SERVICE] = []string{gc.Service}
 	querystring[VERSION] = []string{gc.Version}
+	gc.GetCapabilitiesParameters.SetQueryParameters(querystring)
 
 	return querystring
 }
 
 // ToXML builds a 'new' XML document 'based' on the 'original' XML document
 func (gc GetCapabilitiesRequest) ToXML() []byte {
-	si, _ := xml.MarshalIndent(gc, "", "")
-	re := regexp.MustCompile(`><.*>`)
-	return []byte(xml.Header + re.ReplaceAllString(string(si), "/>"))
+	e := utils.NewEncoder(wsc200.Prefixes)
+	doc, _ := e.Marshal(gc)
+	return doc
 }
 
 // GetCapabilitiesRequest struct with the needed parameters/attributes needed for making a GetCapabilities request
 type GetCapabilitiesRequest struct {
-	XMLName xml.Name           `xml:"GetCapabilities" yaml:"getCapabilities"`
-	Service string             `xml:"service,attr" yaml:"service"`
-	Version string             `xml:"version,attr" yaml:"version"`
-	Attr    utils.XMLAttribute `xml:",attr" yaml:"attr"`
+	XMLName                          xml.Name           `xml:"GetCapabilities" yaml:"getCapabilities"`
+	Service                          string             `xml:"service,attr" yaml:"service"`
+	Version                          string             `xml:"version,attr" yaml:"version"`
+	Attr                             utils.XMLAttribute `xml:",attr" yaml:"attr"`
+	wsc200.GetCapabilitiesParameters `yaml:",inline"`
 }
@@ -2,8 +2,8 @@ package wcs201
 
 import (
 	"encoding/xml"
-	"regexp"
 
+	"github.com/pdok/ogc-specifications/pkg/utils"
 	"github.com/pdok/ogc-specifications/pkg/wsc200"
 )
 
@@ -22,22 +22,43 @@ func (gc *GetCapabilitiesResponse) Version() string {
 	return Version
 }
 
+// capabilitiesPrefixes contains the prefixes of the namespaces used in the struct tags of the WCS 2.0.1 capabilities
+var capabilitiesPrefixes = utils.Prefixes{
+	`http://www.opengis.net/wcs/2.0`:                      `wcs`,
+	`http://www.opengis.net/ows/2.0`:                      `ows`,
+	`http://www.opengis.net/ows/1.1`:                      `ows`,
+	`http://www.opengis.net/ogc`:                          `ogc`,
+	`http://www.opengis.net/gml/3.2`:                      `gml`,
+	`http://www.opengis.net/gmlcov/1.0`:                   `gmlcov`,
+	`http://www.opengis.net/swe/2.0`:                      `swe`,
+	`http://www.opengis.net/wcs/crs/1.0`:                  `crs`,
+	`http://www.opengis.net/wcs/interpolation/1.0`:        `int`,
+	`http://inspire.ec.europa.eu/schemas/common/1.0`:      `inspire_common`,
+	`http://inspire.ec.europa.eu/schemas/inspire_dls/1.0`: `inspire_dls`,
+}
+
+// ParseXML builds a GetCapabilitiesResponse from a WCS 2.0.1 capabilities document,
+// regardless of the namespace prefixes used in the document
+func (gc *GetCapabilitiesResponse) ParseXML(doc []byte) error {
+	return utils.UnmarshalPrefixed(doc, gc, capabilitiesPrefixes)
+}
+
 // ToXML builds a GetCapabilities response object
 func (gc GetCapabilitiesResponse) ToXML() []byte {
-	si, _ := xml.MarshalIndent(gc, "", "")
-	re := regexp.MustCompile(`><.*>`)
-	return []byte(xml.Header + re.ReplaceAllString(string(si), "/>"))
+	e := utils.NewEncoder(capabilitiesPrefixes)
+	doc, _ := e.Marshal(gc)
+	return doc
 }
 
 // GetCapabilitiesResponse base struct
 type GetCapabilitiesResponse struct {
 	XMLName xml.Name `xml:"wcs:Capabilities" yaml:"wcsCapabilities"`
 	Namespaces
-	ServiceIdentification ServiceIdentification `xml:"ows:ServiceIdentification" yaml:"serviceIdentification"`
-	ServiceProvider       ServiceProvider       `xml:"ows:ServiceProvider" yaml:"serviceProvider"`
-	OperationsMetadata    OperationsMetadata    `xml:"ows:OperationsMetadata" yaml:"operationsMetadata"`
-	ServiceMetadata       ServiceMetadata       `xml:"wcs:ServiceMetadata" yaml:"serviceMetadata"`
-	Contents              Contents              `xml:"wcs:Contents" yaml:"contents"`
+	ServiceIdentification *wsc200.ServiceIdentification `xml:"ows:ServiceIdentification,omitempty" yaml:"serviceIdentification,omitempty"`
+	ServiceProvider       *wsc200.ServiceProvider       `xml:"ows:ServiceProvider,omitempty" yaml:"serviceProvider,omitempty"`
+	OperationsMetadata    OperationsMetadata            `xml:"ows:OperationsMetadata" yaml:"operationsMetadata"`
+	ServiceMetadata       ServiceMetadata               `xml:"wcs:ServiceMetadata" yaml:"serviceMetadata"`
+	Contents              Contents                      `xml:"wcs:Contents" yaml:"contents"`
 }
 
 // Namespaces struct containing the namespaces needed for the XML document
@@ -55,75 +76,6 @@ type Namespaces struct {
 	XmlnsCrs           string `xml:"xmlns:crs,attr" yaml:"crs"`                                //http://www.opengis.net/wcs/crs/1.0
 	XmlnsInt           string `xml:"xmlns:int,attr" yaml:"int"`                                //http://www.opengis.net/wcs/interpolation/1.0
 	Version            string `xml:"version,attr" yaml:"version"`
+	UpdateSequence     string `xml:"updateSequence,attr,omitempty" yaml:"updateSequence,omitempty"`
 	SchemaLocation     string `xml:"xsi:schemaLocation,attr" yaml:"schemaLocation"`
 }
-
-// ServiceIdentification struct should only be fill by the "template" configuration wcs201.yaml
-type ServiceIdentification struct {
-	Title              string           `xml:"ows:Title" yaml:"title"`
-	Abstract           string           `xml:"ows:Abstract" yaml:"abstract"`
-	Keywords           *wsc200.Keywords `xml:"ows:Keywords" yaml:"keywords"`
-	ServiceType        ServiceType      `xml:"ows:ServiceType" yaml:"serviceType"`
-	ServiceTypeVersion []string         `xml:"ows:ServiceTypeVersion" yaml:"serviceTypeVersion"`
-	Profile            []string         `xml:"ows:Profile" yaml:"profile"`
-	Fees               string           `xml:"ows:Fees" yaml:"fees"`
-	AccessConstraints  string           `xml:"ows:AccessConstraints" yaml:"accessConstraints"`
-}
-
-// ServiceType struct containing the service type
-type ServiceType struct {
-	Text      string `xml:",chardata" yaml:"text"`
-	CodeSpace string `xml:"codeSpace,attr" yaml:"codeSpace"`
-}
-
-// ServiceProvider struct containing the provider/organization information should only be fill by the "template" configuration wcs201.yaml
-type ServiceProvider struct {
-	ProviderName   string `xml:"ows:ProviderName" yaml:"providerName"`
-	ProviderSite   `xml:"ows:ProviderSite" yaml:"providerSite"`
-	ServiceContact ServiceContact `xml:"ows:ServiceContact" yaml:"serviceContact"`
-}
-
-// ProviderSite struct containing the website of the provider/organization
-type ProviderSite struct {
-	Type string `xml:"xlink:type,attr" yaml:"type"`
-	Href string `xml:"xlink:href,attr" yaml:"href"`
-}
-
-// ServiceContact struct containing information for the person to contact
-type ServiceContact struct {
-	IndividualName string      `xml:"ows:IndividualName" yaml:"individualName"`
-	PositionName   string      `xml:"ows:PositionName" yaml:"positionName"`
-	ContactInfo    ContactInfo `xml:"ows:ContactInfo" yaml:"contactInfo"`
-	Role           string      `xml:"ows:Role,omitempty" yaml:"role"`
-}
-
-// ContactInfo struct containing the contact information for the service
-type ContactInfo struct {
-	Phone               Phone           `xml:"ows:Phone" yaml:"phone"`
-	Address             Address         `xml:"ows:Address" yaml:"address"`
-	OnlineResource      *OnlineResource `xml:"ows:OnlineResource,omitempty" yaml:"onlineResource"`
-	HoursOfService      string          `xml:"ows:HoursOfService,omitempty" yaml:"hoursOfService"`
-	ContactInstructions string          `xml:"ows:ContactInstructions,omitempty" yaml:"contactInstructions"`
-}
-
-// Phone struct containing the contact telephone or fax number
-type Phone struct {
-	Voice     string `xml:"ows:Voice" yaml:"voice"`
-	Facsimile string `xml:"ows:Facsimile" yaml:"facsimile"`
-}
-
-// Address struct containing the address for the contact supplying the service
-type Address struct {
-	DeliveryPoint         string `xml:"ows:DeliveryPoint" yaml:"deliveryPoint"`
-	City                  string `xml:"ows:City" yaml:"city"`
-	AdministrativeArea    string `xml:"ows:AdministrativeArea" yaml:"administrativeArea"`
-	PostalCode            string `xml:"ows:PostalCode" yaml:"postalCode"`
-	Country               string `xml:"ows:Country" yaml:"country"`
-	ElectronicMailAddress string `xml:"ows:ElectronicMailAddress" yaml:"electronicMailAddress"`
-}
-
-// OnlineResource struct containing the top-level web address of a service or service provider
-type OnlineResource *struct {
-	Type string `xml:"xlink:type,attr,omitempty" yaml:"type"`
-	Href string `xml:"xlink:href,attr,omitempty" yaml:"href"`
-}
@@ -0,0 +1,122 @@
+package wcs201
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGetCapabilitiesResponseParseXML(t *testing.T) {
+	var tests = []struct {
+		doc string
+	}{
+		0: {doc: `<?xml version="1.0" encoding="UTF-8"?>
+<wcs:Capabilities xmlns:wcs="http://www.opengis.net/wcs/2.0" xmlns:ows="http://www.opengis.net/ows/2.0" xmlns:xlink="http://www.w3.org/1999/xlink" xmlns:crs="http://www.opengis.net/wcs/crs/1.0" xmlns:int="http://www.opengis.net/wcs/interpolation/1.0" version="2.0.1">
+ <ows:ServiceIdentification>
+  <ows:Title>Elevation</ows:Title>
+  <ows:ServiceType codeSpace="OGC">OGC WCS</ows:ServiceType>
+  <ows:ServiceTypeVersion>2.0.1</ows:ServiceTypeVersion>
+  <ows:Profile>http://www.opengis.net/spec/WCS/2.0/conf/core</ows:Profile>
+ </ows:ServiceIdentification>
+ <ows:ServiceProvider>
+  <ows:ProviderName>PDOK</ows:ProviderName>
+  <ows:ProviderSite xlink:type="simple" xlink:href="https://www.pdok.nl"/>
+ </ows:ServiceProvider>
+ <ows:OperationsMetadata>
+  <ows:Operation name="GetCoverage">
+   <ows:DCP><ows:HTTP><ows:Get xlink:type="simple" xlink:href="https://example.com/wcs"/></ows:HTTP></ows:DCP>
+  </ows:Operation>
+ </ows:OperationsMetadata>
+ <wcs:ServiceMetadata>
+  <wcs:formatSupported>image/tiff</wcs:formatSupported>
+  <wcs:Extension>
+   <int:InterpolationMetadata><int:InterpolationSupported>nearest</int:InterpolationSupported></int:InterpolationMetadata>
+   <crs:CrsMetadata><crs:crsSupported>http://www.opengis.net/def/crs/EPSG/0/28992</crs:crsSupported></crs:CrsMetadata>
+  </wcs:Extension>
+ </wcs:ServiceMetadata>
+ <wcs:Contents>
+  <wcs:CoverageSummary>
+   <ows:WGS84BoundingBox><ows:LowerCorner>3.2 50.7</ows:LowerCorner><ows:UpperCorner>7.3 53.6</ows:UpperCorner></ows:WGS84BoundingBox>
+   <wcs:CoverageId>ahn</wcs:CoverageId>
+   <wcs:CoverageSubtype>RectifiedGridCoverage</wcs:CoverageSubtype>
+   <ows:BoundingBox crs="http://www.opengis.net/def/crs/EPSG/0/28992"><ows:LowerCorner>10000 300000</ows:LowerCorner><ows:UpperCorner>280000 625000</ows:UpperCorner></ows:BoundingBox>
+  </wcs:CoverageSummary>
+ </wcs:Contents>
+</wcs:Capabilities>`},
+		1: {doc: `<?xml version="1.0" encoding="UTF-8"?>
+<Capabilities xmlns="http://www.opengis.net/wcs/2.0" xmlns:ows20="http://www.opengis.net/ows/2.0" xmlns:xl="http://www.w3.org/1999/xlink" version="2.0.1">
+ <ows20:ServiceIdentification>
+  <ows20:Title>Elevation</ows20:Title>
+  <ows20:ServiceType codeSpace="OGC">OGC WCS</ows20:ServiceType>
+  <ows20:ServiceTypeVersion>2.0.1</ows20:ServiceTypeVersion>
+  <ows20:Profile>http://www.opengis.net/spec/WCS/2.0/conf/core</ows20:Profile>
+ </ows20:ServiceIdentification>
+ <ows20:ServiceProvider>
+  <ows20:ProviderName>PDOK</ows20:ProviderName>
+  <ows20:ProviderSite xl:type="simple" xl:href="https://www.pdok.nl"/>
+ </ows20:ServiceProvider>
+ <ows20:OperationsMetadata>
+  <ows20:Operation name="GetCoverage">
+   <ows20:DCP><ows20:HTTP><ows20:Get xl:type="simple" xl:href="https://example.com/wcs"/></ows20:HTTP></ows20:DCP>
+  </ows20:Operation>
+ </ows20:OperationsMetadata>
+ <ServiceMetadata>
+  <formatSupported>image/tiff</formatSupported>
+  <Extension>
+   <InterpolationMetadata xmlns="http://www.opengis.net/wcs/interpolation/1.0"><InterpolationSupported>nearest</InterpolationSupported></InterpolationMetadata>
+   <CrsMetadata xmlns="http://www.opengis.net/wcs/crs/1.0"><crsSupported>http://www.opengis.net/def/crs/EPSG/0/28992</crsSupported></CrsMetadata>
+  </Extension>
+ </ServiceMetadata>
+ <Contents>
+  <CoverageSummary>
+   <CoverageId>ahn</CoverageId>
+   <CoverageSubtype>RectifiedGridCoverage</CoverageSubtype>
+  </CoverageSummary>
+ </Contents>
+</Capabilities>`},
+	}
+
+	for k, test := range tests {
+		var gc GetCapabilitiesResponse
+		if err := gc.ParseXML([]byte(test.doc)); err != nil {
+			t.Errorf("test: %d, expected no error,\n got: %v", k, err)
+			continue
+		}
+
+		if gc.Namespaces.XmlnsWCS != `http://www.opengis.net/wcs/2.0` || gc.Namespaces.XmlnsOWS != `http://www.opengis.net/ows/2.0` {
+			t.Errorf("test: %d, expected the wcs and ows namespaces,\n got: %+v", k, gc.Namespaces)
+		}
+		if gc.ServiceIdentification == nil || gc.ServiceIdentification.Title.Text(``) != `Elevation` ||
+			gc.ServiceProvider == nil || gc.ServiceProvider.ProviderSite.Href != `https://www.pdok.nl` {
+			t.Errorf("test: %d, expected the service identification and provider,\n got: %+v %+v", k, gc.ServiceIdentification, gc.ServiceProvider)
+		}
+		if len(gc.OperationsMetadata.Operation) != 1 || gc.OperationsMetadata.Operation[0].DCP[0].HTTP.Get[0].Href != `https://example.com/wcs` {
+			t.Errorf("test: %d, expected the GetCoverage operation,\n got: %+v", k, gc.OperationsMetadata)
+		}
+		if crs := gc.ServiceMetadata.Extension.CrsMetadata.CrsSupported; len(crs) != 1 || crs[0] != `http://www.opengis.net/def/crs/EPSG/0/28992` {
+			t.Errorf("test: %d, expected the supported crs,\n got: %v", k, crs)
+		}
+		if interpolation := gc.ServiceMetadata.Extension.InterpolationMetadata.InterpolationSupported; len(interpolation) != 1 || interpolation[0] != `nearest` {
+			t.Errorf("test: %d, expected the supported interpolation,\n got: %v", k, interpolation)
+		}
+		if len(gc.Contents.CoverageSummary) != 1 || gc.Contents.CoverageSummary[0].CoverageID != `ahn` {
+			t.Errorf("test: %d, expected the ahn coverage,\n got: %+v", k, gc.Contents)
+		} else if summary := gc.Contents.CoverageSummary[0]; k == 0 && (len(summary.WGS84BoundingBox) != 1 || len(summary.BoundingBox) != 1 ||
+			summary.BoundingBox[0].Crs == nil || *summary.BoundingBox[0].Crs != `http://www.opengis.net/def/crs/EPSG/0/28992`) {
+			t.Errorf("test: %d, expected the bounding boxes of the ahn coverage,\n got: %+v", k, summary)
+		}
+
+		var c Capabilities
+		if err := c.ParseXML([]byte(test.doc)); err != nil || !reflect.DeepEqual(c.Contents, gc.Contents) {
+			t.Errorf("test: %d, expected: %+v,\n got: %+v %v", k, gc.Contents, c.Contents, err)
+		}
+
+		// marshalled back it is parsed to the same capabilities
+		b := gc.ToXML()
+		var roundtrip GetCapabilitiesResponse
+		if err := roundtrip.ParseXML(b); err != nil || !reflect.DeepEqual(roundtrip.Contents, gc.Contents) || !reflect.DeepEqual(roundtrip.ServiceMetadata, gc.ServiceMetadata) ||
+			!reflect.DeepEqual(roundtrip.ServiceIdentification, gc.ServiceIdentification) || !reflect.DeepEqual(roundtrip.ServiceProvider, gc.ServiceProvider) ||
+			!reflect.DeepEqual(roundtrip.OperationsMetadata, gc.OperationsMetadata) {
+			t.Errorf("test: %d, expected: %+v,\n got: %+v %v", k, gc, roundtrip, err)
+		}
+	}
+}
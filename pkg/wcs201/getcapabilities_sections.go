@@ -0,0 +1,74 @@
+package wcs201
+
+import (
+	"encoding/xml"
+
+	"github.com/pdok/ogc-specifications/pkg/utils"
+	"github.com/pdok/ogc-specifications/pkg/wsc200"
+)
+
+// ServiceMetadataSection is the section of the WCS 2.0.1 capabilities next to the OWS Common sections
+const ServiceMetadataSection = `ServiceMetadata`
+
+// sections contains the sections that can be requested
+var sections = []string{
+	wsc200.ServiceIdentificationSection,
+	wsc200.ServiceProviderSection,
+	wsc200.OperationsMetadataSection,
+	ServiceMetadataSection,
+	wsc200.ContentsSection,
+	wsc200.LanguagesSection,
+}
+
+// Trim returns the capabilities for the GetCapabilities request: the requested sections, or only the version and
+// update sequence when the requested update sequence is the current one.
+// InvalidUpdateSequence is returned when the requested update sequence is greater than the current one.
+func (gc GetCapabilitiesResponse) Trim(r GetCapabilitiesRequest) (GetCapabilitiesResponse, []wsc200.Exception) {
+	if exceptions := r.ValidateSections(sections...); len(exceptions) > 0 {
+		return GetCapabilitiesResponse{}, exceptions
+	}
+
+	equal, exception := r.CheckUpdateSequence(gc.Namespaces.UpdateSequence)
+	if exception != nil {
+		return GetCapabilitiesResponse{}, exception.ToExceptions()
+	}
+
+	trimmed := GetCapabilitiesResponse{XMLName: gc.XMLName, Namespaces: gc.Namespaces}
+	if equal {
+		return trimmed, nil
+	}
+	if r.HasSection(wsc200.ServiceIdentificationSection) {
+		trimmed.ServiceIdentification = gc.ServiceIdentification
+	}
+	if r.HasSection(wsc200.ServiceProviderSection) {
+		trimmed.ServiceProvider = gc.ServiceProvider
+	}
+	if r.HasSection(wsc200.OperationsMetadataSection) {
+		trimmed.OperationsMetadata = gc.OperationsMetadata
+	}
+	if r.HasSection(ServiceMetadataSection) {
+		trimmed.ServiceMetadata = gc.ServiceMetadata
+	}
+	if r.HasSection(wsc200.ContentsSection) {
+		trimmed.Contents = gc.Contents
+	}
+	return trimmed, nil
+}
+
+// MarshalXML leaves out empty OperationsMetadata
+func (o OperationsMetadata) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type section OperationsMetadata
+	return utils.EncodeNonZero(e, section(o), start)
+}
+
+// MarshalXML leaves out empty ServiceMetadata
+func (s ServiceMetadata) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type section ServiceMetadata
+	return utils.EncodeNonZero(e, section(s), start)
+}
+
+// MarshalXML leaves out empty Contents
+func (c Contents) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type section Contents
+	return utils.EncodeNonZero(e, section(c), start)
+}
@@ -0,0 +1,65 @@
+package wcs201
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/pdok/ogc-specifications/pkg/wsc200"
+)
+
+func TestGetCapabilitiesResponseTrim(t *testing.T) {
+	gc := GetCapabilitiesResponse{
+		Namespaces:            Namespaces{XmlnsWCS: `http://www.opengis.net/wcs/2.0`, XmlnsOWS: `http://www.opengis.net/ows/2.0`, Version: Version, UpdateSequence: `2021-03-01T12:00:00Z`},
+		ServiceIdentification: &wsc200.ServiceIdentification{Title: wsc200.LanguageStrings{{Value: `Elevation`}}},
+		ServiceProvider:       &wsc200.ServiceProvider{ProviderName: `PDOK`},
+		ServiceMetadata:       ServiceMetadata{FormatSupported: []string{`image/tiff`}},
+		Contents:              Contents{CoverageSummary: []CoverageSummary{{CoverageID: `dtm`}}},
+	}
+
+	var tests = []struct {
+		query    url.Values
+		contains []string
+		omits    []string
+		code     string
+	}{
+		0: {query: url.Values{`SECTIONS`: {`ServiceMetadata,Contents`}},
+			contains: []string{`<wcs:ServiceMetadata>`, `<wcs:Contents>`},
+			omits:    []string{`ows:ServiceIdentification`, `ows:ServiceProvider`, `ows:OperationsMetadata`}},
+		1: {query: url.Values{`UPDATESEQUENCE`: {`2021-03-01T12:00:00Z`}},
+			contains: []string{`updateSequence="2021-03-01T12:00:00Z"`},
+			omits:    []string{`ows:ServiceIdentification`, `wcs:ServiceMetadata`, `wcs:Contents`}},
+		2: {query: url.Values{`UPDATESEQUENCE`: {`2022-01-01T00:00:00Z`}},
+			code: `InvalidUpdateSequence`},
+		3: {query: url.Values{`SECTIONS`: {`FeatureTypeList`}},
+			code: `InvalidParameterValue`},
+	}
+
+	for k, test := range tests {
+		var r GetCapabilitiesRequest
+		if exceptions := r.QueryParameters(test.query); exceptions != nil {
+			t.Errorf("test: %d, expected no exceptions,\n got: %v", k, exceptions)
+			continue
+		}
+
+		trimmed, exceptions := gc.Trim(r)
+		if test.code != `` {
+			if len(exceptions) != 1 || exceptions[0].Code() != test.code {
+				t.Errorf("test: %d, expected: %s,\n got: %v", k, test.code, exceptions)
+			}
+			continue
+		}
+
+		doc := string(trimmed.ToXML())
+		for _, s := range test.contains {
+			if !strings.Contains(doc, s) {
+				t.Errorf("test: %d, expected %s in:\n %s", k, s, doc)
+			}
+		}
+		for _, s := range test.omits {
+			if strings.Contains(doc, s) {
+				t.Errorf("test: %d, expected no %s in:\n %s", k, s, doc)
+			}
+		}
+	}
+}
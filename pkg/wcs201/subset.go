@@ -0,0 +1,162 @@
+package wcs201
+
+import (
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pdok/ogc-specifications/pkg/temporal"
+	"github.com/pdok/ogc-specifications/pkg/utils"
+	"github.com/pdok/ogc-specifications/pkg/wsc200"
+)
+
+// SUBSET is the KVP key of the dimension subsetting of a GetCoverage request
+const SUBSET = `SUBSET`
+
+// TimeAxis is the label of the time axis, its values are ISO 8601 instants
+const TimeAxis = `time`
+
+// openBound is the unbounded low or high value of a trim
+const openBound = `*`
+
+// subsetRegex matches axis[,crs](low,high) and axis[,crs](point)
+var subsetRegex = regexp.MustCompile(`^([^,()]+)(?:,([^()]+))?\(([^,()]+)(?:,([^,()]+))?\)$`)
+
+// Subset is a trim, from the Low to the High value, or a slice, at the Point, of a coverage along an axis
+type Subset struct {
+	Axis  string  `yaml:"axis"`
+	CRS   *string `yaml:"crs,omitempty"`
+	Low   *string `yaml:"low,omitempty"`
+	High  *string `yaml:"high,omitempty"`
+	Point *string `yaml:"point,omitempty"`
+}
+
+// ParseSubset parses a SUBSET value as defined in WCS 2.0.1 KVP Protocol Binding Table 4, like
+// time("2020-01-01","2020-06-01") or x,http://www.opengis.net/def/crs/EPSG/0/28992(0,1000)
+func ParseSubset(s string) (Subset, wsc200.Exception) {
+	m := subsetRegex.FindStringSubmatch(strings.TrimSpace(s))
+	if m == nil {
+		return Subset{}, wsc200.InvalidParameterValue(s, SUBSET)
+	}
+
+	subset := Subset{Axis: strings.TrimSpace(m[1])}
+	if m[2] != `` {
+		crs := strings.TrimSpace(m[2])
+		subset.CRS = &crs
+	}
+	first := unquote(m[3])
+	if m[4] == `` {
+		subset.Point = &first
+		return subset, nil
+	}
+	second := unquote(m[4])
+	subset.Low, subset.High = &first, &second
+	return subset, nil
+}
+
+// ParseSubsets parses the SUBSET values of the query, an axis can only be subset once
+func ParseSubsets(query url.Values) ([]Subset, wsc200.Exceptions) {
+	var subsets []Subset
+	var exceptions wsc200.Exceptions
+	axes := map[string]bool{}
+	for _, value := range utils.KeysToUpper(query)[SUBSET] {
+		subset, exception := ParseSubset(value)
+		if exception != nil {
+			exceptions = append(exceptions, exception)
+			continue
+		}
+		if axes[strings.ToLower(subset.Axis)] {
+			exceptions = append(exceptions, InvalidAxisLabel(subset.Axis))
+			continue
+		}
+		axes[strings.ToLower(subset.Axis)] = true
+		subsets = append(subsets, subset)
+	}
+	return subsets, exceptions
+}
+
+// String returns the SUBSET value, the values of the time axis are quoted
+func (s Subset) String() string {
+	axis := s.Axis
+	if s.CRS != nil {
+		axis += `,` + *s.CRS
+	}
+	if s.Point != nil {
+		return axis + `(` + s.value(*s.Point) + `)`
+	}
+	var low, high string
+	if s.Low != nil {
+		low = s.value(*s.Low)
+	}
+	if s.High != nil {
+		high = s.value(*s.High)
+	}
+	return axis + `(` + low + `,` + high + `)`
+}
+
+// IsTime returns whether the subset is along the time axis
+func (s Subset) IsTime() bool {
+	return strings.EqualFold(s.Axis, TimeAxis)
+}
+
+// ValidateTime checks the subset against the time extent of the coverage:
+// a slice has to be one of the values of the extent, a trim has to lie within one of its periods.
+// An open low or high bound is the start or end of the extent.
+func (s Subset) ValidateTime(extent temporal.Extent) wsc200.Exception {
+	if s.Point != nil {
+		point, err := temporal.ParseInstant(*s.Point)
+		if err != nil || !extent.Contains(point) {
+			return InvalidSubsetting(s.Axis)
+		}
+		return nil
+	}
+	if s.Low == nil || s.High == nil || len(extent) == 0 {
+		return InvalidSubsetting(s.Axis)
+	}
+
+	start, end := envelope(extent)
+	low, high := *s.Low, *s.High
+	if low == openBound {
+		low = start.String()
+	}
+	if high == openBound {
+		high = end.String()
+	}
+	p, err := temporal.ParsePeriod(low + `/` + high)
+	if err != nil || !extent.Covers(temporal.Extent{p}) {
+		return InvalidSubsetting(s.Axis)
+	}
+	return nil
+}
+
+// value quotes the value of the time axis, an open bound is never quoted
+func (s Subset) value(v string) string {
+	if s.IsTime() && v != openBound {
+		return strconv.Quote(v)
+	}
+	return v
+}
+
+// unquote removes the quotes around a time value
+func unquote(s string) string {
+	s = strings.TrimSpace(s)
+	if u, err := strconv.Unquote(s); err == nil {
+		return u
+	}
+	return s
+}
+
+// envelope returns the first start and the last end of the extent
+func envelope(extent temporal.Extent) (temporal.Instant, temporal.Instant) {
+	start, end := extent[0].Start, extent[0].End
+	for _, p := range extent[1:] {
+		if p.Start.Resolve().Before(start.Resolve()) {
+			start = p.Start
+		}
+		if p.End.Resolve().After(end.Resolve()) {
+			end = p.End
+		}
+	}
+	return start, end
+}
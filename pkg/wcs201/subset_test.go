@@ -0,0 +1,111 @@
+package wcs201
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/pdok/ogc-specifications/pkg/temporal"
+)
+
+func sp(s string) *string {
+	return &s
+}
+
+func TestParseSubset(t *testing.T) {
+	var tests = []struct {
+		subset    string
+		expected  Subset
+		exception bool
+	}{
+		0: {subset: `time("2020-01-01","2020-06-01")`, expected: Subset{Axis: `time`, Low: sp(`2020-01-01`), High: sp(`2020-06-01`)}},
+		1: {subset: `time("2020-01-01")`, expected: Subset{Axis: `time`, Point: sp(`2020-01-01`)}},
+		2: {subset: `x,http://www.opengis.net/def/crs/EPSG/0/28992(0,1000)`, expected: Subset{Axis: `x`, CRS: sp(`http://www.opengis.net/def/crs/EPSG/0/28992`), Low: sp(`0`), High: sp(`1000`)}},
+		3: {subset: `time(*,"2020-06-01")`, expected: Subset{Axis: `time`, Low: sp(`*`), High: sp(`2020-06-01`)}},
+		4: {subset: `time`, exception: true},
+		5: {subset: `time(2020-01-01,2020-02-01,2020-03-01)`, exception: true},
+	}
+
+	for k, test := range tests {
+		subset, exception := ParseSubset(test.subset)
+		if test.exception {
+			if exception == nil {
+				t.Errorf("test: %d, expected an exception,\n got: %+v", k, subset)
+			}
+			continue
+		}
+		if exception != nil {
+			t.Errorf("test: %d, expected no exception,\n got: %s", k, exception.Error())
+			continue
+		}
+		if !reflect.DeepEqual(subset, test.expected) {
+			t.Errorf("test: %d, expected: %+v,\n got: %+v", k, test.expected, subset)
+		}
+		if s := subset.String(); s != test.subset {
+			t.Errorf("test: %d, expected: %s,\n got: %s", k, test.subset, s)
+		}
+	}
+}
+
+func TestParseSubsets(t *testing.T) {
+	var tests = []struct {
+		query      url.Values
+		subsets    int
+		exceptions []string
+	}{
+		0: {query: url.Values{`subset`: {`time("2020-01-01")`, `x(0,1000)`}}, subsets: 2},
+		1: {query: url.Values{`SUBSET`: {`time("2020-01-01")`, `TIME("2020-02-01")`}}, subsets: 1, exceptions: []string{`InvalidAxisLabel`}},
+		2: {query: url.Values{`SUBSET`: {`time(`}}, exceptions: []string{`InvalidParameterValue`}},
+		3: {query: url.Values{}},
+	}
+
+	for k, test := range tests {
+		subsets, exceptions := ParseSubsets(test.query)
+		if len(subsets) != test.subsets {
+			t.Errorf("test: %d, expected: %d subsets,\n got: %+v", k, test.subsets, subsets)
+		}
+		var codes []string
+		for _, e := range exceptions {
+			codes = append(codes, e.Code())
+		}
+		if !reflect.DeepEqual(codes, test.exceptions) {
+			t.Errorf("test: %d, expected: %v,\n got: %v", k, test.exceptions, codes)
+		}
+	}
+}
+
+func TestSubsetValidateTime(t *testing.T) {
+	extent, err := temporal.ParseExtent(`2019-06-01,2020-01-01/2020-12-01/P1M`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		subset    string
+		exception bool
+	}{
+		0: {subset: `time("2020-03-01")`},
+		1: {subset: `time("2019-06-01")`},
+		2: {subset: `time("2020-03-15")`, exception: true},
+		3: {subset: `time("2020-02-01","2020-06-01")`},
+		4: {subset: `time("2019-06-01","2020-06-01")`, exception: true},
+		5: {subset: `time("2020-06-01",*)`},
+		6: {subset: `time(*,"2020-06-01")`, exception: true},
+		7: {subset: `time("2020-06-01","2020-02-01")`, exception: true},
+		8: {subset: `time("June","2020-06-01")`, exception: true},
+	}
+
+	for k, test := range tests {
+		subset, exception := ParseSubset(test.subset)
+		if exception != nil {
+			t.Fatalf("test: %d, expected no exception,\n got: %s", k, exception.Error())
+		}
+		exception = subset.ValidateTime(extent)
+		if test.exception && (exception == nil || exception.Code() != `InvalidSubsetting`) {
+			t.Errorf("test: %d, expected an InvalidSubsetting exception,\n got: %v", k, exception)
+		}
+		if !test.exception && exception != nil {
+			t.Errorf("test: %d, expected no exception,\n got: %s", k, exception.Error())
+		}
+	}
+}
@@ -0,0 +1,107 @@
+// Package wfs110 parses the WFS 1.1.0 and WFS 1.0.0 GetFeature and DescribeFeatureType requests legacy GIS clients
+// still send, and translates them into their wfs200 equivalent, so one WFS 2.0.0 backend can serve those clients.
+//
+// The Filter Encoding 1.1.0 and 1.0.0 filters, in the ogc namespace, are translated into the FES 2.0 filter of the
+// wfs200 package and the exceptions are reported in the exception report of the requested version:
+// the OWS 1.0 ExceptionReport for WFS 1.1.0 and the ogc ServiceExceptionReport for WFS 1.0.0.
+package wfs110
+
+import (
+	"encoding/xml"
+	"net/url"
+	"strings"
+
+	"github.com/pdok/ogc-specifications/pkg/utils"
+	"github.com/pdok/ogc-specifications/pkg/wfs200"
+)
+
+const (
+	getfeature          = `GetFeature`
+	describefeaturetype = `DescribeFeatureType`
+
+	Service string = `WFS`
+	// Version is WFS 1.1.0, the package also accepts requests of Version100
+	Version string = `1.1.0`
+	// Version100 is WFS 1.0.0, it uses FES 1.0.0 filters with GML 2 geometries
+	Version100 string = `1.0.0`
+)
+
+// WFS 1.1.0 and 1.0.0 Keys
+const (
+	SERVICE = `SERVICE`
+	REQUEST = `REQUEST`
+	VERSION = `VERSION`
+
+	OUTPUTFORMAT = `OUTPUTFORMAT`
+	TYPENAME     = `TYPENAME`
+)
+
+// legacyNamespaces are the namespaces of the WFS 1.x requests, these aren't carried over to the WFS 2.0.0 request
+var legacyNamespaces = map[string]bool{
+	`http://www.opengis.net/wfs`: true,
+	`http://www.opengis.net/ogc`: true,
+	`http://www.opengis.net/gml`: true,
+	`http://www.opengis.net/ows`: true,
+}
+
+// parseBaseQueryParameters returns the version of a KVP encoded request, the SERVICE and VERSION are mandatory
+func parseBaseQueryParameters(query url.Values) (string, Exceptions) {
+	var exceptions Exceptions
+	switch service := first(query, SERVICE); {
+	case service == ``:
+		exceptions = append(exceptions, MissingParameterValue(SERVICE))
+	case !strings.EqualFold(service, Service):
+		exceptions = append(exceptions, InvalidParameterValue(service, SERVICE))
+	}
+
+	version := first(query, VERSION)
+	exceptions = append(exceptions, checkVersion(version)...)
+	return version, exceptions
+}
+
+// checkService returns an exception when the service attribute of a XML request, that defaults to WFS, isn't WFS
+func checkService(service string) Exceptions {
+	if service == `` || strings.EqualFold(service, Service) {
+		return nil
+	}
+	return InvalidParameterValue(service, SERVICE).ToExceptions()
+}
+
+// checkVersion returns an exception when the version isn't WFS 1.1.0 or 1.0.0
+func checkVersion(version string) Exceptions {
+	switch version {
+	case Version, Version100:
+		return nil
+	case ``:
+		return MissingParameterValue(VERSION).ToExceptions()
+	}
+	return InvalidParameterValue(version, VERSION).ToExceptions()
+}
+
+// baseRequest returns the wfs200.BaseRequest with the namespace declarations of the XML request,
+// except for the legacy WFS, FES, GML and OWS namespaces
+func baseRequest(attr []xml.Attr) wfs200.BaseRequest {
+	var declarations []xml.Attr
+	for _, a := range attr {
+		if (a.Name.Space == `xmlns` || a.Name.Local == `xmlns`) && !legacyNamespaces[a.Value] {
+			declarations = append(declarations, a)
+		}
+	}
+	return wfs200.BaseRequest{Service: Service, Version: wfs200.Version, Attr: utils.StripDuplicateAttr(declarations)}
+}
+
+// list splits a comma separated list
+func list(s string) []string {
+	var items []string
+	for _, item := range strings.Split(s, `,`) {
+		items = append(items, strings.TrimSpace(item))
+	}
+	return items
+}
+
+func first(query url.Values, key string) string {
+	if len(query[key]) > 0 {
+		return query[key][0]
+	}
+	return ``
+}
@@ -0,0 +1,43 @@
+package wfs110
+
+import (
+	"github.com/pdok/ogc-specifications/pkg/common"
+	"github.com/pdok/ogc-specifications/pkg/wsc110"
+)
+
+// owsCodes are the exception codes of OWS Common 1.0, WFS 1.1.0 and 1.0.0 clients know only these
+var owsCodes = map[string]bool{
+	`OperationNotSupported`:    true,
+	`MissingParameterValue`:    true,
+	`InvalidParameterValue`:    true,
+	`VersionNegotiationFailed`: true,
+	`InvalidUpdateSequence`:    true,
+	`NoApplicableCode`:         true,
+}
+
+// exceptionCodes maps the WFS 2.0.0 and OWS Common 1.1 exception codes to the closest OWS Common 1.0 code,
+// the other codes, like the locking and stored query codes, become NoApplicableCode
+var exceptionCodes = map[string]string{
+	`InvalidValue`:           `InvalidParameterValue`,
+	`OperationParsingFailed`: `InvalidParameterValue`,
+	`OptionNotSupported`:     `OperationNotSupported`,
+}
+
+// FromWFS200 maps the exceptions of a WFS 2.0.0 backend to the Exceptions reported to a WFS 1.1.0 or 1.0.0 client
+func FromWFS200(exceptions ...wsc110.Exception) Exceptions {
+	var result Exceptions
+	for _, e := range exceptions {
+		code := e.Code()
+		if mapped, ok := exceptionCodes[code]; ok {
+			code = mapped
+		} else if !owsCodes[code] {
+			code = `NoApplicableCode`
+		}
+		result = append(result, Exception{ExceptionDetails: common.ExceptionDetails{
+			ExceptionText: e.Error(),
+			ExceptionCode: code,
+			LocatorCode:   e.Locator(),
+		}})
+	}
+	return result
+}
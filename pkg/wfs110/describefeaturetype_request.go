@@ -0,0 +1,111 @@
+package wfs110
+
+import (
+	"encoding/xml"
+	"net/url"
+	"strings"
+
+	"github.com/pdok/ogc-specifications/pkg/utils"
+	"github.com/pdok/ogc-specifications/pkg/wfs200"
+)
+
+// The default DescribeFeatureType output format of WFS 1.0.0, WFS 1.1.0 defaults to GML311
+const XMLSCHEMA = `XMLSCHEMA`
+
+// DescribeFeatureTypeRequest is a WFS 1.1.0 or 1.0.0 DescribeFeatureType request,
+// parsed into the equivalent WFS 2.0.0 request
+type DescribeFeatureTypeRequest struct {
+	// Version of the request, 1.1.0 or 1.0.0, the exceptions are reported in the report of this version
+	Version string `yaml:"version"`
+
+	request wfs200.DescribeFeatureTypeRequest
+}
+
+// describeFeatureType is the XML encoding of a WFS 1.x DescribeFeatureType request
+type describeFeatureType struct {
+	XMLName      xml.Name `xml:"DescribeFeatureType"`
+	Service      string   `xml:"service,attr"`
+	Version      string   `xml:"version,attr"`
+	OutputFormat string   `xml:"outputFormat,attr"`
+	TypeName     []string `xml:"TypeName"`
+}
+
+// Type returns DescribeFeatureType
+func (d DescribeFeatureTypeRequest) Type() string {
+	return describefeaturetype
+}
+
+// ToWFS200 returns the WFS 2.0.0 DescribeFeatureType request
+func (d DescribeFeatureTypeRequest) ToWFS200() wfs200.DescribeFeatureTypeRequest {
+	return d.request
+}
+
+// ParseQueryParameters builds a DescribeFeatureTypeRequest based on the available query parameters,
+// without a TYPENAME all the feature types are described
+func (d *DescribeFeatureTypeRequest) ParseQueryParameters(q url.Values) Exceptions {
+	query := utils.KeysToUpper(q)
+	version, exceptions := parseBaseQueryParameters(query)
+	if exceptions != nil {
+		return exceptions
+	}
+
+	var typeNames []string
+	if v := first(query, TYPENAME); v != `` {
+		typeNames = list(v)
+	}
+	d.Version = version
+	d.request = newDescribeFeatureTypeRequest(version, first(query, OUTPUTFORMAT), typeNames)
+	return nil
+}
+
+// ParseXML builds a DescribeFeatureTypeRequest based on a XML document,
+// the namespaces declared on the root element are carried over except for the WFS 1.x namespaces
+func (d *DescribeFeatureTypeRequest) ParseXML(doc []byte) Exceptions {
+	var xmlAttributes utils.XMLAttribute
+	if err := xml.Unmarshal(doc, &xmlAttributes); err != nil {
+		return NoApplicableCode("Could not process XML, is it XML?").ToExceptions()
+	}
+	var r describeFeatureType
+	if err := xml.Unmarshal(doc, &r); err != nil {
+		return NoApplicableCode(err.Error()).ToExceptions()
+	}
+
+	if exceptions := checkService(r.Service); exceptions != nil {
+		return exceptions
+	}
+	version := r.Version
+	if version == `` {
+		version = Version
+	}
+	if exceptions := checkVersion(version); exceptions != nil {
+		return exceptions
+	}
+
+	var typeNames []string
+	for _, typeName := range r.TypeName {
+		typeNames = append(typeNames, strings.TrimSpace(typeName))
+	}
+	d.Version = version
+	d.request = newDescribeFeatureTypeRequest(version, r.OutputFormat, typeNames)
+	d.request.BaseRequest = baseRequest(xmlAttributes)
+	return nil
+}
+
+// newDescribeFeatureTypeRequest returns the WFS 2.0.0 request, with the default output format of the version
+func newDescribeFeatureTypeRequest(version, outputFormat string, typeNames []string) wfs200.DescribeFeatureTypeRequest {
+	r := wfs200.DescribeFeatureTypeRequest{XMLName: xml.Name{Local: describefeaturetype}}
+	r.BaseRequest = wfs200.BaseRequest{Service: Service, Version: wfs200.Version}
+
+	switch {
+	case outputFormat != ``:
+		r.OutputFormat = sp(outputFormat)
+	case version == Version100:
+		r.OutputFormat = sp(XMLSCHEMA)
+	default:
+		r.OutputFormat = sp(GML311)
+	}
+	if len(typeNames) > 0 {
+		r.TypeNames = sp(strings.Join(typeNames, `,`))
+	}
+	return r
+}
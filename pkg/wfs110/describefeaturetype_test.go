@@ -0,0 +1,82 @@
+package wfs110
+
+import (
+	"encoding/xml"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/pdok/ogc-specifications/pkg/wfs200"
+)
+
+func TestDescribeFeatureTypeParseQueryParameters(t *testing.T) {
+	var tests = []struct {
+		query      url.Values
+		result     wfs200.DescribeFeatureTypeRequest
+		exceptions []string
+	}{
+		0: {query: url.Values{SERVICE: {`WFS`}, VERSION: {`1.1.0`}, REQUEST: {`DescribeFeatureType`}, TYPENAME: {`ns:rivers,ns:roads`}},
+			result: wfs200.DescribeFeatureTypeRequest{XMLName: xml.Name{Local: `DescribeFeatureType`},
+				BaseRequest: wfs200.BaseRequest{Service: Service, Version: wfs200.Version},
+				BaseDescribeFeatureTypeRequest: wfs200.BaseDescribeFeatureTypeRequest{
+					OutputFormat: sp(GML311), TypeNames: sp(`ns:rivers,ns:roads`)}}},
+		1: {query: url.Values{SERVICE: {`WFS`}, VERSION: {`1.0.0`}, REQUEST: {`DescribeFeatureType`}},
+			result: wfs200.DescribeFeatureTypeRequest{XMLName: xml.Name{Local: `DescribeFeatureType`},
+				BaseRequest: wfs200.BaseRequest{Service: Service, Version: wfs200.Version},
+				BaseDescribeFeatureTypeRequest: wfs200.BaseDescribeFeatureTypeRequest{
+					OutputFormat: sp(XMLSCHEMA)}}},
+		2: {query: url.Values{SERVICE: {`WFS`}, REQUEST: {`DescribeFeatureType`}},
+			exceptions: []string{`MissingParameterValue`}},
+	}
+
+	for k, test := range tests {
+		var d DescribeFeatureTypeRequest
+		exceptions := d.ParseQueryParameters(test.query)
+		if len(test.exceptions) > 0 {
+			if len(exceptions) != 1 || exceptions[0].Code() != test.exceptions[0] {
+				t.Errorf("test: %d, expected: %v,\n got: %v", k, test.exceptions, exceptions)
+			}
+			continue
+		}
+		if exceptions != nil {
+			t.Errorf("test: %d, expected no exceptions,\n got: %v", k, exceptions)
+		} else if !reflect.DeepEqual(d.ToWFS200(), test.result) {
+			t.Errorf("test: %d, expected: %+v,\n got: %+v", k, test.result, d.ToWFS200())
+		}
+	}
+}
+
+func TestDescribeFeatureTypeParseXML(t *testing.T) {
+	var tests = []struct {
+		doc     string
+		version string
+		result  wfs200.DescribeFeatureTypeRequest
+	}{
+		0: {doc: `<wfs:DescribeFeatureType service="WFS" version="1.0.0" xmlns:wfs="http://www.opengis.net/wfs"><wfs:TypeName>rivers</wfs:TypeName><wfs:TypeName>roads</wfs:TypeName></wfs:DescribeFeatureType>`,
+			version: Version100,
+			result: wfs200.DescribeFeatureTypeRequest{XMLName: xml.Name{Local: `DescribeFeatureType`},
+				BaseRequest: wfs200.BaseRequest{Service: Service, Version: wfs200.Version},
+				BaseDescribeFeatureTypeRequest: wfs200.BaseDescribeFeatureTypeRequest{
+					OutputFormat: sp(XMLSCHEMA), TypeNames: sp(`rivers,roads`)}}},
+		1: {doc: `<DescribeFeatureType outputFormat="text/xml; subtype=gml/3.1.1"/>`,
+			version: Version,
+			result: wfs200.DescribeFeatureTypeRequest{XMLName: xml.Name{Local: `DescribeFeatureType`},
+				BaseRequest: wfs200.BaseRequest{Service: Service, Version: wfs200.Version},
+				BaseDescribeFeatureTypeRequest: wfs200.BaseDescribeFeatureTypeRequest{
+					OutputFormat: sp(GML311)}}},
+	}
+
+	for k, test := range tests {
+		var d DescribeFeatureTypeRequest
+		if exceptions := d.ParseXML([]byte(test.doc)); exceptions != nil {
+			t.Errorf("test: %d, expected no exceptions,\n got: %v", k, exceptions)
+			continue
+		}
+		if d.Version != test.version {
+			t.Errorf("test: %d, expected: %s,\n got: %s", k, test.version, d.Version)
+		}
+		if !reflect.DeepEqual(d.ToWFS200(), test.result) {
+			t.Errorf("test: %d, expected: %+v,\n got: %+v", k, test.result, d.ToWFS200())
+		}
+	}
+}
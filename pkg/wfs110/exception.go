@@ -0,0 +1,114 @@
+package wfs110
+
+import (
+	"encoding/xml"
+
+	"github.com/pdok/ogc-specifications/pkg/common"
+)
+
+// Versions of the exception reports
+const (
+	// owsVersion is the version of the OWS 1.0 ExceptionReport schema WFS 1.1.0 uses
+	owsVersion = `1.0.0`
+	// serviceExceptionVersion is the version of the ogc ServiceExceptionReport schema WFS 1.0.0 uses
+	serviceExceptionVersion = `1.2.0`
+)
+
+// Exception
+//
+//nolint:errname
+type Exception struct {
+	common.ExceptionDetails
+}
+
+// Exceptions is an array of the Exception interface
+type Exceptions []Exception
+
+// ExceptionReport is the OWS 1.0 exception report of WFS 1.1.0
+type ExceptionReport struct {
+	XMLName        xml.Name       `xml:"ows:ExceptionReport" yaml:"exceptionReport"`
+	Ows            string         `xml:"xmlns:ows,attr,omitempty" yaml:"ows"`
+	Xsi            string         `xml:"xmlns:xsi,attr,omitempty" yaml:"xsi"`
+	SchemaLocation string         `xml:"xsi:schemaLocation,attr,omitempty" yaml:"schemaLocation"`
+	Version        string         `xml:"version,attr" yaml:"version"`
+	Language       string         `xml:"language,attr,omitempty" yaml:"language,omitempty"`
+	Exception      []owsException `xml:"ows:Exception" yaml:"exception"`
+}
+
+// owsException is an OWS 1.0 exception, with the text in an ExceptionText element
+type owsException struct {
+	ExceptionCode string `xml:"exceptionCode,attr" yaml:"exceptionCode"`
+	LocatorCode   string `xml:"locator,attr,omitempty" yaml:"locator,omitempty"`
+	ExceptionText string `xml:"ows:ExceptionText,omitempty" yaml:"exceptionText,omitempty"`
+}
+
+// ServiceExceptionReport is the exception report of WFS 1.0.0, in the ogc namespace
+type ServiceExceptionReport struct {
+	XMLName          xml.Name   `xml:"ServiceExceptionReport" yaml:"serviceExceptionReport"`
+	Xmlns            string     `xml:"xmlns,attr,omitempty" yaml:"xmlns"`
+	Xsi              string     `xml:"xmlns:xsi,attr,omitempty" yaml:"xsi"`
+	SchemaLocation   string     `xml:"xsi:schemaLocation,attr,omitempty" yaml:"schemaLocation"`
+	Version          string     `xml:"version,attr" yaml:"version"`
+	ServiceException Exceptions `xml:"ServiceException" yaml:"serviceException"`
+}
+
+// ToReport builds the OWS 1.0 ExceptionReport of WFS 1.1.0 from an array of Exceptions
+func (e Exceptions) ToReport() ExceptionReport {
+	r := ExceptionReport{}
+	r.Ows = `http://www.opengis.net/ows`
+	r.Xsi = `http://www.w3.org/2001/XMLSchema-instance`
+	r.SchemaLocation = `http://www.opengis.net/ows http://schemas.opengis.net/ows/1.0.0/owsExceptionReport.xsd`
+	r.Version = owsVersion
+	r.Language = `en`
+	for _, exception := range e {
+		r.Exception = append(r.Exception, owsException{
+			ExceptionCode: exception.ExceptionCode,
+			LocatorCode:   exception.LocatorCode,
+			ExceptionText: exception.ExceptionText,
+		})
+	}
+	return r
+}
+
+// ToServiceExceptionReport builds the ServiceExceptionReport of WFS 1.0.0 from an array of Exceptions
+func (e Exceptions) ToServiceExceptionReport() ServiceExceptionReport {
+	r := ServiceExceptionReport{}
+	r.Xmlns = `http://www.opengis.net/ogc`
+	r.Xsi = `http://www.w3.org/2001/XMLSchema-instance`
+	r.SchemaLocation = `http://www.opengis.net/ogc http://schemas.opengis.net/wfs/1.0.0/OGC-exception.xsd`
+	r.Version = serviceExceptionVersion
+	r.ServiceException = e
+	return r
+}
+
+// ToBytes makes from a ExceptionReport a []byte
+func (r ExceptionReport) ToBytes() []byte {
+	si, _ := xml.MarshalIndent(r, "", " ")
+	return append([]byte(xml.Header), si...)
+}
+
+// ToBytes makes from a ServiceExceptionReport a []byte
+func (r ServiceExceptionReport) ToBytes() []byte {
+	si, _ := xml.MarshalIndent(r, "", " ")
+	return append([]byte(xml.Header), si...)
+}
+
+// ToExceptions promotes a single Exception to an array of one
+func (e Exception) ToExceptions() Exceptions {
+	return Exceptions{e}
+}
+
+// Error returns available ExceptionText
+func (e Exception) Error() string {
+	return e.ExceptionText
+}
+
+// Code returns available ExceptionCode
+func (e Exception) Code() string {
+	return e.ExceptionCode
+}
+
+// Locator returns available ExceptionCode
+func (e Exception) Locator() string {
+	return e.LocatorCode
+}
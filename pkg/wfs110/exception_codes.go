@@ -0,0 +1,72 @@
+package wfs110
+
+import (
+	"fmt"
+
+	"github.com/pdok/ogc-specifications/pkg/common"
+)
+
+// OperationNotSupported Exception
+func OperationNotSupported(message string) Exception {
+	return Exception{ExceptionDetails: common.ExceptionDetails{
+		ExceptionText: "This service does not know the operation: " + message,
+		ExceptionCode: `OperationNotSupported`,
+		LocatorCode:   message,
+	}}
+}
+
+// MissingParameterValue Exception
+func MissingParameterValue(s ...string) Exception {
+	if len(s) >= 2 {
+		return Exception{ExceptionDetails: common.ExceptionDetails{
+			ExceptionText: fmt.Sprintf("%s key got incorrect value: %s", s[0], s[1]),
+			ExceptionCode: `MissingParameterValue`,
+			LocatorCode:   s[0],
+		}}
+	}
+	if len(s) == 1 {
+		return Exception{ExceptionDetails: common.ExceptionDetails{
+			ExceptionText: "Missing key: " + s[0],
+			ExceptionCode: `MissingParameterValue`,
+			LocatorCode:   s[0],
+		}}
+	}
+	return Exception{ExceptionDetails: common.ExceptionDetails{
+		ExceptionText: `Could not determine REQUEST`,
+		ExceptionCode: `MissingParameterValue`,
+		LocatorCode:   REQUEST,
+	}}
+}
+
+// InvalidParameterValue Exception
+func InvalidParameterValue(value, locator string) Exception {
+	return Exception{ExceptionDetails: common.ExceptionDetails{
+		ExceptionText: fmt.Sprintf("%s contains a invalid value: %s", locator, value),
+		ExceptionCode: `InvalidParameterValue`,
+		LocatorCode:   locator,
+	}}
+}
+
+// VersionNegotiationFailed Exception
+func VersionNegotiationFailed(version string) Exception {
+	return Exception{ExceptionDetails: common.ExceptionDetails{
+		ExceptionText: version + " is an invalid version number",
+		ExceptionCode: `VersionNegotiationFailed`,
+		LocatorCode:   VERSION,
+	}}
+}
+
+// InvalidUpdateSequence Exception
+func InvalidUpdateSequence() Exception {
+	return Exception{ExceptionDetails: common.ExceptionDetails{
+		ExceptionCode: `InvalidUpdateSequence`,
+	}}
+}
+
+// NoApplicableCode Exception
+func NoApplicableCode(message string) Exception {
+	return Exception{ExceptionDetails: common.ExceptionDetails{
+		ExceptionText: message,
+		ExceptionCode: `NoApplicableCode`,
+	}}
+}
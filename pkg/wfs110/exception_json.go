@@ -0,0 +1,22 @@
+package wfs110
+
+import (
+	"github.com/pdok/ogc-specifications/pkg/common"
+)
+
+// Encode returns the Exceptions as a XML, JSON or problem+json report depending on the format,
+// together with its content type. The XML report is the OWS 1.0 ExceptionReport for WFS 1.1.0
+// and the ServiceExceptionReport for WFS 1.0.0.
+func (e Exceptions) Encode(format, version string) ([]byte, string) {
+	reportVersion := owsVersion
+	if version == Version100 {
+		reportVersion = serviceExceptionVersion
+	}
+
+	toXML := e.ToReport().ToBytes
+	if version == Version100 {
+		toXML = e.ToServiceExceptionReport().ToBytes
+	}
+	return common.EncodeExceptions(common.ExceptionReportJSON{Version: reportVersion, Exceptions: common.Details(e)},
+		format, e.StatusCode(), toXML)
+}
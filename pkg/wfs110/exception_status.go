@@ -0,0 +1,16 @@
+package wfs110
+
+import (
+	"github.com/pdok/ogc-specifications/pkg/common"
+)
+
+// StatusCode returns the HTTP status code for the Exception, the exception codes are the OWS Common codes
+// and unknown exception codes are considered to be server errors
+func (e Exception) StatusCode() int {
+	return common.StatusCode(nil, e)
+}
+
+// StatusCode returns the HTTP status code for a report with these Exceptions
+func (e Exceptions) StatusCode() int {
+	return common.StatusCode(nil, e...)
+}
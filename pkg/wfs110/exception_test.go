@@ -0,0 +1,83 @@
+package wfs110
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/pdok/ogc-specifications/pkg/wfs200"
+	"github.com/pdok/ogc-specifications/pkg/wsc110"
+)
+
+func TestExceptionsEncode(t *testing.T) {
+	var tests = []struct {
+		exceptions  Exceptions
+		format      string
+		version     string
+		contentType string
+		body        []string
+	}{
+		0: {exceptions: MissingParameterValue(TYPENAME).ToExceptions(), version: Version, contentType: `text/xml`,
+			body: []string{
+				`<ows:ExceptionReport xmlns:ows="http://www.opengis.net/ows"`,
+				`version="1.0.0" language="en">`,
+				`<ows:Exception exceptionCode="MissingParameterValue" locator="TYPENAME">`,
+				`<ows:ExceptionText>Missing key: TYPENAME</ows:ExceptionText>`}},
+		1: {exceptions: MissingParameterValue(TYPENAME).ToExceptions(), version: Version100, contentType: `text/xml`,
+			body: []string{
+				`<ServiceExceptionReport xmlns="http://www.opengis.net/ogc"`,
+				`version="1.2.0">`,
+				`<ServiceException code="MissingParameterValue" locator="TYPENAME">Missing key: TYPENAME</ServiceException>`}},
+		2: {exceptions: InvalidParameterValue(`2.0.0`, VERSION).ToExceptions(), format: `application/json`, version: Version100, contentType: `application/json`,
+			body: []string{`"version": "1.2.0"`, `"code": "InvalidParameterValue"`}},
+	}
+
+	for k, test := range tests {
+		body, contentType := test.exceptions.Encode(test.format, test.version)
+		if contentType != test.contentType {
+			t.Errorf("test: %d, expected: %s,\n got: %s", k, test.contentType, contentType)
+		}
+		for _, b := range test.body {
+			if !strings.Contains(string(body), b) {
+				t.Errorf("test: %d, expected: %s,\n got: %s", k, b, body)
+			}
+		}
+	}
+}
+
+func TestExceptionsStatusCode(t *testing.T) {
+	var tests = []struct {
+		exceptions Exceptions
+		statusCode int
+	}{
+		0: {exceptions: Exceptions{MissingParameterValue(TYPENAME)}, statusCode: http.StatusBadRequest},
+		1: {exceptions: Exceptions{OperationNotSupported(`Transaction`)}, statusCode: http.StatusNotImplemented},
+		2: {exceptions: Exceptions{NoApplicableCode(`failure`), InvalidParameterValue(`a`, BBOX)}, statusCode: http.StatusInternalServerError},
+	}
+
+	for k, test := range tests {
+		if statusCode := test.exceptions.StatusCode(); statusCode != test.statusCode {
+			t.Errorf("test: %d, expected: %d,\n got: %d", k, test.statusCode, statusCode)
+		}
+	}
+}
+
+func TestFromWFS200(t *testing.T) {
+	var tests = []struct {
+		exception wsc110.Exception
+		code      string
+		locator   string
+	}{
+		0: {exception: wsc110.MissingParameterValue(`TYPENAMES`), code: `MissingParameterValue`, locator: `TYPENAMES`},
+		1: {exception: wfs200.InvalidValue(`BBOX`), code: `InvalidParameterValue`, locator: `BBOX`},
+		2: {exception: wsc110.OptionNotSupported(`resolve`), code: `OperationNotSupported`},
+		3: {exception: wfs200.LockHasExpired(), code: `NoApplicableCode`},
+	}
+
+	for k, test := range tests {
+		exceptions := FromWFS200(test.exception)
+		if len(exceptions) != 1 || exceptions[0].Code() != test.code || exceptions[0].Locator() != test.locator {
+			t.Errorf("test: %d, expected: %s %s,\n got: %v", k, test.code, test.locator, exceptions)
+		}
+	}
+}
@@ -0,0 +1,576 @@
+package wfs110
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/pdok/ogc-specifications/pkg/wfs200"
+	"github.com/pdok/ogc-specifications/pkg/wsc110"
+)
+
+// Filter is a Filter Encoding 1.1.0 or 1.0.0 filter. The elements are matched by their local name,
+// so the ogc prefixed filters of XML requests and the unprefixed filters of KVP requests are both parsed.
+type Filter struct {
+	XMLName     xml.Name      `xml:"Filter" yaml:"filter"`
+	FeatureID   []FeatureID   `xml:"FeatureId" yaml:"featureId,omitempty"`
+	GmlObjectID []GmlObjectID `xml:"GmlObjectId" yaml:"gmlObjectId,omitempty"`
+	Operators
+}
+
+// FeatureID is a FES 1.x feature identifier
+type FeatureID struct {
+	Fid string `xml:"fid,attr" yaml:"fid"`
+}
+
+// GmlObjectID is a FES 1.1.0 identifier of a GML object, the identifier is the gml:id attribute
+type GmlObjectID struct {
+	ID string `xml:"id,attr" yaml:"id"`
+}
+
+// Operators contains the logical, comparison and spatial operators of a filter
+type Operators struct {
+	And []Operators `xml:"And" yaml:"and,omitempty"`
+	Or  []Operators `xml:"Or" yaml:"or,omitempty"`
+	Not []Operators `xml:"Not" yaml:"not,omitempty"`
+
+	PropertyIsEqualTo              []BinaryComparisonOperator `xml:"PropertyIsEqualTo" yaml:"propertyIsEqualTo,omitempty"`
+	PropertyIsNotEqualTo           []BinaryComparisonOperator `xml:"PropertyIsNotEqualTo" yaml:"propertyIsNotEqualTo,omitempty"`
+	PropertyIsLessThan             []BinaryComparisonOperator `xml:"PropertyIsLessThan" yaml:"propertyIsLessThan,omitempty"`
+	PropertyIsGreaterThan          []BinaryComparisonOperator `xml:"PropertyIsGreaterThan" yaml:"propertyIsGreaterThan,omitempty"`
+	PropertyIsLessThanOrEqualTo    []BinaryComparisonOperator `xml:"PropertyIsLessThanOrEqualTo" yaml:"propertyIsLessThanOrEqualTo,omitempty"`
+	PropertyIsGreaterThanOrEqualTo []BinaryComparisonOperator `xml:"PropertyIsGreaterThanOrEqualTo" yaml:"propertyIsGreaterThanOrEqualTo,omitempty"`
+	PropertyIsLike                 []PropertyIsLike           `xml:"PropertyIsLike" yaml:"propertyIsLike,omitempty"`
+	PropertyIsNull                 []PropertyIsNull           `xml:"PropertyIsNull" yaml:"propertyIsNull,omitempty"`
+	PropertyIsBetween              []PropertyIsBetween        `xml:"PropertyIsBetween" yaml:"propertyIsBetween,omitempty"`
+
+	Equals     []SpatialOperator  `xml:"Equals" yaml:"equals,omitempty"`
+	Disjoint   []SpatialOperator  `xml:"Disjoint" yaml:"disjoint,omitempty"`
+	Touches    []SpatialOperator  `xml:"Touches" yaml:"touches,omitempty"`
+	Within     []SpatialOperator  `xml:"Within" yaml:"within,omitempty"`
+	Overlaps   []SpatialOperator  `xml:"Overlaps" yaml:"overlaps,omitempty"`
+	Crosses    []SpatialOperator  `xml:"Crosses" yaml:"crosses,omitempty"`
+	Intersects []SpatialOperator  `xml:"Intersects" yaml:"intersects,omitempty"`
+	Contains   []SpatialOperator  `xml:"Contains" yaml:"contains,omitempty"`
+	DWithin    []DistanceOperator `xml:"DWithin" yaml:"dWithin,omitempty"`
+	Beyond     []DistanceOperator `xml:"Beyond" yaml:"beyond,omitempty"`
+	BBOX       []BBOXOperator     `xml:"BBOX" yaml:"bbox,omitempty"`
+}
+
+// BinaryComparisonOperator compares a property with a literal
+type BinaryComparisonOperator struct {
+	MatchCase    *string `xml:"matchCase,attr" yaml:"matchCase,omitempty"`
+	PropertyName string  `xml:"PropertyName" yaml:"propertyName"`
+	Literal      string  `xml:"Literal" yaml:"literal"`
+}
+
+// PropertyIsLike matches a property with a pattern, FES 1.0.0 names the escape character escape and FES 1.1.0 escapeChar
+type PropertyIsLike struct {
+	WildCard     string  `xml:"wildCard,attr" yaml:"wildCard"`
+	SingleChar   string  `xml:"singleChar,attr" yaml:"singleChar"`
+	EscapeChar   string  `xml:"escapeChar,attr,omitempty" yaml:"escapeChar,omitempty"`
+	Escape       string  `xml:"escape,attr,omitempty" yaml:"escape,omitempty"`
+	MatchCase    *string `xml:"matchCase,attr" yaml:"matchCase,omitempty"`
+	PropertyName string  `xml:"PropertyName" yaml:"propertyName"`
+	Literal      string  `xml:"Literal" yaml:"literal"`
+}
+
+// PropertyIsNull tests if a property is null
+type PropertyIsNull struct {
+	PropertyName string `xml:"PropertyName" yaml:"propertyName"`
+}
+
+// PropertyIsBetween tests if a property is between the literals of the boundaries
+type PropertyIsBetween struct {
+	PropertyName  string `xml:"PropertyName" yaml:"propertyName"`
+	LowerBoundary string `xml:"LowerBoundary>Literal" yaml:"lowerBoundary"`
+	UpperBoundary string `xml:"UpperBoundary>Literal" yaml:"upperBoundary"`
+}
+
+// SpatialOperator relates a geometry property with a GML 2 or GML 3.1 geometry
+type SpatialOperator struct {
+	PropertyName string `xml:"PropertyName" yaml:"propertyName"`
+	GeometryOperand
+}
+
+// DistanceOperator relates a geometry property with a geometry, within or beyond the distance
+type DistanceOperator struct {
+	PropertyName string `xml:"PropertyName" yaml:"propertyName"`
+	GeometryOperand
+	Distance wfs200.Distance `xml:"Distance" yaml:"distance"`
+}
+
+// BBOXOperator tests if a geometry property intersects the GML 2 Box or GML 3.1 Envelope
+type BBOXOperator struct {
+	PropertyName string    `xml:"PropertyName" yaml:"propertyName"`
+	Box          *Envelope `xml:"Box" yaml:"box,omitempty"`
+	Envelope     *Envelope `xml:"Envelope" yaml:"envelope,omitempty"`
+}
+
+// GeometryOperand contains the geometry of a spatial operator, the content of the geometry is kept as is
+type GeometryOperand struct {
+	Point           *wfs200.Geometry `xml:"Point" yaml:"point,omitempty"`
+	MultiPoint      *wfs200.Geometry `xml:"MultiPoint" yaml:"multiPoint,omitempty"`
+	LineString      *wfs200.Geometry `xml:"LineString" yaml:"lineString,omitempty"`
+	MultiLineString *wfs200.Geometry `xml:"MultiLineString" yaml:"multiLineString,omitempty"`
+	Curve           *wfs200.Geometry `xml:"Curve" yaml:"curve,omitempty"`
+	MultiCurve      *wfs200.Geometry `xml:"MultiCurve" yaml:"multiCurve,omitempty"`
+	Polygon         *wfs200.Geometry `xml:"Polygon" yaml:"polygon,omitempty"`
+	MultiPolygon    *wfs200.Geometry `xml:"MultiPolygon" yaml:"multiPolygon,omitempty"`
+	Surface         *wfs200.Geometry `xml:"Surface" yaml:"surface,omitempty"`
+	MultiSurface    *wfs200.Geometry `xml:"MultiSurface" yaml:"multiSurface,omitempty"`
+	Box             *Envelope        `xml:"Box" yaml:"box,omitempty"`
+	Envelope        *Envelope        `xml:"Envelope" yaml:"envelope,omitempty"`
+}
+
+// Envelope is a GML 2 Box, with coordinates or coord elements, or a GML 3.1 Envelope, with corners or pos elements
+type Envelope struct {
+	SrsName     string       `xml:"srsName,attr" yaml:"srsName,omitempty"`
+	Coordinates *Coordinates `xml:"coordinates" yaml:"coordinates,omitempty"`
+	Coord       []Coord      `xml:"coord" yaml:"coord,omitempty"`
+	LowerCorner string       `xml:"lowerCorner" yaml:"lowerCorner,omitempty"`
+	UpperCorner string       `xml:"upperCorner" yaml:"upperCorner,omitempty"`
+	Pos         []string     `xml:"pos" yaml:"pos,omitempty"`
+	Content     string       `xml:",innerxml" yaml:"-"`
+}
+
+// Coordinates is a GML 2 coordinates list, by default the coordinates are separated by a comma and the tuples by a space
+type Coordinates struct {
+	Decimal string `xml:"decimal,attr" yaml:"decimal,omitempty"`
+	Cs      string `xml:"cs,attr" yaml:"cs,omitempty"`
+	Ts      string `xml:"ts,attr" yaml:"ts,omitempty"`
+	Text    string `xml:",chardata" yaml:"text"`
+}
+
+// Coord is a GML 2 coordinate
+type Coord struct {
+	X string `xml:"X" yaml:"x"`
+	Y string `xml:"Y" yaml:"y"`
+}
+
+// ParseFilter parses a FES 1.x filter document
+func ParseFilter(doc []byte) (Filter, error) {
+	var f Filter
+	if err := xml.Unmarshal(doc, &f); err != nil {
+		return f, err
+	}
+	return f, nil
+}
+
+// ToWFS200 translates the filter into the FES 2.0 filter of the wfs200 package. The property names become
+// value references, the feature and GML object identifiers resource identifiers and the BBOX gets the URN of its
+// srsName with the coordinates in the axis order of that CRS. WFS 1.0.0 and the EPSG:4326 notation of WFS 1.1.0
+// always use the x/y axis order, for WFS 1.1.0 the URN notation follows the axis order of the CRS.
+// An error is returned for the parts of the filter the wfs200 filter can't express.
+func (f Filter) ToWFS200(version string) (*wfs200.Filter, error) {
+	if len(f.FeatureID) > 0 || len(f.GmlObjectID) > 0 {
+		if !f.Operators.empty() {
+			return nil, errors.New(`the FeatureId and GmlObjectId can't be combined with other operators`)
+		}
+		var rids wfs200.ResourceIDs
+		for _, id := range f.FeatureID {
+			rids = append(rids, wfs200.ResourceID{Rid: id.Fid})
+		}
+		for _, id := range f.GmlObjectID {
+			rids = append(rids, wfs200.ResourceID{Rid: id.ID})
+		}
+		return &wfs200.Filter{ResourceID: &rids}, nil
+	}
+
+	o, err := f.Operators.toWFS200(version)
+	if err != nil {
+		return nil, err
+	}
+	return &wfs200.Filter{AND: o.AND, OR: o.OR, NOT: o.NOT, ComparisonOperator: o.ComparisonOperator, SpatialOperator: o.SpatialOperator}, nil
+}
+
+func (o Operators) empty() bool {
+	return reflect.ValueOf(o).IsZero()
+}
+
+// toWFS200 translates the operators into a wfs200.AND, that has the same operators as a wfs200.OR, wfs200.NOT and
+// the wfs200.Filter itself. Those have a single logical and spatial operator of each kind.
+//
+//nolint:cyclop,funlen
+func (o Operators) toWFS200(version string) (wfs200.AND, error) {
+	var r wfs200.AND
+	counts := []struct {
+		name  string
+		count int
+	}{{`And`, len(o.And)}, {`Or`, len(o.Or)}, {`Not`, len(o.Not)},
+		{`Equals`, len(o.Equals)}, {`Disjoint`, len(o.Disjoint)}, {`Touches`, len(o.Touches)}, {`Within`, len(o.Within)},
+		{`Overlaps`, len(o.Overlaps)}, {`Crosses`, len(o.Crosses)}, {`Intersects`, len(o.Intersects)},
+		{`Contains`, len(o.Contains)}, {`DWithin`, len(o.DWithin)}, {`Beyond`, len(o.Beyond)}, {`BBOX`, len(o.BBOX)}}
+	for _, c := range counts {
+		if c.count > 1 {
+			return r, fmt.Errorf(`more than one %s in the same operator can't be translated into FES 2.0`, c.name)
+		}
+	}
+	if len(o.And) == 1 {
+		and, err := o.And[0].toWFS200(version)
+		if err != nil {
+			return r, err
+		}
+		r.AND = &and
+	}
+	if len(o.Or) == 1 {
+		and, err := o.Or[0].toWFS200(version)
+		if err != nil {
+			return r, err
+		}
+		or := wfs200.OR(and)
+		r.OR = &or
+	}
+	if len(o.Not) == 1 {
+		and, err := o.Not[0].toWFS200(version)
+		if err != nil {
+			return r, err
+		}
+		not := wfs200.NOT(and)
+		r.NOT = &not
+	}
+
+	r.ComparisonOperator = o.comparisonOperator()
+
+	if len(o.Equals) == 1 {
+		g, err := o.Equals[0].toWFS200(version)
+		if err != nil {
+			return r, err
+		}
+		r.Equals = &wfs200.Equals{PropertyName: o.Equals[0].PropertyName, GeometryOperand: g}
+	}
+	if len(o.Disjoint) == 1 {
+		g, err := o.Disjoint[0].toWFS200(version)
+		if err != nil {
+			return r, err
+		}
+		r.Disjoint = &wfs200.Disjoint{PropertyName: o.Disjoint[0].PropertyName, GeometryOperand: g}
+	}
+	if len(o.Touches) == 1 {
+		g, err := o.Touches[0].toWFS200(version)
+		if err != nil {
+			return r, err
+		}
+		r.Touches = &wfs200.Touches{PropertyName: o.Touches[0].PropertyName, GeometryOperand: g}
+	}
+	if len(o.Within) == 1 {
+		g, err := o.Within[0].toWFS200(version)
+		if err != nil {
+			return r, err
+		}
+		r.Within = &wfs200.Within{PropertyName: o.Within[0].PropertyName, GeometryOperand: g}
+	}
+	if len(o.Overlaps) == 1 {
+		g, err := o.Overlaps[0].toWFS200(version)
+		if err != nil {
+			return r, err
+		}
+		r.Overlaps = &wfs200.Overlaps{PropertyName: o.Overlaps[0].PropertyName, GeometryOperand: g}
+	}
+	if len(o.Crosses) == 1 {
+		g, err := o.Crosses[0].toWFS200(version)
+		if err != nil {
+			return r, err
+		}
+		r.Crosses = &wfs200.Crosses{PropertyName: o.Crosses[0].PropertyName, GeometryOperand: g}
+	}
+	if len(o.Intersects) == 1 {
+		g, err := o.Intersects[0].toWFS200(version)
+		if err != nil {
+			return r, err
+		}
+		r.Intersects = &wfs200.Intersects{PropertyName: o.Intersects[0].PropertyName, GeometryOperand: g}
+	}
+	if len(o.Contains) == 1 {
+		g, err := o.Contains[0].toWFS200(version)
+		if err != nil {
+			return r, err
+		}
+		r.Contains = &wfs200.Contains{PropertyName: o.Contains[0].PropertyName, GeometryOperand: g}
+	}
+	if len(o.DWithin) == 1 {
+		g, err := o.DWithin[0].toWFS200(version)
+		if err != nil {
+			return r, err
+		}
+		r.DWithin = &wfs200.DWithin{PropertyName: o.DWithin[0].PropertyName, GeometryOperand: g, Distance: o.DWithin[0].Distance}
+	}
+	if len(o.Beyond) == 1 {
+		// the wfs200.Beyond has no property name, so only a Beyond on the default geometry property can be translated
+		if o.Beyond[0].PropertyName != `` {
+			return r, errors.New(`Beyond with a PropertyName can't be translated into FES 2.0`)
+		}
+		g, err := o.Beyond[0].toWFS200(version)
+		if err != nil {
+			return r, err
+		}
+		r.Beyond = &wfs200.Beyond{GeometryOperand: g, Distance: o.Beyond[0].Distance}
+	}
+	if len(o.BBOX) == 1 {
+		bbox, err := o.BBOX[0].toWFS200(version)
+		if err != nil {
+			return r, err
+		}
+		r.BBOX = &bbox
+	}
+	return r, nil
+}
+
+func (o Operators) comparisonOperator() wfs200.ComparisonOperator {
+	var c wfs200.ComparisonOperator
+	if len(o.PropertyIsEqualTo) > 0 {
+		var ops []wfs200.PropertyIsEqualTo
+		for _, op := range o.PropertyIsEqualTo {
+			ops = append(ops, wfs200.PropertyIsEqualTo{ComparisonOperatorAttribute: op.toWFS200()})
+		}
+		c.PropertyIsEqualTo = &ops
+	}
+	if len(o.PropertyIsNotEqualTo) > 0 {
+		var ops []wfs200.PropertyIsNotEqualTo
+		for _, op := range o.PropertyIsNotEqualTo {
+			ops = append(ops, wfs200.PropertyIsNotEqualTo{ComparisonOperatorAttribute: op.toWFS200()})
+		}
+		c.PropertyIsNotEqualTo = &ops
+	}
+	if len(o.PropertyIsLessThan) > 0 {
+		var ops []wfs200.PropertyIsLessThan
+		for _, op := range o.PropertyIsLessThan {
+			ops = append(ops, wfs200.PropertyIsLessThan{ComparisonOperatorAttribute: op.toWFS200()})
+		}
+		c.PropertyIsLessThan = &ops
+	}
+	if len(o.PropertyIsGreaterThan) > 0 {
+		var ops []wfs200.PropertyIsGreaterThan
+		for _, op := range o.PropertyIsGreaterThan {
+			ops = append(ops, wfs200.PropertyIsGreaterThan{ComparisonOperatorAttribute: op.toWFS200()})
+		}
+		c.PropertyIsGreaterThan = &ops
+	}
+	if len(o.PropertyIsLessThanOrEqualTo) > 0 {
+		var ops []wfs200.PropertyIsLessThanOrEqualTo
+		for _, op := range o.PropertyIsLessThanOrEqualTo {
+			ops = append(ops, wfs200.PropertyIsLessThanOrEqualTo{ComparisonOperatorAttribute: op.toWFS200()})
+		}
+		c.PropertyIsLessThanOrEqualTo = &ops
+	}
+	if len(o.PropertyIsGreaterThanOrEqualTo) > 0 {
+		var ops []wfs200.PropertyIsGreaterThanOrEqualTo
+		for _, op := range o.PropertyIsGreaterThanOrEqualTo {
+			ops = append(ops, wfs200.PropertyIsGreaterThanOrEqualTo{ComparisonOperatorAttribute: op.toWFS200()})
+		}
+		c.PropertyIsGreaterThanOrEqualTo = &ops
+	}
+	if len(o.PropertyIsLike) > 0 {
+		var ops []wfs200.PropertyIsLike
+		for _, op := range o.PropertyIsLike {
+			ops = append(ops, op.toWFS200())
+		}
+		c.PropertyIsLike = &ops
+	}
+	if len(o.PropertyIsBetween) > 0 {
+		var ops []wfs200.PropertyIsBetween
+		for _, op := range o.PropertyIsBetween {
+			ops = append(ops, wfs200.PropertyIsBetween(op))
+		}
+		c.PropertyIsBetween = &ops
+	}
+	if len(o.PropertyIsNull) > 0 {
+		var ops []wfs200.PropertyIsNull
+		for _, op := range o.PropertyIsNull {
+			ops = append(ops, wfs200.PropertyIsNull{ValueReference: sp(op.PropertyName)})
+		}
+		c.PropertyIsNull = &ops
+	}
+	return c
+}
+
+// toWFS200 returns the FES 2.0 comparison, with the property name as value reference
+func (c BinaryComparisonOperator) toWFS200() wfs200.ComparisonOperatorAttribute {
+	return wfs200.ComparisonOperatorAttribute{MatchCase: c.MatchCase, ValueReference: sp(c.PropertyName), Literal: c.Literal}
+}
+
+func (l PropertyIsLike) toWFS200() wfs200.PropertyIsLike {
+	escape := l.EscapeChar
+	if escape == `` {
+		escape = l.Escape
+	}
+	return wfs200.PropertyIsLike{
+		Wildcard:   l.WildCard,
+		SingleChar: l.SingleChar,
+		Escape:     escape,
+		ComparisonOperatorAttribute: wfs200.ComparisonOperatorAttribute{
+			MatchCase: l.MatchCase, ValueReference: sp(l.PropertyName), Literal: l.Literal},
+	}
+}
+
+// toWFS200 returns the FES 2.0 geometry operand, an Envelope gets the URN of its CRS and the coordinates in its axis order
+func (g GeometryOperand) toWFS200(version string) (wfs200.GeometryOperand, error) {
+	var r wfs200.GeometryOperand
+	if g.Point != nil {
+		r.Point = &wfs200.Point{Geometry: *g.Point}
+	}
+	if g.MultiPoint != nil {
+		r.MultiPoint = &wfs200.MultiPoint{Geometry: *g.MultiPoint}
+	}
+	if g.LineString != nil {
+		r.LineString = &wfs200.LineString{Geometry: *g.LineString}
+	}
+	if g.MultiLineString != nil {
+		r.MultiLineString = &wfs200.MultiLineString{Geometry: *g.MultiLineString}
+	}
+	if g.Curve != nil {
+		r.Curve = &wfs200.Curve{Geometry: *g.Curve}
+	}
+	if g.MultiCurve != nil {
+		r.MultiCurve = &wfs200.MultiCurve{Geometry: *g.MultiCurve}
+	}
+	if g.Polygon != nil {
+		r.Polygon = &wfs200.Polygon{Geometry: *g.Polygon}
+	}
+	if g.MultiPolygon != nil {
+		r.MultiPolygon = &wfs200.MultiPolygon{Geometry: *g.MultiPolygon}
+	}
+	if g.Surface != nil {
+		r.Surface = &wfs200.Surface{Geometry: *g.Surface}
+	}
+	if g.MultiSurface != nil {
+		r.MultiSurface = &wfs200.MultiSurface{Geometry: *g.MultiSurface}
+	}
+	if g.Box != nil {
+		r.Box = &wfs200.Box{Geometry: wfs200.Geometry{SrsName: g.Box.SrsName, Content: g.Box.Content}}
+	}
+	if g.Envelope != nil {
+		lower, upper, err := g.Envelope.Corners()
+		if err != nil {
+			return r, err
+		}
+		envelope := wfs200.Envelope{LowerCorner: lower, UpperCorner: upper}
+		if g.Envelope.SrsName != `` {
+			var srsName *string
+			srsName, envelope = srsEnvelope(version, g.Envelope.SrsName, envelope)
+			envelope.SrsName = srsName
+		}
+		r.Envelope = &envelope
+	}
+	return r, nil
+}
+
+// toWFS200 returns the FES 2.0 BBOX, with the URN of the CRS and the coordinates in its axis order
+func (b BBOXOperator) toWFS200(version string) (wfs200.GEOBBOX, error) {
+	envelope := b.Envelope
+	if envelope == nil {
+		envelope = b.Box
+	}
+	if envelope == nil {
+		return wfs200.GEOBBOX{}, errors.New(`the BBOX has no Box or Envelope`)
+	}
+	lower, upper, err := envelope.Corners()
+	if err != nil {
+		return wfs200.GEOBBOX{}, err
+	}
+
+	bbox := wfs200.GEOBBOX{Envelope: wfs200.Envelope{LowerCorner: lower, UpperCorner: upper}}
+	if b.PropertyName != `` {
+		bbox.ValueReference = sp(b.PropertyName)
+	}
+	if envelope.SrsName != `` {
+		bbox.SrsName, bbox.Envelope = srsEnvelope(version, envelope.SrsName, bbox.Envelope)
+	}
+	return bbox, nil
+}
+
+// srsEnvelope returns the URN of the srsName, with the envelope in the axis order of that CRS.
+// Unknown CRSs are returned as is.
+func srsEnvelope(version, srsName string, e wfs200.Envelope) (*string, wfs200.Envelope) {
+	var c wfs200.CRS
+	c.ParseString(srsName)
+	if c.Code == 0 {
+		return sp(srsName), e
+	}
+	if eastNorth(version, srsName) && c.NorthEast() {
+		e = e.SwapAxis()
+	}
+	return sp(c.Identifier()), e
+}
+
+// eastNorth returns whether the coordinates are in the x/y (east/north) axis order regardless of the CRS,
+// that is the case for WFS 1.0.0 and for the EPSG:4326 and epsg.xml#4326 notations of WFS 1.1.0
+func eastNorth(version, srsName string) bool {
+	if version == Version100 {
+		return true
+	}
+	lower := strings.ToLower(strings.TrimSpace(srsName))
+	return !strings.HasPrefix(lower, `urn:`) && !strings.Contains(lower, `/def/crs/`)
+}
+
+// Corners returns the lower and upper corner of the Envelope, as they are given
+func (e Envelope) Corners() (wsc110.Position, wsc110.Position, error) {
+	var positions [][]string
+	switch {
+	case e.LowerCorner != `` || e.UpperCorner != ``:
+		positions = [][]string{strings.Fields(e.LowerCorner), strings.Fields(e.UpperCorner)}
+	case len(e.Pos) > 0:
+		for _, pos := range e.Pos {
+			positions = append(positions, strings.Fields(pos))
+		}
+	case len(e.Coord) > 0:
+		for _, coord := range e.Coord {
+			positions = append(positions, []string{strings.TrimSpace(coord.X), strings.TrimSpace(coord.Y)})
+		}
+	case e.Coordinates != nil:
+		positions = e.Coordinates.tuples()
+	}
+
+	if len(positions) != 2 {
+		return wsc110.Position{}, wsc110.Position{}, errors.New(`the envelope needs a lower and upper corner`)
+	}
+	var corners [2]wsc110.Position
+	for i, position := range positions {
+		if len(position) < 2 {
+			return wsc110.Position{}, wsc110.Position{}, fmt.Errorf(`the corner %s needs two coordinates`, strings.Join(position, ` `))
+		}
+		for j := range corners[i] {
+			v, err := strconv.ParseFloat(position[j], 64)
+			if err != nil {
+				return wsc110.Position{}, wsc110.Position{}, fmt.Errorf(`the coordinate %s is not a number`, position[j])
+			}
+			corners[i][j] = v
+		}
+	}
+	return corners[0], corners[1], nil
+}
+
+// tuples splits the coordinates in the tuples and their coordinates, with the decimal separator as a point
+func (c Coordinates) tuples() [][]string {
+	decimal, cs, ts := `.`, `,`, ` `
+	if c.Decimal != `` {
+		decimal = c.Decimal
+	}
+	if c.Cs != `` {
+		cs = c.Cs
+	}
+	if c.Ts != `` {
+		ts = c.Ts
+	}
+
+	split := strings.Fields(c.Text)
+	if strings.TrimSpace(ts) != `` {
+		split = strings.Split(c.Text, ts)
+	}
+
+	var tuples [][]string
+	for _, tuple := range split {
+		if tuple = strings.TrimSpace(tuple); tuple == `` {
+			continue
+		}
+		var coordinates []string
+		for _, coordinate := range strings.Split(tuple, cs) {
+			coordinates = append(coordinates, strings.ReplaceAll(strings.TrimSpace(coordinate), decimal, `.`))
+		}
+		tuples = append(tuples, coordinates)
+	}
+	return tuples
+}
@@ -0,0 +1,143 @@
+package wfs110
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/pdok/ogc-specifications/pkg/wfs200"
+	"github.com/pdok/ogc-specifications/pkg/wsc110"
+)
+
+func TestFilterToWFS200(t *testing.T) {
+	var tests = []struct {
+		version string
+		filter  string
+		result  *wfs200.Filter
+		err     bool
+	}{
+		0: {version: Version,
+			filter: `<ogc:Filter xmlns:ogc="http://www.opengis.net/ogc"><ogc:PropertyIsEqualTo matchCase="false"><ogc:PropertyName>name</ogc:PropertyName><ogc:Literal>Rhine</ogc:Literal></ogc:PropertyIsEqualTo></ogc:Filter>`,
+			result: &wfs200.Filter{ComparisonOperator: wfs200.ComparisonOperator{
+				PropertyIsEqualTo: &[]wfs200.PropertyIsEqualTo{{ComparisonOperatorAttribute: wfs200.ComparisonOperatorAttribute{
+					MatchCase: sp(`false`), ValueReference: sp(`name`), Literal: `Rhine`}}}}}},
+		1: {version: Version100,
+			filter: `<Filter><FeatureId fid="rivers.1"/><FeatureId fid="rivers.2"/></Filter>`,
+			result: &wfs200.Filter{ResourceID: &wfs200.ResourceIDs{{Rid: `rivers.1`}, {Rid: `rivers.2`}}}},
+		2: {version: Version,
+			filter: `<ogc:Filter xmlns:ogc="http://www.opengis.net/ogc" xmlns:gml="http://www.opengis.net/gml"><ogc:GmlObjectId gml:id="rivers.1"/></ogc:Filter>`,
+			result: &wfs200.Filter{ResourceID: &wfs200.ResourceIDs{{Rid: `rivers.1`}}}},
+		// EPSG:4326 is in the x/y axis order, the URN in the latitude/longitude axis order
+		3: {version: Version,
+			filter: `<ogc:Filter xmlns:ogc="http://www.opengis.net/ogc" xmlns:gml="http://www.opengis.net/gml"><ogc:And>
+				<ogc:PropertyIsGreaterThan><ogc:PropertyName>length</ogc:PropertyName><ogc:Literal>100</ogc:Literal></ogc:PropertyIsGreaterThan>
+				<ogc:BBOX><ogc:PropertyName>geom</ogc:PropertyName><gml:Envelope srsName="EPSG:4326"><gml:lowerCorner>5 50</gml:lowerCorner><gml:upperCorner>6 52</gml:upperCorner></gml:Envelope></ogc:BBOX>
+				</ogc:And></ogc:Filter>`,
+			result: &wfs200.Filter{AND: &wfs200.AND{
+				ComparisonOperator: wfs200.ComparisonOperator{
+					PropertyIsGreaterThan: &[]wfs200.PropertyIsGreaterThan{{ComparisonOperatorAttribute: wfs200.ComparisonOperatorAttribute{
+						ValueReference: sp(`length`), Literal: `100`}}}},
+				SpatialOperator: wfs200.SpatialOperator{BBOX: &wfs200.GEOBBOX{
+					SrsName:        sp(`urn:ogc:def:crs:EPSG::4326`),
+					ValueReference: sp(`geom`),
+					Envelope:       wfs200.Envelope{LowerCorner: wsc110.Position{50, 5}, UpperCorner: wsc110.Position{52, 6}}}}}}},
+		4: {version: Version,
+			filter: `<Filter><BBOX><PropertyName>geom</PropertyName><Envelope srsName="urn:ogc:def:crs:EPSG::4326"><lowerCorner>50 5</lowerCorner><upperCorner>52 6</upperCorner></Envelope></BBOX></Filter>`,
+			result: &wfs200.Filter{SpatialOperator: wfs200.SpatialOperator{BBOX: &wfs200.GEOBBOX{
+				SrsName:        sp(`urn:ogc:def:crs:EPSG::4326`),
+				ValueReference: sp(`geom`),
+				Envelope:       wfs200.Envelope{LowerCorner: wsc110.Position{50, 5}, UpperCorner: wsc110.Position{52, 6}}}}}},
+		// WFS 1.0.0 is always in the x/y axis order
+		5: {version: Version100,
+			filter: `<Filter><BBOX><PropertyName>geom</PropertyName><gml:Box xmlns:gml="http://www.opengis.net/gml" srsName="http://www.opengis.net/gml/srs/epsg.xml#28992"><gml:coordinates>100000,400000 110000,410000</gml:coordinates></gml:Box></BBOX></Filter>`,
+			result: &wfs200.Filter{SpatialOperator: wfs200.SpatialOperator{BBOX: &wfs200.GEOBBOX{
+				SrsName:        sp(`urn:ogc:def:crs:EPSG::28992`),
+				ValueReference: sp(`geom`),
+				Envelope:       wfs200.Envelope{LowerCorner: wsc110.Position{100000, 400000}, UpperCorner: wsc110.Position{110000, 410000}}}}}},
+		6: {version: Version100,
+			filter: `<Filter><Not><PropertyIsLike wildCard="*" singleChar="." escape="!"><PropertyName>name</PropertyName><Literal>R*</Literal></PropertyIsLike></Not></Filter>`,
+			result: &wfs200.Filter{NOT: &wfs200.NOT{ComparisonOperator: wfs200.ComparisonOperator{
+				PropertyIsLike: &[]wfs200.PropertyIsLike{{Wildcard: `*`, SingleChar: `.`, Escape: `!`,
+					ComparisonOperatorAttribute: wfs200.ComparisonOperatorAttribute{ValueReference: sp(`name`), Literal: `R*`}}}}}}},
+		7: {version: Version,
+			filter: `<Filter><PropertyIsBetween><PropertyName>length</PropertyName><LowerBoundary><Literal>10</Literal></LowerBoundary><UpperBoundary><Literal>20</Literal></UpperBoundary></PropertyIsBetween></Filter>`,
+			result: &wfs200.Filter{ComparisonOperator: wfs200.ComparisonOperator{
+				PropertyIsBetween: &[]wfs200.PropertyIsBetween{{PropertyName: `length`, LowerBoundary: `10`, UpperBoundary: `20`}}}}},
+		8: {version: Version,
+			filter: `<Filter><PropertyIsNull><PropertyName>name</PropertyName></PropertyIsNull></Filter>`,
+			result: &wfs200.Filter{ComparisonOperator: wfs200.ComparisonOperator{
+				PropertyIsNull: &[]wfs200.PropertyIsNull{{ValueReference: sp(`name`)}}}}},
+		// FES 2.0 as modelled by wfs200 has a single And in an Or
+		9: {version: Version,
+			filter: `<Filter><Or><And><PropertyIsEqualTo><PropertyName>a</PropertyName><Literal>1</Literal></PropertyIsEqualTo></And><And><PropertyIsEqualTo><PropertyName>b</PropertyName><Literal>2</Literal></PropertyIsEqualTo></And></Or></Filter>`,
+			err:    true},
+		10: {version: Version,
+			filter: `<Filter><FeatureId fid="rivers.1"/><PropertyIsEqualTo><PropertyName>a</PropertyName><Literal>1</Literal></PropertyIsEqualTo></Filter>`,
+			err:    true},
+		// the Envelope of a spatial operator gets the URN and axis order of its CRS, like the BBOX
+		11: {version: Version,
+			filter: `<Filter><Intersects><PropertyName>geom</PropertyName><Envelope srsName="EPSG:4326"><lowerCorner>5 50</lowerCorner><upperCorner>6 52</upperCorner></Envelope></Intersects></Filter>`,
+			result: &wfs200.Filter{SpatialOperator: wfs200.SpatialOperator{Intersects: &wfs200.Intersects{
+				PropertyName: `geom`,
+				GeometryOperand: wfs200.GeometryOperand{Envelope: &wfs200.Envelope{
+					SrsName: sp(`urn:ogc:def:crs:EPSG::4326`), LowerCorner: wsc110.Position{50, 5}, UpperCorner: wsc110.Position{52, 6}}}}}}},
+		12: {version: Version,
+			filter: `<Filter><Intersects><PropertyName>geom</PropertyName><Envelope><lowerCorner>5 50</lowerCorner></Envelope></Intersects></Filter>`,
+			err:    true},
+		// the wfs200.Beyond has no property name
+		13: {version: Version,
+			filter: `<Filter><Beyond><PropertyName>geom</PropertyName><Envelope><lowerCorner>5 50</lowerCorner><upperCorner>6 52</upperCorner></Envelope><Distance units="m">10</Distance></Beyond></Filter>`,
+			err:    true},
+	}
+
+	for k, test := range tests {
+		f, err := ParseFilter([]byte(test.filter))
+		if err != nil {
+			t.Errorf("test: %d, expected no error,\n got: %s", k, err)
+			continue
+		}
+		result, err := f.ToWFS200(test.version)
+		if test.err {
+			if err == nil {
+				t.Errorf("test: %d, expected an error,\n got: %+v", k, result)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("test: %d, expected no error,\n got: %s", k, err)
+		} else if !reflect.DeepEqual(result, test.result) {
+			t.Errorf("test: %d, expected: %+v,\n got: %+v", k, test.result, result)
+		}
+	}
+}
+
+func TestEnvelopeCorners(t *testing.T) {
+	var tests = []struct {
+		envelope Envelope
+		lower    wsc110.Position
+		upper    wsc110.Position
+		err      bool
+	}{
+		0: {envelope: Envelope{LowerCorner: `1 2`, UpperCorner: `3 4`}, lower: wsc110.Position{1, 2}, upper: wsc110.Position{3, 4}},
+		1: {envelope: Envelope{Pos: []string{`1 2`, `3 4`}}, lower: wsc110.Position{1, 2}, upper: wsc110.Position{3, 4}},
+		2: {envelope: Envelope{Coord: []Coord{{X: `1`, Y: `2`}, {X: `3`, Y: `4`}}}, lower: wsc110.Position{1, 2}, upper: wsc110.Position{3, 4}},
+		3: {envelope: Envelope{Coordinates: &Coordinates{Text: "1.5,2\n 3,4"}}, lower: wsc110.Position{1.5, 2}, upper: wsc110.Position{3, 4}},
+		4: {envelope: Envelope{Coordinates: &Coordinates{Decimal: `,`, Cs: ` `, Ts: `;`, Text: `1,5 2;3 4`}}, lower: wsc110.Position{1.5, 2}, upper: wsc110.Position{3, 4}},
+		5: {envelope: Envelope{LowerCorner: `1 2`}, err: true},
+		6: {envelope: Envelope{LowerCorner: `1 a`, UpperCorner: `3 4`}, err: true},
+	}
+
+	for k, test := range tests {
+		lower, upper, err := test.envelope.Corners()
+		if test.err {
+			if err == nil {
+				t.Errorf("test: %d, expected an error,\n got: %v %v", k, lower, upper)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("test: %d, expected no error,\n got: %s", k, err)
+		} else if lower != test.lower || upper != test.upper {
+			t.Errorf("test: %d, expected: %v %v,\n got: %v %v", k, test.lower, test.upper, lower, upper)
+		}
+	}
+}
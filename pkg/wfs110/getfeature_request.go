@@ -0,0 +1,337 @@
+package wfs110
+
+import (
+	"encoding/xml"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/pdok/ogc-specifications/pkg/utils"
+	"github.com/pdok/ogc-specifications/pkg/wfs200"
+	"github.com/pdok/ogc-specifications/pkg/wsc110"
+)
+
+// WFS 1.1.0 and 1.0.0 GetFeature keys
+const (
+	MAXFEATURES  = `MAXFEATURES`
+	FEATUREID    = `FEATUREID`
+	FILTER       = `FILTER`
+	BBOX         = `BBOX`
+	SRSNAME      = `SRSNAME`
+	PROPERTYNAME = `PROPERTYNAME`
+	RESULTTYPE   = `RESULTTYPE`
+	SORTBY       = `SORTBY`
+)
+
+// The default output formats, a WFS 2.0.0 backend defaults to GML 3.2 that the legacy clients can't read
+const (
+	GML311 = `text/xml; subtype=gml/3.1.1`
+	GML2   = `GML2`
+)
+
+// GetFeatureRequest is a WFS 1.1.0 or 1.0.0 GetFeature request, parsed into the equivalent WFS 2.0.0 request.
+// Only a single query is supported, like the wfs200.GetFeatureRequest has.
+type GetFeatureRequest struct {
+	// Version of the request, 1.1.0 or 1.0.0, the exceptions are reported in the report of this version
+	Version string `yaml:"version"`
+
+	request wfs200.GetFeatureRequest
+}
+
+// getFeature is the XML encoding of a WFS 1.x GetFeature request
+type getFeature struct {
+	XMLName      xml.Name `xml:"GetFeature"`
+	Service      string   `xml:"service,attr"`
+	Version      string   `xml:"version,attr"`
+	OutputFormat string   `xml:"outputFormat,attr"`
+	ResultType   string   `xml:"resultType,attr"`
+	MaxFeatures  string   `xml:"maxFeatures,attr"`
+	Query        []query  `xml:"Query"`
+}
+
+// query is the XML encoding of a WFS 1.x Query
+type query struct {
+	TypeName     string   `xml:"typeName,attr"`
+	SrsName      string   `xml:"srsName,attr"`
+	PropertyName []string `xml:"PropertyName"`
+	Filter       *Filter  `xml:"Filter"`
+	SortBy       *struct {
+		SortProperty []struct {
+			PropertyName string `xml:"PropertyName"`
+			SortOrder    string `xml:"SortOrder"`
+		} `xml:"SortProperty"`
+	} `xml:"SortBy"`
+}
+
+// Type returns GetFeature
+func (f GetFeatureRequest) Type() string {
+	return getfeature
+}
+
+// ToWFS200 returns the WFS 2.0.0 GetFeature request
+func (f GetFeatureRequest) ToWFS200() wfs200.GetFeatureRequest {
+	return f.request
+}
+
+// ParseQueryParameters builds a GetFeatureRequest based on the available query parameters.
+// The FEATUREID, FILTER and BBOX parameters are mutually exclusive.
+//
+//nolint:cyclop,funlen
+func (f *GetFeatureRequest) ParseQueryParameters(q url.Values) Exceptions {
+	query := utils.KeysToUpper(q)
+	version, exceptions := parseBaseQueryParameters(query)
+	if exceptions != nil {
+		return exceptions
+	}
+
+	r := newGetFeatureRequest(version)
+	typeName := first(query, TYPENAME)
+	switch {
+	case strings.Contains(typeName, `(`):
+		// the lists of typenames in parentheses are the typenames of multiple queries
+		exceptions = append(exceptions, InvalidParameterValue(typeName, TYPENAME))
+	case typeName == `` && first(query, FEATUREID) == ``:
+		exceptions = append(exceptions, MissingParameterValue(TYPENAME))
+	case typeName != ``:
+		r.Query.TypeNames = strings.Join(list(typeName), `,`)
+	}
+
+	if v := first(query, MAXFEATURES); v != `` {
+		count, exception := parseMaxFeatures(v)
+		exceptions = append(exceptions, exception...)
+		r.Count = count
+	}
+	if v := first(query, OUTPUTFORMAT); v != `` {
+		r.OutputFormat = sp(v)
+	}
+	if v := first(query, RESULTTYPE); v != `` {
+		resultType, exception := parseResultType(v)
+		exceptions = append(exceptions, exception...)
+		r.ResultType = resultType
+	}
+	if v := first(query, SRSNAME); v != `` {
+		r.Query.SrsName = sp(v)
+	}
+	if v := first(query, PROPERTYNAME); v != `` {
+		propertyNames := list(strings.Trim(v, `()`))
+		r.Query.PropertyName = &propertyNames
+	}
+	if v := first(query, SORTBY); v != `` {
+		sortBy, exception := parseSortBy(v)
+		exceptions = append(exceptions, exception...)
+		r.Query.SortBy = sortBy
+	}
+
+	var selection []string
+	for _, key := range []string{FEATUREID, FILTER, BBOX} {
+		if first(query, key) != `` {
+			selection = append(selection, key)
+		}
+	}
+	if len(selection) > 1 {
+		exceptions = append(exceptions, InvalidParameterValue(strings.Join(selection, `,`), selection[1]))
+		return exceptions
+	}
+	if len(selection) == 1 {
+		filter, exception := parseSelection(version, selection[0], first(query, selection[0]))
+		exceptions = append(exceptions, exception...)
+		r.Query.Filter = filter
+	}
+
+	if len(exceptions) > 0 {
+		return exceptions
+	}
+	f.Version = version
+	f.request = r
+	return nil
+}
+
+// ParseXML builds a GetFeatureRequest based on a XML document,
+// the namespaces declared on the root element are carried over except for the WFS 1.x namespaces
+func (f *GetFeatureRequest) ParseXML(doc []byte) Exceptions {
+	var xmlAttributes utils.XMLAttribute
+	if err := xml.Unmarshal(doc, &xmlAttributes); err != nil {
+		return NoApplicableCode("Could not process XML, is it XML?").ToExceptions()
+	}
+	var g getFeature
+	if err := xml.Unmarshal(doc, &g); err != nil {
+		return NoApplicableCode(err.Error()).ToExceptions()
+	}
+
+	if exceptions := checkService(g.Service); exceptions != nil {
+		return exceptions
+	}
+	version := g.Version
+	if version == `` {
+		version = Version
+	}
+	if exceptions := checkVersion(version); exceptions != nil {
+		return exceptions
+	}
+	if len(g.Query) != 1 {
+		return InvalidParameterValue(strconv.Itoa(len(g.Query))+` queries`, `Query`).ToExceptions()
+	}
+
+	r := newGetFeatureRequest(version)
+	r.BaseRequest = baseRequest(xmlAttributes)
+
+	var exceptions Exceptions
+	if g.MaxFeatures != `` {
+		count, exception := parseMaxFeatures(g.MaxFeatures)
+		exceptions = append(exceptions, exception...)
+		r.Count = count
+	}
+	if g.OutputFormat != `` {
+		r.OutputFormat = sp(g.OutputFormat)
+	}
+	if g.ResultType != `` {
+		resultType, exception := parseResultType(g.ResultType)
+		exceptions = append(exceptions, exception...)
+		r.ResultType = resultType
+	}
+
+	q := g.Query[0]
+	r.Query.TypeNames = q.TypeName
+	if q.SrsName != `` {
+		r.Query.SrsName = sp(q.SrsName)
+	}
+	if len(q.PropertyName) > 0 {
+		propertyNames := q.PropertyName
+		r.Query.PropertyName = &propertyNames
+	}
+	if q.SortBy != nil {
+		sortBy := wfs200.SortBy{}
+		for _, p := range q.SortBy.SortProperty {
+			property := wfs200.SortProperty{ValueReference: p.PropertyName}
+			if p.SortOrder != `` {
+				property.SortOrder = sp(p.SortOrder)
+			}
+			sortBy.SortProperty = append(sortBy.SortProperty, property)
+		}
+		r.Query.SortBy = &sortBy
+	}
+	if q.Filter != nil {
+		filter, err := q.Filter.ToWFS200(version)
+		if err != nil {
+			exceptions = append(exceptions, InvalidParameterValue(err.Error(), `Filter`))
+		}
+		r.Query.Filter = filter
+	}
+
+	if len(exceptions) > 0 {
+		return exceptions
+	}
+	f.Version = version
+	f.request = r
+	return nil
+}
+
+// newGetFeatureRequest returns the WFS 2.0.0 request with the default output format of the version
+func newGetFeatureRequest(version string) wfs200.GetFeatureRequest {
+	r := wfs200.GetFeatureRequest{XMLName: xml.Name{Local: getfeature}}
+	r.BaseRequest = wfs200.BaseRequest{Service: Service, Version: wfs200.Version}
+	r.OutputFormat = sp(GML311)
+	if version == Version100 {
+		r.OutputFormat = sp(GML2)
+	}
+	return r
+}
+
+func parseMaxFeatures(s string) (*int, Exceptions) {
+	count, err := strconv.Atoi(s)
+	if err != nil || count < 1 {
+		return nil, InvalidParameterValue(s, MAXFEATURES).ToExceptions()
+	}
+	return ip(count), nil
+}
+
+// parseResultType accepts the results and hits result types of WFS 1.1.0
+func parseResultType(s string) (*string, Exceptions) {
+	switch strings.ToLower(s) {
+	case `results`, `hits`:
+		return sp(strings.ToLower(s)), nil
+	}
+	return nil, InvalidParameterValue(s, RESULTTYPE).ToExceptions()
+}
+
+// parseSortBy parses the WFS 1.1.0 SORTBY list, like name A,population D, into the WFS 2.0.0 SortBy
+func parseSortBy(s string) (*wfs200.SortBy, Exceptions) {
+	sortBy := wfs200.SortBy{}
+	for _, item := range list(s) {
+		fields := strings.Fields(item)
+		if len(fields) == 0 || len(fields) > 2 {
+			return nil, InvalidParameterValue(s, SORTBY).ToExceptions()
+		}
+		property := wfs200.SortProperty{ValueReference: fields[0]}
+		if len(fields) == 2 {
+			switch strings.ToUpper(fields[1]) {
+			case `A`, `ASC`:
+				property.SortOrder = sp(`ASC`)
+			case `D`, `DESC`:
+				property.SortOrder = sp(`DESC`)
+			default:
+				return nil, InvalidParameterValue(s, SORTBY).ToExceptions()
+			}
+		}
+		sortBy.SortProperty = append(sortBy.SortProperty, property)
+	}
+	return &sortBy, nil
+}
+
+// parseSelection builds the wfs200.Filter of the FEATUREID, FILTER or BBOX parameter
+func parseSelection(version, key, value string) (*wfs200.Filter, Exceptions) {
+	switch key {
+	case FEATUREID:
+		var rids wfs200.ResourceIDs
+		for _, id := range list(value) {
+			rids = append(rids, wfs200.ResourceID{Rid: id})
+		}
+		return &wfs200.Filter{ResourceID: &rids}, nil
+	case FILTER:
+		// a filter of a single query can be in parentheses, like the filters of multiple queries
+		if strings.HasPrefix(value, `(`) && strings.HasSuffix(value, `)`) {
+			value = value[1 : len(value)-1]
+		}
+		f, err := ParseFilter([]byte(value))
+		if err != nil {
+			return nil, InvalidParameterValue(`Filter is not valid XML`, FILTER).ToExceptions()
+		}
+		filter, err := f.ToWFS200(version)
+		if err != nil {
+			return nil, InvalidParameterValue(err.Error(), FILTER).ToExceptions()
+		}
+		return filter, nil
+	}
+
+	bbox, exceptions := parseBBOX(version, value)
+	if exceptions != nil {
+		return nil, exceptions
+	}
+	return &wfs200.Filter{SpatialOperator: wfs200.SpatialOperator{BBOX: bbox}}, nil
+}
+
+// parseBBOX parses minx,miny,maxx,maxy with an optional srsName, with the srsName the BBOX gets the URN
+// of the CRS and the coordinates in its axis order, like the BBOX of a filter
+func parseBBOX(version, s string) (*wfs200.GEOBBOX, Exceptions) {
+	parts := list(s)
+	if len(parts) != 4 && len(parts) != 5 {
+		return nil, InvalidParameterValue(s, BBOX).ToExceptions()
+	}
+	var coordinates [4]float64
+	for i := range coordinates {
+		v, err := strconv.ParseFloat(parts[i], 64)
+		if err != nil {
+			return nil, InvalidParameterValue(s, BBOX).ToExceptions()
+		}
+		coordinates[i] = v
+	}
+
+	bbox := wfs200.GEOBBOX{Envelope: wfs200.Envelope{
+		LowerCorner: wsc110.Position{coordinates[0], coordinates[1]},
+		UpperCorner: wsc110.Position{coordinates[2], coordinates[3]},
+	}}
+	if len(parts) == 5 {
+		bbox.SrsName, bbox.Envelope = srsEnvelope(version, parts[4], bbox.Envelope)
+	}
+	return &bbox, nil
+}
@@ -0,0 +1,158 @@
+package wfs110
+
+import (
+	"encoding/xml"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/pdok/ogc-specifications/pkg/utils"
+	"github.com/pdok/ogc-specifications/pkg/wfs200"
+	"github.com/pdok/ogc-specifications/pkg/wsc110"
+)
+
+func TestGetFeatureType(t *testing.T) {
+	f := GetFeatureRequest{}
+	if f.Type() != `GetFeature` {
+		t.Errorf("test: %d, expected: %s,\n got: %s", 0, `GetFeature`, f.Type())
+	}
+}
+
+func TestGetFeatureParseQueryParameters(t *testing.T) {
+	base := wfs200.BaseRequest{Service: Service, Version: wfs200.Version}
+
+	var tests = []struct {
+		query      url.Values
+		version    string
+		result     wfs200.GetFeatureRequest
+		exceptions []string
+	}{
+		0: {query: url.Values{SERVICE: {`WFS`}, VERSION: {`1.1.0`}, REQUEST: {`GetFeature`},
+			TYPENAME: {`ns:rivers`}, MAXFEATURES: {`10`}, SRSNAME: {`EPSG:28992`}, PROPERTYNAME: {`name,geom`}, SORTBY: {`name A,length D`}},
+			version: Version,
+			result: wfs200.GetFeatureRequest{XMLName: xml.Name{Local: `GetFeature`}, BaseRequest: base,
+				StandardPresentationParameters: wfs200.StandardPresentationParameters{OutputFormat: sp(GML311), Count: ip(10)},
+				Query: wfs200.Query{TypeNames: `ns:rivers`, SrsName: sp(`EPSG:28992`), PropertyName: &[]string{`name`, `geom`},
+					SortBy: &wfs200.SortBy{SortProperty: []wfs200.SortProperty{{ValueReference: `name`, SortOrder: sp(`ASC`)}, {ValueReference: `length`, SortOrder: sp(`DESC`)}}}}}},
+		// the TYPENAME is optional with a FEATUREID
+		1: {query: url.Values{`service`: {`wfs`}, `version`: {`1.0.0`}, `request`: {`GetFeature`}, `featureid`: {`rivers.1,rivers.2`}},
+			version: Version100,
+			result: wfs200.GetFeatureRequest{XMLName: xml.Name{Local: `GetFeature`}, BaseRequest: base,
+				StandardPresentationParameters: wfs200.StandardPresentationParameters{OutputFormat: sp(GML2)},
+				Query:                          wfs200.Query{Filter: &wfs200.Filter{ResourceID: &wfs200.ResourceIDs{{Rid: `rivers.1`}, {Rid: `rivers.2`}}}}}},
+		2: {query: url.Values{SERVICE: {`WFS`}, VERSION: {`1.1.0`}, TYPENAME: {`rivers`}, RESULTTYPE: {`hits`},
+			FILTER: {`<Filter><PropertyIsEqualTo><PropertyName>name</PropertyName><Literal>Rhine</Literal></PropertyIsEqualTo></Filter>`}},
+			version: Version,
+			result: wfs200.GetFeatureRequest{XMLName: xml.Name{Local: `GetFeature`}, BaseRequest: base,
+				StandardPresentationParameters: wfs200.StandardPresentationParameters{OutputFormat: sp(GML311), ResultType: sp(`hits`)},
+				Query: wfs200.Query{TypeNames: `rivers`, Filter: &wfs200.Filter{ComparisonOperator: wfs200.ComparisonOperator{
+					PropertyIsEqualTo: &[]wfs200.PropertyIsEqualTo{{ComparisonOperatorAttribute: wfs200.ComparisonOperatorAttribute{ValueReference: sp(`name`), Literal: `Rhine`}}}}}}}},
+		3: {query: url.Values{SERVICE: {`WFS`}, VERSION: {`1.1.0`}, TYPENAME: {`rivers`}, BBOX: {`5,50,6,52,EPSG:4326`}},
+			version: Version,
+			result: wfs200.GetFeatureRequest{XMLName: xml.Name{Local: `GetFeature`}, BaseRequest: base,
+				StandardPresentationParameters: wfs200.StandardPresentationParameters{OutputFormat: sp(GML311)},
+				Query: wfs200.Query{TypeNames: `rivers`, Filter: &wfs200.Filter{SpatialOperator: wfs200.SpatialOperator{BBOX: &wfs200.GEOBBOX{
+					SrsName:  sp(`urn:ogc:def:crs:EPSG::4326`),
+					Envelope: wfs200.Envelope{LowerCorner: wsc110.Position{50, 5}, UpperCorner: wsc110.Position{52, 6}}}}}}}},
+		4: {query: url.Values{SERVICE: {`WFS`}, VERSION: {`1.1.0`}, TYPENAME: {`rivers`}, BBOX: {`5,50,6,52`}, FEATUREID: {`rivers.1`}},
+			exceptions: []string{`InvalidParameterValue`}},
+		5: {query: url.Values{SERVICE: {`WFS`}, VERSION: {`2.0.0`}, TYPENAME: {`rivers`}},
+			exceptions: []string{`InvalidParameterValue`}},
+		6: {query: url.Values{VERSION: {`1.1.0`}},
+			exceptions: []string{`MissingParameterValue`}},
+		7: {query: url.Values{SERVICE: {`WFS`}, VERSION: {`1.1.0`}},
+			exceptions: []string{`MissingParameterValue`}},
+		8: {query: url.Values{SERVICE: {`WFS`}, VERSION: {`1.1.0`}, TYPENAME: {`rivers`}, MAXFEATURES: {`-1`}, SORTBY: {`name X`}},
+			exceptions: []string{`InvalidParameterValue`, `InvalidParameterValue`}},
+		9: {query: url.Values{SERVICE: {`WFS`}, VERSION: {`1.1.0`}, TYPENAME: {`rivers`}, FILTER: {`<Filter><Beyond><PropertyName>geom</PropertyName><Envelope><lowerCorner>5 50</lowerCorner><upperCorner>6 52</upperCorner></Envelope><Distance units="m">10</Distance></Beyond></Filter>`}},
+			exceptions: []string{`InvalidParameterValue`}},
+		10: {query: url.Values{SERVICE: {`WFS`}, VERSION: {`1.1.0`}, TYPENAME: {`(rivers)(roads)`}},
+			exceptions: []string{`InvalidParameterValue`}},
+	}
+
+	for k, test := range tests {
+		var f GetFeatureRequest
+		exceptions := f.ParseQueryParameters(test.query)
+		if len(test.exceptions) > 0 {
+			if len(exceptions) != len(test.exceptions) {
+				t.Errorf("test: %d, expected: %v,\n got: %v", k, test.exceptions, exceptions)
+				continue
+			}
+			for i, exception := range exceptions {
+				if exception.Code() != test.exceptions[i] {
+					t.Errorf("test: %d, expected: %s,\n got: %s", k, test.exceptions[i], exception.Code())
+				}
+			}
+			continue
+		}
+		if exceptions != nil {
+			t.Errorf("test: %d, expected no exceptions,\n got: %v", k, exceptions)
+			continue
+		}
+		if f.Version != test.version {
+			t.Errorf("test: %d, expected: %s,\n got: %s", k, test.version, f.Version)
+		}
+		if !reflect.DeepEqual(f.ToWFS200(), test.result) {
+			t.Errorf("test: %d, expected: %+v,\n got: %+v", k, test.result, f.ToWFS200())
+		}
+	}
+}
+
+func TestGetFeatureParseXML(t *testing.T) {
+	var tests = []struct {
+		doc        string
+		version    string
+		result     wfs200.GetFeatureRequest
+		exceptions []string
+	}{
+		0: {doc: `<wfs:GetFeature service="WFS" version="1.1.0" maxFeatures="5" outputFormat="text/xml; subtype=gml/3.1.1"
+			xmlns:wfs="http://www.opengis.net/wfs" xmlns:ogc="http://www.opengis.net/ogc" xmlns:ns="http://example.org/ns">
+			<wfs:Query typeName="ns:rivers" srsName="urn:ogc:def:crs:EPSG::28992">
+				<ogc:PropertyName>ns:name</ogc:PropertyName>
+				<ogc:Filter><ogc:PropertyIsLessThan><ogc:PropertyName>ns:length</ogc:PropertyName><ogc:Literal>10</ogc:Literal></ogc:PropertyIsLessThan></ogc:Filter>
+				<ogc:SortBy><ogc:SortProperty><ogc:PropertyName>ns:name</ogc:PropertyName><ogc:SortOrder>DESC</ogc:SortOrder></ogc:SortProperty></ogc:SortBy>
+			</wfs:Query></wfs:GetFeature>`,
+			version: Version,
+			result: wfs200.GetFeatureRequest{XMLName: xml.Name{Local: `GetFeature`},
+				BaseRequest: wfs200.BaseRequest{Service: Service, Version: wfs200.Version, Attr: utils.XMLAttribute{
+					{Name: xml.Name{Space: `xmlns`, Local: `ns`}, Value: `http://example.org/ns`}}},
+				StandardPresentationParameters: wfs200.StandardPresentationParameters{OutputFormat: sp(GML311), Count: ip(5)},
+				Query: wfs200.Query{TypeNames: `ns:rivers`, SrsName: sp(`urn:ogc:def:crs:EPSG::28992`), PropertyName: &[]string{`ns:name`},
+					Filter: &wfs200.Filter{ComparisonOperator: wfs200.ComparisonOperator{
+						PropertyIsLessThan: &[]wfs200.PropertyIsLessThan{{ComparisonOperatorAttribute: wfs200.ComparisonOperatorAttribute{ValueReference: sp(`ns:length`), Literal: `10`}}}}},
+					SortBy: &wfs200.SortBy{SortProperty: []wfs200.SortProperty{{ValueReference: `ns:name`, SortOrder: sp(`DESC`)}}}}}},
+		1: {doc: `<GetFeature version="1.0.0"><Query typeName="rivers"/></GetFeature>`,
+			version: Version100,
+			result: wfs200.GetFeatureRequest{XMLName: xml.Name{Local: `GetFeature`},
+				BaseRequest:                    wfs200.BaseRequest{Service: Service, Version: wfs200.Version},
+				StandardPresentationParameters: wfs200.StandardPresentationParameters{OutputFormat: sp(GML2)},
+				Query:                          wfs200.Query{TypeNames: `rivers`}}},
+		2: {doc: `<GetFeature version="1.1.0"><Query typeName="rivers"/><Query typeName="roads"/></GetFeature>`,
+			exceptions: []string{`InvalidParameterValue`}},
+		3: {doc: `<GetFeature service="WMS" version="1.1.0"><Query typeName="rivers"/></GetFeature>`,
+			exceptions: []string{`InvalidParameterValue`}},
+		4: {doc: `<GetFeature`,
+			exceptions: []string{`NoApplicableCode`}},
+	}
+
+	for k, test := range tests {
+		var f GetFeatureRequest
+		exceptions := f.ParseXML([]byte(test.doc))
+		if len(test.exceptions) > 0 {
+			if len(exceptions) != 1 || exceptions[0].Code() != test.exceptions[0] {
+				t.Errorf("test: %d, expected: %v,\n got: %v", k, test.exceptions, exceptions)
+			}
+			continue
+		}
+		if exceptions != nil {
+			t.Errorf("test: %d, expected no exceptions,\n got: %v", k, exceptions)
+			continue
+		}
+		if f.Version != test.version {
+			t.Errorf("test: %d, expected: %s,\n got: %s", k, test.version, f.Version)
+		}
+		if !reflect.DeepEqual(f.ToWFS200(), test.result) {
+			t.Errorf("test: %d, expected: %+v,\n got: %+v", k, test.result, f.ToWFS200())
+		}
+	}
+}
@@ -0,0 +1,9 @@
+package wfs110
+
+func sp(s string) *string {
+	return &s
+}
+
+func ip(i int) *int {
+	return &i
+}
@@ -3,17 +3,29 @@ package wfs200
 import (
 	"encoding/xml"
 
+	"github.com/pdok/ogc-specifications/pkg/utils"
 	"github.com/pdok/ogc-specifications/pkg/wsc110"
 )
 
-// ParseXML func
-func (c *Capabilities) ParseXML(_ []byte) error {
+// ParseXML builds the Capabilities from a WFS 2.0.0 capabilities document
+func (c *Capabilities) ParseXML(doc []byte) error {
+	var gc GetCapabilitiesResponse
+	if err := gc.ParseXML(doc); err != nil {
+		return err
+	}
+	*c = gc.Capabilities
 	return nil
 }
 
-// ParseYAML func
-func (c *Capabilities) ParseYAML(_ []byte) error {
-	return nil
+// ParseYAML builds the Capabilities from a YAML document, unknown keys are ignored
+func (c *Capabilities) ParseYAML(doc []byte) error {
+	return c.ParseYAMLWithMode(doc, utils.Lenient)
+}
+
+// ParseYAMLWithMode builds the Capabilities from a YAML document,
+// in utils.Strict mode unknown keys are rejected with an error wrapping utils.ErrUnknownField
+func (c *Capabilities) ParseYAMLWithMode(doc []byte, mode utils.ParseMode) error {
+	return utils.UnmarshalYAML(doc, c, mode)
 }
 
 // Capabilities struct
@@ -1,12 +1,14 @@
 package wfs200
 
 import (
-	"regexp"
 	"strconv"
+
+	"github.com/pdok/ogc-specifications/pkg/crs"
 )
 
 const (
-	codeSpace = `urn:ogc:def:crs:EPSG:`
+	codeSpace    = `urn:ogc:def:crs:EPSG:`
+	ogcCodeSpace = `urn:ogc:def:crs:OGC:1.3:CRS`
 )
 
 // CRS struct with namespace/authority/registry and code
@@ -17,27 +19,56 @@ type CRS struct {
 
 // String of the EPSGCode
 func (c *CRS) String() string {
+	if c.Namespace == ogcCodeSpace {
+		return c.Namespace + strconv.Itoa(c.Code)
+	}
 	return c.Namespace + `:` + strconv.Itoa(c.Code)
 }
 
-// Identifier returns the EPSG
+// Identifier returns the URN of the CRS
 func (c *CRS) Identifier() string {
+	if d, ok := c.Definition(); ok {
+		return d.URN()
+	}
 	return codeSpace + strconv.Itoa(c.Code)
 }
 
+// Definition returns the registered definition of the CRS, containing the axis order and units
+func (c CRS) Definition() (crs.Definition, bool) {
+	if c.Namespace == ogcCodeSpace {
+		return crs.Lookup(crs.CRS, c.Code)
+	}
+	return crs.Lookup(crs.EPSG, c.Code)
+}
+
+// NorthEast returns if the first axis of the CRS is the northing/latitude
+func (c CRS) NorthEast() bool {
+	d, ok := c.Definition()
+	return ok && d.NorthEast()
+}
+
+// Geographic returns if the coordinates of the CRS are expressed in degrees
+func (c CRS) Geographic() bool {
+	d, ok := c.Definition()
+	return ok && d.Geographic
+}
+
 // ParseString build CRS struct from input string
 func (c *CRS) ParseString(s string) {
 	c.parseString(s)
 }
 
+// parseString normalises the different CRS notations, like EPSG:4326, CRS:84,
+// urn:ogc:def:crs:EPSG::4326 and http://www.opengis.net/def/crs/EPSG/0/4326
 func (c *CRS) parseString(s string) {
-	regex := regexp.MustCompile(`(^.*):([0-9]+)`)
-	code := regex.FindStringSubmatch(s)
-	if len(code) == 3 { // code[0] is the full match, the other the parts
-		c.Namespace = codeSpace
-
-		// the regex already checks if it [0-9]
-		i, _ := strconv.Atoi(code[2])
-		c.Code = i
+	authority, code, ok := crs.Parse(s)
+	if !ok {
+		return
+	}
+
+	c.Namespace = codeSpace
+	if authority == crs.CRS {
+		c.Namespace = ogcCodeSpace
 	}
+	c.Code = code
 }
@@ -1,8 +1,11 @@
 package wfs200
 
 import (
+	"encoding/xml"
 	"testing"
 
+	"github.com/pdok/ogc-specifications/pkg/wsc110"
+
 	"gopkg.in/yaml.v3"
 )
 
@@ -14,6 +17,8 @@ func TestCRSParseString(t *testing.T) {
 		0: {}, // Empty input == empty struct
 		1: {input: `urn:ogc:def:crs:EPSG::4326`, expectedCRS: CRS{Code: 4326, Namespace: `urn:ogc:def:crs:EPSG:`}},
 		2: {input: `EPSG:4326`, expectedCRS: CRS{Code: 4326, Namespace: `urn:ogc:def:crs:EPSG:`}},
+		3: {input: `http://www.opengis.net/def/crs/EPSG/0/28992`, expectedCRS: CRS{Code: 28992, Namespace: `urn:ogc:def:crs:EPSG:`}},
+		4: {input: `urn:ogc:def:crs:OGC:1.3:CRS84`, expectedCRS: CRS{Code: 84, Namespace: `urn:ogc:def:crs:OGC:1.3:CRS`}},
 	}
 
 	for k, test := range tests {
@@ -76,3 +81,51 @@ func TestMarshalYAMLCrs(t *testing.T) {
 		}
 	}
 }
+
+func TestCRSXML(t *testing.T) {
+	var tests = []struct {
+		CRS         CRS
+		expectedXML string
+	}{
+		0: {CRS: CRS{Code: 4326, Namespace: codeSpace}, expectedXML: `<doc><CRS>urn:ogc:def:crs:EPSG::4326</CRS></doc>`},
+		1: {CRS: CRS{Code: 84, Namespace: ogcCodeSpace}, expectedXML: `<doc><CRS>urn:ogc:def:crs:OGC:1.3:CRS84</CRS></doc>`},
+	}
+
+	for k, test := range tests {
+		type doc struct {
+			XMLName xml.Name `xml:"doc"`
+			CRS     *CRS     `xml:"CRS"`
+		}
+		b, err := xml.Marshal(doc{CRS: &test.CRS})
+		if err != nil || string(b) != test.expectedXML {
+			t.Errorf("test: %d, expected: %s,\n got: %s %v", k, test.expectedXML, b, err)
+			continue
+		}
+
+		// marshalled back it is parsed to the same CRS
+		var roundtrip doc
+		if err := xml.Unmarshal(b, &roundtrip); err != nil || roundtrip.CRS == nil || *roundtrip.CRS != test.CRS {
+			t.Errorf("test: %d, expected: %v,\n got: %v %v", k, test.CRS, roundtrip.CRS, err)
+		}
+	}
+}
+
+func TestGEOBBOXEastNorth(t *testing.T) {
+	var tests = []struct {
+		bbox     GEOBBOX
+		expected Envelope
+	}{
+		0: {bbox: GEOBBOX{SrsName: sp(`urn:ogc:def:crs:EPSG::4326`), Envelope: Envelope{LowerCorner: wsc110.Position{50.5, 3.2}, UpperCorner: wsc110.Position{53.7, 7.3}}},
+			expected: Envelope{LowerCorner: wsc110.Position{3.2, 50.5}, UpperCorner: wsc110.Position{7.3, 53.7}}},
+		1: {bbox: GEOBBOX{SrsName: sp(`urn:ogc:def:crs:OGC:1.3:CRS84`), Envelope: Envelope{LowerCorner: wsc110.Position{3.2, 50.5}, UpperCorner: wsc110.Position{7.3, 53.7}}},
+			expected: Envelope{LowerCorner: wsc110.Position{3.2, 50.5}, UpperCorner: wsc110.Position{7.3, 53.7}}},
+		2: {bbox: GEOBBOX{Envelope: Envelope{LowerCorner: wsc110.Position{0, 300000}, UpperCorner: wsc110.Position{280000, 625000}}},
+			expected: Envelope{LowerCorner: wsc110.Position{0, 300000}, UpperCorner: wsc110.Position{280000, 625000}}},
+	}
+
+	for k, test := range tests {
+		if eastnorth := test.bbox.EastNorth(); eastnorth != test.expected {
+			t.Errorf("test: %d, expected: %v,\n got: %v", k, test.expected, eastnorth)
+		}
+	}
+}
@@ -2,14 +2,13 @@ package wfs200
 
 import (
 	"encoding/xml"
-	"fmt"
 )
 
 // MarshalXML Position
 func (c *CRS) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	var s = ``
 	if c.Namespace != `` {
-		s = fmt.Sprintf("%s:%d", c.Namespace, c.Code)
+		s = c.String()
 	}
 
 	return e.EncodeElement(s, start)
@@ -17,7 +16,7 @@ func (c *CRS) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 
 // UnmarshalXML Position
 func (c *CRS) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
-	var crs CRS
+	var result CRS
 	for {
 		token, err := d.Token()
 		if err != nil {
@@ -25,10 +24,10 @@ func (c *CRS) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
 		}
 		switch el := token.(type) {
 		case xml.CharData:
-			crs.parseString(string([]byte(el)))
+			result.parseString(string([]byte(el)))
 		case xml.EndElement:
 			if el == start.End() {
-				*c = crs
+				*c = result
 				return nil
 			}
 		}
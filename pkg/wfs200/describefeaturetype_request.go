@@ -7,7 +7,6 @@ import (
 	"github.com/pdok/ogc-specifications/pkg/utils"
 	"github.com/pdok/ogc-specifications/pkg/wsc110"
 
-	"regexp"
 	"strings"
 )
 
@@ -104,9 +103,9 @@ func (d DescribeFeatureTypeRequest) ToQueryParameters() url.Values {
 
 // ToXML builds a 'new' XML document 'based' on the 'original' XML document
 func (d DescribeFeatureTypeRequest) ToXML() []byte {
-	si, _ := xml.MarshalIndent(&d, "", "")
-	re := regexp.MustCompile(`><.*>`)
-	return []byte(xml.Header + re.ReplaceAllString(string(si), "/>"))
+	e := utils.NewEncoder(nil)
+	doc, _ := e.Marshal(&d)
+	return doc
 }
 
 // DescribeFeatureTypeRequest struct with the needed parameters/attributes needed for making a DescribeFeatureType request
@@ -7,12 +7,11 @@ import (
 	"github.com/pdok/ogc-specifications/pkg/wsc110"
 )
 
-//nolint:govet
 type describeFeatureTypeRequestParameterValue struct {
-	service string `yaml:"service" json:"service"`
+	service string `yaml:"service"`
 	baseParameterValueRequest
-	typeName     *string `yaml:"typeName" json:"typeName"`         // [0..*]
-	outputFormat *string `yaml:"outputFormat" json:"outputFormat"` // default: "text/xml; subtype=gml/3.2"
+	typeName     *string `yaml:"typeName"`     // [0..*]
+	outputFormat *string `yaml:"outputFormat"` // default: "text/xml; subtype=gml/3.2"
 }
 
 func (dpv *describeFeatureTypeRequestParameterValue) parseQueryParameters(query url.Values) []wsc110.Exception {
@@ -0,0 +1,28 @@
+package wfs200
+
+import (
+	"net/http"
+
+	"github.com/pdok/ogc-specifications/pkg/common"
+	"github.com/pdok/ogc-specifications/pkg/wsc110"
+)
+
+// statusCodes contains the HTTP status codes for the WFS 2.0 specific exception codes
+var statusCodes = map[string]int{
+	`CannotLockAllFeatures`:             http.StatusBadRequest,
+	`DuplicateStoredQueryIDValue`:       http.StatusBadRequest,
+	`DuplicateStoredQueryParameterName`: http.StatusBadRequest,
+	`FeaturesNotLocked`:                 http.StatusBadRequest,
+	`InvalidLockID`:                     http.StatusBadRequest,
+	`InvalidValue`:                      http.StatusBadRequest,
+	`LockHasExpired`:                    http.StatusForbidden,
+	`OperationParsingFailed`:            http.StatusBadRequest,
+	`OperationProcessingFailed`:         http.StatusForbidden,
+	`ResponseCacheExpired`:              http.StatusForbidden,
+}
+
+// StatusCode returns the HTTP status code for a report with the given exceptions,
+// exception codes that are not defined by WFS 2.0 are looked up in the shared OWS Common codes
+func StatusCode(exceptions ...wsc110.Exception) int {
+	return common.StatusCode(statusCodes, exceptions...)
+}
@@ -0,0 +1,119 @@
+package wfs200
+
+import (
+	"math"
+	"strings"
+
+	"github.com/pdok/ogc-specifications/pkg/wsc110"
+)
+
+// Valid checks that the Envelope has finite coordinates and that
+// the LowerCorner is below and to the left of the UpperCorner
+func (e Envelope) Valid() bool {
+	return e.boundingBox().Valid()
+}
+
+// Intersects checks if the Envelope shares any point with the other Envelope, Envelopes that only touch along an
+// edge or in a corner intersect. Both Envelopes need to be in the same CRS
+func (e Envelope) Intersects(other Envelope) bool {
+	return e.boundingBox().Intersects(other.boundingBox())
+}
+
+// Contains checks if the other Envelope lies completely within the Envelope,
+// both Envelopes need to be in the same CRS
+func (e Envelope) Contains(other Envelope) bool {
+	return e.boundingBox().Contains(other.boundingBox())
+}
+
+// Union returns the smallest Envelope that contains both Envelopes,
+// both Envelopes need to be in the same CRS
+func (e Envelope) Union(other Envelope) Envelope {
+	return Envelope{
+		LowerCorner: wsc110.Position{math.Min(e.LowerCorner[0], other.LowerCorner[0]), math.Min(e.LowerCorner[1], other.LowerCorner[1])},
+		UpperCorner: wsc110.Position{math.Max(e.UpperCorner[0], other.UpperCorner[0]), math.Max(e.UpperCorner[1], other.UpperCorner[1])},
+	}
+}
+
+// Area returns the area of the Envelope in the units of its CRS, an invalid Envelope has no area
+func (e Envelope) Area() float64 {
+	return e.boundingBox().Area()
+}
+
+func (e Envelope) boundingBox() wsc110.BoundingBox {
+	return wsc110.BoundingBox{LowerCorner: e.LowerCorner, UpperCorner: e.UpperCorner}
+}
+
+// Validate raises an InvalidParameterValue exception for an inverted or degenerate BBOX
+func (gb GEOBBOX) Validate() []wsc110.Exception {
+	if !gb.Envelope.Valid() {
+		return wsc110.InvalidParameterValue(gb.MarshalText(), BBOX).ToExceptions()
+	}
+	return nil
+}
+
+// Intersects checks if the BBOX shares any point with the given WGS84BoundingBox. A BBOX without a srsName is in the
+// given srsName, the srsName of the query or the DefaultCRS of the feature type as FES 2.0 prescribes.
+// Without either it is considered to be in urn:ogc:def:crs:EPSG::4326, with a latitude/longitude axis order.
+func (gb GEOBBOX) Intersects(extent wsc110.WGS84BoundingBox, srsName string) (bool, error) {
+	if gb.SrsName == nil {
+		if srsName == `` {
+			srsName = codeSpace + `:4326`
+		}
+		gb.SrsName = &srsName
+	}
+
+	transformed, err := gb.Transform(`urn:ogc:def:crs:OGC:1.3:CRS84`)
+	if err != nil {
+		return false, err
+	}
+	return BoundingBoxIntersects(extent, transformed.Envelope), nil
+}
+
+// BoundingBoxIntersects checks if the Envelope, in longitude/latitude, shares any point with the WGS84BoundingBox
+func BoundingBoxIntersects(extent wsc110.WGS84BoundingBox, e Envelope) bool {
+	return wsc110.BoundingBox(extent).Intersects(e.boundingBox())
+}
+
+// FeatureTypesOutOfExtent returns the requested feature types for which the requested BBOX lies entirely
+// outside the advertised WGS84BoundingBox of the feature type, so these will return no features.
+// Feature types for which the extent can't be determined are not reported.
+func (f GetFeatureRequest) FeatureTypesOutOfExtent(c Capabilities) []string {
+	if f.Query.Filter == nil || f.Query.Filter.BBOX == nil {
+		return nil
+	}
+
+	var featuretypes []string
+	for _, typename := range splitTypeNames(f.Query.TypeNames) {
+		for _, ft := range c.FeatureTypeList.FeatureType {
+			if ft.Name != typename || ft.WGS84BoundingBox == nil {
+				continue
+			}
+			intersects, err := f.Query.Filter.BBOX.Intersects(*ft.WGS84BoundingBox, f.Query.crs(ft))
+			if err == nil && !intersects {
+				featuretypes = append(featuretypes, typename)
+			}
+		}
+	}
+	return featuretypes
+}
+
+func splitTypeNames(typenames string) []string {
+	var result []string
+	for _, typename := range strings.Split(typenames, `,`) {
+		if t := strings.TrimSpace(typename); t != `` {
+			result = append(result, t)
+		}
+	}
+	return result
+}
+
+// crs returns the srsName of the query, or the DefaultCRS of the feature type when the query has none
+func (q Query) crs(ft FeatureType) string {
+	if q.SrsName != nil {
+		return *q.SrsName
+	}
+	if ft.DefaultCRS != nil && ft.DefaultCRS.Namespace != `` {
+		return ft.DefaultCRS.String()
+	}
+	return ``
+}
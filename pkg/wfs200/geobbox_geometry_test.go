@@ -0,0 +1,74 @@
+package wfs200
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pdok/ogc-specifications/pkg/wsc110"
+)
+
+func TestGEOBBOXValidate(t *testing.T) {
+	var tests = []struct {
+		bbox  GEOBBOX
+		valid bool
+	}{
+		0: {bbox: GEOBBOX{Envelope: Envelope{LowerCorner: wsc110.Position{0, 0}, UpperCorner: wsc110.Position{10, 10}}}, valid: true},
+		1: {bbox: GEOBBOX{Envelope: Envelope{LowerCorner: wsc110.Position{10, 10}, UpperCorner: wsc110.Position{0, 0}}}, valid: false},
+		2: {bbox: GEOBBOX{Envelope: Envelope{LowerCorner: wsc110.Position{0, 0}, UpperCorner: wsc110.Position{0, 10}}}, valid: false},
+	}
+
+	for k, test := range tests {
+		if exceptions := test.bbox.Validate(); (exceptions == nil) != test.valid {
+			t.Errorf("test: %d, expected valid: %t,\n got exceptions: %v", k, test.valid, exceptions)
+		}
+	}
+}
+
+func TestFeatureTypesOutOfExtent(t *testing.T) {
+	c := Capabilities{
+		FeatureTypeList: FeatureTypeList{
+			FeatureType: []FeatureType{
+				{Name: `netherlands`, WGS84BoundingBox: &wsc110.WGS84BoundingBox{LowerCorner: wsc110.Position{3.05, 50.67}, UpperCorner: wsc110.Position{7.28, 53.61}}},
+				{Name: `belgium`, WGS84BoundingBox: &wsc110.WGS84BoundingBox{LowerCorner: wsc110.Position{2.5, 49.5}, UpperCorner: wsc110.Position{6.4, 51.5}}},
+				{Name: `rivers`, DefaultCRS: &CRS{Namespace: codeSpace, Code: 28992}, WGS84BoundingBox: &wsc110.WGS84BoundingBox{LowerCorner: wsc110.Position{4.7, 52.2}, UpperCorner: wsc110.Position{5.1, 52.5}}},
+			},
+		},
+	}
+
+	var tests = []struct {
+		bbox      *GEOBBOX
+		typenames string
+		srsName   *string
+		out       []string
+	}{
+		0: {bbox: nil},
+		// Amsterdam in RD
+		1: {bbox: &GEOBBOX{SrsName: sp(`urn:ogc:def:crs:EPSG::28992`), Envelope: Envelope{LowerCorner: wsc110.Position{110000, 476000}, UpperCorner: wsc110.Position{135000, 496000}}},
+			out: []string{`belgium`}},
+		// Paris in lat/lon without srsName
+		2: {bbox: &GEOBBOX{Envelope: Envelope{LowerCorner: wsc110.Position{48.8, 2.3}, UpperCorner: wsc110.Position{48.9, 2.4}}},
+			out: []string{`netherlands`, `belgium`}},
+		// Maastricht in lat/lon without srsName
+		3: {bbox: &GEOBBOX{Envelope: Envelope{LowerCorner: wsc110.Position{50.8, 5.6}, UpperCorner: wsc110.Position{50.9, 5.7}}}},
+		// Amsterdam in RD without srsName, in the DefaultCRS of the feature type
+		4: {bbox: &GEOBBOX{Envelope: Envelope{LowerCorner: wsc110.Position{110000, 476000}, UpperCorner: wsc110.Position{135000, 496000}}},
+			typenames: `rivers`},
+		// Maastricht in RD without srsName, in the srsName of the query
+		5: {bbox: &GEOBBOX{Envelope: Envelope{LowerCorner: wsc110.Position{174000, 316000}, UpperCorner: wsc110.Position{180000, 320000}}},
+			typenames: `netherlands, rivers`, srsName: sp(`urn:ogc:def:crs:EPSG::28992`), out: []string{`rivers`}},
+	}
+
+	for k, test := range tests {
+		f := GetFeatureRequest{Query: Query{TypeNames: `netherlands, belgium`, SrsName: test.srsName}}
+		if test.typenames != `` {
+			f.Query.TypeNames = test.typenames
+		}
+		if test.bbox != nil {
+			f.Query.Filter = &Filter{SpatialOperator: SpatialOperator{BBOX: test.bbox}}
+		}
+
+		if out := f.FeatureTypesOutOfExtent(c); strings.Join(out, `,`) != strings.Join(test.out, `,`) {
+			t.Errorf("test: %d, expected: %v \ngot: %v", k, test.out, out)
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package wfs200
+
+import (
+	"fmt"
+
+	"github.com/pdok/ogc-specifications/pkg/crs"
+	"github.com/pdok/ogc-specifications/pkg/wsc110"
+)
+
+// Transform converts the GEOBBOX from its srsName to the given srsName.
+// The coordinates of the GEOBBOX, and the result, follow the axis order of the srsName.
+func (gb GEOBBOX) Transform(srsName string) (GEOBBOX, error) {
+	if gb.SrsName == nil {
+		return GEOBBOX{}, fmt.Errorf("%w: the BBOX has no srsName", crs.ErrNoTransformation)
+	}
+
+	eastnorth := gb.EastNorth()
+	bounds, err := crs.TransformBounds(*gb.SrsName, srsName,
+		eastnorth.LowerCorner[0], eastnorth.LowerCorner[1], eastnorth.UpperCorner[0], eastnorth.UpperCorner[1], crs.DefaultDensify)
+	if err != nil {
+		return GEOBBOX{}, err
+	}
+
+	result := gb
+	result.SrsName = &srsName
+	result.Envelope = Envelope{
+		LowerCorner: wsc110.Position{bounds[0], bounds[1]},
+		UpperCorner: wsc110.Position{bounds[2], bounds[3]},
+	}
+	// swapping the axes back to the axis order of the srsName is the same operation
+	result.Envelope = result.EastNorth()
+	return result, nil
+}
@@ -0,0 +1,31 @@
+package wfs200
+
+import (
+	"math"
+	"testing"
+
+	"github.com/pdok/ogc-specifications/pkg/wsc110"
+)
+
+func TestGEOBBOXTransform(t *testing.T) {
+	bbox := GEOBBOX{
+		SrsName:  sp(`urn:ogc:def:crs:EPSG::28992`),
+		Envelope: Envelope{LowerCorner: wsc110.Position{155000, 463000}, UpperCorner: wsc110.Position{155000, 463000}},
+	}
+
+	result, err := bbox.Transform(`urn:ogc:def:crs:EPSG::4326`)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if *result.SrsName != `urn:ogc:def:crs:EPSG::4326` {
+		t.Errorf("expected srsName: urn:ogc:def:crs:EPSG::4326,\n got: %s", *result.SrsName)
+	}
+	// lat/lon axis order
+	if math.Abs(result.Envelope.LowerCorner[0]-52.155174) > 1e-5 || math.Abs(result.Envelope.LowerCorner[1]-5.387206) > 1e-5 {
+		t.Errorf("expected: 52.155174 5.387206,\n got: %v", result.Envelope.LowerCorner)
+	}
+
+	if _, err := (GEOBBOX{}).Transform(`urn:ogc:def:crs:EPSG::4326`); err == nil {
+		t.Errorf("expected an error for a BBOX without srsName")
+	}
+}
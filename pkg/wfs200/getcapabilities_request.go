@@ -3,7 +3,6 @@ package wfs200
 import (
 	"encoding/xml"
 	"net/url"
-	"regexp"
 	"strings"
 
 	"github.com/pdok/ogc-specifications/pkg/utils"
@@ -17,9 +16,13 @@ func (g GetCapabilitiesRequest) Type() string {
 	return getcapabilities
 }
 
-// Validate returns GetCapabilities
+// Validate validates the OWS Common parameters of the GetCapabilities request
 func (g GetCapabilitiesRequest) Validate(_ wsc110.Capabilities) []wsc110.Exception {
 	var exceptions []wsc110.Exception
+	if _, exception := g.NegotiateVersion(Version); exception != nil {
+		exceptions = append(exceptions, exception)
+	}
+	exceptions = append(exceptions, g.ValidateSections(sections...)...)
 	return exceptions
 }
 
@@ -32,11 +35,15 @@ func (g *GetCapabilitiesRequest) ParseXML(doc []byte) []wsc110.Exception {
 	if err := xml.Unmarshal(doc, &g); err != nil {
 		return []wsc110.Exception{wsc110.OperationNotSupported(err.Error())} // TODO Should be OperationParsingFailed
 	}
+	if err := g.GetCapabilitiesParameters.ParseXML(doc); err != nil {
+		return []wsc110.Exception{wsc110.NoApplicableCode(err.Error())}
+	}
 	var n []xml.Attr
 	for _, a := range xmlAttributes {
 		switch strings.ToUpper(a.Name.Local) {
 		case VERSION:
 		case SERVICE:
+		case wsc110.UPDATESEQUENCE:
 		default:
 			n = append(n, a)
 		}
@@ -65,6 +72,10 @@ func (g *GetCapabilitiesRequest) ParseQueryParameters(query url.Values) []wsc110
 		return exception
 	}
 
+	if exceptions := g.GetCapabilitiesParameters.ParseQueryParameters(query); len(exceptions) > 0 {
+		return exceptions
+	}
+
 	return nil
 }
 
@@ -83,20 +94,22 @@ func (g GetCapabilitiesRequest) ToQueryParameters() url.Values {
 	gpv.parseGetCapabilitiesRequest(g)
 
 	q := gpv.toQueryParameters()
+	g.GetCapabilitiesParameters.SetQueryParameters(q)
 	return q
 }
 
 // ToXML builds a 'new' XML document 'based' on the 'original' XML document
 func (g GetCapabilitiesRequest) ToXML() []byte {
-	si, _ := xml.MarshalIndent(&g, "", "")
-	re := regexp.MustCompile(`><.*>`)
-	return []byte(xml.Header + re.ReplaceAllString(string(si), "/>"))
+	e := utils.NewEncoder(wsc110.Prefixes)
+	doc, _ := e.Marshal(&g)
+	return doc
 }
 
 // GetCapabilitiesRequest struct with the needed parameters/attributes needed for making a GetCapabilities request
 type GetCapabilitiesRequest struct {
-	XMLName xml.Name           `xml:"GetCapabilities" yaml:"getCapabilities"`
-	Service string             `xml:"service,attr" yaml:"service"`
-	Version string             `xml:"version,attr" yaml:"version"`
-	Attr    utils.XMLAttribute `xml:",attr" yaml:"attr"`
+	XMLName                          xml.Name           `xml:"GetCapabilities" yaml:"getCapabilities"`
+	Service                          string             `xml:"service,attr" yaml:"service"`
+	Version                          string             `xml:"version,attr" yaml:"version"`
+	Attr                             utils.XMLAttribute `xml:",attr" yaml:"attr"`
+	wsc110.GetCapabilitiesParameters `yaml:",inline"`
 }
@@ -2,8 +2,9 @@ package wfs200
 
 import (
 	"encoding/xml"
-	"regexp"
+	"strings"
 
+	"github.com/pdok/ogc-specifications/pkg/utils"
 	"github.com/pdok/ogc-specifications/pkg/wsc110"
 )
 
@@ -29,11 +30,50 @@ func (gc *GetCapabilitiesResponse) Validate() []wsc110.Exception {
 	return nil
 }
 
+// capabilitiesPrefixes contains the prefixes of the namespaces used in the struct tags of the WFS 2.0.0 capabilities
+var capabilitiesPrefixes = utils.Prefixes{
+	`http://www.opengis.net/wfs/2.0`:                      ``,
+	`http://www.opengis.net/ows/1.1`:                      `ows`,
+	`http://www.opengis.net/fes/2.0`:                      `fes`,
+	`http://www.opengis.net/gml/3.2`:                      `gml`,
+	`http://inspire.ec.europa.eu/schemas/common/1.0`:      `inspire_common`,
+	`http://inspire.ec.europa.eu/schemas/inspire_dls/1.0`: `inspire_dls`,
+}
+
+// ParseXML builds a GetCapabilitiesResponse from a WFS 2.0.0 capabilities document,
+// regardless of the namespace prefixes used in the document
+func (gc *GetCapabilitiesResponse) ParseXML(doc []byte) error {
+	if err := utils.UnmarshalPrefixed(doc, gc, capabilitiesPrefixes); err != nil {
+		return err
+	}
+	if prefix := gc.featureTypePrefix(); gc.Namespaces != nil && prefix != `` {
+		namespaces, err := utils.RootNamespaces(doc)
+		if err != nil {
+			return err
+		}
+		gc.XmlnsPrefix = namespaces[prefix]
+	}
+	return nil
+}
+
 // ToXML builds a GetCapabilities response object
 func (gc GetCapabilitiesResponse) ToXML() []byte {
-	si, _ := xml.MarshalIndent(gc, "", "")
-	re := regexp.MustCompile(`><.*>`)
-	return []byte(xml.Header + re.ReplaceAllString(string(si), "/>"))
+	e := utils.NewEncoder(capabilitiesPrefixes)
+	if prefix := gc.featureTypePrefix(); gc.Namespaces != nil && gc.XmlnsPrefix != `` && prefix != `` {
+		e.Declare(prefix, gc.XmlnsPrefix)
+	}
+	doc, _ := e.Marshal(gc)
+	return doc
+}
+
+// featureTypePrefix returns the prefix of the feature type names, like app for app:roads
+func (gc GetCapabilitiesResponse) featureTypePrefix() string {
+	for _, ft := range gc.FeatureTypeList.FeatureType {
+		if i := strings.Index(ft.Name, `:`); i > 0 {
+			return ft.Name[:i]
+		}
+	}
+	return ``
 }
 
 // GetCapabilitiesResponse base struct
@@ -55,8 +95,9 @@ type Namespaces struct {
 	XmlnsFes           string `xml:"xmlns:fes,attr" yaml:"fes"`                                          // http://www.opengis.net/fes/2.0
 	XmlnsInspireCommon string `xml:"xmlns:inspire_common,attr,omitempty" yaml:"inspireCommon,omitempty"` // http://inspire.ec.europa.eu/schemas/common/1.0
 	XmlnsInspireDls    string `xml:"xmlns:inspire_dls,attr,omitempty" yaml:"inspireDls,omitempty"`       // http://inspire.ec.europa.eu/schemas/inspire_dls/1.0
-	XmlnsPrefix        string `xml:"xmlns:{{.Prefix}},attr" yaml:"prefix"`                               // namespace_uri placeholder
+	XmlnsPrefix        string `xml:"-" yaml:"prefix"`                                                    // namespace_uri of the feature types, declared with the prefix of their names
 	Version            string `xml:"version,attr" yaml:"version"`
+	UpdateSequence     string `xml:"updateSequence,attr,omitempty" yaml:"updateSequence,omitempty"`
 	SchemaLocation     string `xml:"xsi:schemaLocation,attr" yaml:"schemaLocation"`
 }
 
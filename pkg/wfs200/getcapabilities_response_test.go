@@ -0,0 +1,132 @@
+package wfs200
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestGetCapabilitiesResponseParseXML(t *testing.T) {
+	var tests = []struct {
+		doc string
+	}{
+		0: {doc: `<?xml version="1.0" encoding="UTF-8"?>
+<WFS_Capabilities xmlns="http://www.opengis.net/wfs/2.0" xmlns:ows="http://www.opengis.net/ows/1.1" xmlns:fes="http://www.opengis.net/fes/2.0" xmlns:gml="http://www.opengis.net/gml/3.2" xmlns:xlink="http://www.w3.org/1999/xlink" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xmlns:roads="http://example.com/roads" version="2.0.0">
+ <ows:ServiceIdentification>
+  <ows:Title>Roads</ows:Title>
+  <ows:ServiceType codeSpace="OGC">WFS</ows:ServiceType>
+  <ows:ServiceTypeVersion>2.0.0</ows:ServiceTypeVersion>
+ </ows:ServiceIdentification>
+ <ows:ServiceProvider>
+  <ows:ProviderName>PDOK</ows:ProviderName>
+  <ows:ProviderSite xlink:type="simple" xlink:href="https://www.pdok.nl"/>
+ </ows:ServiceProvider>
+ <ows:OperationsMetadata>
+  <ows:Operation name="GetFeature">
+   <ows:DCP><ows:HTTP><ows:Get xlink:type="simple" xlink:href="https://example.com/wfs"/></ows:HTTP></ows:DCP>
+  </ows:Operation>
+ </ows:OperationsMetadata>
+ <FeatureTypeList>
+  <FeatureType>
+   <Name>roads:road</Name>
+   <Title>Road</Title>
+   <DefaultCRS>urn:ogc:def:crs:EPSG::28992</DefaultCRS>
+   <ows:WGS84BoundingBox>
+    <ows:LowerCorner>3.05 50.67</ows:LowerCorner>
+    <ows:UpperCorner>7.28 53.61</ows:UpperCorner>
+   </ows:WGS84BoundingBox>
+  </FeatureType>
+ </FeatureTypeList>
+ <fes:Filter_Capabilities>
+  <fes:Spatial_Capabilities>
+   <fes:SpatialOperators><fes:SpatialOperator name="BBOX"/></fes:SpatialOperators>
+  </fes:Spatial_Capabilities>
+ </fes:Filter_Capabilities>
+</WFS_Capabilities>`},
+		1: {doc: `<?xml version="1.0" encoding="UTF-8"?>
+<wfs:WFS_Capabilities xmlns:wfs="http://www.opengis.net/wfs/2.0" xmlns:o="http://www.opengis.net/ows/1.1" xmlns:f="http://www.opengis.net/fes/2.0" xmlns:gml="http://www.opengis.net/gml/3.2" xmlns:xlink="http://www.w3.org/1999/xlink" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xmlns:roads="http://example.com/roads" version="2.0.0">
+ <o:ServiceIdentification>
+  <o:Title>Roads</o:Title>
+  <o:ServiceType codeSpace="OGC">WFS</o:ServiceType>
+  <o:ServiceTypeVersion>2.0.0</o:ServiceTypeVersion>
+ </o:ServiceIdentification>
+ <o:ServiceProvider>
+  <o:ProviderName>PDOK</o:ProviderName>
+  <o:ProviderSite xlink:type="simple" xlink:href="https://www.pdok.nl"/>
+ </o:ServiceProvider>
+ <o:OperationsMetadata>
+  <o:Operation name="GetFeature">
+   <o:DCP><o:HTTP><o:Get xlink:type="simple" xlink:href="https://example.com/wfs"/></o:HTTP></o:DCP>
+  </o:Operation>
+ </o:OperationsMetadata>
+ <wfs:FeatureTypeList>
+  <wfs:FeatureType>
+   <wfs:Name>roads:road</wfs:Name>
+   <wfs:Title>Road</wfs:Title>
+   <wfs:DefaultCRS>urn:ogc:def:crs:EPSG::28992</wfs:DefaultCRS>
+   <o:WGS84BoundingBox>
+    <o:LowerCorner>3.05 50.67</o:LowerCorner>
+    <o:UpperCorner>7.28 53.61</o:UpperCorner>
+   </o:WGS84BoundingBox>
+  </wfs:FeatureType>
+ </wfs:FeatureTypeList>
+ <f:Filter_Capabilities>
+  <f:Spatial_Capabilities>
+   <f:SpatialOperators><f:SpatialOperator name="BBOX"/></f:SpatialOperators>
+  </f:Spatial_Capabilities>
+ </f:Filter_Capabilities>
+</wfs:WFS_Capabilities>`},
+	}
+
+	var expected GetCapabilitiesResponse
+	for k, test := range tests {
+		var gc GetCapabilitiesResponse
+		if err := gc.ParseXML([]byte(test.doc)); err != nil {
+			t.Errorf("test: %d, expected no error,\n got: %v", k, err)
+			continue
+		}
+
+		if gc.Namespaces == nil || gc.Namespaces.XmlnsOWS != `http://www.opengis.net/ows/1.1` || gc.Namespaces.XmlnsFes != `http://www.opengis.net/fes/2.0` {
+			t.Errorf("test: %d, expected the ows and fes namespaces,\n got: %+v", k, gc.Namespaces)
+		}
+		if gc.Namespaces != nil && gc.Namespaces.XmlnsPrefix != `http://example.com/roads` {
+			t.Errorf("test: %d, expected the namespace of the feature types: http://example.com/roads,\n got: %s", k, gc.Namespaces.XmlnsPrefix)
+		}
+		if gc.ServiceIdentification.Title != `Roads` || gc.ServiceProvider.ProviderSite == nil || gc.ServiceProvider.ProviderSite.Href != `https://www.pdok.nl` {
+			t.Errorf("test: %d, expected the service identification and provider,\n got: %+v %+v", k, gc.ServiceIdentification, gc.ServiceProvider)
+		}
+		if gc.OperationsMetadata == nil || len(gc.OperationsMetadata.Operation) != 1 || gc.OperationsMetadata.Operation[0].DCP.HTTP.Get.Href != `https://example.com/wfs` {
+			t.Errorf("test: %d, expected the GetFeature operation,\n got: %+v", k, gc.OperationsMetadata)
+		}
+		if len(gc.FeatureTypeList.FeatureType) != 1 || gc.FeatureTypeList.FeatureType[0].DefaultCRS == nil || gc.FeatureTypeList.FeatureType[0].DefaultCRS.Code != 28992 {
+			t.Errorf("test: %d, expected the roads:road feature type,\n got: %+v", k, gc.FeatureTypeList)
+		}
+		if bbox := gc.FeatureTypeList.FeatureType[0].WGS84BoundingBox; bbox == nil || bbox.UpperCorner[1] != 53.61 {
+			t.Errorf("test: %d, expected the WGS84BoundingBox,\n got: %+v", k, bbox)
+		}
+		if gc.FilterCapabilities == nil || len(gc.FilterCapabilities.SpatialCapabilities.SpatialOperators.SpatialOperator) != 1 {
+			t.Errorf("test: %d, expected the BBOX spatial operator,\n got: %+v", k, gc.FilterCapabilities)
+		}
+
+		if k == 0 {
+			expected = gc
+		} else if !reflect.DeepEqual(gc, expected) {
+			t.Errorf("test: %d, expected: %+v,\n got: %+v", k, expected, gc)
+		}
+
+		var c Capabilities
+		if err := c.ParseXML([]byte(test.doc)); err != nil || !reflect.DeepEqual(c, gc.Capabilities) {
+			t.Errorf("test: %d, expected: %+v,\n got: %+v %v", k, gc.Capabilities, c, err)
+		}
+
+		// marshalled back it is parsed to the same capabilities
+		b := gc.ToXML()
+		if !strings.Contains(string(b), `xmlns:roads="http://example.com/roads"`) {
+			t.Errorf("test: %d, expected the declaration of the roads prefix,\n got: %s", k, b)
+		}
+		var roundtrip GetCapabilitiesResponse
+		if err := roundtrip.ParseXML(b); err != nil || !reflect.DeepEqual(roundtrip, gc) {
+			t.Errorf("test: %d, expected: %+v,\n got: %+v %v", k, gc, roundtrip, err)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package wfs200
+
+import (
+	"encoding/xml"
+
+	"github.com/pdok/ogc-specifications/pkg/utils"
+	"github.com/pdok/ogc-specifications/pkg/wsc110"
+)
+
+// Sections of the WFS 2.0.0 capabilities next to the OWS Common sections
+const (
+	FeatureTypeListSection    = `FeatureTypeList`
+	FilterCapabilitiesSection = `Filter_Capabilities`
+)
+
+// sections contains the sections that can be requested
+var sections = []string{
+	wsc110.ServiceIdentificationSection,
+	wsc110.ServiceProviderSection,
+	wsc110.OperationsMetadataSection,
+	FeatureTypeListSection,
+	FilterCapabilitiesSection,
+}
+
+// Trim returns the capabilities for the GetCapabilities request: the requested sections, or only the version and
+// update sequence when the requested update sequence is the current one.
+// InvalidUpdateSequence is returned when the requested update sequence is greater than the current one.
+func (gc GetCapabilitiesResponse) Trim(r GetCapabilitiesRequest) (GetCapabilitiesResponse, []wsc110.Exception) {
+	if exceptions := r.ValidateSections(sections...); len(exceptions) > 0 {
+		return GetCapabilitiesResponse{}, exceptions
+	}
+
+	var current string
+	if gc.Namespaces != nil {
+		current = gc.Namespaces.UpdateSequence
+	}
+	equal, exception := r.CheckUpdateSequence(current)
+	if exception != nil {
+		return GetCapabilitiesResponse{}, exception.ToExceptions()
+	}
+
+	trimmed := GetCapabilitiesResponse{XMLName: gc.XMLName, Namespaces: gc.Namespaces}
+	if equal {
+		return trimmed, nil
+	}
+	if r.HasSection(wsc110.ServiceIdentificationSection) {
+		trimmed.ServiceIdentification = gc.ServiceIdentification
+	}
+	if r.HasSection(wsc110.ServiceProviderSection) {
+		trimmed.ServiceProvider = gc.ServiceProvider
+	}
+	if r.HasSection(wsc110.OperationsMetadataSection) {
+		trimmed.OperationsMetadata = gc.OperationsMetadata
+	}
+	if r.HasSection(FeatureTypeListSection) {
+		trimmed.FeatureTypeList = gc.FeatureTypeList
+	}
+	if r.HasSection(FilterCapabilitiesSection) {
+		trimmed.FilterCapabilities = gc.FilterCapabilities
+	}
+	return trimmed, nil
+}
+
+// MarshalXML leaves out an empty ServiceIdentification, like one that isn't requested
+func (s ServiceIdentification) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type section ServiceIdentification
+	return utils.EncodeNonZero(e, section(s), start)
+}
+
+// MarshalXML leaves out an empty ServiceProvider
+func (s ServiceProvider) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type section ServiceProvider
+	return utils.EncodeNonZero(e, section(s), start)
+}
+
+// MarshalXML leaves out an empty FeatureTypeList
+func (f FeatureTypeList) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type section FeatureTypeList
+	return utils.EncodeNonZero(e, section(f), start)
+}
@@ -0,0 +1,114 @@
+package wfs200
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/pdok/ogc-specifications/pkg/wsc110"
+)
+
+func TestGetCapabilitiesResponseTrim(t *testing.T) {
+	providerName := `PDOK`
+	gc := GetCapabilitiesResponse{
+		Namespaces:            &Namespaces{XmlnsWFS: `http://www.opengis.net/wfs/2.0`, XmlnsOWS: `http://www.opengis.net/ows/1.1`, Version: Version, UpdateSequence: `5`},
+		ServiceIdentification: ServiceIdentification{Title: `Roads`},
+		ServiceProvider:       ServiceProvider{ProviderName: &providerName},
+		Capabilities: Capabilities{
+			OperationsMetadata: &OperationsMetadata{},
+			FeatureTypeList:    FeatureTypeList{FeatureType: []FeatureType{{Name: `roads:road`}}},
+		},
+	}
+
+	var tests = []struct {
+		query      url.Values
+		contains   []string
+		omits      []string
+		exceptions []wsc110.Exception
+	}{
+		0: {query: url.Values{},
+			contains: []string{`<ows:ServiceIdentification>`, `<ows:ServiceProvider>`, `<FeatureTypeList>`}},
+		1: {query: url.Values{`SECTIONS`: {`FeatureTypeList`}},
+			contains: []string{`<FeatureTypeList>`},
+			omits:    []string{`ows:ServiceIdentification`, `ows:ServiceProvider`, `ows:OperationsMetadata`}},
+		2: {query: url.Values{`SECTIONS`: {`ServiceIdentification,All`}},
+			contains: []string{`<ows:ServiceIdentification>`, `<ows:ServiceProvider>`, `<FeatureTypeList>`}},
+		3: {query: url.Values{`UPDATESEQUENCE`: {`4`}, `SECTIONS`: {`ServiceProvider`}},
+			contains: []string{`<ows:ServiceProvider>`},
+			omits:    []string{`ows:ServiceIdentification`, `FeatureTypeList`}},
+		// the capabilities are current, only the version and update sequence are returned
+		4: {query: url.Values{`UPDATESEQUENCE`: {`5`}},
+			contains: []string{`version="2.0.0"`, `updateSequence="5"`},
+			omits:    []string{`ows:ServiceIdentification`, `ows:ServiceProvider`, `ows:OperationsMetadata`, `FeatureTypeList`}},
+		5: {query: url.Values{`UPDATESEQUENCE`: {`6`}},
+			exceptions: wsc110.InvalidUpdateSequence().ToExceptions()},
+		6: {query: url.Values{`SECTIONS`: {`Contents`}},
+			exceptions: wsc110.InvalidParameterValue(`Contents`, wsc110.SECTIONS).ToExceptions()},
+	}
+
+	for k, test := range tests {
+		var r GetCapabilitiesRequest
+		test.query[`SERVICE`] = []string{Service}
+		test.query[`REQUEST`] = []string{getcapabilities}
+		if exceptions := r.ParseQueryParameters(test.query); exceptions != nil {
+			t.Errorf("test: %d, expected no exceptions,\n got: %v", k, exceptions)
+			continue
+		}
+
+		trimmed, exceptions := gc.Trim(r)
+		if test.exceptions != nil {
+			if len(exceptions) != 1 || exceptions[0].Error() != test.exceptions[0].Error() {
+				t.Errorf("test: %d, expected: %v,\n got: %v", k, test.exceptions, exceptions)
+			}
+			continue
+		}
+		if exceptions != nil {
+			t.Errorf("test: %d, expected no exceptions,\n got: %v", k, exceptions)
+			continue
+		}
+
+		doc := string(trimmed.ToXML())
+		for _, s := range test.contains {
+			if !strings.Contains(doc, s) {
+				t.Errorf("test: %d, expected %s in:\n %s", k, s, doc)
+			}
+		}
+		for _, s := range test.omits {
+			if strings.Contains(doc, s) {
+				t.Errorf("test: %d, expected no %s in:\n %s", k, s, doc)
+			}
+		}
+	}
+}
+
+func TestGetCapabilitiesRequestParameters(t *testing.T) {
+	doc := []byte(`<GetCapabilities xmlns="http://www.opengis.net/wfs/2.0" xmlns:ows="http://www.opengis.net/ows/1.1" service="WFS" updateSequence="5">` +
+		`<ows:AcceptVersions><ows:Version>2.0.0</ows:Version></ows:AcceptVersions>` +
+		`<ows:Sections><ows:Section>FeatureTypeList</ows:Section></ows:Sections>` +
+		`</GetCapabilities>`)
+
+	var r GetCapabilitiesRequest
+	if exceptions := r.ParseXML(doc); exceptions != nil {
+		t.Fatalf("test: %d, expected no exceptions,\n got: %v", 0, exceptions)
+	}
+	if exceptions := r.Validate(nil); len(exceptions) > 0 {
+		t.Errorf("test: %d, expected no exceptions,\n got: %v", 0, exceptions)
+	}
+
+	expected := url.Values{SERVICE: {`WFS`}, REQUEST: {getcapabilities}, VERSION: {``}, wsc110.UPDATESEQUENCE: {`5`}, wsc110.ACCEPTVERSIONS: {`2.0.0`}, wsc110.SECTIONS: {`FeatureTypeList`}}
+	if q := r.ToQueryParameters(); q.Encode() != expected.Encode() {
+		t.Errorf("test: %d, expected: %s,\n got: %s", 0, expected.Encode(), q.Encode())
+	}
+
+	result := string(r.ToXML())
+	for _, s := range []string{`updateSequence="5"`, `<ows:AcceptVersions><ows:Version>2.0.0</ows:Version></ows:AcceptVersions>`, `<ows:Sections><ows:Section>FeatureTypeList</ows:Section></ows:Sections>`} {
+		if !strings.Contains(result, s) {
+			t.Errorf("test: %d, expected %s in:\n %s", 0, s, result)
+		}
+	}
+
+	r.AcceptVersions = &wsc110.AcceptVersions{Version: []string{`1.1.0`}}
+	if exceptions := r.Validate(nil); len(exceptions) != 1 || exceptions[0].Code() != `VersionNegotiationFailed` {
+		t.Errorf("test: %d, expected: VersionNegotiationFailed,\n got: %v", 1, exceptions)
+	}
+}
@@ -62,10 +62,10 @@ func TestGetCapabilitiesParseXML(t *testing.T) {
 			}
 		} else {
 			if gc.Service != test.result.Service {
-				t.Errorf("test: %d, expected: %s ,\n got: %s", k, test.result, gc)
+				t.Errorf("test: %d, expected: %v ,\n got: %v", k, test.result, gc)
 			}
 			if gc.Version != test.result.Version {
-				t.Errorf("test: %d, expected: %s ,\n got: %s", k, test.result, gc)
+				t.Errorf("test: %d, expected: %v ,\n got: %v", k, test.result, gc)
 			}
 			if len(test.result.Attr) == len(gc.Attr) {
 				c := false
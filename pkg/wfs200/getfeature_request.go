@@ -57,6 +57,9 @@ func (f GetFeatureRequest) Type() string {
 func (f GetFeatureRequest) Validate(_ wsc110.Capabilities) []wsc110.Exception {
 
 	// getfeaturecap := c.(capabilities.Capabilities)
+	if f.Query.Filter != nil && f.Query.Filter.BBOX != nil {
+		return f.Query.Filter.BBOX.Validate()
+	}
 	return nil
 }
 
@@ -300,7 +303,7 @@ func (q *Query) parseKVPRequest(fpv getFeatureRequestParameterValue) []wsc110.Ex
 			if exception := b.parseKVPRequest(*fpv.bbox); exception != nil {
 				exceptions = append(exceptions, exception...)
 			}
-			q.Filter.BBOX = &b
+			q.Filter = &Filter{SpatialOperator: SpatialOperator{BBOX: &b}}
 		}
 	}
 
@@ -376,9 +379,9 @@ type Filter struct {
 }
 
 func (f Filter) toString() string {
-	si, _ := xml.MarshalIndent(f, "", "")
-	re := regexp.MustCompile(`><.*>`)
-	return xml.Header + re.ReplaceAllString(string(si), "/>")
+	e := utils.NewEncoder(nil)
+	doc, _ := e.Marshal(f)
+	return string(doc)
 }
 
 func (f *Filter) parseKVPRequest(filter string) []wsc110.Exception {
@@ -454,6 +457,7 @@ type ComparisonOperator struct {
 	PropertyIsGreaterThanOrEqualTo *[]PropertyIsGreaterThanOrEqualTo `xml:"PropertyIsGreaterThanOrEqualTo" yaml:"propertyIsGreaterThanOrEqualTo"`
 	PropertyIsBetween              *[]PropertyIsBetween              `xml:"PropertyIsBetween" yaml:"propertyIsBetween"`
 	PropertyIsLike                 *[]PropertyIsLike                 `xml:"PropertyIsLike" yaml:"propertyIsLike"`
+	PropertyIsNull                 *[]PropertyIsNull                 `xml:"PropertyIsNull" yaml:"propertyIsNull"`
 }
 
 // ComparisonOperatorAttribute struct for the ComparisonOperators
@@ -503,6 +507,12 @@ type PropertyIsLike struct {
 	ComparisonOperatorAttribute
 }
 
+// PropertyIsNull for ComparisonOperator
+type PropertyIsNull struct {
+	PropertyName   *string `xml:"PropertyName" yaml:"propertyName"`
+	ValueReference *string `xml:"ValueReference" yaml:"valueReference"`
+}
+
 // PropertyIsBetween for ComparisonOperator
 type PropertyIsBetween struct {
 	PropertyName  string `xml:"PropertyName" yaml:"propertyName"`
@@ -589,6 +599,7 @@ type Box struct {
 
 // Envelope struct for GeometryOperand
 type Envelope struct {
+	SrsName     *string         `xml:"srsName,attr,omitempty" yaml:"srsName,omitempty"`
 	LowerCorner wsc110.Position `xml:"lowerCorner" yaml:"lowerCorner"`
 	UpperCorner wsc110.Position `xml:"upperCorner" yaml:"upperCorner"`
 }
@@ -725,6 +736,29 @@ func (gb *GEOBBOX) parseKVPRequest(q string) []wsc110.Exception {
 	return nil
 }
 
+// EastNorth returns the Envelope with the coordinates in the x/y (east/north) axis order.
+// The coordinates of a WFS 2.0.0 BBOX follow the axis order of its srsName,
+// so for a CRS like urn:ogc:def:crs:EPSG::4326 the latitude/longitude axes are swapped.
+func (gb GEOBBOX) EastNorth() Envelope {
+	if gb.SrsName != nil {
+		var c CRS
+		c.parseString(*gb.SrsName)
+		if c.NorthEast() {
+			return gb.Envelope.SwapAxis()
+		}
+	}
+	return gb.Envelope
+}
+
+// SwapAxis returns the Envelope with the first and second axis swapped
+func (e Envelope) SwapAxis() Envelope {
+	return Envelope{
+		SrsName:     e.SrsName,
+		LowerCorner: wsc110.Position{e.LowerCorner[1], e.LowerCorner[0]},
+		UpperCorner: wsc110.Position{e.UpperCorner[1], e.UpperCorner[0]},
+	}
+}
+
 // MarshalText build a Parameter Value string of a GEOBBOX object
 func (gb *GEOBBOX) MarshalText() string {
 	regex := regexp.MustCompile(` `)
@@ -0,0 +1,78 @@
+package wms111
+
+import (
+	"github.com/pdok/ogc-specifications/pkg/wms130"
+)
+
+// Capabilities contains the Capability section of the WMS 1.1.1 capabilities, requests are validated against it
+type Capabilities struct {
+	Request   wms130.Request       `xml:"Request" yaml:"request"`
+	Exception wms130.ExceptionType `xml:"Exception" yaml:"exception"`
+	Layer     []Layer              `xml:"Layer" yaml:"layer"`
+}
+
+// Layer contains the WMS 1.1.1 layer configuration, the elements that didn't change in WMS 1.3.0 use the wms130 types
+type Layer struct {
+	Queryable         *int                   `xml:"queryable,attr" yaml:"queryable"`
+	Opaque            *string                `xml:"opaque,attr" yaml:"opaque,omitempty"`
+	Name              *string                `xml:"Name" yaml:"name,omitempty"`
+	Title             string                 `xml:"Title" yaml:"title"`
+	Abstract          *string                `xml:"Abstract,omitempty" yaml:"abstract,omitempty"`
+	KeywordList       *wms130.Keywords       `xml:"KeywordList" yaml:"keywordList,omitempty"`
+	SRS               []string               `xml:"SRS" yaml:"srs,omitempty"`
+	LatLonBoundingBox *LatLonBoundingBox     `xml:"LatLonBoundingBox" yaml:"latLonBoundingBox,omitempty"`
+	BoundingBox       []*LayerBoundingBox    `xml:"BoundingBox" yaml:"boundingBox,omitempty"`
+	Dimension         []*Dimension           `xml:"Dimension" yaml:"dimension,omitempty"`
+	Extent            []*Extent              `xml:"Extent" yaml:"extent,omitempty"`
+	Attribution       *wms130.Attribution    `xml:"Attribution,omitempty" yaml:"attribution,omitempty"`
+	AuthorityURL      []*wms130.AuthorityURL `xml:"AuthorityURL" yaml:"authorityUrl,omitempty"`
+	Identifier        *wms130.Identifier     `xml:"Identifier" yaml:"identifier,omitempty"`
+	MetadataURL       []*wms130.MetadataURL  `xml:"MetadataURL" yaml:"metadataUrl,omitempty"`
+	DataURL           *wms130.URL            `xml:"DataURL,omitempty" yaml:"dataUrl,omitempty"`
+	FeatureListURL    *wms130.URL            `xml:"FeatureListURL,omitempty" yaml:"featureListUrl,omitempty"`
+	Style             []*wms130.Style        `xml:"Style" yaml:"style,omitempty"`
+	ScaleHint         *ScaleHint             `xml:"ScaleHint" yaml:"scaleHint,omitempty"`
+	Layer             []*Layer               `xml:"Layer" yaml:"layer,omitempty"`
+}
+
+// LatLonBoundingBox is the extent of the layer in EPSG:4326, with the longitudes as x and the latitudes as y
+type LatLonBoundingBox struct {
+	Minx float64 `xml:"minx,attr" yaml:"minx"`
+	Miny float64 `xml:"miny,attr" yaml:"miny"`
+	Maxx float64 `xml:"maxx,attr" yaml:"maxx"`
+	Maxy float64 `xml:"maxy,attr" yaml:"maxy"`
+}
+
+// LayerBoundingBox is the extent of the layer in a SRS, always in the x/y (east/north) axis order
+type LayerBoundingBox struct {
+	SRS  string  `xml:"SRS,attr" yaml:"srs"`
+	Minx float64 `xml:"minx,attr" yaml:"minx"`
+	Miny float64 `xml:"miny,attr" yaml:"miny"`
+	Maxx float64 `xml:"maxx,attr" yaml:"maxx"`
+	Maxy float64 `xml:"maxy,attr" yaml:"maxy"`
+	Resx float64 `xml:"resx,attr,omitempty" yaml:"resx,omitempty"`
+	Resy float64 `xml:"resy,attr,omitempty" yaml:"resy,omitempty"`
+}
+
+// Dimension declares a dimension of the layer, its values are declared by the Extent with the same name
+type Dimension struct {
+	Name       string  `xml:"name,attr" yaml:"name"`
+	Units      string  `xml:"units,attr" yaml:"units"`
+	UnitSymbol *string `xml:"unitSymbol,attr,omitempty" yaml:"unitSymbol,omitempty"`
+}
+
+// Extent contains the values of a Dimension
+type Extent struct {
+	Name           string  `xml:"name,attr" yaml:"name"`
+	Default        *string `xml:"default,attr,omitempty" yaml:"default,omitempty"`
+	MultipleValues *string `xml:"multipleValues,attr,omitempty" yaml:"multipleValues,omitempty"`
+	NearestValue   *string `xml:"nearestValue,attr,omitempty" yaml:"nearestValue,omitempty"`
+	Current        *string `xml:"current,attr,omitempty" yaml:"current,omitempty"`
+	Value          string  `xml:",chardata" yaml:"value"`
+}
+
+// ScaleHint contains the range of the diagonal size of a pixel, in ground units, the layer is meant to be shown at
+type ScaleHint struct {
+	Min float64 `xml:"min,attr" yaml:"min"`
+	Max float64 `xml:"max,attr" yaml:"max"`
+}
@@ -0,0 +1,73 @@
+// Package wms111 contains the WMS 1.1.1 requests, capabilities document and ServiceExceptionReport.
+//
+// WMS 1.1.1 differs from WMS 1.3.0 in the SRS parameter instead of CRS, the X and Y parameters instead of I and J
+// and a BBOX that is always in the x/y (east/north) axis order. The types convert to and from the wms130 types,
+// swapping the axes of the BBOX where the CRS needs it, so one WMS 1.3.0 backend can serve both versions.
+package wms111
+
+import (
+	"net/url"
+
+	"github.com/pdok/ogc-specifications/pkg/utils"
+)
+
+const (
+	getcapabilities = `GetCapabilities`
+	getmap          = `GetMap`
+	getfeatureinfo  = `GetFeatureInfo`
+
+	Service string = `WMS`
+	Version string = `1.1.1`
+)
+
+// WMS 1.1.1 Keys
+const (
+	SERVICE = `SERVICE`
+	REQUEST = `REQUEST`
+	VERSION = `VERSION`
+	// WMTVER is the WMS 1.0.0 name of the VERSION parameter, WMS 1.1.1 servers still accept it
+	WMTVER = `WMTVER`
+)
+
+// baseParameterValueRequest struct
+type baseParameterValueRequest struct {
+	version string `yaml:"version,omitempty"`
+	request string `yaml:"request,omitempty"`
+}
+
+// BaseRequest contains the service and version of a WMS 1.1.1 request
+type BaseRequest struct {
+	Service string             `xml:"service,attr" yaml:"service,omitempty"`
+	Version string             `xml:"version,attr" yaml:"version"`
+	Attr    utils.XMLAttribute `xml:",attr" yaml:"attr"`
+}
+
+// ParseQueryParameters builds a BaseRequest struct based on the given parameters
+func (b *BaseRequest) ParseQueryParameters(q url.Values) Exceptions {
+	query := utils.KeysToUpper(q)
+
+	if len(query[SERVICE]) > 0 {
+		b.Service = query[SERVICE][0]
+	}
+	switch {
+	case len(query[VERSION]) > 0:
+		b.Version = query[VERSION][0]
+	case len(query[WMTVER]) > 0:
+		b.Version = query[WMTVER][0]
+	default:
+		return MissingParameterValue(VERSION).ToExceptions()
+	}
+	return nil
+}
+
+// parseBaseParameterValueRequest builds a BaseRequest struct
+func (b *BaseRequest) parseBaseParameterValueRequest(bpv baseParameterValueRequest) Exceptions {
+	// Service is optional, because it's implicit for a GetMap/GetFeatureInfo request
+	b.Service = Service
+
+	if bpv.version == `` {
+		return MissingParameterValue(VERSION).ToExceptions()
+	}
+	b.Version = bpv.version
+	return nil
+}
@@ -0,0 +1,393 @@
+package wms111
+
+import (
+	"math"
+	"strings"
+
+	"github.com/pdok/ogc-specifications/pkg/wms130"
+)
+
+// ServiceName is the Name of the Service in the WMS 1.1.1 capabilities
+const ServiceName = `OGC:WMS`
+
+// The formats that are named differently by WMS 1.1.1 and WMS 1.3.0, by their WMS 1.1.1 name
+var (
+	exceptionFormats = map[string]string{
+		ExceptionsXML:     wms130.ExceptionsXML,
+		ExceptionsINIMAGE: wms130.ExceptionsINIMAGE,
+		ExceptionsBLANK:   wms130.ExceptionsBLANK,
+	}
+	capabilitiesFormats = map[string]string{
+		`application/vnd.ogc.wms_xml`: `text/xml`,
+	}
+	exceptionCodes = map[string]string{
+		`InvalidSRS`: `InvalidCRS`,
+	}
+)
+
+// pixelDiagonal is the diagonal size in metres of the 0.28mm standardized rendering pixel,
+// it converts a ScaleHint to a scale denominator
+var pixelDiagonal = 0.00028 * math.Sqrt2
+
+// toWMS130 returns the WMS 1.3.0 name of a value, other values are returned as is
+func toWMS130(names map[string]string, value string) string {
+	if name, ok := names[value]; ok {
+		return name
+	}
+	return value
+}
+
+// fromWMS130 returns the WMS 1.1.1 name of a value, other values are returned as is
+func fromWMS130(names map[string]string, value string) string {
+	for name, wms130Name := range names {
+		if wms130Name == value {
+			return name
+		}
+	}
+	return value
+}
+
+func formatsToWMS130(names map[string]string, formats []string) []string {
+	var result []string
+	for _, f := range formats {
+		result = append(result, toWMS130(names, f))
+	}
+	return result
+}
+
+func formatsFromWMS130(names map[string]string, formats []string) []string {
+	var result []string
+	for _, f := range formats {
+		result = append(result, fromWMS130(names, f))
+	}
+	return result
+}
+
+func exceptionsFormatToWMS130(format *string) *string {
+	if format == nil {
+		return nil
+	}
+	f := toWMS130(exceptionFormats, *format)
+	return &f
+}
+
+func exceptionsFormatFromWMS130(format *string) *string {
+	if format == nil {
+		return nil
+	}
+	f := fromWMS130(exceptionFormats, *format)
+	return &f
+}
+
+// ToWMS130 returns the Exceptions as WMS 1.3.0 exceptions, InvalidSRS becomes InvalidCRS
+func (e Exceptions) ToWMS130() wms130.Exceptions {
+	var result wms130.Exceptions
+	for _, exception := range e {
+		exception.ExceptionCode = toWMS130(exceptionCodes, exception.ExceptionCode)
+		result = append(result, wms130.Exception{ExceptionDetails: exception.ExceptionDetails})
+	}
+	return result
+}
+
+// FromWMS130 sets the Exceptions to the WMS 1.3.0 exceptions, InvalidCRS becomes InvalidSRS
+func (e *Exceptions) FromWMS130(exceptions wms130.Exceptions) {
+	var result Exceptions
+	for _, exception := range exceptions {
+		exception.ExceptionCode = fromWMS130(exceptionCodes, exception.ExceptionCode)
+		result = append(result, Exception{ExceptionDetails: exception.ExceptionDetails})
+	}
+	*e = result
+}
+
+// ToWMS130 returns the GetCapabilities request as WMS 1.3.0 request, which has no UpdateSequence
+func (g GetCapabilitiesRequest) ToWMS130() wms130.GetCapabilitiesRequest {
+	r := wms130.GetCapabilitiesRequest{BaseRequest: wms130.BaseRequest{Service: g.Service, Version: wms130.Version, Attr: g.Attr}}
+	r.XMLName.Local = getcapabilities
+	return r
+}
+
+// FromWMS130 sets the GetCapabilities request to the WMS 1.3.0 request
+func (g *GetCapabilitiesRequest) FromWMS130(r wms130.GetCapabilitiesRequest) {
+	*g = GetCapabilitiesRequest{BaseRequest: BaseRequest{Service: r.Service, Version: Version, Attr: r.Attr}}
+	g.XMLName.Local = getcapabilities
+}
+
+// ToWMS130 returns the GetMap request as WMS 1.3.0 request,
+// the axes of the BBOX are swapped when the first axis of the CRS is the northing, like for EPSG:4326
+func (m GetMapRequest) ToWMS130() wms130.GetMapRequest {
+	r := wms130.GetMapRequest{
+		BaseRequest:           wms130.BaseRequest{Service: m.Service, Version: wms130.Version, Attr: m.Attr},
+		StyledLayerDescriptor: m.StyledLayerDescriptor,
+		CRS:                   m.SRS,
+		BoundingBox:           m.BoundingBox.EastNorth(m.SRS),
+		Output:                m.Output,
+		Exceptions:            exceptionsFormatToWMS130(m.Exceptions),
+		Time:                  m.Time,
+	}
+	r.XMLName.Local = getmap
+	return r
+}
+
+// FromWMS130 sets the GetMap request to the WMS 1.3.0 request, with the BBOX in the x/y (east/north) axis order
+func (m *GetMapRequest) FromWMS130(r wms130.GetMapRequest) {
+	*m = GetMapRequest{
+		BaseRequest:           BaseRequest{Service: r.Service, Version: Version, Attr: r.Attr},
+		StyledLayerDescriptor: r.StyledLayerDescriptor,
+		SRS:                   r.CRS,
+		BoundingBox:           r.BoundingBox.EastNorth(r.CRS),
+		Output:                r.Output,
+		Exceptions:            exceptionsFormatFromWMS130(r.Exceptions),
+		Time:                  r.Time,
+	}
+	m.XMLName.Local = getmap
+}
+
+// ToWMS130 returns the GetFeatureInfo request as WMS 1.3.0 request, X and Y become I and J
+// and the axes of the BBOX are swapped when the first axis of the CRS is the northing, like for EPSG:4326
+func (gfi GetFeatureInfoRequest) ToWMS130() wms130.GetFeatureInfoRequest {
+	r := wms130.GetFeatureInfoRequest{
+		BaseRequest:           wms130.BaseRequest{Service: gfi.Service, Version: wms130.Version, Attr: gfi.Attr},
+		StyledLayerDescriptor: gfi.StyledLayerDescriptor,
+		CRS:                   gfi.SRS,
+		BoundingBox:           gfi.BoundingBox.EastNorth(parseSRS(gfi.SRS)),
+		Size:                  gfi.Size,
+		Format:                gfi.Format,
+		QueryLayers:           gfi.QueryLayers,
+		I:                     gfi.X,
+		J:                     gfi.Y,
+		InfoFormat:            gfi.InfoFormat,
+		FeatureCount:          gfi.FeatureCount,
+		Exceptions:            exceptionsFormatToWMS130(gfi.Exceptions),
+	}
+	r.XMLName.Local = getfeatureinfo
+	return r
+}
+
+// FromWMS130 sets the GetFeatureInfo request to the WMS 1.3.0 request, I and J become X and Y
+// and the BBOX is in the x/y (east/north) axis order
+func (gfi *GetFeatureInfoRequest) FromWMS130(r wms130.GetFeatureInfoRequest) {
+	*gfi = GetFeatureInfoRequest{
+		BaseRequest:           BaseRequest{Service: r.Service, Version: Version, Attr: r.Attr},
+		StyledLayerDescriptor: r.StyledLayerDescriptor,
+		SRS:                   r.CRS,
+		BoundingBox:           r.BoundingBox.EastNorth(parseSRS(r.CRS)),
+		Size:                  r.Size,
+		Format:                r.Format,
+		QueryLayers:           r.QueryLayers,
+		X:                     r.I,
+		Y:                     r.J,
+		InfoFormat:            r.InfoFormat,
+		FeatureCount:          r.FeatureCount,
+		Exceptions:            exceptionsFormatFromWMS130(r.Exceptions),
+	}
+	gfi.XMLName.Local = getfeatureinfo
+}
+
+// ToWMS130 returns the capabilities as WMS 1.3.0 capabilities
+func (gc GetCapabilitiesResponse) ToWMS130() wms130.GetCapabilitiesResponse {
+	r := wms130.GetCapabilitiesResponse{
+		Namespaces: &wms130.Namespaces{
+			XmlnsWMS:       `http://www.opengis.net/wms`,
+			XmlnsSLD:       `http://www.opengis.net/sld`,
+			XmlnsXlink:     `http://www.w3.org/1999/xlink`,
+			XmlnsXSI:       `http://www.w3.org/2001/XMLSchema-instance`,
+			Version:        wms130.Version,
+			SchemaLocation: `http://www.opengis.net/wms http://schemas.opengis.net/wms/1.3.0/capabilities_1_3_0.xsd`,
+		},
+		WMSService: wms130.WMSService{
+			Name:               wms130.Service,
+			Title:              gc.WMSService.Title,
+			Abstract:           gc.WMSService.Abstract,
+			KeywordList:        gc.WMSService.KeywordList,
+			OnlineResource:     gc.WMSService.OnlineResource,
+			ContactInformation: gc.WMSService.ContactInformation,
+			Fees:               gc.WMSService.Fees,
+			AccessConstraints:  gc.WMSService.AccessConstraints,
+		},
+		Capabilities: gc.Capabilities.ToWMS130(),
+	}
+	r.XMLName.Local = `WMS_Capabilities`
+	return r
+}
+
+// FromWMS130 sets the capabilities to the WMS 1.3.0 capabilities. The OptionalConstraints
+// and the INSPIRE ExtendedCapabilities can't be expressed in WMS 1.1.1 and are left out.
+func (gc *GetCapabilitiesResponse) FromWMS130(r wms130.GetCapabilitiesResponse) {
+	*gc = GetCapabilitiesResponse{
+		Attributes: Attributes{Version: Version},
+		WMSService: WMSService{
+			Name:               ServiceName,
+			Title:              r.WMSService.Title,
+			Abstract:           r.WMSService.Abstract,
+			KeywordList:        r.WMSService.KeywordList,
+			OnlineResource:     r.WMSService.OnlineResource,
+			ContactInformation: r.WMSService.ContactInformation,
+			Fees:               r.WMSService.Fees,
+			AccessConstraints:  r.WMSService.AccessConstraints,
+		},
+	}
+	gc.XMLName.Local = `WMT_MS_Capabilities`
+	gc.Capabilities.FromWMS130(r.Capabilities)
+}
+
+// ToWMS130 returns the Capability section as WMS 1.3.0 Capabilities
+func (c Capabilities) ToWMS130() wms130.Capabilities {
+	var r wms130.Capabilities
+	r.Request = c.Request
+	r.Request.GetCapabilities.Format = formatsToWMS130(capabilitiesFormats, c.Request.GetCapabilities.Format)
+	r.Exception.Format = formatsToWMS130(exceptionFormats, c.Exception.Format)
+	for _, l := range c.Layer {
+		r.Layer = append(r.Layer, l.ToWMS130())
+	}
+	return r
+}
+
+// FromWMS130 sets the Capability section to the WMS 1.3.0 Capabilities
+func (c *Capabilities) FromWMS130(r wms130.Capabilities) {
+	*c = Capabilities{Request: r.Request}
+	c.Request.GetCapabilities.Format = formatsFromWMS130(capabilitiesFormats, r.Request.GetCapabilities.Format)
+	c.Exception.Format = formatsFromWMS130(exceptionFormats, r.Exception.Format)
+	for _, l := range r.Layer {
+		var layer Layer
+		layer.FromWMS130(l)
+		c.Layer = append(c.Layer, layer)
+	}
+}
+
+// ToWMS130 returns the layer as WMS 1.3.0 layer. The axes of the bounding boxes are swapped
+// when the first axis of the CRS is the northing, the Extents become the values of the Dimensions
+// and the ScaleHint becomes the scale denominators.
+func (l Layer) ToWMS130() wms130.Layer {
+	r := wms130.Layer{
+		Queryable:      l.Queryable,
+		Opaque:         l.Opaque,
+		Name:           l.Name,
+		Title:          l.Title,
+		Abstract:       l.Abstract,
+		KeywordList:    l.KeywordList,
+		Attribution:    l.Attribution,
+		AuthorityURL:   l.AuthorityURL,
+		Identifier:     l.Identifier,
+		MetadataURL:    l.MetadataURL,
+		DataURL:        l.DataURL,
+		FeatureListURL: l.FeatureListURL,
+		Style:          l.Style,
+	}
+
+	// WMS 1.1.0 allowed a whitespace separated list of SRS in one element
+	for _, srs := range l.SRS {
+		for _, s := range strings.Fields(srs) {
+			if c := parseSRS(s); c.Namespace != `` {
+				r.CRS = append(r.CRS, c)
+			}
+		}
+	}
+
+	if b := l.LatLonBoundingBox; b != nil {
+		r.EXGeographicBoundingBox = &wms130.EXGeographicBoundingBox{
+			WestBoundLongitude: b.Minx,
+			EastBoundLongitude: b.Maxx,
+			SouthBoundLatitude: b.Miny,
+			NorthBoundLatitude: b.Maxy,
+		}
+	}
+
+	for _, b := range l.BoundingBox {
+		bbox := wms130.LayerBoundingBox{CRS: b.SRS, Minx: b.Minx, Miny: b.Miny, Maxx: b.Maxx, Maxy: b.Maxy, Resx: b.Resx, Resy: b.Resy}
+		// swapping the axes is its own inverse, so EastNorth also swaps the x/y order to the CRS order
+		bbox = bbox.EastNorth()
+		r.BoundingBox = append(r.BoundingBox, &bbox)
+	}
+
+	for _, d := range l.Dimension {
+		dimension := &wms130.Dimension{Name: sp(d.Name), Units: sp(d.Units)}
+		for _, e := range l.Extent {
+			if e.Name == d.Name {
+				dimension.Default = e.Default
+				dimension.MultipleValues = e.MultipleValues
+				dimension.NearestValue = e.NearestValue
+				dimension.Current = e.Current
+				dimension.Value = sp(e.Value)
+			}
+		}
+		r.Dimension = append(r.Dimension, dimension)
+	}
+
+	if l.ScaleHint != nil {
+		if l.ScaleHint.Min > 0 {
+			r.MinScaleDenominator = fp(l.ScaleHint.Min / pixelDiagonal)
+		}
+		if l.ScaleHint.Max > 0 && l.ScaleHint.Max < math.MaxFloat64 {
+			r.MaxScaleDenominator = fp(l.ScaleHint.Max / pixelDiagonal)
+		}
+	}
+
+	for _, child := range l.Layer {
+		c := child.ToWMS130()
+		r.Layer = append(r.Layer, &c)
+	}
+	return r
+}
+
+// FromWMS130 sets the layer to the WMS 1.3.0 layer, with the bounding boxes in the x/y (east/north) axis order
+func (l *Layer) FromWMS130(r wms130.Layer) {
+	*l = Layer{
+		Queryable:      r.Queryable,
+		Opaque:         r.Opaque,
+		Name:           r.Name,
+		Title:          r.Title,
+		Abstract:       r.Abstract,
+		KeywordList:    r.KeywordList,
+		Attribution:    r.Attribution,
+		AuthorityURL:   r.AuthorityURL,
+		Identifier:     r.Identifier,
+		MetadataURL:    r.MetadataURL,
+		DataURL:        r.DataURL,
+		FeatureListURL: r.FeatureListURL,
+		Style:          r.Style,
+	}
+
+	for _, c := range r.CRS {
+		l.SRS = append(l.SRS, c.String())
+	}
+
+	if b := r.EXGeographicBoundingBox; b != nil {
+		l.LatLonBoundingBox = &LatLonBoundingBox{
+			Minx: b.WestBoundLongitude,
+			Miny: b.SouthBoundLatitude,
+			Maxx: b.EastBoundLongitude,
+			Maxy: b.NorthBoundLatitude,
+		}
+	}
+
+	for _, b := range r.BoundingBox {
+		bbox := b.EastNorth()
+		l.BoundingBox = append(l.BoundingBox, &LayerBoundingBox{SRS: bbox.CRS, Minx: bbox.Minx, Miny: bbox.Miny, Maxx: bbox.Maxx, Maxy: bbox.Maxy, Resx: bbox.Resx, Resy: bbox.Resy})
+	}
+
+	for _, d := range r.Dimension {
+		dimension := &Dimension{Name: value(d.Name), Units: value(d.Units)}
+		l.Dimension = append(l.Dimension, dimension)
+		if d.Value != nil || d.Default != nil || d.MultipleValues != nil || d.NearestValue != nil || d.Current != nil {
+			l.Extent = append(l.Extent, &Extent{Name: dimension.Name, Default: d.Default, MultipleValues: d.MultipleValues,
+				NearestValue: d.NearestValue, Current: d.Current, Value: value(d.Value)})
+		}
+	}
+
+	if r.MinScaleDenominator != nil || r.MaxScaleDenominator != nil {
+		// both bounds are required, an unbounded maximum is expressed as the largest float
+		l.ScaleHint = &ScaleHint{Min: 0, Max: math.MaxFloat64}
+		if r.MinScaleDenominator != nil {
+			l.ScaleHint.Min = *r.MinScaleDenominator * pixelDiagonal
+		}
+		if r.MaxScaleDenominator != nil {
+			l.ScaleHint.Max = *r.MaxScaleDenominator * pixelDiagonal
+		}
+	}
+
+	for _, child := range r.Layer {
+		var c Layer
+		c.FromWMS130(*child)
+		l.Layer = append(l.Layer, &c)
+	}
+}
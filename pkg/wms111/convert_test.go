@@ -0,0 +1,163 @@
+package wms111
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/pdok/ogc-specifications/pkg/wms130"
+)
+
+func TestGetMapToWMS130(t *testing.T) {
+	var tests = []struct {
+		getmap GetMapRequest
+		bbox   wms130.BoundingBox
+	}{
+		// EPSG:4326 has a latitude/longitude axis order in WMS 1.3.0
+		0: {getmap: GetMapRequest{
+			BaseRequest: BaseRequest{Service: Service, Version: Version},
+			SRS:         wms130.CRS{Namespace: `EPSG`, Code: 4326},
+			BoundingBox: wms130.BoundingBox{LowerCorner: wms130.Position{-180, -90}, UpperCorner: wms130.Position{180, 90}},
+			Output:      wms130.Output{Size: wms130.Size{Width: 1024, Height: 512}, Format: `image/png`},
+			Exceptions:  sp(ExceptionsBLANK),
+		},
+			bbox: wms130.BoundingBox{LowerCorner: wms130.Position{-90, -180}, UpperCorner: wms130.Position{90, 180}}},
+		1: {getmap: GetMapRequest{
+			BaseRequest: BaseRequest{Service: Service, Version: Version},
+			SRS:         wms130.CRS{Namespace: `EPSG`, Code: 28992},
+			BoundingBox: wms130.BoundingBox{LowerCorner: wms130.Position{0, 300000}, UpperCorner: wms130.Position{280000, 620000}},
+		},
+			bbox: wms130.BoundingBox{LowerCorner: wms130.Position{0, 300000}, UpperCorner: wms130.Position{280000, 620000}}},
+		2: {getmap: GetMapRequest{
+			BaseRequest: BaseRequest{Service: Service, Version: Version},
+			SRS:         wms130.CRS{Namespace: `CRS`, Code: 84},
+			BoundingBox: wms130.BoundingBox{LowerCorner: wms130.Position{-180, -90}, UpperCorner: wms130.Position{180, 90}},
+		},
+			bbox: wms130.BoundingBox{LowerCorner: wms130.Position{-180, -90}, UpperCorner: wms130.Position{180, 90}}},
+		// the TIME is the same in both versions
+		3: {getmap: GetMapRequest{
+			BaseRequest: BaseRequest{Service: Service, Version: Version},
+			SRS:         wms130.CRS{Namespace: `EPSG`, Code: 28992},
+			BoundingBox: wms130.BoundingBox{LowerCorner: wms130.Position{0, 300000}, UpperCorner: wms130.Position{280000, 620000}},
+			Time:        sp(`2020-01-01/2020-12-31/P1D`),
+		},
+			bbox: wms130.BoundingBox{LowerCorner: wms130.Position{0, 300000}, UpperCorner: wms130.Position{280000, 620000}}},
+	}
+
+	for k, test := range tests {
+		test.getmap.XMLName.Local = getmap
+		r := test.getmap.ToWMS130()
+		if r.BoundingBox != test.bbox || r.Version != wms130.Version || r.CRS != test.getmap.SRS {
+			t.Errorf("test: %d, expected: %v,\n got: %+v", k, test.bbox, r)
+		}
+		if !reflect.DeepEqual(r.Time, test.getmap.Time) {
+			t.Errorf("test: %d, expected: %v,\n got: %v", k, test.getmap.Time, r.Time)
+		}
+		if test.getmap.Exceptions != nil && *r.Exceptions != wms130.ExceptionsBLANK {
+			t.Errorf("test: %d, expected: %s,\n got: %s", k, wms130.ExceptionsBLANK, *r.Exceptions)
+		}
+
+		var result GetMapRequest
+		result.FromWMS130(r)
+		if !reflect.DeepEqual(result, test.getmap) {
+			t.Errorf("test: %d, expected: %+v,\n got: %+v", k, test.getmap, result)
+		}
+	}
+}
+
+func TestGetFeatureInfoToWMS130(t *testing.T) {
+	gfi := GetFeatureInfoRequest{
+		BaseRequest: BaseRequest{Service: Service, Version: Version},
+		SRS:         `EPSG:4258`,
+		BoundingBox: wms130.BoundingBox{LowerCorner: wms130.Position{3, 50}, UpperCorner: wms130.Position{8, 54}},
+		Size:        wms130.Size{Width: 256, Height: 256},
+		QueryLayers: []string{`Rivers`},
+		X:           10,
+		Y:           20,
+		InfoFormat:  `text/html`,
+	}
+	gfi.XMLName.Local = getfeatureinfo
+
+	r := gfi.ToWMS130()
+	expected := wms130.BoundingBox{LowerCorner: wms130.Position{50, 3}, UpperCorner: wms130.Position{54, 8}}
+	if r.I != 10 || r.J != 20 || r.BoundingBox != expected {
+		t.Errorf("expected: I 10, J 20 and %v,\n got: %+v", expected, r)
+	}
+
+	var result GetFeatureInfoRequest
+	result.FromWMS130(r)
+	if !reflect.DeepEqual(result, gfi) {
+		t.Errorf("expected: %+v,\n got: %+v", gfi, result)
+	}
+}
+
+func TestExceptionsToWMS130(t *testing.T) {
+	exceptions := Exceptions{InvalidSRS(`EPSG:3857`), LayerNotDefined(`Rivers`)}
+
+	r := exceptions.ToWMS130()
+	if r[0].Code() != `InvalidCRS` || r[1].Code() != `LayerNotDefined` {
+		t.Errorf("expected: InvalidCRS and LayerNotDefined,\n got: %v", r)
+	}
+
+	var result Exceptions
+	result.FromWMS130(r)
+	if !reflect.DeepEqual(result, exceptions) {
+		t.Errorf("expected: %v,\n got: %v", exceptions, result)
+	}
+}
+
+func TestLayerToWMS130(t *testing.T) {
+	layer := Layer{
+		Queryable:         ip(1),
+		Name:              sp(`Rivers`),
+		Title:             `Rivers`,
+		SRS:               []string{`EPSG:4326`, `EPSG:28992`},
+		LatLonBoundingBox: &LatLonBoundingBox{Minx: 3, Miny: 50, Maxx: 8, Maxy: 54},
+		BoundingBox:       []*LayerBoundingBox{{SRS: `EPSG:4326`, Minx: 3, Miny: 50, Maxx: 8, Maxy: 54}},
+		Dimension:         []*Dimension{{Name: `time`, Units: `ISO8601`}},
+		Extent:            []*Extent{{Name: `time`, Default: sp(`2020-01-01`), NearestValue: sp(`1`), Current: sp(`0`), Value: `2020-01-01/2020-12-31/P1D`}},
+		Layer:             []*Layer{{Name: sp(`Canals`), Title: `Canals`}},
+	}
+
+	r := layer.ToWMS130()
+	if len(r.CRS) != 2 || r.CRS[0] != (wms130.CRS{Namespace: `EPSG`, Code: 4326}) {
+		t.Errorf("expected: EPSG:4326 and EPSG:28992,\n got: %v", r.CRS)
+	}
+	if *r.BoundingBox[0] != (wms130.LayerBoundingBox{CRS: `EPSG:4326`, Minx: 50, Miny: 3, Maxx: 54, Maxy: 8}) {
+		t.Errorf("expected the axes of the bounding box to be swapped,\n got: %+v", *r.BoundingBox[0])
+	}
+	if *r.EXGeographicBoundingBox != (wms130.EXGeographicBoundingBox{WestBoundLongitude: 3, EastBoundLongitude: 8, SouthBoundLatitude: 50, NorthBoundLatitude: 54}) {
+		t.Errorf("expected the LatLonBoundingBox as EX_GeographicBoundingBox,\n got: %+v", *r.EXGeographicBoundingBox)
+	}
+	if *r.Dimension[0].Value != `2020-01-01/2020-12-31/P1D` || *r.Dimension[0].Default != `2020-01-01` {
+		t.Errorf("expected the Extent as value of the Dimension,\n got: %+v", *r.Dimension[0])
+	}
+
+	var result Layer
+	result.FromWMS130(r)
+	if !reflect.DeepEqual(result, layer) {
+		t.Errorf("expected: %+v,\n got: %+v", layer, result)
+	}
+}
+
+func TestLayerScaleHint(t *testing.T) {
+	var tests = []struct {
+		min, max *float64
+	}{
+		0: {min: fp(1000), max: fp(50000)},
+		1: {min: fp(1000)},
+		2: {max: fp(50000)},
+	}
+
+	for k, test := range tests {
+		var l Layer
+		l.FromWMS130(wms130.Layer{MinScaleDenominator: test.min, MaxScaleDenominator: test.max})
+		r := l.ToWMS130()
+
+		for _, c := range []struct{ expected, result *float64 }{{test.min, r.MinScaleDenominator}, {test.max, r.MaxScaleDenominator}} {
+			if (c.expected == nil) != (c.result == nil) || (c.expected != nil && math.Abs(*c.expected-*c.result) > 1e-6) {
+				t.Errorf("test: %d, expected: %v - %v,\n got: %v - %v", k, test.min, test.max, r.MinScaleDenominator, r.MaxScaleDenominator)
+			}
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package wms111
+
+import (
+	"encoding/xml"
+
+	"github.com/pdok/ogc-specifications/pkg/common"
+)
+
+// ContentType of the WMS 1.1.1 ServiceExceptionReport
+const ContentType = `application/vnd.ogc.se_xml`
+
+// exceptionDocType is the DTD the WMS 1.1.1 ServiceExceptionReport is validated against
+const exceptionDocType = `<!DOCTYPE ServiceExceptionReport SYSTEM "http://schemas.opengis.net/wms/1.1.1/exception_1_1_1.dtd">` + "\n"
+
+// Exception
+//
+//nolint:errname
+type Exception struct {
+	common.ExceptionDetails
+}
+
+// Exceptions is an array of the Exception interface
+type Exceptions []Exception
+
+// ServiceExceptionReport struct, WMS 1.1.1 uses a DTD instead of a namespace
+type ServiceExceptionReport struct {
+	XMLName          xml.Name   `xml:"ServiceExceptionReport" yaml:"serviceExceptionReport"`
+	Version          string     `xml:"version,attr" yaml:"version"`
+	ServiceException Exceptions `xml:"ServiceException" yaml:"serviceException"`
+}
+
+// ToReport builds a ServiceExceptionReport from an array of Exceptions
+func (e Exceptions) ToReport() ServiceExceptionReport {
+	return ServiceExceptionReport{Version: Version, ServiceException: e}
+}
+
+// ToBytes makes from a ServiceExceptionReport a []byte
+func (r ServiceExceptionReport) ToBytes() []byte {
+	si, _ := xml.MarshalIndent(r, "", " ")
+	return append([]byte(xml.Header+exceptionDocType), si...)
+}
+
+// ToExceptions promotes a single Exception to an array of one
+func (e Exception) ToExceptions() Exceptions {
+	return Exceptions{e}
+}
+
+// Error returns available ExceptionText
+func (e Exception) Error() string {
+	return e.ExceptionText
+}
+
+// Code returns available ExceptionCode
+func (e Exception) Code() string {
+	return e.ExceptionCode
+}
+
+// Locator returns available ExceptionCode
+func (e Exception) Locator() string {
+	return e.LocatorCode
+}
@@ -0,0 +1,30 @@
+package wms111
+
+import (
+	"github.com/pdok/ogc-specifications/pkg/common"
+)
+
+// ToJSON makes from a ServiceExceptionReport a JSON []byte
+func (r ServiceExceptionReport) ToJSON() []byte {
+	return common.ExceptionReportJSON{Version: r.Version, Exceptions: common.Details(r.ServiceException)}.ToBytes()
+}
+
+// ToProblemJSON makes from a ServiceExceptionReport a RFC 7807 problem+json []byte,
+// the status is the HTTP status code for the exceptions
+func (r ServiceExceptionReport) ToProblemJSON() []byte {
+	p := common.NewProblem(common.Details(r.ServiceException))
+	p.Status = r.ServiceException.StatusCode()
+	return p.ToBytes()
+}
+
+// Encode returns the Exceptions as a XML, JSON or problem+json report depending on the format,
+// together with its content type. The XML report has the WMS 1.1.1 content type application/vnd.ogc.se_xml.
+func (e Exceptions) Encode(format string) ([]byte, string) {
+	r := e.ToReport()
+	body, contentType := common.EncodeExceptions(common.ExceptionReportJSON{Version: r.Version, Exceptions: common.Details(e)},
+		format, e.StatusCode(), r.ToBytes)
+	if contentType == common.XMLContentType {
+		contentType = ContentType
+	}
+	return body, contentType
+}
@@ -0,0 +1,30 @@
+package wms111
+
+import (
+	"net/http"
+
+	"github.com/pdok/ogc-specifications/pkg/common"
+)
+
+// statusCodes contains the HTTP status codes for the WMS 1.1.1 specific exception codes,
+// these follow the same OWS Common 1.1 mapping as the WMS 1.3.0 exception codes
+var statusCodes = map[string]int{
+	`InvalidFormat`:         http.StatusBadRequest,
+	`InvalidSRS`:            http.StatusBadRequest,
+	`LayerNotDefined`:       http.StatusBadRequest,
+	`StyleNotDefined`:       http.StatusBadRequest,
+	`LayerNotQueryable`:     http.StatusBadRequest,
+	`InvalidPoint`:          http.StatusBadRequest,
+	`MissingDimensionValue`: http.StatusBadRequest,
+	`InvalidDimensionValue`: http.StatusBadRequest,
+}
+
+// StatusCode returns the HTTP status code for the Exception, unknown exception codes are considered to be server errors
+func (e Exception) StatusCode() int {
+	return common.StatusCode(statusCodes, e)
+}
+
+// StatusCode returns the HTTP status code for a report with these Exceptions
+func (e Exceptions) StatusCode() int {
+	return common.StatusCode(statusCodes, e...)
+}
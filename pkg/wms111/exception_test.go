@@ -0,0 +1,55 @@
+package wms111
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestExceptionsEncode(t *testing.T) {
+	var tests = []struct {
+		exceptions  Exceptions
+		format      string
+		contentType string
+		body        []string
+	}{
+		0: {exceptions: InvalidSRS(`EPSG:3857`).ToExceptions(), format: ContentType, contentType: ContentType,
+			body: []string{
+				`<!DOCTYPE ServiceExceptionReport SYSTEM "http://schemas.opengis.net/wms/1.1.1/exception_1_1_1.dtd">`,
+				`<ServiceExceptionReport version="1.1.1">`,
+				`<ServiceException code="InvalidSRS">SRS is not known by this service: EPSG:3857</ServiceException>`}},
+		1: {exceptions: InvalidPoint(`a`, `1`).ToExceptions(), format: `XML`, contentType: ContentType,
+			body: []string{`code="InvalidPoint"`, `given: a for X and 1 for Y`}},
+		2: {exceptions: LayerNotDefined(`Rivers`).ToExceptions(), format: `application/json`, contentType: `application/json`,
+			body: []string{`"version": "1.1.1"`, `"code": "LayerNotDefined"`}},
+	}
+
+	for k, test := range tests {
+		body, contentType := test.exceptions.Encode(test.format)
+		if contentType != test.contentType {
+			t.Errorf("test: %d, expected: %s,\n got: %s", k, test.contentType, contentType)
+		}
+		for _, b := range test.body {
+			if !strings.Contains(string(body), b) {
+				t.Errorf("test: %d, expected: %s,\n got: %s", k, b, body)
+			}
+		}
+	}
+}
+
+func TestExceptionsStatusCode(t *testing.T) {
+	var tests = []struct {
+		exceptions Exceptions
+		statusCode int
+	}{
+		0: {exceptions: Exceptions{InvalidSRS()}, statusCode: http.StatusBadRequest},
+		1: {exceptions: Exceptions{OperationNotSupported(`GetLegendGraphic`)}, statusCode: http.StatusNotImplemented},
+		2: {exceptions: Exceptions{NoApplicableCode(`failure`), InvalidSRS()}, statusCode: http.StatusInternalServerError},
+	}
+
+	for k, test := range tests {
+		if statusCode := test.exceptions.StatusCode(); statusCode != test.statusCode {
+			t.Errorf("test: %d, expected: %d,\n got: %d", k, test.statusCode, statusCode)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package wms111
+
+import (
+	"encoding/xml"
+	"net/url"
+	"strings"
+
+	"github.com/pdok/ogc-specifications/pkg/utils"
+)
+
+// UPDATESEQUENCE is the optional GetCapabilities key
+const UPDATESEQUENCE = `UPDATESEQUENCE`
+
+// GetCapabilitiesRequest struct with the needed parameters/attributes needed for making a GetCapabilities request
+type GetCapabilitiesRequest struct {
+	XMLName xml.Name `xml:"GetCapabilities" yaml:"getCapabilities"`
+	BaseRequest
+	UpdateSequence string `xml:"updateSequence,attr,omitempty" yaml:"updateSequence,omitempty"`
+}
+
+// Validate returns GetCapabilities
+func (g *GetCapabilitiesRequest) Validate(_ Capabilities) Exceptions {
+	return nil
+}
+
+// ParseXML builds a GetCapabilities object based on a XML document
+func (g *GetCapabilitiesRequest) ParseXML(body []byte) Exceptions {
+	var xmlattributes utils.XMLAttribute
+	if err := xml.Unmarshal(body, &xmlattributes); err != nil {
+		return Exceptions{MissingParameterValue()}
+	}
+	if err := xml.Unmarshal(body, &g); err != nil {
+		return Exceptions{MissingParameterValue(REQUEST)}
+	}
+	var n []xml.Attr
+	for _, a := range xmlattributes {
+		switch strings.ToUpper(a.Name.Local) {
+		case VERSION, SERVICE, UPDATESEQUENCE:
+		default:
+			n = append(n, a)
+		}
+	}
+
+	g.Attr = utils.StripDuplicateAttr(n)
+	return nil
+}
+
+// ParseQueryParameters builds a GetCapabilities object based on the available query parameters
+func (g *GetCapabilitiesRequest) ParseQueryParameters(query url.Values) Exceptions {
+	if len(query) == 0 {
+		// When there are no query value we know that at least
+		// the mandatory SERVICE and REQUEST parameter is missing.
+		return Exceptions{MissingParameterValue(SERVICE), MissingParameterValue(REQUEST)}
+	}
+
+	gpv := getCapabilitiesRequestParameterValue{}
+	if exceptions := gpv.parseQueryParameters(query); exceptions != nil {
+		return exceptions
+	}
+
+	g.XMLName.Local = getcapabilities
+	g.Service = gpv.service
+	g.Version = gpv.version
+	g.UpdateSequence = gpv.updatesequence
+	return nil
+}
+
+// ToQueryParameters builds a new query string that will be proxied
+func (g GetCapabilitiesRequest) ToQueryParameters() url.Values {
+	gpv := getCapabilitiesRequestParameterValue{}
+	gpv.parseGetCapabilitiesRequest(g)
+
+	return gpv.toQueryParameters()
+}
+
+// ToXML builds a 'new' XML document 'based' on the 'original' XML document
+func (g GetCapabilitiesRequest) ToXML() []byte {
+	e := utils.NewEncoder(nil)
+	doc, _ := e.Marshal(&g)
+	return doc
+}
@@ -0,0 +1,66 @@
+package wms111
+
+import (
+	"net/url"
+	"strings"
+)
+
+// getCapabilitiesRequestParameterValue struct
+type getCapabilitiesRequestParameterValue struct {
+	service string `yaml:"service,omitempty"`
+	baseParameterValueRequest
+	updatesequence string `yaml:"updatesequence,omitempty"`
+}
+
+// parseQueryParameters builds a getCapabilitiesRequestParameterValue object based on the available query parameters,
+// the VERSION is optional and the WMS 1.0.0 WMTVER is accepted as well
+func (gpv *getCapabilitiesRequestParameterValue) parseQueryParameters(query url.Values) Exceptions {
+	var exceptions Exceptions
+	for k, v := range query {
+		if len(v) != 1 {
+			exceptions = append(exceptions, InvalidParameterValue(strings.Join(v, ","), k))
+			continue
+		}
+		switch strings.ToUpper(k) {
+		case SERVICE:
+			gpv.service = strings.ToUpper(v[0])
+		case VERSION:
+			gpv.version = v[0]
+		case WMTVER:
+			if gpv.version == `` {
+				gpv.version = v[0]
+			}
+		case REQUEST:
+			gpv.request = v[0]
+		case UPDATESEQUENCE:
+			gpv.updatesequence = v[0]
+		}
+	}
+
+	if len(exceptions) > 0 {
+		return exceptions
+	}
+	return nil
+}
+
+// parseGetCapabilitiesRequest builds a getCapabilitiesRequestParameterValue object based on a GetCapabilities struct
+func (gpv *getCapabilitiesRequestParameterValue) parseGetCapabilitiesRequest(g GetCapabilitiesRequest) {
+	gpv.request = getcapabilities
+	gpv.version = g.Version
+	gpv.service = g.Service
+	gpv.updatesequence = g.UpdateSequence
+}
+
+// toQueryParameters builds a url.Values query from a getCapabilitiesRequestParameterValue struct
+func (gpv getCapabilitiesRequestParameterValue) toQueryParameters() url.Values {
+	query := make(map[string][]string)
+
+	query[SERVICE] = []string{gpv.service}
+	query[VERSION] = []string{gpv.version}
+	query[REQUEST] = []string{gpv.request}
+	if gpv.updatesequence != `` {
+		query[UPDATESEQUENCE] = []string{gpv.updatesequence}
+	}
+
+	return query
+}
@@ -0,0 +1,72 @@
+package wms111
+
+import (
+	"bytes"
+	"encoding/xml"
+
+	"github.com/pdok/ogc-specifications/pkg/utils"
+	"github.com/pdok/ogc-specifications/pkg/wms130"
+)
+
+// capabilitiesDocType is the DTD the WMS 1.1.1 capabilities are validated against
+const capabilitiesDocType = `<!DOCTYPE WMT_MS_Capabilities SYSTEM "http://schemas.opengis.net/wms/1.1.1/WMS_MS_Capabilities.dtd">` + "\n"
+
+// Type function needed for the interface
+func (gc *GetCapabilitiesResponse) Type() string {
+	return getcapabilities
+}
+
+// Service function needed for the interface
+func (gc *GetCapabilitiesResponse) Service() string {
+	return Service
+}
+
+// Version function needed for the interface
+func (gc *GetCapabilitiesResponse) Version() string {
+	return Version
+}
+
+// Validate function of the wms111 spec
+func (gc *GetCapabilitiesResponse) Validate() Exceptions {
+	return nil
+}
+
+// ParseXML builds a GetCapabilitiesResponse from a WMS 1.1.1 capabilities document,
+// a malformed document results in a *utils.ParseError
+func (gc *GetCapabilitiesResponse) ParseXML(doc []byte) error {
+	return utils.UnmarshalPrefixed(doc, gc, nil)
+}
+
+// ToXML builds a GetCapabilities response object, with the DOCTYPE of the WMS 1.1.1 DTD
+func (gc GetCapabilitiesResponse) ToXML() []byte {
+	e := utils.NewEncoder(nil)
+	doc, _ := e.Marshal(gc)
+	return append([]byte(xml.Header+capabilitiesDocType), bytes.TrimPrefix(doc, []byte(xml.Header))...)
+}
+
+// GetCapabilitiesResponse base struct
+type GetCapabilitiesResponse struct {
+	XMLName      xml.Name `xml:"WMT_MS_Capabilities" yaml:"wmtMsCapabilities"`
+	Attributes   `yaml:"attributes"`
+	WMSService   WMSService   `xml:"Service" yaml:"service"`
+	Capabilities Capabilities `xml:"Capability" yaml:"capability"`
+}
+
+// Attributes of the WMS 1.1.1 capabilities document, that has no namespace
+type Attributes struct {
+	Version        string `xml:"version,attr" yaml:"version"`
+	UpdateSequence string `xml:"updateSequence,attr,omitempty" yaml:"updateSequence,omitempty"`
+}
+
+// WMSService struct containing the base service information filled from the template,
+// the Name of a WMS 1.1.1 service is OGC:WMS
+type WMSService struct {
+	Name               string                     `xml:"Name" yaml:"name"`
+	Title              string                     `xml:"Title" yaml:"title"`
+	Abstract           *string                    `xml:"Abstract" yaml:"abstract"`
+	KeywordList        *wms130.Keywords           `xml:"KeywordList" yaml:"keywordList"`
+	OnlineResource     wms130.OnlineResource      `xml:"OnlineResource" yaml:"onlineResource"`
+	ContactInformation *wms130.ContactInformation `xml:"ContactInformation" yaml:"contactInformation"`
+	Fees               *string                    `xml:"Fees" yaml:"fees"`
+	AccessConstraints  *string                    `xml:"AccessConstraints" yaml:"accessConstraints"`
+}
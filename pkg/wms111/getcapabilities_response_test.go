@@ -0,0 +1,106 @@
+package wms111
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/pdok/ogc-specifications/pkg/wms130"
+)
+
+const capabilities111 = `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE WMT_MS_Capabilities SYSTEM "http://schemas.opengis.net/wms/1.1.1/WMS_MS_Capabilities.dtd">
+<WMT_MS_Capabilities version="1.1.1" updateSequence="3">
+ <Service>
+  <Name>OGC:WMS</Name>
+  <Title>Rivers</Title>
+  <OnlineResource xmlns:xlink="http://www.w3.org/1999/xlink" xlink:type="simple" xlink:href="https://example.com/wms"/>
+ </Service>
+ <Capability>
+  <Request>
+   <GetCapabilities>
+    <Format>application/vnd.ogc.wms_xml</Format>
+    <DCPType><HTTP><Get><OnlineResource xmlns:xlink="http://www.w3.org/1999/xlink" xlink:type="simple" xlink:href="https://example.com/wms"/></Get></HTTP></DCPType>
+   </GetCapabilities>
+   <GetMap>
+    <Format>image/png</Format>
+    <DCPType><HTTP><Get><OnlineResource xmlns:xlink="http://www.w3.org/1999/xlink" xlink:type="simple" xlink:href="https://example.com/wms"/></Get></HTTP></DCPType>
+   </GetMap>
+  </Request>
+  <Exception>
+   <Format>application/vnd.ogc.se_xml</Format>
+   <Format>application/vnd.ogc.se_inimage</Format>
+  </Exception>
+  <Layer>
+   <Title>Hydrography</Title>
+   <SRS>EPSG:4326 EPSG:28992</SRS>
+   <LatLonBoundingBox minx="3" miny="50" maxx="8" maxy="54"/>
+   <Layer queryable="1">
+    <Name>Rivers</Name>
+    <Title>Rivers</Title>
+    <BoundingBox SRS="EPSG:4326" minx="3" miny="50" maxx="8" maxy="54"/>
+    <ScaleHint min="0" max="100"/>
+   </Layer>
+  </Layer>
+ </Capability>
+</WMT_MS_Capabilities>`
+
+func TestGetCapabilitiesResponseParseXML(t *testing.T) {
+	var gc GetCapabilitiesResponse
+	if err := gc.ParseXML([]byte(capabilities111)); err != nil {
+		t.Fatalf("expected no error,\n got: %s", err)
+	}
+
+	if gc.Attributes != (Attributes{Version: Version, UpdateSequence: `3`}) || gc.WMSService.Name != ServiceName {
+		t.Errorf("expected: version 1.1.1, updateSequence 3 and OGC:WMS,\n got: %+v %s", gc.Attributes, gc.WMSService.Name)
+	}
+	if href := gc.WMSService.OnlineResource.Href; href == nil || *href != `https://example.com/wms` {
+		t.Errorf("expected: https://example.com/wms,\n got: %v", href)
+	}
+	rivers := gc.Capabilities.Layer[0].Layer[0]
+	if *rivers.Name != `Rivers` || *rivers.BoundingBox[0] != (LayerBoundingBox{SRS: `EPSG:4326`, Minx: 3, Miny: 50, Maxx: 8, Maxy: 54}) || *rivers.ScaleHint != (ScaleHint{Max: 100}) {
+		t.Errorf("expected the Rivers layer,\n got: %+v", rivers)
+	}
+
+	doc := gc.ToXML()
+	for _, s := range []string{capabilitiesDocType, `<WMT_MS_Capabilities xmlns:xlink="http://www.w3.org/1999/xlink" version="1.1.1" updateSequence="3">`} {
+		if !strings.Contains(string(doc), s) {
+			t.Errorf("expected: %s,\n got: %s", s, doc)
+		}
+	}
+
+	var result GetCapabilitiesResponse
+	if err := result.ParseXML(doc); err != nil {
+		t.Fatalf("expected no error,\n got: %s", err)
+	}
+	if !reflect.DeepEqual(result, gc) {
+		t.Errorf("expected: %+v,\n got: %+v", gc, result)
+	}
+}
+
+func TestGetCapabilitiesResponseToWMS130(t *testing.T) {
+	var gc GetCapabilitiesResponse
+	if err := gc.ParseXML([]byte(capabilities111)); err != nil {
+		t.Fatalf("expected no error,\n got: %s", err)
+	}
+
+	r := gc.ToWMS130()
+	if r.WMSService.Name != wms130.Service || r.Namespaces.Version != wms130.Version {
+		t.Errorf("expected: WMS 1.3.0,\n got: %s %s", r.WMSService.Name, r.Namespaces.Version)
+	}
+	if formats := r.Capabilities.Request.GetCapabilities.Format; !reflect.DeepEqual(formats, []string{`text/xml`}) {
+		t.Errorf("expected: text/xml,\n got: %v", formats)
+	}
+	if formats := r.Capabilities.Exception.Format; !reflect.DeepEqual(formats, []string{wms130.ExceptionsXML, wms130.ExceptionsINIMAGE}) {
+		t.Errorf("expected: XML and INIMAGE,\n got: %v", formats)
+	}
+	if crs := r.Capabilities.Layer[0].CRS; len(crs) != 2 {
+		t.Errorf("expected: EPSG:4326 and EPSG:28992,\n got: %v", crs)
+	}
+
+	var result GetCapabilitiesResponse
+	result.FromWMS130(r)
+	if !reflect.DeepEqual(result.Capabilities.Request, gc.Capabilities.Request) || !reflect.DeepEqual(result.Capabilities.Exception, gc.Capabilities.Exception) {
+		t.Errorf("expected: %+v,\n got: %+v", gc.Capabilities, result.Capabilities)
+	}
+}
@@ -0,0 +1,184 @@
+package wms111
+
+import (
+	"encoding/xml"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/pdok/ogc-specifications/pkg/utils"
+	"github.com/pdok/ogc-specifications/pkg/wms130"
+)
+
+// GetFeatureInfo
+const (
+	// Mandatory
+	QUERYLAYERS = `QUERY_LAYERS`
+	X           = `X`
+	Y           = `Y`
+
+	// Optional GetFeatureInfo Keys
+	INFOFORMAT   = `INFO_FORMAT`
+	FEATURECOUNT = `FEATURE_COUNT`
+)
+
+// GetFeatureInfoRequest struct with the needed parameters/attributes needed for making a WMS 1.1.1 GetFeatureInfo request.
+// The BoundingBox is always in the x/y (east/north) axis order, regardless of the SRS.
+type GetFeatureInfoRequest struct {
+	XMLName xml.Name `xml:"GetFeatureInfo" yaml:"getFeatureInfo"`
+	BaseRequest
+
+	// <map_request_copy>
+	StyledLayerDescriptor wms130.StyledLayerDescriptor `xml:"StyledLayerDescriptor" yaml:"styledLayerDescriptor"`
+	SRS                   string                       `xml:"SRS" yaml:"srs"`
+	BoundingBox           wms130.BoundingBox           `xml:"BoundingBox" yaml:"boundingBox"`
+	Size                  wms130.Size                  `xml:"Size" yaml:"size"`
+	Format                string                       `xml:"Format,omitempty" yaml:"format,omitempty"`
+
+	QueryLayers []string `xml:"QueryLayers" yaml:"queryLayers"`
+	X           int      `xml:"X" yaml:"x"`
+	Y           int      `xml:"Y" yaml:"y"`
+	InfoFormat  string   `xml:"InfoFormat" yaml:"infoFormat" default:"text/plain"` // default text/plain
+
+	// Optional Keys
+	FeatureCount *int    `xml:"FeatureCount,omitempty" yaml:"featureCount,omitempty" default:"1"` // default 1
+	Exceptions   *string `xml:"Exceptions" yaml:"exceptions"`
+}
+
+// Validate validates a GetFeatureInfoRequest against the capabilities, by validating it as a WMS 1.3.0 request
+func (gfi *GetFeatureInfoRequest) Validate(c Capabilities) Exceptions {
+	r := gfi.ToWMS130()
+	var exceptions Exceptions
+	exceptions.FromWMS130(r.Validate(c.ToWMS130()))
+	return exceptions
+}
+
+// ParseXML builds a GetFeatureInfo object based on a XML document,
+// like the WMS 1.3.0 GetFeatureInfo this is an interpretation based on the GetMap request
+func (gfi *GetFeatureInfoRequest) ParseXML(body []byte) Exceptions {
+	var xmlAttributes utils.XMLAttribute
+	if err := xml.Unmarshal(body, &xmlAttributes); err != nil {
+		return Exceptions{MissingParameterValue()}
+	}
+	if err := xml.Unmarshal(body, &gfi); err != nil {
+		return Exceptions{MissingParameterValue(REQUEST)}
+	}
+	var n []xml.Attr
+	for _, a := range xmlAttributes {
+		switch strings.ToUpper(a.Name.Local) {
+		case VERSION, SERVICE:
+		default:
+			n = append(n, a)
+		}
+	}
+
+	gfi.Attr = utils.StripDuplicateAttr(n)
+	return nil
+}
+
+// parseGetFeatureInfoRequestParameterValue process the simple struct to a complex struct
+func (gfi *GetFeatureInfoRequest) parseGetFeatureInfoRequestParameterValue(ipv getFeatureInfoRequestParameterValue) Exceptions {
+	var exceptions Exceptions
+
+	gfi.XMLName.Local = getfeatureinfo
+	if ex := gfi.BaseRequest.parseBaseParameterValueRequest(ipv.baseParameterValueRequest); ex != nil {
+		exceptions = append(exceptions, ex...)
+	}
+
+	sld, ex := ipv.buildStyledLayerDescriptor()
+	if ex != nil {
+		exceptions = append(exceptions, ex...)
+	}
+	gfi.StyledLayerDescriptor = sld
+
+	gfi.SRS = ipv.srs
+
+	bbox, ex := parseBoundingBox(ipv.bbox)
+	if ex != nil {
+		exceptions = append(exceptions, ex...)
+	}
+	gfi.BoundingBox = bbox
+
+	w, err := strconv.Atoi(ipv.width)
+	if err != nil {
+		exceptions = append(exceptions, InvalidParameterValue(ipv.width, WIDTH))
+	}
+	gfi.Size.Width = w
+
+	h, err := strconv.Atoi(ipv.height)
+	if err != nil {
+		exceptions = append(exceptions, InvalidParameterValue(ipv.height, HEIGHT))
+	}
+	gfi.Size.Height = h
+	gfi.Format = ipv.format
+
+	gfi.QueryLayers = strings.Split(ipv.querylayers, ",")
+
+	if ex := gfi.parseXY(ipv.x, ipv.y); ex != nil {
+		exceptions = append(exceptions, ex...)
+	}
+
+	gfi.InfoFormat = ipv.infoformat
+
+	// Optional keys
+	if ipv.featurecount != nil {
+		fc, err := strconv.Atoi(*ipv.featurecount)
+		if err != nil {
+			exceptions = append(exceptions, InvalidParameterValue(*ipv.featurecount, FEATURECOUNT))
+		}
+		gfi.FeatureCount = &fc
+	}
+	gfi.Exceptions = ipv.exceptions
+
+	if len(exceptions) > 0 {
+		return exceptions
+	}
+	return nil
+}
+
+// ParseQueryParameters builds a GetFeatureInfo object based on the available query parameters
+func (gfi *GetFeatureInfoRequest) ParseQueryParameters(query url.Values) Exceptions {
+	if len(query) == 0 {
+		// When there are no query value we know that at least
+		// the mandatory VERSION and REQUEST parameter is missing.
+		return Exceptions{MissingParameterValue(VERSION), MissingParameterValue(REQUEST)}
+	}
+
+	ipv := getFeatureInfoRequestParameterValue{}
+	if exceptions := ipv.parseQueryParameters(query); len(exceptions) != 0 {
+		return exceptions
+	}
+
+	return gfi.parseGetFeatureInfoRequestParameterValue(ipv)
+}
+
+// ToQueryParameters builds a new query string that will be proxied
+func (gfi *GetFeatureInfoRequest) ToQueryParameters() url.Values {
+	ipv := getFeatureInfoRequestParameterValue{}
+	ipv.parseGetFeatureInfoRequest(*gfi)
+
+	return ipv.toQueryParameters()
+}
+
+// ToXML builds a 'new' XML document 'based' on the 'original' XML document
+func (gfi *GetFeatureInfoRequest) ToXML() []byte {
+	e := utils.NewEncoder(nil)
+	e.Indent(` `)
+	doc, _ := e.Marshal(gfi)
+	return doc
+}
+
+func (gfi *GetFeatureInfoRequest) parseXY(x string, y string) Exceptions {
+	xx, err := strconv.Atoi(x)
+	if err != nil {
+		return InvalidPoint(x, y).ToExceptions()
+	}
+	yy, err := strconv.Atoi(y)
+	if err != nil {
+		return InvalidPoint(x, y).ToExceptions()
+	}
+	gfi.X = xx
+	gfi.Y = yy
+
+	return nil
+}
@@ -0,0 +1,143 @@
+package wms111
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// getFeatureInfoRequestParameterValue struct
+type getFeatureInfoRequestParameterValue struct {
+	service string `yaml:"service,omitempty"`
+	baseParameterValueRequest
+	getMapParameterValueMandatory
+	getFeatureInfoParameterValueMandatory
+	getFeatureInfoParameterValueOptional
+}
+
+// parseQueryParameters builds a getFeatureInfoRequestParameterValue object based on the available query parameters
+//
+//nolint:cyclop
+func (ipv *getFeatureInfoRequestParameterValue) parseQueryParameters(query url.Values) (exceptions Exceptions) {
+	for k, v := range query {
+		if len(v) != 1 {
+			exceptions = append(exceptions, InvalidParameterValue(strings.Join(v, ","), k))
+			continue
+		}
+		switch strings.ToUpper(k) {
+		case SERVICE:
+			ipv.service = strings.ToUpper(v[0])
+		case VERSION:
+			ipv.version = v[0]
+		case WMTVER:
+			if ipv.version == `` {
+				ipv.version = v[0]
+			}
+		case REQUEST:
+			ipv.request = v[0]
+		case LAYERS:
+			ipv.layers = v[0]
+		case STYLES:
+			ipv.styles = v[0]
+		case SRS:
+			ipv.srs = v[0]
+		case BBOX:
+			ipv.bbox = v[0]
+		case WIDTH:
+			ipv.width = v[0]
+		case HEIGHT:
+			ipv.height = v[0]
+		case FORMAT:
+			ipv.format = v[0]
+		case QUERYLAYERS:
+			ipv.querylayers = v[0]
+		case INFOFORMAT:
+			ipv.infoformat = v[0]
+		case X:
+			ipv.x = v[0]
+		case Y:
+			ipv.y = v[0]
+		case FEATURECOUNT:
+			ipv.featurecount = &(v[0])
+		case EXCEPTIONS:
+			ipv.exceptions = &(v[0])
+		}
+	}
+
+	if len(exceptions) > 0 {
+		return exceptions
+	}
+	return nil
+}
+
+// toQueryParameters builds a url.Values query from a getFeatureInfoRequestParameterValue struct
+func (ipv getFeatureInfoRequestParameterValue) toQueryParameters() url.Values {
+	query := make(map[string][]string)
+
+	query[SERVICE] = []string{ipv.service}
+	query[VERSION] = []string{ipv.version}
+	query[REQUEST] = []string{ipv.request}
+	query[LAYERS] = []string{ipv.layers}
+	query[STYLES] = []string{ipv.styles}
+	query[SRS] = []string{ipv.srs}
+	query[BBOX] = []string{ipv.bbox}
+	query[WIDTH] = []string{ipv.width}
+	query[HEIGHT] = []string{ipv.height}
+
+	if ipv.format != `` {
+		query[FORMAT] = []string{ipv.format}
+	}
+
+	query[QUERYLAYERS] = []string{ipv.querylayers}
+	query[INFOFORMAT] = []string{ipv.infoformat}
+	query[X] = []string{ipv.x}
+	query[Y] = []string{ipv.y}
+
+	if ipv.featurecount != nil {
+		query[FEATURECOUNT] = []string{*ipv.featurecount}
+	}
+	if ipv.exceptions != nil {
+		query[EXCEPTIONS] = []string{*ipv.exceptions}
+	}
+
+	return query
+}
+
+// parseGetFeatureInfoRequest builds a getFeatureInfoRequestParameterValue object based on a GetFeatureInfoRequest struct
+func (ipv *getFeatureInfoRequestParameterValue) parseGetFeatureInfoRequest(i GetFeatureInfoRequest) {
+	ipv.request = getfeatureinfo
+	ipv.version = Version
+	ipv.service = Service
+	ipv.layers = layerParameterValue(i.StyledLayerDescriptor)
+	ipv.styles = styleParameterValue(i.StyledLayerDescriptor)
+	ipv.srs = i.SRS
+	ipv.bbox = i.BoundingBox.ToQueryParameters()
+	ipv.width = strconv.Itoa(i.Size.Width)
+	ipv.height = strconv.Itoa(i.Size.Height)
+	ipv.format = i.Format
+
+	ipv.querylayers = strings.Join(i.QueryLayers, ",")
+	ipv.infoformat = i.InfoFormat
+	ipv.x = strconv.Itoa(i.X)
+	ipv.y = strconv.Itoa(i.Y)
+
+	if i.FeatureCount != nil {
+		fcp := strconv.Itoa(*i.FeatureCount)
+		ipv.featurecount = &fcp
+	}
+	ipv.exceptions = i.Exceptions
+}
+
+// getFeatureInfoParameterValueMandatory struct containing the mandatory WMS request Parameter Value
+type getFeatureInfoParameterValueMandatory struct {
+	querylayers string `yaml:"queryLayers,omitempty"`
+	infoformat  string `yaml:"infoFormat,omitempty"`
+	x           string `yaml:"x,omitempty"`
+	y           string `yaml:"y,omitempty"`
+}
+
+// getFeatureInfoParameterValueOptional struct containing the optional WMS request Parameter Value
+type getFeatureInfoParameterValueOptional struct {
+	featurecount *string `yaml:"featureCount,omitempty"`
+	exceptions   *string `yaml:"exceptions,omitempty"`
+}
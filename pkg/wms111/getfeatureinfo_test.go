@@ -0,0 +1,59 @@
+package wms111
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/pdok/ogc-specifications/pkg/wms130"
+)
+
+func TestGetFeatureInfoParseQueryParameters(t *testing.T) {
+	var tests = []struct {
+		query      url.Values
+		gfi        GetFeatureInfoRequest
+		exceptions Exceptions
+	}{
+		0: {query: map[string][]string{REQUEST: {getfeatureinfo}, SERVICE: {Service}, VERSION: {Version},
+			LAYERS: {`Rivers`}, STYLES: {``}, SRS: {`EPSG:4326`}, BBOX: {`-180,-90,180,90`}, WIDTH: {`1024`}, HEIGHT: {`512`},
+			QUERYLAYERS: {`Rivers`}, X: {`10`}, Y: {`20`}, INFOFORMAT: {`text/xml`}, FEATURECOUNT: {`5`}},
+			gfi: GetFeatureInfoRequest{
+				BaseRequest:           BaseRequest{Service: Service, Version: Version},
+				StyledLayerDescriptor: wms130.StyledLayerDescriptor{Version: `1.1.0`, NamedLayer: []wms130.NamedLayer{{Name: `Rivers`}}},
+				SRS:                   `EPSG:4326`,
+				BoundingBox:           wms130.BoundingBox{LowerCorner: wms130.Position{-180, -90}, UpperCorner: wms130.Position{180, 90}},
+				Size:                  wms130.Size{Width: 1024, Height: 512},
+				QueryLayers:           []string{`Rivers`},
+				X:                     10,
+				Y:                     20,
+				InfoFormat:            `text/xml`,
+				FeatureCount:          ip(5),
+			}},
+		// the WMS 1.3.0 I and J parameters aren't WMS 1.1.1 parameters
+		1: {query: map[string][]string{REQUEST: {getfeatureinfo}, VERSION: {Version},
+			LAYERS: {`Rivers`}, STYLES: {``}, SRS: {`EPSG:4326`}, BBOX: {`-180,-90,180,90`}, WIDTH: {`1024`}, HEIGHT: {`512`},
+			QUERYLAYERS: {`Rivers`}, `I`: {`10`}, `J`: {`20`}, INFOFORMAT: {`text/xml`}},
+			exceptions: Exceptions{InvalidPoint(``, ``)}},
+	}
+
+	for k, test := range tests {
+		var gfi GetFeatureInfoRequest
+		exceptions := gfi.ParseQueryParameters(test.query)
+		if !reflect.DeepEqual(exceptions, test.exceptions) {
+			t.Errorf("test: %d, expected: %v,\n got: %v", k, test.exceptions, exceptions)
+			continue
+		}
+		if test.exceptions != nil {
+			continue
+		}
+		test.gfi.XMLName.Local = getfeatureinfo
+		if !reflect.DeepEqual(gfi, test.gfi) {
+			t.Errorf("test: %d, expected: %+v,\n got: %+v", k, test.gfi, gfi)
+		}
+
+		query := gfi.ToQueryParameters()
+		if query.Get(X) != test.query.Get(X) || query.Get(Y) != test.query.Get(Y) || query.Get(SRS) != test.query.Get(SRS) {
+			t.Errorf("test: %d, expected: %v,\n got: %v", k, test.query, query)
+		}
+	}
+}
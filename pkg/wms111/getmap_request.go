@@ -0,0 +1,232 @@
+package wms111
+
+import (
+	"encoding/xml"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/pdok/ogc-specifications/pkg/crs"
+	"github.com/pdok/ogc-specifications/pkg/utils"
+	"github.com/pdok/ogc-specifications/pkg/wms130"
+)
+
+// Mandatory GetMap Keys
+const (
+	LAYERS = `LAYERS`
+	STYLES = `STYLES`
+	SRS    = `SRS`
+	BBOX   = `BBOX`
+	WIDTH  = `WIDTH`
+	HEIGHT = `HEIGHT`
+	FORMAT = `FORMAT`
+)
+
+// Optional GetMap Keys
+const (
+	TRANSPARENT = `TRANSPARENT`
+	BGCOLOR     = `BGCOLOR`
+	EXCEPTIONS  = `EXCEPTIONS` // defaults to application/vnd.ogc.se_xml
+	TIME        = `TIME`
+)
+
+// GetMapRequest struct with the needed parameters/attributes needed for making a WMS 1.1.1 GetMap request.
+// The BoundingBox is always in the x/y (east/north) axis order, regardless of the SRS.
+type GetMapRequest struct {
+	XMLName xml.Name `xml:"GetMap" yaml:"getmap"`
+	BaseRequest
+	StyledLayerDescriptor wms130.StyledLayerDescriptor `xml:"StyledLayerDescriptor" yaml:"styledLayerDescriptor"`
+	SRS                   wms130.CRS                   `xml:"SRS" yaml:"srs"`
+	BoundingBox           wms130.BoundingBox           `xml:"BoundingBox" yaml:"boundingBox"`
+	Output                wms130.Output                `xml:"Output" yaml:"output"`
+	Exceptions            *string                      `xml:"Exceptions" yaml:"exceptions"`
+	// Time is the value of the time dimension, an instant, list or interval as advertised by the Extent of the layer
+	Time *string `xml:"Time" yaml:"time,omitempty"`
+}
+
+// Validate validates a GetMapRequest against the capabilities, by validating it as a WMS 1.3.0 request
+func (m GetMapRequest) Validate(c Capabilities) Exceptions {
+	var exceptions Exceptions
+	exceptions.FromWMS130(m.ToWMS130().Validate(c.ToWMS130()))
+	return exceptions
+}
+
+// ParseQueryParameters builds a GetMap object based on the available query parameters
+func (m *GetMapRequest) ParseQueryParameters(query url.Values) Exceptions {
+	mpv := getMapRequestParameterValue{}
+	if exceptions := mpv.parseQueryParameters(query); exceptions != nil {
+		return exceptions
+	}
+
+	return m.parsegetMapRequestParameterValue(mpv)
+}
+
+// parsegetMapRequestParameterValue process the simple struct to a complex struct
+func (m *GetMapRequest) parsegetMapRequestParameterValue(mpv getMapRequestParameterValue) Exceptions {
+	m.XMLName.Local = getmap
+	if exceptions := m.BaseRequest.parseBaseParameterValueRequest(mpv.baseParameterValueRequest); exceptions != nil {
+		return exceptions
+	}
+
+	sld, exceptions := mpv.buildStyledLayerDescriptor()
+	if exceptions != nil {
+		return exceptions
+	}
+	m.StyledLayerDescriptor = sld
+
+	m.SRS = parseSRS(mpv.srs)
+
+	bbox, exceptions := parseBoundingBox(mpv.bbox)
+	if exceptions != nil {
+		return exceptions
+	}
+	m.BoundingBox = bbox
+
+	output, exceptions := mpv.buildOutput()
+	if exceptions != nil {
+		return exceptions
+	}
+	m.Output = output
+
+	m.Exceptions = mpv.exceptions
+	m.Time = mpv.time
+
+	return nil
+}
+
+// ParseXML builds a GetMap object based on a XML document
+func (m *GetMapRequest) ParseXML(body []byte) Exceptions {
+	var xmlattributes utils.XMLAttribute
+	if err := xml.Unmarshal(body, &xmlattributes); err != nil {
+		return Exceptions{MissingParameterValue()}
+	}
+	// When object can be Unmarshalled -> XMLAttributes, it can be Unmarshalled -> GetMap
+	_ = xml.Unmarshal(body, &m)
+
+	var n []xml.Attr
+	for _, a := range xmlattributes {
+		switch strings.ToUpper(a.Name.Local) {
+		case VERSION, SERVICE:
+		default:
+			n = append(n, a)
+		}
+	}
+	m.BaseRequest.Attr = utils.StripDuplicateAttr(n)
+	return nil
+}
+
+// ToQueryParameters builds a new query string that will be proxied
+func (m GetMapRequest) ToQueryParameters() url.Values {
+	mpv := getMapRequestParameterValue{}
+	mpv.parseGetMapRequest(m)
+
+	return mpv.toQueryParameters()
+}
+
+// ToXML builds a 'new' XML document 'based' on the 'original' XML document
+func (m GetMapRequest) ToXML() []byte {
+	e := utils.NewEncoder(nil)
+	e.Indent(` `)
+	doc, _ := e.Marshal(&m)
+	return doc
+}
+
+// ExceptionReport returns the Exceptions in the format requested with the EXCEPTIONS parameter of the GetMap request,
+// together with its content type. The images for application/vnd.ogc.se_inimage and application/vnd.ogc.se_blank
+// are rendered like WMS 1.3.0 does, otherwise the WMS 1.1.1 ServiceExceptionReport is returned.
+func (m GetMapRequest) ExceptionReport(e Exceptions) ([]byte, string) {
+	if m.Exceptions != nil {
+		switch strings.ToLower(*m.Exceptions) {
+		case ExceptionsINIMAGE, ExceptionsBLANK:
+			return m.ToWMS130().ExceptionReport(e.ToWMS130())
+		}
+	}
+	return e.Encode(ContentType)
+}
+
+// Exception formats for the EXCEPTIONS parameter of a GetMap request
+const (
+	ExceptionsXML     = `application/vnd.ogc.se_xml`
+	ExceptionsINIMAGE = `application/vnd.ogc.se_inimage`
+	ExceptionsBLANK   = `application/vnd.ogc.se_blank`
+)
+
+// parseSRS normalises the different notations of the SRS, unknown notations result in an empty CRS
+func parseSRS(s string) wms130.CRS {
+	var c wms130.CRS
+	if authority, code, ok := crs.Parse(s); ok {
+		c.Namespace = authority
+		c.Code = code
+	}
+	return c
+}
+
+// parseBoundingBox builds a BoundingBox based on the minx,miny,maxx,maxy BBOX value
+func parseBoundingBox(boundingbox string) (wms130.BoundingBox, Exceptions) {
+	var b wms130.BoundingBox
+	result := strings.Split(boundingbox, ",")
+	if len(result) != 4 {
+		return b, InvalidParameterValue(boundingbox, BBOX).ToExceptions()
+	}
+
+	var coords [4]float64
+	for i, s := range result {
+		f, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+		if err != nil {
+			return b, InvalidParameterValue(boundingbox, BBOX).ToExceptions()
+		}
+		coords[i] = f
+	}
+
+	b.LowerCorner = wms130.Position{coords[0], coords[1]}
+	b.UpperCorner = wms130.Position{coords[2], coords[3]}
+	return b, nil
+}
+
+// buildStyledLayerDescriptor builds the StyledLayerDescriptor from the LAYERS and STYLES values,
+// which are processed like WMS 1.3.0 does
+func buildStyledLayerDescriptor(layers, styles []string) (wms130.StyledLayerDescriptor, Exceptions) {
+	switch {
+	case len(styles) == 0:
+		sld := wms130.StyledLayerDescriptor{Version: `1.1.0`}
+		for _, layer := range layers {
+			sld.NamedLayer = append(sld.NamedLayer, wms130.NamedLayer{Name: layer})
+		}
+		return sld, nil
+	case len(layers) == 0:
+		// will be resolved during validation
+		return wms130.StyledLayerDescriptor{}, nil
+	case len(layers) == len(styles):
+		sld := wms130.StyledLayerDescriptor{Version: `1.1.0`}
+		for k, layer := range layers {
+			sld.NamedLayer = append(sld.NamedLayer, wms130.NamedLayer{Name: layer, NamedStyle: &wms130.NamedStyle{Name: styles[k]}})
+		}
+		return sld, nil
+	}
+	return wms130.StyledLayerDescriptor{}, StyleNotDefined().ToExceptions()
+}
+
+// layerParameterValue returns the LAYERS value of the StyledLayerDescriptor
+func layerParameterValue(sld wms130.StyledLayerDescriptor) string {
+	layers := []string{}
+	for _, l := range sld.NamedLayer {
+		layers = append(layers, l.Name)
+	}
+	return strings.Join(layers, ",")
+}
+
+// styleParameterValue returns the STYLES value of the StyledLayerDescriptor
+func styleParameterValue(sld wms130.StyledLayerDescriptor) string {
+	styles := []string{}
+	for _, l := range sld.NamedLayer {
+		if l.Name == `` {
+			continue
+		}
+		if l.NamedStyle != nil {
+			styles = append(styles, l.NamedStyle.Name)
+		} else {
+			styles = append(styles, ``)
+		}
+	}
+	return strings.Join(styles, ",")
+}
@@ -0,0 +1,199 @@
+package wms111
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/pdok/ogc-specifications/pkg/wms130"
+)
+
+// getMapRequestParameterValue struct
+type getMapRequestParameterValue struct {
+	service string `yaml:"service,omitempty"`
+	baseParameterValueRequest
+	getMapParameterValueMandatory
+	getMapParameterValueOptional
+}
+
+// parseQueryParameters builds a getMapRequestParameterValue object based on the available query parameters
+//
+//nolint:cyclop
+func (mpv *getMapRequestParameterValue) parseQueryParameters(query url.Values) Exceptions {
+	var exceptions Exceptions
+	params := make(map[string]bool)
+	for k, v := range query {
+		if len(v) != 1 {
+			exceptions = append(exceptions, InvalidParameterValue(strings.Join(v, ","), k))
+			continue
+		}
+		param := strings.ToUpper(k)
+		params[param] = true
+		switch param {
+		case SERVICE:
+			mpv.service = strings.ToUpper(v[0])
+		case VERSION:
+			mpv.version = v[0]
+		case WMTVER:
+			params[VERSION] = true
+			if mpv.version == `` {
+				mpv.version = v[0]
+			}
+		case REQUEST:
+			mpv.request = v[0]
+		case LAYERS:
+			mpv.layers = v[0]
+		case STYLES:
+			mpv.styles = v[0]
+		case SRS:
+			mpv.srs = v[0]
+		case BBOX:
+			mpv.bbox = v[0]
+		case WIDTH:
+			mpv.width = v[0]
+		case HEIGHT:
+			mpv.height = v[0]
+		case FORMAT:
+			mpv.format = v[0]
+		case TRANSPARENT:
+			mpv.transparent = &(v[0])
+		case BGCOLOR:
+			mpv.bgcolor = &(v[0])
+		case EXCEPTIONS:
+			mpv.exceptions = &(v[0])
+		case TIME:
+			mpv.time = &(v[0])
+		}
+	}
+
+	for _, key := range []string{VERSION, REQUEST, LAYERS, STYLES, SRS, BBOX, WIDTH, HEIGHT, FORMAT} {
+		if _, ok := params[key]; !ok {
+			exceptions = append(exceptions, MissingParameterValue(key))
+		}
+	}
+	if len(exceptions) > 0 {
+		return exceptions
+	}
+	return nil
+}
+
+// parseGetMapRequest builds a getMapRequestParameterValue object based on a GetMap struct
+func (mpv *getMapRequestParameterValue) parseGetMapRequest(m GetMapRequest) {
+	mpv.request = getmap
+	mpv.version = Version
+	mpv.service = Service
+	mpv.layers = layerParameterValue(m.StyledLayerDescriptor)
+	mpv.styles = styleParameterValue(m.StyledLayerDescriptor)
+	mpv.srs = m.SRS.String()
+	mpv.bbox = m.BoundingBox.ToQueryParameters()
+	mpv.width = strconv.Itoa(m.Output.Size.Width)
+	mpv.height = strconv.Itoa(m.Output.Size.Height)
+	mpv.format = m.Output.Format
+
+	if m.Output.Transparent != nil {
+		tp := strings.ToUpper(strconv.FormatBool(*m.Output.Transparent))
+		mpv.transparent = &tp
+	}
+	mpv.bgcolor = m.Output.BGcolor
+	mpv.exceptions = m.Exceptions
+	mpv.time = m.Time
+}
+
+// buildOutput builds a Output struct from the getMapRequestParameterValue information
+func (mpv *getMapRequestParameterValue) buildOutput() (wms130.Output, Exceptions) {
+	output := wms130.Output{}
+
+	h, err := strconv.Atoi(mpv.height)
+	if err != nil {
+		return output, InvalidParameterValue(mpv.height, HEIGHT).ToExceptions()
+	}
+	w, err := strconv.Atoi(mpv.width)
+	if err != nil {
+		return output, InvalidParameterValue(mpv.width, WIDTH).ToExceptions()
+	}
+
+	output.Size = wms130.Size{Height: h, Width: w}
+	output.Format = mpv.format
+	if mpv.transparent != nil {
+		var b bool
+		switch strings.ToUpper(*mpv.transparent) {
+		case `TRUE`:
+			b = true
+		case `FALSE`:
+			b = false
+		default:
+			return output, InvalidParameterValue(*mpv.transparent, TRANSPARENT).ToExceptions()
+		}
+		output.Transparent = &b
+	}
+	output.BGcolor = mpv.bgcolor
+
+	return output, nil
+}
+
+// styledLayer struct
+type styledLayer struct {
+	layers string `yaml:"layers,omitempty"`
+	styles string `yaml:"styles,omitempty"`
+}
+
+// buildStyledLayerDescriptor builds a StyledLayerDescriptor struct from the parameter value information
+func (sl *styledLayer) buildStyledLayerDescriptor() (wms130.StyledLayerDescriptor, Exceptions) {
+	var layers, styles []string
+	if sl.layers != `` {
+		layers = strings.Split(sl.layers, ",")
+	}
+	if sl.styles != `` {
+		styles = strings.Split(sl.styles, ",")
+	}
+	return buildStyledLayerDescriptor(layers, styles)
+}
+
+// toQueryParameters builds a url.Values query from a getMapRequestParameterValue struct
+func (mpv *getMapRequestParameterValue) toQueryParameters() url.Values {
+	query := make(map[string][]string)
+
+	query[SERVICE] = []string{mpv.service}
+	query[VERSION] = []string{mpv.version}
+	query[REQUEST] = []string{mpv.request}
+	query[LAYERS] = []string{mpv.layers}
+	query[STYLES] = []string{mpv.styles}
+	query[SRS] = []string{mpv.srs}
+	query[BBOX] = []string{mpv.bbox}
+	query[WIDTH] = []string{mpv.width}
+	query[HEIGHT] = []string{mpv.height}
+	query[FORMAT] = []string{mpv.format}
+
+	if mpv.transparent != nil {
+		query[TRANSPARENT] = []string{*mpv.transparent}
+	}
+	if mpv.bgcolor != nil {
+		query[BGCOLOR] = []string{*mpv.bgcolor}
+	}
+	if mpv.exceptions != nil {
+		query[EXCEPTIONS] = []string{*mpv.exceptions}
+	}
+	if mpv.time != nil {
+		query[TIME] = []string{*mpv.time}
+	}
+
+	return query
+}
+
+// getMapParameterValueMandatory struct containing the mandatory WMS request Parameter Value
+type getMapParameterValueMandatory struct {
+	styledLayer
+	srs    string `yaml:"srs,omitempty"`
+	bbox   string `yaml:"bbox,omitempty"`
+	width  string `yaml:"width,omitempty"`
+	height string `yaml:"height,omitempty"`
+	format string `yaml:"format,omitempty"`
+}
+
+// getMapParameterValueOptional struct containing the optional WMS request Parameter Value
+type getMapParameterValueOptional struct {
+	transparent *string `yaml:"transparent,omitempty"`
+	bgcolor     *string `yaml:"bgcolor,omitempty"`
+	exceptions  *string `yaml:"exceptions,omitempty"`
+	time        *string `yaml:"time,omitempty"`
+}
@@ -0,0 +1,182 @@
+package wms111
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/pdok/ogc-specifications/pkg/wms130"
+)
+
+func TestGetMapParseQueryParameters(t *testing.T) {
+	var tests = []struct {
+		query      url.Values
+		getmap     GetMapRequest
+		exceptions Exceptions
+	}{
+		0: {query: map[string][]string{REQUEST: {getmap}, SERVICE: {Service}, VERSION: {Version},
+			LAYERS: {`Rivers,Roads`}, STYLES: {`,CenterLine`}, SRS: {`EPSG:4326`}, BBOX: {`-180.0,-90.0,180.0,90.0`},
+			WIDTH: {`1024`}, HEIGHT: {`512`}, FORMAT: {`image/png`}, TRANSPARENT: {`TRUE`}, EXCEPTIONS: {ExceptionsINIMAGE}},
+			getmap: GetMapRequest{
+				BaseRequest: BaseRequest{Service: Service, Version: Version},
+				StyledLayerDescriptor: wms130.StyledLayerDescriptor{Version: `1.1.0`, NamedLayer: []wms130.NamedLayer{
+					{Name: `Rivers`, NamedStyle: &wms130.NamedStyle{}},
+					{Name: `Roads`, NamedStyle: &wms130.NamedStyle{Name: `CenterLine`}}}},
+				SRS:         wms130.CRS{Namespace: `EPSG`, Code: 4326},
+				BoundingBox: wms130.BoundingBox{LowerCorner: wms130.Position{-180, -90}, UpperCorner: wms130.Position{180, 90}},
+				Output:      wms130.Output{Size: wms130.Size{Width: 1024, Height: 512}, Format: `image/png`, Transparent: bp(true)},
+				Exceptions:  sp(ExceptionsINIMAGE),
+			}},
+		// WMTVER is accepted as VERSION
+		1: {query: map[string][]string{`request`: {getmap}, `wmtver`: {`1.0.0`}, `layers`: {`Rivers`}, `styles`: {``},
+			`srs`: {`EPSG:28992`}, `bbox`: {`0,300000,280000,620000`}, `width`: {`256`}, `height`: {`256`}, `format`: {`image/png`}},
+			getmap: GetMapRequest{
+				BaseRequest:           BaseRequest{Service: Service, Version: `1.0.0`},
+				StyledLayerDescriptor: wms130.StyledLayerDescriptor{Version: `1.1.0`, NamedLayer: []wms130.NamedLayer{{Name: `Rivers`}}},
+				SRS:                   wms130.CRS{Namespace: `EPSG`, Code: 28992},
+				BoundingBox:           wms130.BoundingBox{LowerCorner: wms130.Position{0, 300000}, UpperCorner: wms130.Position{280000, 620000}},
+				Output:                wms130.Output{Size: wms130.Size{Width: 256, Height: 256}, Format: `image/png`},
+			}},
+		// the WMS 1.3.0 CRS parameter isn't a WMS 1.1.1 parameter
+		2: {query: map[string][]string{REQUEST: {getmap}, VERSION: {Version}, LAYERS: {`Rivers`}, STYLES: {``},
+			`CRS`: {`EPSG:4326`}, BBOX: {`-180.0,-90.0,180.0,90.0`}, WIDTH: {`1024`}, HEIGHT: {`512`}, FORMAT: {`image/png`}},
+			exceptions: Exceptions{MissingParameterValue(SRS)}},
+		3: {query: map[string][]string{REQUEST: {getmap}, VERSION: {Version}, LAYERS: {`Rivers`}, STYLES: {``},
+			SRS: {`EPSG:4326`}, BBOX: {`-180.0,-90.0,180.0`}, WIDTH: {`1024`}, HEIGHT: {`512`}, FORMAT: {`image/png`}},
+			exceptions: Exceptions{InvalidParameterValue(`-180.0,-90.0,180.0`, BBOX)}},
+		4: {query: map[string][]string{REQUEST: {getmap}, VERSION: {Version}, LAYERS: {`Rivers`}, STYLES: {``},
+			SRS: {`EPSG:28992`}, BBOX: {`0,300000,280000,620000`}, WIDTH: {`256`}, HEIGHT: {`256`}, FORMAT: {`image/png`}, TIME: {`2020-01-01/2020-12-31`}},
+			getmap: GetMapRequest{
+				BaseRequest:           BaseRequest{Service: Service, Version: Version},
+				StyledLayerDescriptor: wms130.StyledLayerDescriptor{Version: `1.1.0`, NamedLayer: []wms130.NamedLayer{{Name: `Rivers`}}},
+				SRS:                   wms130.CRS{Namespace: `EPSG`, Code: 28992},
+				BoundingBox:           wms130.BoundingBox{LowerCorner: wms130.Position{0, 300000}, UpperCorner: wms130.Position{280000, 620000}},
+				Output:                wms130.Output{Size: wms130.Size{Width: 256, Height: 256}, Format: `image/png`},
+				Time:                  sp(`2020-01-01/2020-12-31`),
+			}},
+	}
+
+	for k, test := range tests {
+		var gm GetMapRequest
+		exceptions := gm.ParseQueryParameters(test.query)
+		if !reflect.DeepEqual(exceptions, test.exceptions) {
+			t.Errorf("test: %d, expected: %v,\n got: %v", k, test.exceptions, exceptions)
+			continue
+		}
+		if test.exceptions != nil {
+			continue
+		}
+		test.getmap.XMLName.Local = getmap
+		if !reflect.DeepEqual(gm, test.getmap) {
+			t.Errorf("test: %d, expected: %+v,\n got: %+v", k, test.getmap, gm)
+		}
+	}
+}
+
+func TestGetMapToQueryParameters(t *testing.T) {
+	var tests = []struct {
+		getmap GetMapRequest
+		query  url.Values
+	}{
+		0: {getmap: GetMapRequest{
+			StyledLayerDescriptor: wms130.StyledLayerDescriptor{NamedLayer: []wms130.NamedLayer{{Name: `Rivers`}, {Name: `Roads`, NamedStyle: &wms130.NamedStyle{Name: `CenterLine`}}}},
+			SRS:                   wms130.CRS{Namespace: `EPSG`, Code: 4326},
+			BoundingBox:           wms130.BoundingBox{LowerCorner: wms130.Position{-180, -90}, UpperCorner: wms130.Position{180, 90}},
+			Output:                wms130.Output{Size: wms130.Size{Width: 1024, Height: 512}, Format: `image/png`, Transparent: bp(false)},
+		},
+			query: map[string][]string{SERVICE: {Service}, VERSION: {Version}, REQUEST: {getmap},
+				LAYERS: {`Rivers,Roads`}, STYLES: {`,CenterLine`}, SRS: {`EPSG:4326`}, BBOX: {`-180.000000,-90.000000,180.000000,90.000000`},
+				WIDTH: {`1024`}, HEIGHT: {`512`}, FORMAT: {`image/png`}, TRANSPARENT: {`FALSE`}}},
+		1: {getmap: GetMapRequest{
+			StyledLayerDescriptor: wms130.StyledLayerDescriptor{NamedLayer: []wms130.NamedLayer{{Name: `Rivers`}}},
+			SRS:                   wms130.CRS{Namespace: `EPSG`, Code: 28992},
+			BoundingBox:           wms130.BoundingBox{LowerCorner: wms130.Position{0, 300000}, UpperCorner: wms130.Position{280000, 620000}},
+			Output:                wms130.Output{Size: wms130.Size{Width: 256, Height: 256}, Format: `image/png`},
+			Time:                  sp(`2020-01-01`),
+		},
+			query: map[string][]string{SERVICE: {Service}, VERSION: {Version}, REQUEST: {getmap},
+				LAYERS: {`Rivers`}, STYLES: {``}, SRS: {`EPSG:28992`}, BBOX: {`0.000000,300000.000000,280000.000000,620000.000000`},
+				WIDTH: {`256`}, HEIGHT: {`256`}, FORMAT: {`image/png`}, TIME: {`2020-01-01`}}},
+	}
+
+	for k, test := range tests {
+		query := test.getmap.ToQueryParameters()
+		if !reflect.DeepEqual(query, test.query) {
+			t.Errorf("test: %d, expected: %v,\n got: %v", k, test.query, query)
+		}
+	}
+}
+
+func TestGetMapParseXML(t *testing.T) {
+	var tests = []struct {
+		body   string
+		getmap GetMapRequest
+	}{
+		0: {body: `<?xml version="1.0" encoding="UTF-8"?>
+<GetMap service="WMS" version="1.1.1" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance">
+ <StyledLayerDescriptor version="1.0.0"><NamedLayer><Name>Rivers</Name></NamedLayer></StyledLayerDescriptor>
+ <SRS>EPSG:4326</SRS>
+ <BoundingBox><LowerCorner>-180 -90</LowerCorner><UpperCorner>180 90</UpperCorner></BoundingBox>
+ <Output><Size><Width>1024</Width><Height>512</Height></Size><Format>image/png</Format></Output>
+</GetMap>`,
+			getmap: GetMapRequest{
+				BaseRequest:           BaseRequest{Service: Service, Version: Version},
+				StyledLayerDescriptor: wms130.StyledLayerDescriptor{Version: `1.0.0`, NamedLayer: []wms130.NamedLayer{{Name: `Rivers`}}},
+				SRS:                   wms130.CRS{Namespace: `EPSG`, Code: 4326},
+				BoundingBox:           wms130.BoundingBox{LowerCorner: wms130.Position{-180, -90}, UpperCorner: wms130.Position{180, 90}},
+				Output:                wms130.Output{Size: wms130.Size{Width: 1024, Height: 512}, Format: `image/png`},
+			}},
+	}
+
+	for k, test := range tests {
+		var gm GetMapRequest
+		if exceptions := gm.ParseXML([]byte(test.body)); exceptions != nil {
+			t.Errorf("test: %d, expected no exceptions,\n got: %v", k, exceptions)
+			continue
+		}
+		if !reflect.DeepEqual(gm.StyledLayerDescriptor, test.getmap.StyledLayerDescriptor) || gm.SRS != test.getmap.SRS ||
+			gm.BoundingBox != test.getmap.BoundingBox || !reflect.DeepEqual(gm.Output, test.getmap.Output) ||
+			gm.Version != test.getmap.Version || gm.Service != test.getmap.Service {
+			t.Errorf("test: %d, expected: %+v,\n got: %+v", k, test.getmap, gm)
+		}
+		if len(gm.Attr) != 1 || gm.Attr[0].Name.Local != `xsi` {
+			t.Errorf("test: %d, expected only the xsi declaration as attribute,\n got: %v", k, gm.Attr)
+		}
+	}
+}
+
+func TestGetMapValidate(t *testing.T) {
+	var gc GetCapabilitiesResponse
+	if err := gc.ParseXML([]byte(capabilities111)); err != nil {
+		t.Fatalf("expected no error,\n got: %s", err)
+	}
+
+	var tests = []struct {
+		query      url.Values
+		exceptions Exceptions
+	}{
+		0: {query: map[string][]string{REQUEST: {getmap}, VERSION: {Version}, LAYERS: {`Rivers`}, STYLES: {``},
+			SRS: {`EPSG:4326`}, BBOX: {`3,50,8,54`}, WIDTH: {`256`}, HEIGHT: {`256`}, FORMAT: {`image/png`}, EXCEPTIONS: {ExceptionsINIMAGE}}},
+		1: {query: map[string][]string{REQUEST: {getmap}, VERSION: {Version}, LAYERS: {`Rivers`}, STYLES: {``},
+			SRS: {`EPSG:3857`}, BBOX: {`3,50,8,54`}, WIDTH: {`256`}, HEIGHT: {`256`}, FORMAT: {`image/png`}},
+			exceptions: Exceptions{InvalidSRS(`EPSG:3857`, `Rivers`)}},
+	}
+
+	for k, test := range tests {
+		var gm GetMapRequest
+		if exceptions := gm.ParseQueryParameters(test.query); exceptions != nil {
+			t.Errorf("test: %d, expected no exceptions,\n got: %v", k, exceptions)
+			continue
+		}
+		exceptions := gm.Validate(gc.Capabilities)
+		if len(exceptions) != len(test.exceptions) {
+			t.Errorf("test: %d, expected: %v,\n got: %v", k, test.exceptions, exceptions)
+			continue
+		}
+		for i := range exceptions {
+			if exceptions[i].Code() != test.exceptions[i].Code() {
+				t.Errorf("test: %d, expected: %v,\n got: %v", k, test.exceptions, exceptions)
+			}
+		}
+	}
+}
@@ -0,0 +1,25 @@
+package wms111
+
+func sp(s string) *string {
+	return &s
+}
+
+func fp(f float64) *float64 {
+	return &f
+}
+
+// value returns the string s points to, or an empty string
+func value(s *string) string {
+	if s == nil {
+		return ``
+	}
+	return *s
+}
+
+func ip(i int) *int {
+	return &i
+}
+
+func bp(b bool) *bool {
+	return &b
+}
@@ -22,6 +22,24 @@ func (b *BoundingBox) ToQueryParameters() string {
 	return fmt.Sprintf("%f,%f,%f,%f", b.LowerCorner[0], b.LowerCorner[1], b.UpperCorner[0], b.UpperCorner[1])
 }
 
+// SwapAxis returns the BoundingBox with the first and second axis swapped
+func (b BoundingBox) SwapAxis() BoundingBox {
+	b.LowerCorner = Position{b.LowerCorner[1], b.LowerCorner[0]}
+	b.UpperCorner = Position{b.UpperCorner[1], b.UpperCorner[0]}
+	return b
+}
+
+// EastNorth returns the BoundingBox with the coordinates in the x/y (east/north) axis order.
+// The BBOX of a WMS 1.3.0 request follows the axis order of the CRS,
+// so for a CRS like EPSG:4326 the latitude/longitude axes are swapped.
+// Swapping is its own inverse, so it also turns x/y coordinates back into the axis order of the CRS.
+func (b BoundingBox) EastNorth(c CRS) BoundingBox {
+	if c.NorthEast() {
+		return b.SwapAxis()
+	}
+	return b
+}
+
 // ParseString builds a BoundingBox based on a string
 func (b *BoundingBox) parseString(boundingbox string) Exceptions {
 	result := strings.Split(boundingbox, ",")
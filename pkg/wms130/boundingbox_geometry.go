@@ -0,0 +1,119 @@
+package wms130
+
+import (
+	"math"
+)
+
+// Valid checks that the BoundingBox has finite coordinates and that
+// the LowerCorner is below and to the left of the UpperCorner
+func (b BoundingBox) Valid() bool {
+	for _, v := range []float64{b.LowerCorner[0], b.LowerCorner[1], b.UpperCorner[0], b.UpperCorner[1]} {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return false
+		}
+	}
+	return b.LowerCorner[0] < b.UpperCorner[0] && b.LowerCorner[1] < b.UpperCorner[1]
+}
+
+// Validate raises an InvalidParameterValue Exception for an inverted or degenerate BoundingBox
+func (b BoundingBox) Validate() Exceptions {
+	if !b.Valid() {
+		return InvalidParameterValue(b.ToQueryParameters(), BBOX).ToExceptions()
+	}
+	return nil
+}
+
+// Intersects checks if the BoundingBox shares any point with the other BoundingBox, like the OGC Intersects operator
+// BoundingBoxes that only touch along an edge or in a corner intersect. Both BoundingBoxes need to be in the same CRS
+func (b BoundingBox) Intersects(other BoundingBox) bool {
+	return b.LowerCorner[0] <= other.UpperCorner[0] && b.UpperCorner[0] >= other.LowerCorner[0] &&
+		b.LowerCorner[1] <= other.UpperCorner[1] && b.UpperCorner[1] >= other.LowerCorner[1]
+}
+
+// Contains checks if the other BoundingBox lies completely within the BoundingBox,
+// both BoundingBoxes need to be in the same CRS
+func (b BoundingBox) Contains(other BoundingBox) bool {
+	return b.LowerCorner[0] <= other.LowerCorner[0] && b.UpperCorner[0] >= other.UpperCorner[0] &&
+		b.LowerCorner[1] <= other.LowerCorner[1] && b.UpperCorner[1] >= other.UpperCorner[1]
+}
+
+// Union returns the smallest BoundingBox that contains both BoundingBoxes,
+// both BoundingBoxes need to be in the same CRS
+func (b BoundingBox) Union(other BoundingBox) BoundingBox {
+	b.LowerCorner = Position{math.Min(b.LowerCorner[0], other.LowerCorner[0]), math.Min(b.LowerCorner[1], other.LowerCorner[1])}
+	b.UpperCorner = Position{math.Max(b.UpperCorner[0], other.UpperCorner[0]), math.Max(b.UpperCorner[1], other.UpperCorner[1])}
+	return b
+}
+
+// Area returns the area of the BoundingBox in the units of its CRS, an invalid BoundingBox has no area
+func (b BoundingBox) Area() float64 {
+	if !b.Valid() {
+		return 0
+	}
+	return (b.UpperCorner[0] - b.LowerCorner[0]) * (b.UpperCorner[1] - b.LowerCorner[1])
+}
+
+// BoundingBox returns the EX_GeographicBoundingBox as a BoundingBox in CRS:84
+func (b EXGeographicBoundingBox) BoundingBox() BoundingBox {
+	return BoundingBox{
+		LowerCorner: Position{b.WestBoundLongitude, b.SouthBoundLatitude},
+		UpperCorner: Position{b.EastBoundLongitude, b.NorthBoundLatitude},
+	}
+}
+
+// BoundingBox returns the LayerBoundingBox as a BoundingBox, in the axis order of its CRS
+func (b LayerBoundingBox) BoundingBox() BoundingBox {
+	return BoundingBox{
+		LowerCorner: Position{b.Minx, b.Miny},
+		UpperCorner: Position{b.Maxx, b.Maxy},
+	}
+}
+
+// Extent returns the extent of the layer in the given CRS.
+// A BoundingBox of the layer in the same CRS is used when available,
+// otherwise the EX_GeographicBoundingBox is transformed to the CRS.
+// The returned bool is false when the extent can't be determined.
+func (l Layer) Extent(c CRS) (BoundingBox, bool) {
+	for _, lbb := range l.BoundingBox {
+		if lbb == nil {
+			continue
+		}
+		var lc CRS
+		lc.parseString(lbb.CRS)
+		if lc == c {
+			return lbb.BoundingBox(), true
+		}
+	}
+
+	if l.EXGeographicBoundingBox != nil {
+		bbox, err := l.EXGeographicBoundingBox.Transform(c)
+		if err == nil {
+			return bbox, true
+		}
+	}
+
+	return BoundingBox{}, false
+}
+
+// LayersOutOfExtent returns the requested layers for which the requested BBOX lies entirely
+// outside the advertised extent of the layer, so these layers will render nothing.
+// Layers for which the extent can't be determined in the requested CRS are not reported.
+func (m GetMapRequest) LayersOutOfExtent(c Capabilities) []string {
+	var layers []string
+
+	for _, name := range m.StyledLayerDescriptor.getNamedLayers() {
+		layer, exceptions := c.GetEffectiveLayer(name)
+		if exceptions != nil {
+			continue
+		}
+		extent, ok := layer.Extent(m.CRS)
+		if !ok {
+			continue
+		}
+		if !extent.Intersects(m.BoundingBox) {
+			layers = append(layers, name)
+		}
+	}
+
+	return layers
+}
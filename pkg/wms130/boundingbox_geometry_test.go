@@ -0,0 +1,114 @@
+package wms130
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBoundingBoxGeometry(t *testing.T) {
+	var tests = []struct {
+		a, b       BoundingBox
+		valid      bool
+		intersects bool
+		contains   bool
+		union      BoundingBox
+		area       float64
+	}{
+		0: {a: BoundingBox{LowerCorner: Position{0, 0}, UpperCorner: Position{10, 10}},
+			b:     BoundingBox{LowerCorner: Position{2, 2}, UpperCorner: Position{4, 4}},
+			valid: true, intersects: true, contains: true,
+			union: BoundingBox{LowerCorner: Position{0, 0}, UpperCorner: Position{10, 10}}, area: 100},
+		1: {a: BoundingBox{LowerCorner: Position{0, 0}, UpperCorner: Position{10, 10}},
+			b:     BoundingBox{LowerCorner: Position{5, 5}, UpperCorner: Position{15, 20}},
+			valid: true, intersects: true, contains: false,
+			union: BoundingBox{LowerCorner: Position{0, 0}, UpperCorner: Position{15, 20}}, area: 100},
+		2: {a: BoundingBox{LowerCorner: Position{0, 0}, UpperCorner: Position{10, 10}},
+			b:     BoundingBox{LowerCorner: Position{20, 20}, UpperCorner: Position{30, 30}},
+			valid: true, intersects: false, contains: false,
+			union: BoundingBox{LowerCorner: Position{0, 0}, UpperCorner: Position{30, 30}}, area: 100},
+		3: {a: BoundingBox{LowerCorner: Position{10, 10}, UpperCorner: Position{0, 0}},
+			b:     BoundingBox{LowerCorner: Position{0, 0}, UpperCorner: Position{1, 1}},
+			valid: false, intersects: false, contains: false,
+			union: BoundingBox{LowerCorner: Position{0, 0}, UpperCorner: Position{1, 1}}, area: 0},
+		4: {a: BoundingBox{LowerCorner: Position{0, math.Inf(1)}, UpperCorner: Position{10, 10}},
+			b:     BoundingBox{LowerCorner: Position{0, 0}, UpperCorner: Position{1, 1}},
+			valid: false, intersects: false, contains: false,
+			union: BoundingBox{LowerCorner: Position{0, 0}, UpperCorner: Position{10, 10}}, area: 0},
+		// touching along an edge
+		5: {a: BoundingBox{LowerCorner: Position{0, 0}, UpperCorner: Position{10, 10}},
+			b:     BoundingBox{LowerCorner: Position{10, 5}, UpperCorner: Position{20, 15}},
+			valid: true, intersects: true, contains: false,
+			union: BoundingBox{LowerCorner: Position{0, 0}, UpperCorner: Position{20, 15}}, area: 100},
+		// touching in a corner
+		6: {a: BoundingBox{LowerCorner: Position{0, 0}, UpperCorner: Position{10, 10}},
+			b:     BoundingBox{LowerCorner: Position{10, 10}, UpperCorner: Position{20, 20}},
+			valid: true, intersects: true, contains: false,
+			union: BoundingBox{LowerCorner: Position{0, 0}, UpperCorner: Position{20, 20}}, area: 100},
+	}
+
+	for k, test := range tests {
+		if valid := test.a.Valid(); valid != test.valid {
+			t.Errorf("test: %d, expected valid: %t,\n got: %t", k, test.valid, valid)
+		}
+		if exceptions := test.a.Validate(); (exceptions == nil) != test.valid {
+			t.Errorf("test: %d, expected valid: %t,\n got exceptions: %v", k, test.valid, exceptions)
+		}
+		if intersects := test.a.Intersects(test.b); intersects != test.intersects {
+			t.Errorf("test: %d, expected intersects: %t,\n got: %t", k, test.intersects, intersects)
+		}
+		if contains := test.a.Contains(test.b); contains != test.contains {
+			t.Errorf("test: %d, expected contains: %t,\n got: %t", k, test.contains, contains)
+		}
+		if union := test.a.Union(test.b); union != test.union {
+			t.Errorf("test: %d, expected union: %v,\n got: %v", k, test.union, union)
+		}
+		if area := test.a.Area(); area != test.area {
+			t.Errorf("test: %d, expected area: %f,\n got: %f", k, test.area, area)
+		}
+	}
+}
+
+func TestLayersOutOfExtent(t *testing.T) {
+	c := Capabilities{
+		WMSCapabilities: WMSCapabilities{
+			Layer: []Layer{
+				{Title: `root`,
+					CRS: []CRS{{Namespace: EPSG, Code: 28992}},
+					Layer: []*Layer{
+						{Name: sp(`netherlands`),
+							EXGeographicBoundingBox: &EXGeographicBoundingBox{WestBoundLongitude: 3.05, EastBoundLongitude: 7.28, SouthBoundLatitude: 50.67, NorthBoundLatitude: 53.61}},
+						{Name: sp(`amsterdam`),
+							BoundingBox: []*LayerBoundingBox{{CRS: `EPSG:28992`, Minx: 110000, Miny: 476000, Maxx: 135000, Maxy: 496000}}},
+						{Name: sp(`unknown`)},
+					},
+				},
+			},
+		},
+	}
+
+	var tests = []struct {
+		bbox BoundingBox
+		out  []string
+	}{
+		// Utrecht
+		0: {bbox: BoundingBox{LowerCorner: Position{130000, 450000}, UpperCorner: Position{145000, 460000}},
+			out: []string{`amsterdam`}},
+		// Amsterdam
+		1: {bbox: BoundingBox{LowerCorner: Position{115000, 480000}, UpperCorner: Position{125000, 490000}}},
+		// North sea, far outside of the Netherlands
+		2: {bbox: BoundingBox{LowerCorner: Position{-200000, 800000}, UpperCorner: Position{-100000, 900000}},
+			out: []string{`netherlands`, `amsterdam`}},
+	}
+
+	for k, test := range tests {
+		m := GetMapRequest{
+			StyledLayerDescriptor: StyledLayerDescriptor{NamedLayer: []NamedLayer{{Name: `netherlands`}, {Name: `amsterdam`}, {Name: `unknown`}}},
+			CRS:                   CRS{Namespace: EPSG, Code: 28992},
+			BoundingBox:           test.bbox,
+		}
+
+		if out := m.LayersOutOfExtent(c); !equalStrings(out, test.out) {
+			t.Errorf("test: %d, expected: %v \ngot: %v", k, test.out, out)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package wms130
+
+import (
+	"github.com/pdok/ogc-specifications/pkg/crs"
+)
+
+// Transform converts the BoundingBox from one CRS to another.
+// The coordinates of the BoundingBox, and the result, follow the axis order of the CRS.
+func (b BoundingBox) Transform(from, to CRS) (BoundingBox, error) {
+	eastnorth := b.EastNorth(from)
+	bounds, err := crs.TransformBounds(from.String(), to.String(),
+		eastnorth.LowerCorner[0], eastnorth.LowerCorner[1], eastnorth.UpperCorner[0], eastnorth.UpperCorner[1], crs.DefaultDensify)
+	if err != nil {
+		return BoundingBox{}, err
+	}
+
+	result := BoundingBox{
+		Crs:         b.Crs,
+		Dimensions:  b.Dimensions,
+		LowerCorner: Position{bounds[0], bounds[1]},
+		UpperCorner: Position{bounds[2], bounds[3]},
+	}
+	if b.Crs != `` {
+		result.Crs = to.String()
+	}
+	return result.EastNorth(to), nil
+}
+
+// Transform converts the EX_GeographicBoundingBox to a BoundingBox in the given CRS
+func (b EXGeographicBoundingBox) Transform(to CRS) (BoundingBox, error) {
+	return b.BoundingBox().Transform(CRS{Namespace: crs.CRS, Code: 84}, to)
+}
+
+// Transform converts the LayerBoundingBox to a BoundingBox in the given CRS
+func (b LayerBoundingBox) Transform(to CRS) (BoundingBox, error) {
+	var from CRS
+	from.parseString(b.CRS)
+
+	return b.BoundingBox().Transform(from, to)
+}
@@ -0,0 +1,53 @@
+package wms130
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBoundingBoxTransform(t *testing.T) {
+	var tests = []struct {
+		bbox     BoundingBox
+		from, to CRS
+		expected BoundingBox
+	}{
+		// EPSG:4326 has a lat/lon axis order
+		0: {bbox: BoundingBox{LowerCorner: Position{0, 300000}, UpperCorner: Position{280000, 625000}},
+			from: CRS{Namespace: EPSG, Code: 28992}, to: CRS{Namespace: EPSG, Code: 4326},
+			expected: BoundingBox{LowerCorner: Position{50.67, 3.05}, UpperCorner: Position{53.61, 7.28}}},
+		1: {bbox: BoundingBox{LowerCorner: Position{50.67, 3.05}, UpperCorner: Position{53.61, 7.28}},
+			from: CRS{Namespace: EPSG, Code: 4326}, to: CRS{Namespace: `CRS`, Code: 84},
+			expected: BoundingBox{LowerCorner: Position{3.05, 50.67}, UpperCorner: Position{7.28, 53.61}}},
+	}
+
+	for k, test := range tests {
+		result, err := test.bbox.Transform(test.from, test.to)
+		if err != nil {
+			t.Errorf("test: %d, expected no error,\n got: %v", k, err)
+			continue
+		}
+		for i := 0; i < 2; i++ {
+			if math.Abs(result.LowerCorner[i]-test.expected.LowerCorner[i]) > 0.01 || math.Abs(result.UpperCorner[i]-test.expected.UpperCorner[i]) > 0.01 {
+				t.Errorf("test: %d, expected: %v,\n got: %v", k, test.expected, result)
+				break
+			}
+		}
+	}
+
+	if _, err := (BoundingBox{}).Transform(CRS{Namespace: EPSG, Code: 28992}, CRS{Namespace: EPSG, Code: 1234}); err == nil {
+		t.Errorf("expected an error for an unsupported CRS")
+	}
+}
+
+func TestEXGeographicBoundingBoxTransform(t *testing.T) {
+	ex := EXGeographicBoundingBox{WestBoundLongitude: 3.05, EastBoundLongitude: 7.28, SouthBoundLatitude: 50.67, NorthBoundLatitude: 53.61}
+
+	result, err := ex.Transform(CRS{Namespace: EPSG, Code: 28992})
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	// The transformed geographic extent encloses the RD extent 0,300000,280000,625000
+	if result.LowerCorner[0] > 0 || result.LowerCorner[1] > 300000 || result.UpperCorner[0] < 280000 || result.UpperCorner[1] < 625000 {
+		t.Errorf("expected the extent to enclose: 0,300000,280000,625000,\n got: %v", result)
+	}
+}
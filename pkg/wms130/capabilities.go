@@ -1,28 +1,24 @@
 package wms130
 
 import (
-	"encoding/xml"
-	"log"
-
-	"gopkg.in/yaml.v3"
+	"github.com/pdok/ogc-specifications/pkg/utils"
 )
 
-// ParseXML func
+// ParseXML builds the Capabilities from a XML document,
+// a malformed document results in a *utils.ParseError
 func (c *Capabilities) ParseXML(doc []byte) error {
-	if err := xml.Unmarshal(doc, c); err != nil {
-		log.Fatalf("error: %v", err)
-		return err
-	}
-	return nil
+	return utils.UnmarshalPrefixed(doc, c, capabilitiesPrefixes)
 }
 
-// ParseYAML func
+// ParseYAML builds the Capabilities from a YAML document, unknown keys are ignored
 func (c *Capabilities) ParseYAML(doc []byte) error {
-	if err := yaml.Unmarshal(doc, c); err != nil {
-		log.Fatalf("error: %v", err)
-		return err
-	}
-	return nil
+	return c.ParseYAMLWithMode(doc, utils.Lenient)
+}
+
+// ParseYAMLWithMode builds the Capabilities from a YAML document,
+// in utils.Strict mode unknown keys are rejected with an error wrapping utils.ErrUnknownField
+func (c *Capabilities) ParseYAMLWithMode(doc []byte, mode utils.ParseMode) error {
+	return utils.UnmarshalYAML(doc, c, mode)
 }
 
 // Capabilities struct needed for keeping all constraints and capabilities together
@@ -78,7 +74,7 @@ type Layer struct {
 	BoundingBox             []*LayerBoundingBox      `xml:"BoundingBox" yaml:"boundingBox,omitempty"`
 	Dimension               []*Dimension             `xml:"Dimension" yaml:"dimension,omitempty"`
 	Attribution             *Attribution             `xml:"Attribution,omitempty" yaml:"attribution,omitempty"`
-	AuthorityURL            *AuthorityURL            `xml:"AuthorityURL" yaml:"authorityUrl,omitempty"`
+	AuthorityURL            []*AuthorityURL          `xml:"AuthorityURL" yaml:"authorityUrl,omitempty"`
 	Identifier              *Identifier              `xml:"Identifier" yaml:"identifier,omitempty"`
 	MetadataURL             []*MetadataURL           `xml:"MetadataURL" yaml:"metadataUrl,omitempty"`
 	DataURL                 *URL                     `xml:"DataURL,omitempty" yaml:"dataUrl,omitempty"`
@@ -89,43 +85,21 @@ type Layer struct {
 	Layer                   []*Layer                 `xml:"Layer" yaml:"layer,omitempty"`
 }
 
-// StyleDefined checks if the style that is defined is available for the requested layer
+// StyleDefined checks if the style that is defined is available for the requested layer,
+// including the styles inherited from its parent layers
 func (c *Capabilities) StyleDefined(layername, stylename string) bool {
-	defined := false
-	for _, layer := range c.Layer {
-		defined = layer.styleDefined(layername, stylename)
-		if defined {
-			return defined
-		}
-	}
-
-	return defined
-}
-
-// styleDefined checks if the style is defined
-func (l *Layer) styleDefined(layername, stylename string) bool {
-	if l.Name != nil && *l.Name == layername {
-		if l.Style != nil {
-			for _, sld := range l.Style {
-				if sld.Name == stylename {
-					return true
-				}
-			}
-		}
+	layer, exceptions := c.GetEffectiveLayer(layername)
+	if exceptions != nil {
+		return false
 	}
 
-	// Not found top level, so we go deeper
-	defined := false
-	if l.Layer != nil {
-		for _, nestedlayer := range l.Layer {
-			defined = nestedlayer.styleDefined(layername, stylename)
-			if defined {
-				return defined
-			}
+	for _, style := range layer.Style {
+		if style.Name == stylename {
+			return true
 		}
 	}
 
-	return defined
+	return false
 }
 
 // GetLayerNames returns the available layers as []string
@@ -300,6 +274,19 @@ type LayerBoundingBox struct {
 	Resy float64 `xml:"resy,attr,omitempty" yaml:"resy,omitempty"`
 }
 
+// EastNorth returns the LayerBoundingBox with the coordinates in the x/y (east/north) axis order,
+// where the minx, miny, maxx and maxy attributes follow the axis order of the CRS
+func (b LayerBoundingBox) EastNorth() LayerBoundingBox {
+	var c CRS
+	c.parseString(b.CRS)
+	if c.NorthEast() {
+		b.Minx, b.Miny = b.Miny, b.Minx
+		b.Maxx, b.Maxy = b.Maxy, b.Maxx
+		b.Resx, b.Resy = b.Resy, b.Resx
+	}
+	return b
+}
+
 // Style in struct for repeatability
 type Style struct {
 	Name          string         `xml:"Name" yaml:"name"`
@@ -343,10 +330,13 @@ type OnlineResource struct {
 	Href  *string `xml:"xlink:href,attr" yaml:"href"`
 }
 
+// Dimension declares a dimension of the layer, like time or elevation, and its values
 type Dimension struct {
-	Name         *string `xml:"name,attr" yaml:"name"`
-	Units        *string `xml:"units,attr" yaml:"units"`
-	Default      *string `xml:"default,attr,omitempty" yaml:"default,omitempty"`
-	NearestValue *string `xml:"nearestValue,attr,omitempty" yaml:"nearestValue,omitempty"`
-	Value        *string `xml:",chardata" yaml:"value"`
+	Name           *string `xml:"name,attr" yaml:"name"`
+	Units          *string `xml:"units,attr" yaml:"units"`
+	Default        *string `xml:"default,attr,omitempty" yaml:"default,omitempty"`
+	MultipleValues *string `xml:"multipleValues,attr,omitempty" yaml:"multipleValues,omitempty"`
+	NearestValue   *string `xml:"nearestValue,attr,omitempty" yaml:"nearestValue,omitempty"`
+	Current        *string `xml:"current,attr,omitempty" yaml:"current,omitempty"`
+	Value          *string `xml:",chardata" yaml:"value"`
 }
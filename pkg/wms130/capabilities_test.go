@@ -1,7 +1,11 @@
 package wms130
 
 import (
+	"errors"
+	"strings"
 	"testing"
+
+	"github.com/pdok/ogc-specifications/pkg/utils"
 )
 
 var capabilities = Capabilities{
@@ -86,3 +90,164 @@ func TestGetLayer(t *testing.T) {
 		}
 	}
 }
+
+func TestGetEffectiveLayer(t *testing.T) {
+	inherited := Capabilities{
+		WMSCapabilities: WMSCapabilities{
+			Layer: []Layer{
+				{Title: `root`,
+					Queryable:               ip(1),
+					CRS:                     []CRS{{Namespace: `EPSG`, Code: 4326}, {Namespace: `EPSG`, Code: 3857}},
+					EXGeographicBoundingBox: &EXGeographicBoundingBox{WestBoundLongitude: -180, EastBoundLongitude: 180, SouthBoundLatitude: -90, NorthBoundLatitude: 90},
+					BoundingBox:             []*LayerBoundingBox{{CRS: `EPSG:4326`, Minx: -90, Miny: -180, Maxx: 90, Maxy: 180}},
+					Style:                   []*Style{{Name: `default`}, nil},
+					MaxScaleDenominator:     fp(50000),
+					AuthorityURL:            []*AuthorityURL{{Name: `PDOK`}, nil, {Name: `NGR`}},
+					Layer: []*Layer{
+						{Name: sp(`child`),
+							AuthorityURL: []*AuthorityURL{{Name: `NGR`, OnlineResource: OnlineResource{Href: sp(`https://www.nationaalgeoregister.nl`)}}, {Name: `RWS`}},
+							Queryable:    ip(0),
+							CRS:          []CRS{{Namespace: `EPSG`, Code: 28992}, {Namespace: `EPSG`, Code: 4326}},
+							BoundingBox:  []*LayerBoundingBox{{CRS: `EPSG:28992`, Minx: 0, Miny: 300000, Maxx: 280000, Maxy: 625000}},
+							Style:        []*Style{nil, {Name: `special`}},
+							Layer: []*Layer{
+								{Name: sp(`grandchild`),
+									BoundingBox: []*LayerBoundingBox{{CRS: `EPSG:4326`, Minx: 50, Miny: 3, Maxx: 54, Maxy: 8}}},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	var tests = []struct {
+		layername   string
+		crs         []CRS
+		styles      []string
+		authorities []string
+		boundingbox map[string]float64
+		queryable   int
+		exception   Exceptions
+	}{
+		0: {layername: `child`,
+			crs:         []CRS{{Namespace: `EPSG`, Code: 4326}, {Namespace: `EPSG`, Code: 3857}, {Namespace: `EPSG`, Code: 28992}},
+			styles:      []string{`special`, `default`},
+			authorities: []string{`NGR`, `RWS`, `PDOK`},
+			boundingbox: map[string]float64{`EPSG:28992`: 0, `EPSG:4326`: -90},
+			queryable:   0},
+		1: {layername: `grandchild`,
+			crs:         []CRS{{Namespace: `EPSG`, Code: 4326}, {Namespace: `EPSG`, Code: 3857}, {Namespace: `EPSG`, Code: 28992}},
+			styles:      []string{`special`, `default`},
+			authorities: []string{`NGR`, `RWS`, `PDOK`},
+			boundingbox: map[string]float64{`EPSG:28992`: 0, `EPSG:4326`: 50},
+			queryable:   0},
+		2: {layername: `unknownLayer`, exception: Exceptions{LayerNotDefined(`unknownLayer`)}},
+	}
+
+	for k, test := range tests {
+		layer, exception := inherited.GetEffectiveLayer(test.layername)
+		if test.exception != nil {
+			if exception == nil || exception[0] != test.exception[0] {
+				t.Errorf("test: %d, expected: %v \ngot: %v", k, test.exception, exception)
+			}
+			continue
+		}
+		if exception != nil {
+			t.Errorf("test: %d, expected no exceptions \ngot: %v", k, exception)
+			continue
+		}
+		if len(layer.CRS) != len(test.crs) {
+			t.Errorf("test: %d, expected: %v \ngot: %v", k, test.crs, layer.CRS)
+		}
+		for _, crs := range test.crs {
+			if checkCRS(crs, layer.CRS) != nil {
+				t.Errorf("test: %d, expected CRS: %s \ngot: %v", k, crs.String(), layer.CRS)
+			}
+		}
+		for _, style := range test.styles {
+			if !inherited.StyleDefined(test.layername, style) {
+				t.Errorf("test: %d, expected style: %s to be defined", k, style)
+			}
+		}
+		var authorities []string
+		for _, a := range layer.AuthorityURL {
+			authorities = append(authorities, a.Name)
+		}
+		if strings.Join(authorities, `,`) != strings.Join(test.authorities, `,`) || layer.AuthorityURL[0].OnlineResource.Href == nil {
+			t.Errorf("test: %d, expected the AuthorityURLs: %v \ngot: %v", k, test.authorities, authorities)
+		}
+		if len(layer.BoundingBox) != len(test.boundingbox) {
+			t.Errorf("test: %d, expected: %v \ngot: %v", k, test.boundingbox, layer.BoundingBox)
+		}
+		for _, bbox := range layer.BoundingBox {
+			if minx, ok := test.boundingbox[bbox.CRS]; !ok || minx != bbox.Minx {
+				t.Errorf("test: %d, expected: %v \ngot: %v", k, test.boundingbox, *bbox)
+			}
+		}
+		if layer.EXGeographicBoundingBox == nil || layer.EXGeographicBoundingBox.EastBoundLongitude != 180 {
+			t.Errorf("test: %d, expected the EX_GeographicBoundingBox to be inherited", k)
+		}
+		if layer.MaxScaleDenominator == nil || *layer.MaxScaleDenominator != 50000 {
+			t.Errorf("test: %d, expected the MaxScaleDenominator to be inherited", k)
+		}
+		if layer.Queryable == nil || *layer.Queryable != test.queryable {
+			t.Errorf("test: %d, expected queryable: %d \ngot: %v", k, test.queryable, layer.Queryable)
+		}
+	}
+
+	// The Capabilities document itself is not altered
+	if len(inherited.Layer[0].Layer[0].CRS) != 2 || len(inherited.Layer[0].Layer[0].Style) != 2 || len(inherited.Layer[0].Layer[0].AuthorityURL) != 2 {
+		t.Errorf("expected the Capabilities document not to be altered, got: %v", inherited.Layer[0].Layer[0])
+	}
+}
+
+func TestCapabilitiesParse(t *testing.T) {
+	var tests = []struct {
+		doc     string
+		yaml    bool
+		mode    utils.ParseMode
+		path    string
+		line    int
+		unknown bool
+	}{
+		0: {doc: "wmsCapabilities:\n  layer:\n    - name: roads\n      title: Roads\noptionalConstraints:\n  layerLimit: 2\n", yaml: true, mode: utils.Strict},
+		1: {doc: "wmsCapabilities:\n  layer:\n    - name: roads\n      colour: red\n", yaml: true, mode: utils.Lenient},
+		2: {doc: "wmsCapabilities:\n  layer:\n    - name: roads\n      colour: red\n", yaml: true, mode: utils.Strict, path: `wmsCapabilities.layer[0].colour`, line: 4, unknown: true},
+		3: {doc: "optionalConstraints:\n  layerLimit: two\n", yaml: true, mode: utils.Lenient, path: `optionalConstraints.layerLimit`, line: 2},
+		4: {doc: "<Capabilities>\n <Layer><Name>roads</Name></Layer>\n <LayerLimit>2</LayerLimit>\n</Capabilities>"},
+		5: {doc: "<Capabilities>\n <Layer><Name>roads</Name></Layer>\n <LayerLimit>two</LayerLimit>\n</Capabilities>", path: `Capabilities/LayerLimit`, line: 3},
+		6: {doc: "<Capabilities>\n <Layer>\n</Capabilities>", path: `Capabilities/Layer`, line: 3},
+	}
+
+	for k, test := range tests {
+		var c Capabilities
+		var err error
+		if test.yaml {
+			err = c.ParseYAMLWithMode([]byte(test.doc), test.mode)
+		} else {
+			err = c.ParseXML([]byte(test.doc))
+		}
+
+		if test.line == 0 {
+			if err != nil {
+				t.Errorf("test: %d, expected no error,\n got: %v", k, err)
+			} else if len(c.Layer) != 1 || *c.Layer[0].Name != `roads` {
+				t.Errorf("test: %d, expected the roads layer,\n got: %v", k, c.Layer)
+			}
+			continue
+		}
+
+		var pe *utils.ParseError
+		if !errors.As(err, &pe) {
+			t.Errorf("test: %d, expected a ParseError,\n got: %v", k, err)
+			continue
+		}
+		if pe.Path != test.path || pe.Line != test.line {
+			t.Errorf("test: %d, expected: %s %d,\n got: %s %d (%v)", k, test.path, test.line, pe.Path, pe.Line, pe)
+		}
+		if errors.Is(err, utils.ErrUnknownField) != test.unknown {
+			t.Errorf("test: %d, expected unknown field: %t,\n got: %v", k, test.unknown, err)
+		}
+	}
+}
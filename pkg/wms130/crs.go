@@ -3,9 +3,9 @@ package wms130
 import (
 	"encoding/xml"
 	"fmt"
-	"regexp"
 	"strconv"
-	"strings"
+
+	"github.com/pdok/ogc-specifications/pkg/crs"
 )
 
 // Default values used by the CRS struct
@@ -25,25 +25,38 @@ func (c *CRS) String() string {
 	return c.Namespace + `:` + strconv.Itoa(c.Code)
 }
 
-// Identifier returns the EPSG
+// Identifier returns the URN of the CRS
 func (c *CRS) Identifier() string {
+	if d, ok := c.Definition(); ok {
+		return d.URN()
+	}
 	return codeSpace + strconv.Itoa(c.Code)
 }
 
-func (c *CRS) parseString(s string) {
-	regex := regexp.MustCompile(`(^.*):([0-9]+)`)
-	code := regex.FindStringSubmatch(s)
-	if len(code) == 3 { // code[0] is the full match, the other the parts
-		f := strings.Index(code[1], EPSG)
-		if f > -1 {
-			c.Namespace = EPSG
-		} else {
-			c.Namespace = code[1]
-		}
+// Definition returns the registered definition of the CRS, containing the axis order and units
+func (c CRS) Definition() (crs.Definition, bool) {
+	return crs.Lookup(c.Namespace, c.Code)
+}
 
-		// the regex already checks if it [0-9]
-		i, _ := strconv.Atoi(code[2])
-		c.Code = i
+// NorthEast returns if the first axis of the CRS is the northing/latitude,
+// so that the coordinates of a BBOX need to be swapped to get a x/y order
+func (c CRS) NorthEast() bool {
+	d, ok := c.Definition()
+	return ok && d.NorthEast()
+}
+
+// Geographic returns if the coordinates of the CRS are expressed in degrees
+func (c CRS) Geographic() bool {
+	d, ok := c.Definition()
+	return ok && d.Geographic
+}
+
+// parseString normalises the different CRS notations, like EPSG:4326, CRS:84,
+// urn:ogc:def:crs:EPSG::4326 and http://www.opengis.net/def/crs/EPSG/0/4326
+func (c *CRS) parseString(s string) {
+	if authority, code, ok := crs.Parse(s); ok {
+		c.Namespace = authority
+		c.Code = code
 	}
 }
 
@@ -59,7 +72,7 @@ func (c *CRS) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 
 // UnmarshalXML Position
 func (c *CRS) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
-	var crs CRS
+	var result CRS
 	for {
 		token, err := d.Token()
 		if err != nil {
@@ -67,10 +80,10 @@ func (c *CRS) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
 		}
 		switch el := token.(type) {
 		case xml.CharData:
-			crs.parseString(string([]byte(el)))
+			result.parseString(string([]byte(el)))
 		case xml.EndElement:
 			if el == start.End() {
-				*c = crs
+				*c = result
 				return nil
 			}
 		}
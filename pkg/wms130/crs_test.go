@@ -36,3 +36,62 @@ othercrs:
 		}
 	}
 }
+
+func TestCRSParseString(t *testing.T) {
+	var tests = []struct {
+		input      string
+		expected   CRS
+		identifier string
+		northEast  bool
+	}{
+		0: {input: `EPSG:4326`, expected: CRS{Namespace: EPSG, Code: 4326}, identifier: `urn:ogc:def:crs:EPSG::4326`, northEast: true},
+		1: {input: `CRS:84`, expected: CRS{Namespace: `CRS`, Code: 84}, identifier: `urn:ogc:def:crs:OGC:1.3:CRS84`},
+		2: {input: `urn:ogc:def:crs:OGC:1.3:CRS84`, expected: CRS{Namespace: `CRS`, Code: 84}, identifier: `urn:ogc:def:crs:OGC:1.3:CRS84`},
+		3: {input: `http://www.opengis.net/def/crs/EPSG/0/28992`, expected: CRS{Namespace: EPSG, Code: 28992}, identifier: `urn:ogc:def:crs:EPSG::28992`},
+		4: {input: `urn:ogc:def:crs:EPSG::4258`, expected: CRS{Namespace: EPSG, Code: 4258}, identifier: `urn:ogc:def:crs:EPSG::4258`, northEast: true},
+	}
+
+	for k, test := range tests {
+		var c CRS
+		c.parseString(test.input)
+		if c != test.expected {
+			t.Errorf("test: %d, expected: %v,\n got: %v", k, test.expected, c)
+		}
+		if c.Identifier() != test.identifier {
+			t.Errorf("test: %d, expected: %s,\n got: %s", k, test.identifier, c.Identifier())
+		}
+		if c.NorthEast() != test.northEast {
+			t.Errorf("test: %d, expected north/east: %t,\n got: %t", k, test.northEast, c.NorthEast())
+		}
+	}
+}
+
+func TestBoundingBoxEastNorth(t *testing.T) {
+	var tests = []struct {
+		bbox     BoundingBox
+		crs      CRS
+		expected BoundingBox
+	}{
+		0: {bbox: BoundingBox{LowerCorner: Position{50.5, 3.2}, UpperCorner: Position{53.7, 7.3}}, crs: CRS{Namespace: EPSG, Code: 4326},
+			expected: BoundingBox{LowerCorner: Position{3.2, 50.5}, UpperCorner: Position{7.3, 53.7}}},
+		1: {bbox: BoundingBox{LowerCorner: Position{3.2, 50.5}, UpperCorner: Position{7.3, 53.7}}, crs: CRS{Namespace: `CRS`, Code: 84},
+			expected: BoundingBox{LowerCorner: Position{3.2, 50.5}, UpperCorner: Position{7.3, 53.7}}},
+		2: {bbox: BoundingBox{LowerCorner: Position{0, 300000}, UpperCorner: Position{280000, 625000}}, crs: CRS{Namespace: EPSG, Code: 28992},
+			expected: BoundingBox{LowerCorner: Position{0, 300000}, UpperCorner: Position{280000, 625000}}},
+	}
+
+	for k, test := range tests {
+		eastnorth := test.bbox.EastNorth(test.crs)
+		if eastnorth != test.expected {
+			t.Errorf("test: %d, expected: %v,\n got: %v", k, test.expected, eastnorth)
+		}
+		if back := eastnorth.EastNorth(test.crs); back != test.bbox {
+			t.Errorf("test: %d, expected: %v,\n got: %v", k, test.bbox, back)
+		}
+	}
+
+	lbb := LayerBoundingBox{CRS: `EPSG:4326`, Minx: 50.5, Miny: 3.2, Maxx: 53.7, Maxy: 7.3}.EastNorth()
+	if lbb.Minx != 3.2 || lbb.Miny != 50.5 || lbb.Maxx != 7.3 || lbb.Maxy != 53.7 {
+		t.Errorf("expected the LayerBoundingBox axes to be swapped, got: %v", lbb)
+	}
+}
@@ -0,0 +1,87 @@
+package wms130
+
+import (
+	"strings"
+
+	"github.com/pdok/ogc-specifications/pkg/temporal"
+)
+
+// TimeDimension is the name of the time dimension, its values are ISO 8601 instants and periods as defined in Annex C and D
+const TimeDimension = `time`
+
+// IsTime returns whether the dimension is the time dimension
+func (d Dimension) IsTime() bool {
+	return d.Name != nil && strings.EqualFold(*d.Name, TimeDimension)
+}
+
+// Extent returns the values of the time dimension
+func (d Dimension) Extent() (temporal.Extent, error) {
+	if d.Value == nil {
+		return temporal.ParseExtent(``)
+	}
+	return temporal.ParseExtent(*d.Value)
+}
+
+// TimeDimension returns the time dimension of the layer
+func (l Layer) TimeDimension() (Dimension, bool) {
+	for _, d := range l.Dimension {
+		if d != nil && d.IsTime() {
+			return *d, true
+		}
+	}
+	return Dimension{}, false
+}
+
+// Resolve returns the value of the dimension to use for the requested value: the default when the value is missing and,
+// when the dimension has nearestValue="1", the nearest value for an instant that isn't one of the values.
+// For the time dimension the value is normalised and it has to be within the extent, other dimensions aren't checked.
+func (d Dimension) Resolve(value *string) (string, Exceptions) {
+	name := TimeDimension
+	if d.Name != nil {
+		name = *d.Name
+	}
+	if value == nil || *value == `` {
+		if d.Default == nil {
+			return ``, Exceptions{MissingDimensionValue(name)}
+		}
+		return *d.Default, nil
+	}
+	if !d.IsTime() {
+		return *value, nil
+	}
+
+	requested, err := temporal.ParseExtent(*value)
+	if err != nil {
+		return ``, Exceptions{InvalidDimensionValue(*value, name)}
+	}
+	// multipleValues defaults to 0, but only a dimension that states it explicitly gets lists and intervals refused
+	single := len(requested) == 1 && requested[0].Start == requested[0].End
+	if d.MultipleValues != nil && *d.MultipleValues == `0` && !single {
+		return ``, Exceptions{InvalidDimensionValue(*value, name)}
+	}
+
+	extent, err := d.Extent()
+	if err != nil {
+		return ``, Exceptions{NoApplicableCode(`The extent of the dimension: ` + name + ` is invalid, ` + err.Error())}
+	}
+	if extent.Covers(requested) {
+		return requested.String(), nil
+	}
+	if d.NearestValue != nil && *d.NearestValue == `1` && single {
+		if nearest, ok := extent.Nearest(requested[0].Start); ok {
+			return nearest.String(), nil
+		}
+	}
+	return ``, Exceptions{InvalidDimensionValue(*value, name)}
+}
+
+// validateTime checks the TIME of the request against the time dimension of the layer,
+// a layer without a time dimension ignores the TIME
+func validateTime(time *string, l Layer) Exceptions {
+	d, ok := l.TimeDimension()
+	if !ok {
+		return nil
+	}
+	_, exceptions := d.Resolve(time)
+	return exceptions
+}
@@ -0,0 +1,62 @@
+package wms130
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDimensionResolve(t *testing.T) {
+	dimension := Dimension{Name: sp(`time`), Units: sp(`ISO8601`), Value: sp(`2019-06-01,2020-01-01/2020-12-01/P1M`)}
+	nearest := dimension
+	nearest.NearestValue, nearest.Default = sp(`1`), sp(`2020-12-01`)
+	single := dimension
+	single.MultipleValues = sp(`0`)
+	elevation := Dimension{Name: sp(`elevation`), Units: sp(`EPSG:5030`), Value: sp(`0,100,200`)}
+
+	var tests = []struct {
+		dimension  Dimension
+		value      *string
+		result     string
+		exceptions Exceptions
+	}{
+		0:  {dimension: dimension, value: sp(`2020-03-01`), result: `2020-03-01`},
+		1:  {dimension: dimension, value: sp(`2020-03-01T00:00:00+00:00`), result: `2020-03-01T00:00:00Z`},
+		2:  {dimension: dimension, value: sp(`2019-06-01,2020-02-01/2020-04-01`), result: `2019-06-01,2020-02-01/2020-04-01`},
+		3:  {dimension: dimension, value: sp(`2020-03-15`), exceptions: Exceptions{InvalidDimensionValue(`2020-03-15`, `time`)}},
+		4:  {dimension: dimension, value: sp(`yesterday`), exceptions: Exceptions{InvalidDimensionValue(`yesterday`, `time`)}},
+		5:  {dimension: dimension, exceptions: Exceptions{MissingDimensionValue(`time`)}},
+		6:  {dimension: nearest, result: `2020-12-01`},
+		7:  {dimension: nearest, value: sp(`2020-03-10`), result: `2020-03-01`},
+		8:  {dimension: nearest, value: sp(`2021-06-01`), result: `2020-12-01`},
+		9:  {dimension: nearest, value: sp(`2020-11-10/2021-03-20`), exceptions: Exceptions{InvalidDimensionValue(`2020-11-10/2021-03-20`, `time`)}},
+		10: {dimension: single, value: sp(`2020-03-01`), result: `2020-03-01`},
+		11: {dimension: single, value: sp(`2020-03-01,2020-04-01`), exceptions: Exceptions{InvalidDimensionValue(`2020-03-01,2020-04-01`, `time`)}},
+		12: {dimension: elevation, value: sp(`50`), result: `50`},
+		13: {dimension: Dimension{Name: sp(`time`), Value: sp(`P1D`)}, value: sp(`2020-03-01`),
+			exceptions: Exceptions{NoApplicableCode(`The extent of the dimension: time is invalid, invalid instant: P1D`)}},
+	}
+
+	for k, test := range tests {
+		result, exceptions := test.dimension.Resolve(test.value)
+		if result != test.result || !reflect.DeepEqual(exceptions, test.exceptions) {
+			t.Errorf("test: %d, expected: %s %v,\n got: %s %v", k, test.result, test.exceptions, result, exceptions)
+		}
+	}
+}
+
+func TestLayerTimeDimension(t *testing.T) {
+	var tests = []struct {
+		layer Layer
+		found bool
+	}{
+		0: {layer: Layer{Dimension: []*Dimension{{Name: sp(`elevation`)}, {Name: sp(`TIME`), Value: sp(`2020`)}}}, found: true},
+		1: {layer: Layer{Dimension: []*Dimension{{Name: sp(`elevation`)}}}},
+		2: {layer: Layer{}},
+	}
+
+	for k, test := range tests {
+		if d, found := test.layer.TimeDimension(); found != test.found || (found && *d.Value != `2020`) {
+			t.Errorf("test: %d, expected: %t,\n got: %t %+v", k, test.found, found, d)
+		}
+	}
+}
@@ -100,14 +100,28 @@ func InvalidUpdateSequence() Exception {
 }
 
 // MissingDimensionValue Exception
-func MissingDimensionValue() Exception {
+func MissingDimensionValue(s ...string) Exception {
+	if len(s) == 1 {
+		return Exception{ExceptionDetails: common.ExceptionDetails{
+			ExceptionText: fmt.Sprintf("The dimension: %s has no default, a value is required", s[0]),
+			ExceptionCode: `MissingDimensionValue`,
+			LocatorCode:   s[0],
+		}}
+	}
 	return Exception{ExceptionDetails: common.ExceptionDetails{
 		ExceptionCode: `MissingDimensionValue`,
 	}}
 }
 
 // InvalidDimensionValue Exception
-func InvalidDimensionValue() Exception {
+func InvalidDimensionValue(s ...string) Exception {
+	if len(s) == 2 {
+		return Exception{ExceptionDetails: common.ExceptionDetails{
+			ExceptionText: fmt.Sprintf("The value: %s is invalid for the dimension: %s", s[0], s[1]),
+			ExceptionCode: `InvalidDimensionValue`,
+			LocatorCode:   s[1],
+		}}
+	}
 	return Exception{ExceptionDetails: common.ExceptionDetails{
 		ExceptionCode: `InvalidDimensionValue`,
 	}}
@@ -0,0 +1,195 @@
+package wms130
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/pdok/ogc-specifications/pkg/common"
+)
+
+// Exception formats for the EXCEPTIONS parameter of a GetMap request
+const (
+	ExceptionsXML     = `XML`
+	ExceptionsINIMAGE = `INIMAGE`
+	ExceptionsBLANK   = `BLANK`
+	ExceptionsJSON    = `JSON`
+)
+
+const (
+	pngContentType = `image/png`
+	gifContentType = `image/gif`
+	jpgContentType = `image/jpeg`
+
+	// defaultImageSize is used when the requested image size is missing or invalid
+	defaultImageSize = 256
+	// maxImageSize limits the size of a rendered exception image
+	maxImageSize = 4096
+	// textMargin is the margin in pixels around the exception text
+	textMargin = 4
+	// lineHeight is the height in pixels of a line of exception text
+	lineHeight = glyphHeight + 3
+	// charWidth is the width in pixels of a character including the spacing
+	charWidth = glyphWidth + 1
+)
+
+// exceptionFormat normalizes the value of the EXCEPTIONS parameter,
+// the WMS 1.1.1 notations like application/vnd.ogc.se_inimage are also supported
+func exceptionFormat(exceptions *string) string {
+	if exceptions == nil {
+		return ExceptionsXML
+	}
+	e := strings.ToUpper(*exceptions)
+	e = strings.TrimPrefix(e, `APPLICATION/VND.OGC.SE_`)
+	switch e {
+	case ExceptionsINIMAGE, ExceptionsBLANK, ExceptionsJSON:
+		return e
+	case `APPLICATION/JSON`:
+		return ExceptionsJSON
+	default:
+		return ExceptionsXML
+	}
+}
+
+// ExceptionReport returns the Exceptions in the format requested with the EXCEPTIONS parameter of the GetMap request,
+// together with its content type. The XML ServiceExceptionReport is returned for XML or when the image can't be encoded.
+// JSON can be requested with EXCEPTIONS=JSON or EXCEPTIONS=application/json.
+func (m GetMapRequest) ExceptionReport(e Exceptions) ([]byte, string) {
+	switch exceptionFormat(m.Exceptions) {
+	case ExceptionsINIMAGE:
+		if b, contentType, err := e.ToImage(m.Output, true); err == nil {
+			return b, contentType
+		}
+	case ExceptionsBLANK:
+		if b, contentType, err := e.ToImage(m.Output, false); err == nil {
+			return b, contentType
+		}
+	case ExceptionsJSON:
+		return e.ToReport().ToJSON(), common.JSONContentType
+	}
+	return e.ToReport().ToBytes(), common.XMLContentType
+}
+
+// ToImage renders the Exceptions as an image with the size, format and background of the Output.
+// When withText is false a blank image is returned, otherwise the exception texts are drawn in the image.
+// PNG, GIF and JPEG are supported, other formats are rendered as PNG.
+func (e Exceptions) ToImage(output Output, withText bool) ([]byte, string, error) {
+	bounds := image.Rect(0, 0, imageSize(output.Size.Width), imageSize(output.Size.Height))
+	contentType := imageContentType(output.Format)
+
+	background := output.BackgroundColor()
+	if output.Transparent != nil && *output.Transparent && contentType != jpgContentType {
+		background = color.RGBA{}
+	}
+
+	img := image.NewRGBA(bounds)
+	draw.Draw(img, bounds, &image.Uniform{C: background}, image.Point{}, draw.Src)
+	if withText {
+		var lines []string
+		for _, exception := range e {
+			lines = append(lines, wrapText(exception.Error(), (bounds.Dx()-2*textMargin)/charWidth)...)
+		}
+		drawText(img, lines, color.RGBA{A: 0xFF})
+	}
+
+	var buf bytes.Buffer
+	var err error
+	switch contentType {
+	case jpgContentType:
+		err = jpeg.Encode(&buf, img, nil)
+	case gifContentType:
+		paletted := image.NewPaletted(bounds, color.Palette{background, color.RGBA{A: 0xFF}})
+		draw.Draw(paletted, bounds, img, image.Point{}, draw.Src)
+		err = gif.Encode(&buf, paletted, nil)
+	default:
+		err = png.Encode(&buf, img)
+	}
+	if err != nil {
+		return nil, ``, err
+	}
+	return buf.Bytes(), contentType, nil
+}
+
+// imageSize limits a requested width or height to a size that can be rendered
+func imageSize(size int) int {
+	if size <= 0 {
+		return defaultImageSize
+	}
+	if size > maxImageSize {
+		return maxImageSize
+	}
+	return size
+}
+
+// imageContentType returns the supported content type for a requested format like image/png; mode=8bit
+func imageContentType(format string) string {
+	mediatype := strings.TrimSpace(strings.ToLower(strings.SplitN(format, `;`, 2)[0]))
+	switch mediatype {
+	case jpgContentType, `image/jpg`:
+		return jpgContentType
+	case gifContentType:
+		return gifContentType
+	default:
+		return pngContentType
+	}
+}
+
+// wrapText splits a text on whitespace in lines of at most width characters (runes),
+// words longer than the width are split
+func wrapText(text string, width int) []string {
+	if width < 1 {
+		width = 1
+	}
+
+	var lines []string
+	var line string
+	for _, field := range strings.Fields(text) {
+		word := []rune(field)
+		for len(word) > width {
+			if line != `` {
+				lines = append(lines, line)
+				line = ``
+			}
+			lines = append(lines, string(word[:width]))
+			word = word[width:]
+		}
+		switch {
+		case line == ``:
+			line = string(word)
+		case utf8.RuneCountInString(line)+1+len(word) <= width:
+			line += ` ` + string(word)
+		default:
+			lines = append(lines, line)
+			line = string(word)
+		}
+	}
+	if line != `` {
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// drawText draws the lines of text from the top left corner of the image, text outside of the image is clipped
+func drawText(img *image.RGBA, lines []string, c color.RGBA) {
+	for l, line := range lines {
+		top := textMargin + l*lineHeight
+		left := textMargin
+		for _, r := range line {
+			g := glyph(r)
+			for x := 0; x < glyphWidth; x++ {
+				for y := 0; y < glyphHeight; y++ {
+					if g[x]&(1<<uint(y)) != 0 {
+						img.SetRGBA(left+x, top+y, c)
+					}
+				}
+			}
+			left += charWidth
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package wms130
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"strings"
+	"testing"
+)
+
+func TestExceptionsToImage(t *testing.T) {
+	exceptions := Exceptions{LayerNotDefined(`unknown`)}
+
+	var tests = []struct {
+		output      Output
+		withText    bool
+		contentType string
+		width       int
+		height      int
+		background  color.RGBA
+		text        bool
+	}{
+		0: {output: Output{Size: Size{Width: 256, Height: 128}, Format: `image/png`},
+			withText: true, contentType: `image/png`, width: 256, height: 128,
+			background: color.RGBA{R: 0xFF, G: 0xFF, B: 0xFF, A: 0xFF}, text: true},
+		1: {output: Output{Size: Size{Width: 256, Height: 128}, Format: `image/png; mode=8bit`, Transparent: bp(true)},
+			withText: false, contentType: `image/png`, width: 256, height: 128,
+			background: color.RGBA{}},
+		2: {output: Output{Size: Size{Width: 100, Height: 50}, Format: `image/gif`, BGcolor: sp(`0xFF0000`)},
+			withText: false, contentType: `image/gif`, width: 100, height: 50,
+			background: color.RGBA{R: 0xFF, A: 0xFF}},
+		3: {output: Output{Size: Size{Width: 100000, Height: -1}, Format: `image/jpeg`},
+			withText: true, contentType: `image/jpeg`, width: 4096, height: 256, text: true},
+		4: {output: Output{Size: Size{Width: 256, Height: 256}, Format: `application/unknown`},
+			withText: true, contentType: `image/png`, width: 256, height: 256,
+			background: color.RGBA{R: 0xFF, G: 0xFF, B: 0xFF, A: 0xFF}, text: true},
+	}
+
+	for k, test := range tests {
+		b, contentType, err := exceptions.ToImage(test.output, test.withText)
+		if err != nil {
+			t.Errorf("test: %d, expected no error,\n got: %v", k, err)
+			continue
+		}
+		if contentType != test.contentType {
+			t.Errorf("test: %d, expected: %s,\n got: %s", k, test.contentType, contentType)
+		}
+
+		img, format, err := image.Decode(bytes.NewReader(b))
+		if err != nil {
+			t.Errorf("test: %d, expected a valid image,\n got: %v", k, err)
+			continue
+		}
+		if `image/`+format != test.contentType {
+			t.Errorf("test: %d, expected: %s,\n got: image/%s", k, test.contentType, format)
+		}
+		if img.Bounds().Dx() != test.width || img.Bounds().Dy() != test.height {
+			t.Errorf("test: %d, expected: %dx%d,\n got: %dx%d", k, test.width, test.height, img.Bounds().Dx(), img.Bounds().Dy())
+		}
+		if test.contentType != `image/jpeg` {
+			if c := color.RGBAModel.Convert(img.At(img.Bounds().Dx()-1, img.Bounds().Dy()-1)); c != test.background {
+				t.Errorf("test: %d, expected background: %v,\n got: %v", k, test.background, c)
+			}
+		}
+		if hasText(img) != test.text {
+			t.Errorf("test: %d, expected text: %t,\n got: %t", k, test.text, !test.text)
+		}
+	}
+}
+
+// hasText checks for dark pixels in the first line of text
+func hasText(img image.Image) bool {
+	for x := textMargin; x < textMargin+10*charWidth; x++ {
+		for y := textMargin; y < textMargin+glyphHeight; y++ {
+			r, g, b, a := img.At(x, y).RGBA()
+			if a > 0x8000 && r < 0x8000 && g < 0x8000 && b < 0x8000 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func TestGetMapExceptionReport(t *testing.T) {
+	exceptions := Exceptions{LayerNotDefined(`unknown`)}
+
+	var tests = []struct {
+		exceptions  *string
+		contentType string
+	}{
+		0: {exceptions: nil, contentType: `text/xml`},
+		1: {exceptions: sp(`XML`), contentType: `text/xml`},
+		2: {exceptions: sp(`INIMAGE`), contentType: `image/png`},
+		3: {exceptions: sp(`BLANK`), contentType: `image/png`},
+		4: {exceptions: sp(`application/vnd.ogc.se_inimage`), contentType: `image/png`},
+		5: {exceptions: sp(`unknown`), contentType: `text/xml`},
+		6: {exceptions: sp(`JSON`), contentType: `application/json`},
+		7: {exceptions: sp(`application/json`), contentType: `application/json`},
+	}
+
+	for k, test := range tests {
+		m := GetMapRequest{Output: Output{Size: Size{Width: 64, Height: 64}, Format: `image/png`}, Exceptions: test.exceptions}
+		b, contentType := m.ExceptionReport(exceptions)
+		if contentType != test.contentType {
+			t.Errorf("test: %d, expected: %s,\n got: %s", k, test.contentType, contentType)
+		}
+		if contentType == `text/xml` && !strings.Contains(string(b), `ServiceExceptionReport`) {
+			t.Errorf("test: %d, expected a ServiceExceptionReport,\n got: %s", k, b)
+		}
+	}
+}
+
+func TestWrapText(t *testing.T) {
+	var tests = []struct {
+		text  string
+		width int
+		lines []string
+	}{
+		0: {text: `Layer not defined`, width: 20, lines: []string{`Layer not defined`}},
+		1: {text: `Layer not defined`, width: 10, lines: []string{`Layer not`, `defined`}},
+		2: {text: `abcdefghij klm`, width: 4, lines: []string{`abcd`, `efgh`, `ij`, `klm`}},
+		3: {text: ``, width: 4, lines: nil},
+		4: {text: `Laag één niet gedefinieerd`, width: 4, lines: []string{`Laag`, `één`, `niet`, `gede`, `fini`, `eerd`}},
+		5: {text: `éé é`, width: 4, lines: []string{`éé é`}},
+	}
+
+	for k, test := range tests {
+		if lines := wrapText(test.text, test.width); !equalStrings(lines, test.lines) {
+			t.Errorf("test: %d, expected: %v,\n got: %v", k, test.lines, lines)
+		}
+	}
+}
@@ -0,0 +1,26 @@
+package wms130
+
+import (
+	"github.com/pdok/ogc-specifications/pkg/common"
+)
+
+// ToJSON makes from a ServiceExceptionReport a JSON []byte
+func (r ServiceExceptionReport) ToJSON() []byte {
+	return common.ExceptionReportJSON{Version: r.Version, Exceptions: common.Details(r.ServiceException)}.ToBytes()
+}
+
+// ToProblemJSON makes from a ServiceExceptionReport a RFC 7807 problem+json []byte,
+// the status is the HTTP status code for the exceptions
+func (r ServiceExceptionReport) ToProblemJSON() []byte {
+	p := common.NewProblem(common.Details(r.ServiceException))
+	p.Status = r.ServiceException.StatusCode()
+	return p.ToBytes()
+}
+
+// Encode returns the Exceptions as a XML, JSON or problem+json report depending on the format,
+// together with its content type. The format is a value as accepted by common.ReportContentType.
+func (e Exceptions) Encode(format string) ([]byte, string) {
+	r := e.ToReport()
+	return common.EncodeExceptions(common.ExceptionReportJSON{Version: r.Version, Exceptions: common.Details(e)},
+		format, e.StatusCode(), r.ToBytes)
+}
@@ -0,0 +1,49 @@
+package wms130
+
+import (
+	"testing"
+)
+
+func TestExceptionsEncode(t *testing.T) {
+	exceptions := Exceptions{LayerNotDefined(`unknown`)}
+
+	var tests = []struct {
+		format      string
+		contentType string
+		body        string
+	}{
+		0: {format: `JSON`, contentType: `application/json`,
+			body: `{
+ "version": "1.3.0",
+ "exceptions": [
+  {
+   "text": "The layer: unknown is not known by the server",
+   "code": "LayerNotDefined"
+  }
+ ]
+}`},
+		1: {format: `application/problem+json`, contentType: `application/problem+json`,
+			body: `{
+ "title": "LayerNotDefined",
+ "status": 400,
+ "detail": "The layer: unknown is not known by the server",
+ "exceptions": [
+  {
+   "text": "The layer: unknown is not known by the server",
+   "code": "LayerNotDefined"
+  }
+ ]
+}`},
+		2: {format: `XML`, contentType: `text/xml`, body: string(exceptions.ToReport().ToBytes())},
+	}
+
+	for k, test := range tests {
+		body, contentType := exceptions.Encode(test.format)
+		if contentType != test.contentType {
+			t.Errorf("test: %d, expected: %s,\n got: %s", k, test.contentType, contentType)
+		}
+		if string(body) != test.body {
+			t.Errorf("test: %d, expected: %s,\n got: %s", k, test.body, body)
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package wms130
+
+import (
+	"net/http"
+
+	"github.com/pdok/ogc-specifications/pkg/common"
+)
+
+// statusCodes contains the HTTP status codes for the WMS 1.3.0 specific exception codes.
+// WMS 1.3.0 doesn't define status codes, so these follow the OWS Common 1.1 mapping:
+// client errors result in 400, unsupported operations in 501 and other errors in 500.
+// The codes WMS 1.3.0 shares with OWS Common are looked up in common.
+var statusCodes = map[string]int{
+	`InvalidFormat`:         http.StatusBadRequest,
+	`InvalidCRS`:            http.StatusBadRequest,
+	`LayerNotDefined`:       http.StatusBadRequest,
+	`StyleNotDefined`:       http.StatusBadRequest,
+	`LayerNotQueryable`:     http.StatusBadRequest,
+	`InvalidPoint`:          http.StatusBadRequest,
+	`MissingDimensionValue`: http.StatusBadRequest,
+	`InvalidDimensionValue`: http.StatusBadRequest,
+}
+
+// StatusCode returns the HTTP status code for the Exception, unknown exception codes are considered to be server errors
+func (e Exception) StatusCode() int {
+	return common.StatusCode(statusCodes, e)
+}
+
+// StatusCode returns the HTTP status code for a report with these Exceptions
+func (e Exceptions) StatusCode() int {
+	return common.StatusCode(statusCodes, e...)
+}
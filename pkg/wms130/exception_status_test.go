@@ -0,0 +1,26 @@
+package wms130
+
+import (
+	"testing"
+)
+
+func TestExceptionsStatusCode(t *testing.T) {
+	var tests = []struct {
+		exceptions Exceptions
+		status     int
+	}{
+		0: {exceptions: nil, status: 200},
+		1: {exceptions: Exceptions{LayerNotDefined(`unknown`)}, status: 400},
+		2: {exceptions: Exceptions{OperationNotSupported(`GetCoconut`)}, status: 501},
+		3: {exceptions: Exceptions{LayerNotDefined(`unknown`), InvalidFormat(`image/coconut`)}, status: 400},
+		4: {exceptions: Exceptions{LayerNotDefined(`unknown`), NoApplicableCode(`Oops`)}, status: 500},
+		5: {exceptions: Exceptions{CurrentUpdateSequence()}, status: 304},
+		6: {exceptions: Exceptions{Exception{}}, status: 500},
+	}
+
+	for k, test := range tests {
+		if status := test.exceptions.StatusCode(); status != test.status {
+			t.Errorf("test: %d, expected: %d,\n got: %d", k, test.status, status)
+		}
+	}
+}
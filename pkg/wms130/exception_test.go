@@ -60,6 +60,16 @@ func TestWFSException(t *testing.T) {
 			exceptionCode: "LayerNotDefined",
 			exceptionText: `The layer: unknown:layer is not known by the server`,
 		},
+		13: {exception: MissingDimensionValue(`time`),
+			exceptionCode: "MissingDimensionValue",
+			exceptionText: `The dimension: time has no default, a value is required`,
+			locatorCode:   `time`,
+		},
+		14: {exception: InvalidDimensionValue(`2010`, `time`),
+			exceptionCode: "InvalidDimensionValue",
+			exceptionText: `The value: 2010 is invalid for the dimension: time`,
+			locatorCode:   `time`,
+		},
 	}
 
 	for k, test := range tests {
@@ -3,7 +3,6 @@ package wms130
 import (
 	"encoding/xml"
 	"net/url"
-	"regexp"
 	"strings"
 
 	"github.com/pdok/ogc-specifications/pkg/utils"
@@ -83,7 +82,7 @@ func (g GetCapabilitiesRequest) ToQueryParameters() url.Values {
 
 // ToXML builds a 'new' XML document 'based' on the 'original' XML document
 func (g GetCapabilitiesRequest) ToXML() []byte {
-	si, _ := xml.MarshalIndent(&g, "", "")
-	re := regexp.MustCompile(`><.*>`)
-	return []byte(xml.Header + re.ReplaceAllString(string(si), "/>"))
+	e := utils.NewEncoder(nil)
+	doc, _ := e.Marshal(&g)
+	return doc
 }
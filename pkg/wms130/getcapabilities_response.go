@@ -2,7 +2,8 @@ package wms130
 
 import (
 	"encoding/xml"
-	"regexp"
+
+	"github.com/pdok/ogc-specifications/pkg/utils"
 )
 
 // Contains the WMS130 struct
@@ -27,11 +28,34 @@ func (gc *GetCapabilitiesResponse) Validate() Exceptions {
 	return nil
 }
 
+// capabilitiesPrefixes contains the prefixes of the namespaces used in the struct tags of the WMS 1.3.0 capabilities
+var capabilitiesPrefixes = utils.Prefixes{
+	`http://www.opengis.net/wms`:                         ``,
+	`http://www.opengis.net/sld`:                         `sld`,
+	`http://inspire.ec.europa.eu/schemas/common/1.0`:     `inspire_common`,
+	`http://inspire.ec.europa.eu/schemas/inspire_vs/1.0`: `inspire_vs`,
+}
+
+// ParseXML builds a GetCapabilitiesResponse from a WMS 1.3.0 capabilities document,
+// regardless of the namespace prefixes used in the document.
+// The OptionalConstraints of the Service are also used for the validation of requests.
+func (gc *GetCapabilitiesResponse) ParseXML(doc []byte) error {
+	if err := utils.UnmarshalPrefixed(doc, gc, capabilitiesPrefixes); err != nil {
+		return err
+	}
+	if gc.Capabilities.OptionalConstraints == nil {
+		gc.Capabilities.OptionalConstraints = gc.WMSService.OptionalConstraints
+	}
+	return nil
+}
+
 // ToXML builds a GetCapabilities response object
 func (gc GetCapabilitiesResponse) ToXML() []byte {
-	si, _ := xml.MarshalIndent(gc, "", "")
-	re := regexp.MustCompile(`><.*>`)
-	return []byte(xml.Header + re.ReplaceAllString(string(si), "/>"))
+	// the OptionalConstraints are part of the Service element, not of the Capability
+	gc.Capabilities.OptionalConstraints = nil
+	e := utils.NewEncoder(capabilitiesPrefixes)
+	doc, _ := e.Marshal(gc)
+	return doc
 }
 
 // GetCapabilitiesResponse base struct
@@ -0,0 +1,109 @@
+package wms130
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestGetCapabilitiesResponseParseXML(t *testing.T) {
+	var tests = []struct {
+		doc string
+	}{
+		0: {doc: `<?xml version="1.0" encoding="UTF-8"?>
+<WMS_Capabilities xmlns="http://www.opengis.net/wms" xmlns:sld="http://www.opengis.net/sld" xmlns:xlink="http://www.w3.org/1999/xlink" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" version="1.3.0" xsi:schemaLocation="http://www.opengis.net/wms http://schemas.opengis.net/wms/1.3.0/capabilities_1_3_0.xsd">
+ <Service>
+  <Name>WMS</Name>
+  <Title>Rivers</Title>
+  <OnlineResource xlink:type="simple" xlink:href="https://example.com/wms"/>
+  <MaxWidth>4000</MaxWidth>
+  <MaxHeight>4000</MaxHeight>
+ </Service>
+ <Capability>
+  <Request>
+   <GetCapabilities><Format>text/xml</Format></GetCapabilities>
+   <GetMap><Format>image/png</Format><Format>image/jpeg</Format></GetMap>
+  </Request>
+  <Exception><Format>XML</Format></Exception>
+  <Layer>
+   <Title>Root</Title>
+   <CRS>EPSG:28992</CRS>
+   <Layer queryable="1">
+    <Name>rivers</Name>
+    <Title>Rivers</Title>
+    <Style><Name>default</Name><Title>Default</Title></Style>
+   </Layer>
+  </Layer>
+ </Capability>
+</WMS_Capabilities>`},
+		1: {doc: `<?xml version="1.0" encoding="UTF-8"?>
+<wms:WMS_Capabilities xmlns:wms="http://www.opengis.net/wms" xmlns:s="http://www.opengis.net/sld" xmlns:xl="http://www.w3.org/1999/xlink" xmlns:xs="http://www.w3.org/2001/XMLSchema-instance" version="1.3.0" xs:schemaLocation="http://www.opengis.net/wms http://schemas.opengis.net/wms/1.3.0/capabilities_1_3_0.xsd">
+ <wms:Service>
+  <wms:Name>WMS</wms:Name>
+  <wms:Title>Rivers</wms:Title>
+  <wms:OnlineResource xl:type="simple" xl:href="https://example.com/wms"/>
+  <wms:MaxWidth>4000</wms:MaxWidth>
+  <wms:MaxHeight>4000</wms:MaxHeight>
+ </wms:Service>
+ <wms:Capability>
+  <wms:Request>
+   <wms:GetCapabilities><wms:Format>text/xml</wms:Format></wms:GetCapabilities>
+   <wms:GetMap><wms:Format>image/png</wms:Format><wms:Format>image/jpeg</wms:Format></wms:GetMap>
+  </wms:Request>
+  <wms:Exception><wms:Format>XML</wms:Format></wms:Exception>
+  <wms:Layer>
+   <wms:Title>Root</wms:Title>
+   <wms:CRS>EPSG:28992</wms:CRS>
+   <wms:Layer queryable="1">
+    <wms:Name>rivers</wms:Name>
+    <wms:Title>Rivers</wms:Title>
+    <wms:Style><wms:Name>default</wms:Name><wms:Title>Default</wms:Title></wms:Style>
+   </wms:Layer>
+  </wms:Layer>
+ </wms:Capability>
+</wms:WMS_Capabilities>`},
+	}
+
+	var expected GetCapabilitiesResponse
+	for k, test := range tests {
+		var gc GetCapabilitiesResponse
+		if err := gc.ParseXML([]byte(test.doc)); err != nil {
+			t.Errorf("test: %d, expected no error,\n got: %v", k, err)
+			continue
+		}
+
+		if gc.Namespaces == nil || gc.Namespaces.XmlnsWMS != `http://www.opengis.net/wms` || gc.Namespaces.XmlnsSLD != `http://www.opengis.net/sld` {
+			t.Errorf("test: %d, expected the wms and sld namespaces,\n got: %+v", k, gc.Namespaces)
+		}
+		if gc.WMSService.OnlineResource.Href == nil || *gc.WMSService.OnlineResource.Href != `https://example.com/wms` {
+			t.Errorf("test: %d, expected: https://example.com/wms,\n got: %v", k, gc.WMSService.OnlineResource.Href)
+		}
+		if gc.Capabilities.OptionalConstraints == nil || gc.Capabilities.MaxWidth != 4000 {
+			t.Errorf("test: %d, expected a MaxWidth of 4000,\n got: %+v", k, gc.Capabilities.OptionalConstraints)
+		}
+		if names := gc.Capabilities.GetLayerNames(); !equalStrings(names, []string{`rivers`}) {
+			t.Errorf("test: %d, expected: [rivers],\n got: %v", k, names)
+		}
+		if !gc.Capabilities.StyleDefined(`rivers`, `default`) {
+			t.Errorf("test: %d, expected the default style of rivers", k)
+		}
+
+		if k == 0 {
+			expected = gc
+		} else if !reflect.DeepEqual(gc, expected) {
+			t.Errorf("test: %d, expected: %+v,\n got: %+v", k, expected, gc)
+		}
+
+		// the OptionalConstraints copied from the Service aren't written in the Capability
+		if doc := string(gc.ToXML()); strings.Contains(doc, `OptionalConstraints`) {
+			t.Errorf("test: %d, expected no OptionalConstraints,\n got: %s", k, doc)
+		}
+
+		// marshalled back it is parsed to the same capabilities
+		b := gc.ToXML()
+		var roundtrip GetCapabilitiesResponse
+		if err := roundtrip.ParseXML(b); err != nil || !reflect.DeepEqual(roundtrip, gc) {
+			t.Errorf("test: %d, expected: %+v,\n got: %+v %v", k, gc, roundtrip, err)
+		}
+	}
+}
@@ -3,7 +3,6 @@ package wms130
 import (
 	"encoding/xml"
 	"net/url"
-	"regexp"
 	"strconv"
 	"strings"
 
@@ -52,7 +51,94 @@ func (gfi *GetFeatureInfoRequest) Validate(c Capabilities) Exceptions {
 	var exceptions Exceptions
 
 	exceptions = append(exceptions, gfi.StyledLayerDescriptor.Validate(c)...)
-	// exceptions = append(exceptions, gfi.Output.Validate(wmsCapabilities)...)
+	exceptions = append(exceptions, gfi.BoundingBox.Validate()...)
+	exceptions = append(exceptions, gfi.validateQueryLayers(c)...)
+	exceptions = append(exceptions, gfi.validatePoint()...)
+	exceptions = append(exceptions, gfi.validateInfoFormat(c)...)
+	exceptions = append(exceptions, gfi.validateCRS(c)...)
+
+	if gfi.FeatureCount != nil && *gfi.FeatureCount < 1 {
+		exceptions = append(exceptions, InvalidParameterValue(strconv.Itoa(*gfi.FeatureCount), FEATURECOUNT))
+	}
+
+	if gfi.Exceptions != nil && !formatDefined(*gfi.Exceptions, c.WMSCapabilities.Exception.Format) {
+		exceptions = append(exceptions, InvalidParameterValue(*gfi.Exceptions, EXCEPTIONS))
+	}
+
+	if len(exceptions) > 0 {
+		return exceptions
+	}
+	return nil
+}
+
+// validateQueryLayers checks that every QUERY_LAYERS value is one of the requested LAYERS
+// and that the layer is advertised as queryable
+func (gfi *GetFeatureInfoRequest) validateQueryLayers(c Capabilities) Exceptions {
+	var exceptions Exceptions
+
+	layers := gfi.StyledLayerDescriptor.getNamedLayers()
+	for _, ql := range gfi.QueryLayers {
+		found := false
+		for _, l := range layers {
+			if l == ql {
+				found = true
+				break
+			}
+		}
+		if !found {
+			exceptions = append(exceptions, LayerNotDefined(ql))
+			continue
+		}
+
+		layer, layerexceptions := c.GetEffectiveLayer(ql)
+		if layerexceptions != nil {
+			// Unknown layers are already reported by the StyledLayerDescriptor validation
+			continue
+		}
+		if layer.Queryable == nil || *layer.Queryable != 1 {
+			exceptions = append(exceptions, LayerNotQueryable(ql))
+		}
+	}
+
+	return exceptions
+}
+
+// validatePoint checks that the I and J values are within the WIDTH and HEIGHT of the map
+func (gfi *GetFeatureInfoRequest) validatePoint() Exceptions {
+	if gfi.I < 0 || gfi.I >= gfi.Size.Width || gfi.J < 0 || gfi.J >= gfi.Size.Height {
+		return InvalidPoint(strconv.Itoa(gfi.I), strconv.Itoa(gfi.J)).ToExceptions()
+	}
+	return nil
+}
+
+// validateInfoFormat checks that the INFO_FORMAT is advertised for the GetFeatureInfo operation
+func (gfi *GetFeatureInfoRequest) validateInfoFormat(c Capabilities) Exceptions {
+	var formats []string
+	if c.WMSCapabilities.Request.GetFeatureInfo != nil {
+		formats = c.WMSCapabilities.Request.GetFeatureInfo.Format
+	}
+	if !formatDefined(gfi.InfoFormat, formats) {
+		return InvalidFormat(gfi.InfoFormat).ToExceptions()
+	}
+	return nil
+}
+
+// validateCRS checks that the CRS is supported by every requested layer
+func (gfi *GetFeatureInfoRequest) validateCRS(c Capabilities) Exceptions {
+	var exceptions Exceptions
+
+	var crs CRS
+	crs.parseString(gfi.CRS)
+
+	for _, name := range gfi.StyledLayerDescriptor.getNamedLayers() {
+		layer, layerexceptions := c.GetEffectiveLayer(name)
+		if layerexceptions != nil {
+			continue
+		}
+		if checkCRS(crs, layer.CRS) != nil {
+			exceptions = append(exceptions, InvalidCRS(gfi.CRS, name))
+		}
+	}
 
 	return exceptions
 }
@@ -181,9 +267,10 @@ func (gfi *GetFeatureInfoRequest) ToQueryParameters() url.Values {
 // good/real OGC example request. So for now we use the GetMap, that is a large part
 // of this request, as a base with the additional GetFeatureInfo parameters.
 func (gfi *GetFeatureInfoRequest) ToXML() []byte {
-	si, _ := xml.MarshalIndent(gfi, "", " ")
-	re := regexp.MustCompile(`><.*>`)
-	return []byte(xml.Header + re.ReplaceAllString(string(si), "/>"))
+	e := utils.NewEncoder(nil)
+	e.Indent(` `)
+	doc, _ := e.Marshal(gfi)
+	return doc
 }
 
 func (gfi *GetFeatureInfoRequest) parseIJ(i string, j string) Exceptions {
@@ -339,6 +339,85 @@ func TestGetFeatureInfoParseXML(t *testing.T) {
 	}
 }
 
+func TestGetFeatureInfoValidate(t *testing.T) {
+	capabilities := Capabilities{
+		WMSCapabilities: WMSCapabilities{
+			Request: Request{
+				GetFeatureInfo: &RequestType{
+					Format: []string{`application/json`, `text/plain`},
+				},
+			},
+			Exception: ExceptionType{Format: []string{`XML`}},
+			Layer: []Layer{
+				{
+					Name:      sp(`Rivers`),
+					Title:     `Rivers`,
+					CRS:       []CRS{{Code: 4326, Namespace: `EPSG`}},
+					Queryable: ip(1),
+				},
+				{
+					Name:  sp(`Roads`),
+					Title: `Roads`,
+					CRS:   []CRS{{Code: 4326, Namespace: `EPSG`}},
+				},
+			},
+		},
+	}
+
+	valid := func() GetFeatureInfoRequest {
+		return GetFeatureInfoRequest{
+			BaseRequest: BaseRequest{Version: Version},
+			StyledLayerDescriptor: StyledLayerDescriptor{
+				NamedLayer: []NamedLayer{{Name: `Rivers`}, {Name: `Roads`}},
+			},
+			CRS:         `EPSG:4326`,
+			BoundingBox: BoundingBox{LowerCorner: [2]float64{-180.0, -90.0}, UpperCorner: [2]float64{180.0, 90.0}},
+			Size:        Size{Width: 1024, Height: 512},
+			QueryLayers: []string{`Rivers`},
+			I:           101,
+			J:           101,
+			InfoFormat:  `application/json`,
+		}
+	}
+
+	var tests = []struct {
+		gfi        func() GetFeatureInfoRequest
+		exceptions Exceptions
+	}{
+		0: {gfi: valid},
+		1: {gfi: func() GetFeatureInfoRequest { r := valid(); r.QueryLayers = []string{`Roads`}; return r },
+			exceptions: Exceptions{LayerNotQueryable(`Roads`)}},
+		2: {gfi: func() GetFeatureInfoRequest { r := valid(); r.QueryLayers = []string{`Houses`}; return r },
+			exceptions: Exceptions{LayerNotDefined(`Houses`)}},
+		3: {gfi: func() GetFeatureInfoRequest { r := valid(); r.I = 1024; return r },
+			exceptions: Exceptions{InvalidPoint(`1024`, `101`)}},
+		4: {gfi: func() GetFeatureInfoRequest { r := valid(); r.J = -1; return r },
+			exceptions: Exceptions{InvalidPoint(`101`, `-1`)}},
+		5: {gfi: func() GetFeatureInfoRequest { r := valid(); r.InfoFormat = `text/html`; return r },
+			exceptions: Exceptions{InvalidFormat(`text/html`)}},
+		6: {gfi: func() GetFeatureInfoRequest { r := valid(); r.CRS = `EPSG:28992`; return r },
+			exceptions: Exceptions{InvalidCRS(`EPSG:28992`, `Rivers`), InvalidCRS(`EPSG:28992`, `Roads`)}},
+		7: {gfi: func() GetFeatureInfoRequest { r := valid(); r.FeatureCount = ip(0); return r },
+			exceptions: Exceptions{InvalidParameterValue(`0`, FEATURECOUNT)}},
+		8: {gfi: func() GetFeatureInfoRequest { r := valid(); r.Exceptions = sp(`INIMAGE`); return r },
+			exceptions: Exceptions{InvalidParameterValue(`INIMAGE`, EXCEPTIONS)}},
+	}
+
+	for k, test := range tests {
+		gfi := test.gfi()
+		exceptions := gfi.Validate(capabilities)
+		if len(exceptions) != len(test.exceptions) {
+			t.Errorf("test: %d, expected: %v,\n got: %v", k, test.exceptions, exceptions)
+			continue
+		}
+		for i := range exceptions {
+			if exceptions[i] != test.exceptions[i] {
+				t.Errorf("test: %d, expected: %v,\n got: %v", k, test.exceptions[i], exceptions[i])
+			}
+		}
+	}
+}
+
 //nolint:cyclop
 func compareGetFeatureInfoObject(result, expected GetFeatureInfoRequest, t *testing.T, k int) {
 	if result.BaseRequest.Version != expected.BaseRequest.Version {
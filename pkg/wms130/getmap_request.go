@@ -3,7 +3,9 @@ package wms130
 import (
 	"encoding/xml"
 	"fmt"
+	"image/color"
 	"net/url"
+	"strconv"
 	"strings"
 
 	"github.com/pdok/ogc-specifications/pkg/utils"
@@ -24,9 +26,9 @@ const (
 	TRANSPARENT = `TRANSPARENT`
 	BGCOLOR     = `BGCOLOR`
 	EXCEPTIONS  = `EXCEPTIONS` // defaults to XML
+	TIME        = `TIME`
 
-	// TODO: something with Time & Elevation
-	// TIME        = `TIME`
+	// TODO: something with Elevation
 	// ELEVATION   = `ELEVATION`
 )
 
@@ -40,9 +42,10 @@ type GetMapRequest struct {
 	BoundingBox           BoundingBox           `xml:"BoundingBox" yaml:"boundingBox"`
 	Output                Output                `xml:"Output" yaml:"output"`
 	Exceptions            *string               `xml:"Exceptions" yaml:"exceptions"`
-	// TODO: something with Time & Elevation
+	// Time is the value of the time dimension, an instant, list or interval as advertised by the layer
+	Time *string `xml:"Time" yaml:"time,omitempty"`
+	// TODO: something with Elevation
 	// Elevation             *[]Elevation          `xml:"Elevation" yaml:"elevation"`
-	// Time                  *string               `xml:"Time" yaml:"time"`
 }
 
 // Validate validates a GetMapRequest
@@ -50,16 +53,24 @@ func (m GetMapRequest) Validate(c Capabilities) Exceptions {
 	var exceptions Exceptions
 
 	exceptions = append(exceptions, m.StyledLayerDescriptor.Validate(c)...)
+	exceptions = append(exceptions, m.BoundingBox.Validate()...)
 	exceptions = append(exceptions, m.Output.Validate(c)...)
+	exceptions = append(exceptions, m.validateLayerLimit(c)...)
+
+	if m.Exceptions != nil && !formatDefined(*m.Exceptions, c.WMSCapabilities.Exception.Format) {
+		exceptions = append(exceptions, InvalidParameterValue(*m.Exceptions, EXCEPTIONS))
+	}
 
 	for _, sld := range m.StyledLayerDescriptor.NamedLayer {
-		layer, layerexception := c.GetLayer(sld.Name)
+		layer, layerexception := c.GetEffectiveLayer(sld.Name)
 		if layerexception != nil {
 			exceptions = append(exceptions, layerexception...)
+			continue
 		}
 		if CRSException := checkCRS(m.CRS, layer.CRS); CRSException != nil {
 			exceptions = append(exceptions, InvalidCRS(m.CRS.String(), sld.Name))
 		}
+		exceptions = append(exceptions, validateTime(m.Time, layer)...)
 	}
 
 	return exceptions
@@ -107,6 +118,7 @@ func (m *GetMapRequest) parsegetMapRequestParameterValue(mpv getMapRequestParame
 	m.Output = output
 
 	m.Exceptions = mpv.exceptions
+	m.Time = mpv.time
 
 	return nil
 }
@@ -157,31 +169,61 @@ type Output struct {
 
 // Validate validates the output parameters
 func (output *Output) Validate(c Capabilities) Exceptions {
-	exceptions := Exceptions{}
-	if c.MaxWidth > 0 && output.Size.Width > c.MaxWidth {
-		exceptions = append(exceptions, NoApplicableCode(fmt.Sprintf("Image size out of range, WIDTH must be between 1 and %d pixels", c.MaxWidth)))
+	var exceptions Exceptions
+	if c.OptionalConstraints != nil {
+		if c.MaxWidth > 0 && output.Size.Width > c.MaxWidth {
+			exceptions = append(exceptions, NoApplicableCode(fmt.Sprintf("Image size out of range, WIDTH must be between 1 and %d pixels", c.MaxWidth)))
+		}
+		if c.MaxHeight > 0 && output.Size.Height > c.MaxHeight {
+			exceptions = append(exceptions, NoApplicableCode(fmt.Sprintf("Image size out of range, HEIGHT must be between 1 and %d pixels", c.MaxHeight)))
+		}
 	}
-	if c.MaxHeight > 0 && output.Size.Height > c.MaxHeight {
-		exceptions = append(exceptions, NoApplicableCode(fmt.Sprintf("Image size out of range, HEIGHT must be between 1 and %d pixels", c.MaxHeight)))
+
+	if !formatDefined(output.Format, c.WMSCapabilities.Request.GetMap.Format) {
+		exceptions = append(exceptions, InvalidFormat(output.Format))
 	}
 
-	for _, format := range c.WMSCapabilities.Request.GetMap.Format {
-		found := false
-		if format == output.Format {
-			found = true
-		}
-		if !found {
-			exceptions = append(exceptions, InvalidFormat(output.Format))
+	// Transparent is a bool so when it is parsed around in the application it is already valid
+	if output.BGcolor != nil {
+		if _, ok := parseBGColor(*output.BGcolor); !ok {
+			exceptions = append(exceptions, InvalidParameterValue(*output.BGcolor, BGCOLOR))
 		}
 	}
 
-	if exceptions != nil {
-		return exceptions
+	return exceptions
+}
+
+// BackgroundColor returns the BGCOLOR as a color,
+// defaults to white when no or an invalid BGCOLOR is given
+func (output Output) BackgroundColor() color.RGBA {
+	if output.BGcolor != nil {
+		if c, ok := parseBGColor(*output.BGcolor); ok {
+			return c
+		}
 	}
+	return color.RGBA{R: 0xFF, G: 0xFF, B: 0xFF, A: 0xFF}
+}
 
-	// Transparent is a bool so when it is parsed around in the application it is already valid
-	// TODO: BGColor -> https://stackoverflow.com/questions/54197913/parse-hex-string-to-image-color
+// parseBGColor parses a hexadecimal color in the 0xRRGGBB notation
+func parseBGColor(s string) (color.RGBA, bool) {
+	if len(s) != 8 || !strings.HasPrefix(strings.ToLower(s), `0x`) {
+		return color.RGBA{}, false
+	}
+	rgb, err := strconv.ParseUint(s[2:], 16, 32)
+	if err != nil {
+		return color.RGBA{}, false
+	}
+	return color.RGBA{R: uint8(rgb >> 16), G: uint8(rgb >> 8), B: uint8(rgb), A: 0xFF}, true
+}
 
+// validateLayerLimit checks the number of requested layers against the advertised LayerLimit
+func (m GetMapRequest) validateLayerLimit(c Capabilities) Exceptions {
+	if c.OptionalConstraints == nil || c.LayerLimit <= 0 {
+		return nil
+	}
+	if n := len(m.StyledLayerDescriptor.NamedLayer); n > c.LayerLimit {
+		return NoApplicableCode(fmt.Sprintf("Too many layers requested, LAYERS can contain at most %d layers", c.LayerLimit)).ToExceptions()
+	}
 	return nil
 }
 
@@ -338,6 +380,16 @@ func buildStyledLayerDescriptor(layers, styles []string) (StyledLayerDescriptor,
 	return StyledLayerDescriptor{}, nil
 }
 
+// formatDefined checks if the given format is one of the defined formats
+func formatDefined(format string, definedFormats []string) bool {
+	for _, defined := range definedFormats {
+		if defined == format {
+			return true
+		}
+	}
+	return false
+}
+
 // checkCRS against a given list of CRS
 func checkCRS(crs CRS, definedCrs []CRS) Exceptions {
 	for _, defined := range definedCrs {
@@ -54,6 +54,8 @@ func (mpv *getMapRequestParameterValue) parseQueryParameters(query url.Values) E
 				mpv.getMapParameterValueOptional.bgcolor = &(v[0])
 			case EXCEPTIONS:
 				mpv.getMapParameterValueOptional.exceptions = &(v[0])
+			case TIME:
+				mpv.getMapParameterValueOptional.time = &(v[0])
 			}
 		}
 	}
@@ -114,11 +116,11 @@ func (mpv *getMapRequestParameterValue) parseGetMapRequest(m GetMapRequest) {
 		mpv.bgcolor = m.Output.BGcolor
 	}
 
-	// TODO: something with Time & Elevation
-	// mpv.Time = m.Time
+	// TODO: something with Elevation
 	// mpv.Elevation = m.Elevation
 
 	mpv.exceptions = m.Exceptions
+	mpv.time = m.Time
 }
 
 // BuildOutput builds a Output struct from the getMapRequestParameterValue information
@@ -137,8 +139,13 @@ func (mpv *getMapRequestParameterValue) buildOutput() (Output, Exceptions) {
 	output.Size = Size{Height: h, Width: w}
 	output.Format = mpv.format
 	if mpv.transparent != nil {
-		b, err := strconv.ParseBool(*mpv.transparent)
-		if err != nil {
+		var b bool
+		switch strings.ToUpper(*mpv.transparent) {
+		case `TRUE`:
+			b = true
+		case `FALSE`:
+			b = false
+		default:
 			return output, InvalidParameterValue(*mpv.transparent, TRANSPARENT).ToExceptions()
 		}
 		output.Transparent = &b
@@ -196,6 +203,9 @@ func (mpv *getMapRequestParameterValue) toQueryParameters() url.Values {
 	if mpv.exceptions != nil {
 		query[EXCEPTIONS] = []string{*mpv.exceptions}
 	}
+	if mpv.time != nil {
+		query[TIME] = []string{*mpv.time}
+	}
 
 	return query
 }
@@ -215,7 +225,7 @@ type getMapParameterValueOptional struct {
 	transparent *string `yaml:"transparent,omitempty"`
 	bgcolor     *string `yaml:"bgcolor,omitempty"`
 	exceptions  *string `yaml:"exceptions,omitempty"`
-	// TODO: something with Time & Elevation
-	// Time        *string `yaml:"time,omitempty"`
+	time        *string `yaml:"time,omitempty"`
+	// TODO: something with Elevation
 	// Elevation   *string `yaml:"elevation,omitempty"`
 }
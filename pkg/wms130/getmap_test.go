@@ -2,6 +2,7 @@ package wms130
 
 import (
 	"encoding/xml"
+	"image/color"
 	"net/url"
 	"testing"
 
@@ -395,6 +396,40 @@ func TestGetMapParseQueryParameters(t *testing.T) {
 					BGcolor:     sp(`0x7F7F7F`)},
 				Exceptions: sp("XML"),
 			}},
+		// REQUEST=GetMap&SERVICE=WMS&VERSION=1.3.0&LAYERS=Rivers&STYLES=&CRS=EPSG:4326&BBOX=-180.0,-90.0,180.0,90.0&WIDTH=1024&HEIGHT=512&FORMAT=image/jpeg&EXCEPTIONS=XML&TIME=2020-01-01/2020-12-31
+		6: {query: map[string][]string{REQUEST: {getmap}, SERVICE: {Service}, VERSION: {Version},
+			LAYERS:     {`Rivers`},
+			STYLES:     {``},
+			"CRS":      {`EPSG:4326`},
+			BBOX:       {`-180.0,-90.0,180.0,90.0`},
+			WIDTH:      {`1024`},
+			HEIGHT:     {`512`},
+			FORMAT:     {`image/jpeg`},
+			EXCEPTIONS: {`XML`},
+			BGCOLOR:    {`0x7F7F7F`},
+			TIME:       {`2020-01-01/2020-12-31`},
+		},
+			excepted: GetMapRequest{
+				BaseRequest: BaseRequest{
+					Version: "1.3.0",
+				},
+				StyledLayerDescriptor: StyledLayerDescriptor{
+					NamedLayer: []NamedLayer{
+						{Name: "Rivers"},
+					}},
+				CRS: CRS{Namespace: "EPSG", Code: 4326},
+				BoundingBox: BoundingBox{
+					LowerCorner: [2]float64{-180.0, -90.0},
+					UpperCorner: [2]float64{180.0, 90.0},
+				},
+				Output: Output{
+					Size:        Size{Width: 1024, Height: 512},
+					Format:      "image/jpeg",
+					Transparent: bp(false),
+					BGcolor:     sp(`0x7F7F7F`)},
+				Exceptions: sp("XML"),
+				Time:       sp(`2020-01-01/2020-12-31`),
+			}},
 	}
 	for k, test := range tests {
 		var gm GetMapRequest
@@ -473,6 +508,29 @@ func TestGetMapToQueryParameters(t *testing.T) {
 				SERVICE:    {`WMS`},
 				EXCEPTIONS: {`XML`},
 			}},
+		2: {object: GetMapRequest{
+			CRS: CRS{Namespace: "EPSG", Code: 4326},
+			BoundingBox: BoundingBox{
+				LowerCorner: [2]float64{-180.0, -90.0},
+				UpperCorner: [2]float64{180.0, 90.0},
+			},
+			Exceptions: sp(`XML`),
+			Time:       sp(`2020-01-01`),
+		},
+			excepted: map[string][]string{
+				TIME:       {`2020-01-01`},
+				LAYERS:     {``},
+				STYLES:     {``},
+				"CRS":      {`EPSG:4326`},
+				BBOX:       {`-180.000000,-90.000000,180.000000,90.000000`},
+				FORMAT:     {``},
+				HEIGHT:     {`0`},
+				WIDTH:      {`0`},
+				VERSION:    {Version},
+				REQUEST:    {`GetMap`},
+				SERVICE:    {`WMS`},
+				EXCEPTIONS: {`XML`},
+			}},
 	}
 
 	for k, test := range tests {
@@ -655,6 +713,9 @@ func compareGetMapObject(result, expected GetMapRequest, t *testing.T, k int) {
 			t.Errorf("test BGcolor: %d, expected: %v+ ,\n got: %v+", k, *expected.Output.BGcolor, *result.Output.BGcolor)
 		}
 	}
+	if (expected.Time == nil) != (result.Time == nil) || (expected.Time != nil && *expected.Time != *result.Time) {
+		t.Errorf("test Time: %d, expected: %v+ ,\n got: %v+", k, expected.Time, result.Time)
+	}
 }
 
 // ----------
@@ -670,11 +731,12 @@ func TestGetMapValidate(t *testing.T) {
 					DCPType: &DCPType{},
 				},
 			},
+			Exception: ExceptionType{Format: []string{`XML`}},
 			Layer: []Layer{
 				{
 					Queryable: ip(1),
 					Title:     `Rivers, Roads and Houses`,
-					CRS:       []CRS{{Code: 4326, Namespace: `EPSG`}},
+					CRS:       []CRS{{Code: 4326, Namespace: `EPSG`}, {Code: 28992, Namespace: `EPSG`}},
 					Layer: []*Layer{
 						{
 							Queryable: ip(1),
@@ -709,11 +771,18 @@ func TestGetMapValidate(t *testing.T) {
 								},
 							},
 						},
+						{
+							Queryable: ip(1),
+							Name:      sp(`Floods`),
+							Title:     `Floods`,
+							CRS:       []CRS{{Code: 4326, Namespace: `EPSG`}},
+							Dimension: []*Dimension{{Name: sp(`time`), Units: sp(`ISO8601`), Value: sp(`2020-01-01/2020-12-31/P1D`)}},
+						},
 					},
 				},
 			},
 		},
-		OptionalConstraints: &OptionalConstraints{LayerLimit: 1, MaxWidth: 2048, MaxHeight: 2048},
+		OptionalConstraints: &OptionalConstraints{LayerLimit: 3, MaxWidth: 2048, MaxHeight: 2048},
 	}
 
 	var tests = []struct {
@@ -752,12 +821,130 @@ func TestGetMapValidate(t *testing.T) {
 				Transparent: bp(false)},
 			Exceptions: sp("XML"),
 		}},
+		// The CRS is only defined on the parent layer
+		1: {gm: GetMapRequest{
+			BaseRequest: BaseRequest{Version: "1.3.0"},
+			StyledLayerDescriptor: StyledLayerDescriptor{
+				Version:    "1.1.0",
+				NamedLayer: []NamedLayer{{Name: "Rivers", NamedStyle: &NamedStyle{Name: "CenterLine"}}}},
+			CRS: CRS{Namespace: "EPSG", Code: 28992},
+			BoundingBox: BoundingBox{
+				LowerCorner: [2]float64{0, 300000},
+				UpperCorner: [2]float64{280000, 625000},
+			},
+			Output: Output{
+				Size:   Size{Width: 1024, Height: 512},
+				Format: "image/jpeg"},
+		}},
+		// Invalid output and too many layers
+		2: {gm: GetMapRequest{
+			BaseRequest: BaseRequest{Version: "1.3.0"},
+			StyledLayerDescriptor: StyledLayerDescriptor{
+				Version: "1.1.0",
+				NamedLayer: []NamedLayer{
+					{Name: "Rivers"}, {Name: "Roads"}, {Name: "Houses"}, {Name: "Rivers"},
+				}},
+			CRS: CRS{Namespace: "EPSG", Code: 4326},
+			BoundingBox: BoundingBox{
+				LowerCorner: [2]float64{-180.0, -90.0},
+				UpperCorner: [2]float64{180.0, 90.0},
+			},
+			Output: Output{
+				Size:    Size{Width: 4096, Height: 512},
+				Format:  "image/png",
+				BGcolor: sp("0xGGHHII")},
+			Exceptions: sp("INIMAGE"),
+		},
+			exceptions: Exceptions{
+				NoApplicableCode("Image size out of range, WIDTH must be between 1 and 2048 pixels"),
+				InvalidFormat("image/png"),
+				InvalidParameterValue("0xGGHHII", BGCOLOR),
+				NoApplicableCode("Too many layers requested, LAYERS can contain at most 3 layers"),
+				InvalidParameterValue("INIMAGE", EXCEPTIONS),
+			}},
+		// The TIME must be a value of the time dimension, layers without one ignore it
+		3: {gm: GetMapRequest{
+			BaseRequest: BaseRequest{Version: "1.3.0"},
+			StyledLayerDescriptor: StyledLayerDescriptor{
+				Version:    "1.1.0",
+				NamedLayer: []NamedLayer{{Name: "Floods"}, {Name: "Rivers", NamedStyle: &NamedStyle{Name: "CenterLine"}}}},
+			CRS: CRS{Namespace: "EPSG", Code: 4326},
+			BoundingBox: BoundingBox{
+				LowerCorner: [2]float64{-180.0, -90.0},
+				UpperCorner: [2]float64{180.0, 90.0},
+			},
+			Output: Output{
+				Size:   Size{Width: 1024, Height: 512},
+				Format: "image/jpeg"},
+			Time: sp("2020-06-01/2020-06-30"),
+		}},
+		4: {gm: GetMapRequest{
+			BaseRequest: BaseRequest{Version: "1.3.0"},
+			StyledLayerDescriptor: StyledLayerDescriptor{
+				Version:    "1.1.0",
+				NamedLayer: []NamedLayer{{Name: "Floods"}}},
+			CRS: CRS{Namespace: "EPSG", Code: 4326},
+			BoundingBox: BoundingBox{
+				LowerCorner: [2]float64{-180.0, -90.0},
+				UpperCorner: [2]float64{180.0, 90.0},
+			},
+			Output: Output{
+				Size:   Size{Width: 1024, Height: 512},
+				Format: "image/jpeg"},
+			Time: sp("2021-01-01"),
+		},
+			exceptions: Exceptions{
+				InvalidDimensionValue("2021-01-01", "time"),
+			}},
+		5: {gm: GetMapRequest{
+			BaseRequest: BaseRequest{Version: "1.3.0"},
+			StyledLayerDescriptor: StyledLayerDescriptor{
+				Version:    "1.1.0",
+				NamedLayer: []NamedLayer{{Name: "Floods"}}},
+			CRS: CRS{Namespace: "EPSG", Code: 4326},
+			BoundingBox: BoundingBox{
+				LowerCorner: [2]float64{-180.0, -90.0},
+				UpperCorner: [2]float64{180.0, 90.0},
+			},
+			Output: Output{
+				Size:   Size{Width: 1024, Height: 512},
+				Format: "image/jpeg"},
+		},
+			exceptions: Exceptions{
+				MissingDimensionValue("time"),
+			}},
 	}
 
 	for k, test := range tests {
 		getmapexceptions := test.gm.Validate(capabilities)
-		if getmapexceptions != nil {
+		if len(getmapexceptions) != len(test.exceptions) {
 			t.Errorf("test Validation: %d, expected: %v+ ,\n got: %v+", k, test.exceptions, getmapexceptions)
+			continue
+		}
+		for i, exception := range test.exceptions {
+			if getmapexceptions[i] != exception {
+				t.Errorf("test Validation: %d, expected: %v+ ,\n got: %v+", k, exception, getmapexceptions[i])
+			}
+		}
+	}
+}
+
+func TestOutputBackgroundColor(t *testing.T) {
+	var tests = []struct {
+		bgcolor *string
+		color   color.RGBA
+	}{
+		0: {bgcolor: nil, color: color.RGBA{R: 0xFF, G: 0xFF, B: 0xFF, A: 0xFF}},
+		1: {bgcolor: sp(`0xFF0000`), color: color.RGBA{R: 0xFF, G: 0x00, B: 0x00, A: 0xFF}},
+		2: {bgcolor: sp(`0X1a2B3c`), color: color.RGBA{R: 0x1A, G: 0x2B, B: 0x3C, A: 0xFF}},
+		3: {bgcolor: sp(`#FF0000`), color: color.RGBA{R: 0xFF, G: 0xFF, B: 0xFF, A: 0xFF}},
+		4: {bgcolor: sp(`0xFF00`), color: color.RGBA{R: 0xFF, G: 0xFF, B: 0xFF, A: 0xFF}},
+	}
+
+	for k, test := range tests {
+		output := Output{BGcolor: test.bgcolor}
+		if c := output.BackgroundColor(); c != test.color {
+			t.Errorf("test: %d, expected: %v,\n got: %v", k, test.color, c)
 		}
 	}
 }
@@ -0,0 +1,205 @@
+package wms130
+
+// Layer properties are inherited by child layers as described in
+// WMS 1.3.0 Table 7 - Inheritance of Layer properties:
+//
+//	Style                    add
+//	CRS                      add
+//	EX_GeographicBoundingBox replace
+//	BoundingBox              replace
+//	Dimension                replace
+//	Attribution              replace
+//	AuthorityURL             add
+//	MinScaleDenominator      replace
+//	MaxScaleDenominator      replace
+//	queryable                replace
+//	opaque                   replace
+//
+// All other properties are not inherited.
+
+// GetEffectiveLayer returns the Layer Capabilities from the Capabilities document
+// with all the properties that are inherited from its parent layers applied.
+// When the requested Layer is not found a Exception is thrown.
+func (c *Capabilities) GetEffectiveLayer(layername string) (Layer, Exceptions) {
+	for i := range c.Layer {
+		if path := c.Layer[i].findLayerPath(layername); path != nil {
+			return effectiveLayer(path), nil
+		}
+	}
+
+	return Layer{}, Exceptions{LayerNotDefined(layername)}
+}
+
+// findLayerPath returns the layers from this layer down to the layer with the given name
+func (l *Layer) findLayerPath(layername string) []*Layer {
+	if l.Name != nil && *l.Name == layername {
+		return []*Layer{l}
+	}
+	for _, n := range l.Layer {
+		if n == nil {
+			continue
+		}
+		if path := n.findLayerPath(layername); path != nil {
+			return append([]*Layer{l}, path...)
+		}
+	}
+	return nil
+}
+
+// effectiveLayer resolves a path of layers, starting at the root, into a single layer
+func effectiveLayer(path []*Layer) Layer {
+	effective := copyLayer(*path[0])
+	for _, child := range path[1:] {
+		effective = child.inherit(effective)
+	}
+	return effective
+}
+
+// inherit returns a copy of the layer with the inheritable properties of the parent applied
+func (l Layer) inherit(parent Layer) Layer {
+	layer := copyLayer(l)
+
+	layer.Style = inheritStyles(parent.Style, l.Style)
+	layer.CRS = inheritCRS(parent.CRS, l.CRS)
+	layer.BoundingBox = inheritBoundingBoxes(parent.BoundingBox, l.BoundingBox)
+	layer.Dimension = inheritDimensions(parent.Dimension, l.Dimension)
+	layer.AuthorityURL = inheritAuthorityURLs(parent.AuthorityURL, l.AuthorityURL)
+
+	if layer.EXGeographicBoundingBox == nil {
+		layer.EXGeographicBoundingBox = parent.EXGeographicBoundingBox
+	}
+	if layer.Attribution == nil {
+		layer.Attribution = parent.Attribution
+	}
+	if layer.MinScaleDenominator == nil {
+		layer.MinScaleDenominator = parent.MinScaleDenominator
+	}
+	if layer.MaxScaleDenominator == nil {
+		layer.MaxScaleDenominator = parent.MaxScaleDenominator
+	}
+	if layer.Queryable == nil {
+		layer.Queryable = parent.Queryable
+	}
+	if layer.Opaque == nil {
+		layer.Opaque = parent.Opaque
+	}
+
+	return layer
+}
+
+// copyLayer makes a copy of the layer so the inherited properties
+// don't alter the slices of the Capabilities document
+func copyLayer(l Layer) Layer {
+	layer := l
+	layer.CRS = append([]CRS(nil), l.CRS...)
+	layer.BoundingBox = append([]*LayerBoundingBox(nil), l.BoundingBox...)
+	layer.Dimension = append([]*Dimension(nil), l.Dimension...)
+	layer.Style = append([]*Style(nil), l.Style...)
+	layer.AuthorityURL = append([]*AuthorityURL(nil), l.AuthorityURL...)
+	return layer
+}
+
+// inheritStyles adds the parent styles to the child styles,
+// a child style with the same name takes precedence
+func inheritStyles(parent, child []*Style) []*Style {
+	child = withoutNil(child)
+	styles := append([]*Style(nil), child...)
+	for _, p := range withoutNil(parent) {
+		found := false
+		for _, c := range child {
+			if p.Name == c.Name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			styles = append(styles, p)
+		}
+	}
+	return styles
+}
+
+// inheritAuthorityURLs adds the parent AuthorityURLs to the child AuthorityURLs,
+// a child AuthorityURL with the same name takes precedence
+func inheritAuthorityURLs(parent, child []*AuthorityURL) []*AuthorityURL {
+	child = withoutNil(child)
+	authorityURLs := append([]*AuthorityURL(nil), child...)
+	for _, p := range withoutNil(parent) {
+		found := false
+		for _, c := range child {
+			if p.Name == c.Name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			authorityURLs = append(authorityURLs, p)
+		}
+	}
+	return authorityURLs
+}
+
+// withoutNil returns the entries that are not nil
+func withoutNil[T any](entries []*T) []*T {
+	var result []*T
+	for _, e := range entries {
+		if e != nil {
+			result = append(result, e)
+		}
+	}
+	return result
+}
+
+// inheritCRS adds the parent CRS to the child CRS
+func inheritCRS(parent, child []CRS) []CRS {
+	crs := append([]CRS(nil), parent...)
+	for _, c := range child {
+		found := false
+		for _, p := range parent {
+			if p == c {
+				found = true
+				break
+			}
+		}
+		if !found {
+			crs = append(crs, c)
+		}
+	}
+	return crs
+}
+
+// inheritBoundingBoxes replaces the parent BoundingBox by a child BoundingBox with the same CRS
+func inheritBoundingBoxes(parent, child []*LayerBoundingBox) []*LayerBoundingBox {
+	boundingboxes := append([]*LayerBoundingBox(nil), child...)
+	for _, p := range parent {
+		found := false
+		for _, c := range child {
+			if p.CRS == c.CRS {
+				found = true
+				break
+			}
+		}
+		if !found {
+			boundingboxes = append(boundingboxes, p)
+		}
+	}
+	return boundingboxes
+}
+
+// inheritDimensions replaces the parent Dimension by a child Dimension with the same name
+func inheritDimensions(parent, child []*Dimension) []*Dimension {
+	dimensions := append([]*Dimension(nil), child...)
+	for _, p := range parent {
+		found := false
+		for _, c := range child {
+			if p.Name != nil && c.Name != nil && *p.Name == *c.Name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			dimensions = append(dimensions, p)
+		}
+	}
+	return dimensions
+}
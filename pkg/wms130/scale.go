@@ -0,0 +1,83 @@
+package wms130
+
+import "math"
+
+const (
+	// pixelSize is the standardized rendering pixel size of 0.28mm x 0.28mm
+	// as defined by the Symbology Encoding and WMTS specifications
+	pixelSize = 0.00028
+
+	// metersPerDegree is the length of a degree on the equator of the WGS84 ellipsoid
+	metersPerDegree = 6378137.0 * 2.0 * math.Pi / 360.0
+)
+
+// ScaleDenominator calculates the scale denominator of a map with the given size for the BoundingBox in the given CRS.
+// The scale is based on the horizontal extent of the map and the standardized rendering pixel size of 0.28mm.
+// For geographic CRS the degrees are converted to meters on the equator.
+func ScaleDenominator(bbox BoundingBox, size Size, crs CRS) float64 {
+	if size.Width <= 0 {
+		return 0
+	}
+
+	eastnorth := bbox.EastNorth(crs)
+	extent := eastnorth.UpperCorner[0] - eastnorth.LowerCorner[0]
+	if crs.Geographic() {
+		extent *= metersPerDegree
+	}
+
+	return math.Abs(extent) / float64(size.Width) / pixelSize
+}
+
+// ScaleDenominator returns the scale denominator of the requested map
+func (m GetMapRequest) ScaleDenominator() float64 {
+	return ScaleDenominator(m.BoundingBox, m.Output.Size, m.CRS)
+}
+
+// InScale checks if the layer is visible at the given scale denominator.
+// A layer is visible when MinScaleDenominator <= scale < MaxScaleDenominator.
+func (l Layer) InScale(scale float64) bool {
+	if l.MinScaleDenominator != nil && scale < *l.MinScaleDenominator {
+		return false
+	}
+	if l.MaxScaleDenominator != nil && scale >= *l.MaxScaleDenominator {
+		return false
+	}
+	return true
+}
+
+// LayersOutOfScale returns the requested layers that are not visible at the scale of the requested map,
+// so they can be skipped when rendering the map
+func (m GetMapRequest) LayersOutOfScale(c Capabilities) []string {
+	var layers []string
+
+	scale := m.ScaleDenominator()
+	for _, name := range m.StyledLayerDescriptor.getNamedLayers() {
+		layer, exceptions := c.GetEffectiveLayer(name)
+		if exceptions != nil {
+			continue
+		}
+		if !layer.InScale(scale) {
+			layers = append(layers, name)
+		}
+	}
+
+	return layers
+}
+
+// LayersInScale returns the requested layers that are visible at the scale of the requested map
+func (m GetMapRequest) LayersInScale(c Capabilities) []string {
+	var layers []string
+
+	scale := m.ScaleDenominator()
+	for _, name := range m.StyledLayerDescriptor.getNamedLayers() {
+		layer, exceptions := c.GetEffectiveLayer(name)
+		if exceptions != nil {
+			continue
+		}
+		if layer.InScale(scale) {
+			layers = append(layers, name)
+		}
+	}
+
+	return layers
+}
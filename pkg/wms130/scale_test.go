@@ -0,0 +1,96 @@
+package wms130
+
+import (
+	"math"
+	"testing"
+)
+
+func TestScaleDenominator(t *testing.T) {
+	var tests = []struct {
+		bbox     BoundingBox
+		size     Size
+		crs      CRS
+		expected float64
+	}{
+		// 280m on 1000px -> 0.28m per pixel -> 1:1000
+		0: {bbox: BoundingBox{LowerCorner: [2]float64{100000, 400000}, UpperCorner: [2]float64{100280, 400280}},
+			size: Size{Width: 1000, Height: 1000}, crs: CRS{Namespace: EPSG, Code: 28992}, expected: 1000},
+		1: {bbox: BoundingBox{LowerCorner: [2]float64{0, 0}, UpperCorner: [2]float64{2800, 1400}},
+			size: Size{Width: 1000, Height: 500}, crs: CRS{Namespace: EPSG, Code: 3857}, expected: 10000},
+		// EPSG:4326 has a lat/lon axis order so the second axis is used
+		2: {bbox: BoundingBox{LowerCorner: [2]float64{-90, -180}, UpperCorner: [2]float64{90, 180}},
+			size: Size{Width: 256, Height: 128}, crs: CRS{Namespace: EPSG, Code: 4326}, expected: 360 * metersPerDegree / 256 / pixelSize},
+		3: {bbox: BoundingBox{LowerCorner: [2]float64{-180, -90}, UpperCorner: [2]float64{180, 90}},
+			size: Size{Width: 256, Height: 128}, crs: CRS{Namespace: `CRS`, Code: 84}, expected: 360 * metersPerDegree / 256 / pixelSize},
+		4: {bbox: BoundingBox{LowerCorner: [2]float64{0, 0}, UpperCorner: [2]float64{2800, 1400}},
+			size: Size{}, crs: CRS{Namespace: EPSG, Code: 3857}, expected: 0},
+	}
+
+	for k, test := range tests {
+		scale := ScaleDenominator(test.bbox, test.size, test.crs)
+		if math.Abs(scale-test.expected) > 1e-6 {
+			t.Errorf("test: %d, expected: %f \ngot: %f", k, test.expected, scale)
+		}
+	}
+}
+
+func TestLayersOutOfScale(t *testing.T) {
+	c := Capabilities{
+		WMSCapabilities: WMSCapabilities{
+			Layer: []Layer{
+				{Title: `root`,
+					MaxScaleDenominator: fp(50000),
+					Layer: []*Layer{
+						{Name: sp(`overview`), MinScaleDenominator: fp(50000), MaxScaleDenominator: fp(1000000)},
+						{Name: sp(`detail`)},
+						{Name: sp(`buildings`), MinScaleDenominator: fp(500), MaxScaleDenominator: fp(5000)},
+					},
+				},
+			},
+		},
+	}
+
+	var tests = []struct {
+		bbox     BoundingBox
+		outscale []string
+		inscale  []string
+	}{
+		// 1:1000
+		0: {bbox: BoundingBox{LowerCorner: [2]float64{100000, 400000}, UpperCorner: [2]float64{100280, 400280}},
+			outscale: []string{`overview`}, inscale: []string{`detail`, `buildings`}},
+		// 1:10000
+		1: {bbox: BoundingBox{LowerCorner: [2]float64{100000, 400000}, UpperCorner: [2]float64{102800, 402800}},
+			outscale: []string{`overview`, `buildings`}, inscale: []string{`detail`}},
+		// 1:100000
+		2: {bbox: BoundingBox{LowerCorner: [2]float64{100000, 400000}, UpperCorner: [2]float64{128000, 428000}},
+			outscale: []string{`detail`, `buildings`}, inscale: []string{`overview`}},
+	}
+
+	for k, test := range tests {
+		m := GetMapRequest{
+			StyledLayerDescriptor: StyledLayerDescriptor{NamedLayer: []NamedLayer{{Name: `overview`}, {Name: `detail`}, {Name: `buildings`}}},
+			CRS:                   CRS{Namespace: EPSG, Code: 28992},
+			BoundingBox:           test.bbox,
+			Output:                Output{Size: Size{Width: 1000, Height: 1000}},
+		}
+
+		if out := m.LayersOutOfScale(c); !equalStrings(out, test.outscale) {
+			t.Errorf("test: %d, expected: %v \ngot: %v", k, test.outscale, out)
+		}
+		if in := m.LayersInScale(c); !equalStrings(in, test.inscale) {
+			t.Errorf("test: %d, expected: %v \ngot: %v", k, test.inscale, in)
+		}
+	}
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
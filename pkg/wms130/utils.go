@@ -12,3 +12,7 @@ func ip(i int) *int {
 func bp(b bool) *bool {
 	return &b
 }
+
+func fp(f float64) *float64 {
+	return &f
+}
@@ -1,17 +1,29 @@
 package wmts100
 
 import (
+	"github.com/pdok/ogc-specifications/pkg/utils"
 	"github.com/pdok/ogc-specifications/pkg/wsc110"
 )
 
-// ParseXML func
-func (c *Contents) ParseXML(_ []byte) error {
+// ParseXML builds the Contents from a WMTS 1.0.0 capabilities document
+func (c *Contents) ParseXML(doc []byte) error {
+	var gc GetCapabilitiesResponse
+	if err := gc.ParseXML(doc); err != nil {
+		return err
+	}
+	*c = gc.Contents
 	return nil
 }
 
-// ParseYAML func
-func (c *Contents) ParseYAML(_ []byte) error {
-	return nil
+// ParseYAML builds the Contents from a YAML document, unknown keys are ignored
+func (c *Contents) ParseYAML(doc []byte) error {
+	return c.ParseYAMLWithMode(doc, utils.Lenient)
+}
+
+// ParseYAMLWithMode builds the Contents from a YAML document,
+// in utils.Strict mode unknown keys are rejected with an error wrapping utils.ErrUnknownField
+func (c *Contents) ParseYAMLWithMode(doc []byte, mode utils.ParseMode) error {
+	return utils.UnmarshalYAML(doc, c, mode)
 }
 
 // Contents struct for the WMTS 1.0.0
@@ -31,6 +43,20 @@ func (c Contents) GetTilematrixsets() map[string]bool {
 	return tilematrixsets
 }
 
+// Themes struct for the WMTS 1.0.0
+type Themes struct {
+	Theme []Theme `xml:"Theme" yaml:"theme"`
+}
+
+// Theme in struct for repeatability, a Theme groups layers and sub-themes
+type Theme struct {
+	Title      string   `xml:"ows:Title,omitempty" yaml:"title"`
+	Abstract   string   `xml:"ows:Abstract,omitempty" yaml:"abstract"`
+	Identifier string   `xml:"ows:Identifier" yaml:"identifier"`
+	Theme      []Theme  `xml:"Theme" yaml:"theme"`
+	LayerRef   []string `xml:"LayerRef" yaml:"layerRef"`
+}
+
 // Layer in struct for repeatability
 type Layer struct {
 	Title             string                  `xml:"ows:Title" yaml:"title"`
@@ -41,6 +67,7 @@ type Layer struct {
 	Style             []Style                 `xml:"Style" yaml:"style"`
 	Format            []string                `xml:"Format" yaml:"format"`
 	InfoFormat        []string                `xml:"InfoFormat" yaml:"infoFormat"`
+	Dimension         []Dimension             `xml:"Dimension,omitempty" yaml:"dimension,omitempty"`
 	TileMatrixSetLink []TileMatrixSetLink     `xml:"TileMatrixSetLink" yaml:"tileMatrixSetLink"`
 	ResourceURL       []ResourceURL           `xml:"ResourceURL" yaml:"resourceUrl"`
 }
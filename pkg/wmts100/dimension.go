@@ -0,0 +1,97 @@
+package wmts100
+
+import (
+	"strings"
+
+	"github.com/pdok/ogc-specifications/pkg/temporal"
+	"github.com/pdok/ogc-specifications/pkg/wsc110"
+)
+
+// TimeDimension is the identifier of the time dimension, its values are ISO 8601 instants and start/end/period intervals
+const TimeDimension = `Time`
+
+// Dimension of a Layer, the tiles are available for each of its values
+type Dimension struct {
+	Identifier string   `xml:"ows:Identifier" yaml:"identifier"`
+	UOM        *string  `xml:"ows:UOM,omitempty" yaml:"uom,omitempty"`
+	Default    string   `xml:"Default" yaml:"default"`
+	Current    *bool    `xml:"Current,omitempty" yaml:"current,omitempty"`
+	Value      []string `xml:"Value" yaml:"value"`
+}
+
+// DimensionNameValue is the value of a Dimension in a GetTile request
+type DimensionNameValue struct {
+	Name  string `xml:"name,attr" yaml:"name"`
+	Value string `xml:",chardata" yaml:"value"`
+}
+
+// IsTime returns whether the dimension is the time dimension
+func (d Dimension) IsTime() bool {
+	return strings.EqualFold(d.Identifier, TimeDimension)
+}
+
+// Extent returns the values of the time dimension
+func (d Dimension) Extent() (temporal.Extent, error) {
+	return temporal.ParseExtent(strings.Join(d.Value, `,`))
+}
+
+// Resolve returns the value of the dimension to use for the requested value, the default when the value is empty.
+// A time is normalised and has to be one of the values of the extent, or current when the dimension allows it,
+// the value of another dimension has to be one of its values.
+func (d Dimension) Resolve(value string) (string, wsc110.Exception) {
+	if value == `` {
+		return d.Default, nil
+	}
+	if !d.IsTime() {
+		if !contains(d.Value, value) {
+			return ``, wsc110.InvalidParameterValue(value, d.Identifier)
+		}
+		return value, nil
+	}
+
+	requested, err := temporal.ParseInstant(value)
+	if err != nil {
+		return ``, wsc110.InvalidParameterValue(value, d.Identifier)
+	}
+	if requested.Current {
+		if d.Current == nil || !*d.Current {
+			return ``, wsc110.InvalidParameterValue(value, d.Identifier)
+		}
+		return requested.String(), nil
+	}
+	extent, err := d.Extent()
+	if err != nil {
+		return ``, wsc110.NoApplicableCode(`The extent of the dimension: ` + d.Identifier + ` is invalid, ` + err.Error())
+	}
+	if !extent.Contains(requested) {
+		return ``, wsc110.InvalidParameterValue(value, d.Identifier)
+	}
+	return requested.String(), nil
+}
+
+// dimension returns the dimension of the layer, the identifiers are compared case insensitive like the KVP keys
+func (l Layer) dimension(identifier string) (Dimension, bool) {
+	for _, d := range l.Dimension {
+		if strings.EqualFold(d.Identifier, identifier) {
+			return d, true
+		}
+	}
+	return Dimension{}, false
+}
+
+// validateDimensions checks the dimension values of the request against the dimensions of the layer,
+// a dimension without a value gets its default
+func validateDimensions(values []DimensionNameValue, l Layer) wsc110.Exceptions {
+	var exceptions wsc110.Exceptions
+	for _, v := range values {
+		d, ok := l.dimension(v.Name)
+		if !ok {
+			exceptions = append(exceptions, wsc110.InvalidParameterValue(v.Value, v.Name))
+			continue
+		}
+		if _, exception := d.Resolve(v.Value); exception != nil {
+			exceptions = append(exceptions, exception)
+		}
+	}
+	return exceptions
+}
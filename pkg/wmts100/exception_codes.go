@@ -5,11 +5,11 @@ import (
 	"github.com/pdok/ogc-specifications/pkg/wsc110"
 )
 
-// TileOutOfRange exception
-func TileOutOfRange() wsc110.Exception {
+// TileOutOfRange exception, the locator is the TILEROW or TILECOL parameter that is out of range
+func TileOutOfRange(locator string) wsc110.Exception {
 	return exception{ExceptionDetails: common.ExceptionDetails{
 		ExceptionCode: "TileOutOfRange",
-		ExceptionText: "TileRow or TileCol out of rangeName",
-		LocatorCode:   "", // TODO parse the right parameter TileRow or TileCol
+		ExceptionText: locator + " is out of range",
+		LocatorCode:   locator,
 	}}
 }
@@ -0,0 +1,19 @@
+package wmts100
+
+import (
+	"net/http"
+
+	"github.com/pdok/ogc-specifications/pkg/common"
+	"github.com/pdok/ogc-specifications/pkg/wsc110"
+)
+
+// statusCodes contains the HTTP status codes for the WMTS 1.0 specific exception codes
+var statusCodes = map[string]int{
+	`TileOutOfRange`: http.StatusBadRequest,
+}
+
+// StatusCode returns the HTTP status code for a report with the given exceptions,
+// exception codes that are not defined by WMTS 1.0 are looked up in the shared OWS Common codes
+func StatusCode(exceptions ...wsc110.Exception) int {
+	return common.StatusCode(statusCodes, exceptions...)
+}
@@ -0,0 +1,26 @@
+package wmts100
+
+import (
+	"testing"
+
+	"github.com/pdok/ogc-specifications/pkg/wsc110"
+)
+
+func TestStatusCode(t *testing.T) {
+	var tests = []struct {
+		exceptions []wsc110.Exception
+		status     int
+	}{
+		0: {exceptions: nil, status: 200},
+		1: {exceptions: []wsc110.Exception{TileOutOfRange(`TILEROW`)}, status: 400},
+		2: {exceptions: []wsc110.Exception{TileOutOfRange(`TILEROW`), wsc110.MissingParameterValue(`VERSION`)}, status: 400},
+		3: {exceptions: []wsc110.Exception{wsc110.OperationNotSupported(`GetCoconut`)}, status: 501},
+		4: {exceptions: []wsc110.Exception{TileOutOfRange(`TILECOL`), wsc110.NoApplicableCode(`Oops`)}, status: 500},
+	}
+
+	for k, test := range tests {
+		if status := StatusCode(test.exceptions...); status != test.status {
+			t.Errorf("test: %d, expected: %d,\n got: %d", k, test.status, status)
+		}
+	}
+}
@@ -3,7 +3,6 @@ package wmts100
 import (
 	"encoding/xml"
 	"net/url"
-	"regexp"
 	"strings"
 
 	"github.com/pdok/ogc-specifications/pkg/utils"
@@ -19,10 +18,21 @@ const (
 
 // GetCapabilitiesRequest struct with the needed parameters/attributes needed for making a GetCapabilities request
 type GetCapabilitiesRequest struct {
-	XMLName xml.Name           `xml:"GetCapabilities" yaml:"getCapabilities"`
-	Service string             `xml:"service,attr" yaml:"service"`
-	Version string             `xml:"version,attr" yaml:"version"`
-	Attr    utils.XMLAttribute `xml:",attr" yaml:"attr"`
+	XMLName                          xml.Name           `xml:"GetCapabilities" yaml:"getCapabilities"`
+	Service                          string             `xml:"service,attr" yaml:"service"`
+	Version                          string             `xml:"version,attr" yaml:"version"`
+	Attr                             utils.XMLAttribute `xml:",attr" yaml:"attr"`
+	wsc110.GetCapabilitiesParameters `yaml:",inline"`
+}
+
+// Validate validates the OWS Common parameters of the GetCapabilities request
+func (gc GetCapabilitiesRequest) Validate(_ wsc110.Capabilities) wsc110.Exceptions {
+	var exceptions wsc110.Exceptions
+	if _, exception := gc.NegotiateVersion(Version); exception != nil {
+		exceptions = append(exceptions, exception)
+	}
+	exceptions = append(exceptions, gc.ValidateSections(sections...)...)
+	return exceptions
 }
 
 // ParseXML builds a GetCapabilities object based on a XML document
@@ -34,11 +44,15 @@ func (gc *GetCapabilitiesRequest) ParseXML(body []byte) wsc110.Exceptions {
 	if err := xml.Unmarshal(body, &gc); err != nil {
 		return wsc110.Exceptions{wsc110.MissingParameterValue("REQUEST")}
 	}
+	if err := gc.GetCapabilitiesParameters.ParseXML(body); err != nil {
+		return wsc110.Exceptions{wsc110.NoApplicableCode(err.Error())}
+	}
 	var n []xml.Attr
 	for _, a := range xmlattributes {
 		switch strings.ToUpper(a.Name.Local) {
 		case VERSION:
 		case SERVICE:
+		case wsc110.UPDATESEQUENCE:
 		default:
 			n = append(n, a)
 		}
@@ -62,7 +76,7 @@ func (gc *GetCapabilitiesRequest) ParseQueryParameters(query url.Values) wsc110.
 			gc.Version = strings.ToUpper(v[0])
 		}
 	}
-	return nil
+	return gc.GetCapabilitiesParameters.ParseQueryParameters(query)
 }
 
 // ToQueryParameters builds a new query string that will be proxied
@@ -71,13 +85,14 @@ func (gc GetCapabilitiesRequest) ToQueryParameters() url.Values {
 	querystring[REQUEST] = []string{gc.XMLName.Local}
 	querystring[SERVICE] = []string{gc.Service}
 	querystring[VERSION] = []string{gc.Version}
+	gc.GetCapabilitiesParameters.SetQueryParameters(querystring)
 
 	return querystring
 }
 
 // ToXML builds a 'new' XML document 'based' on the 'original' XML document
 func (gc GetCapabilitiesRequest) ToXML() []byte {
-	si, _ := xml.MarshalIndent(gc, "", "")
-	re := regexp.MustCompile(`><.*>`)
-	return []byte(xml.Header + re.ReplaceAllString(string(si), "/>"))
+	e := utils.NewEncoder(wsc110.Prefixes)
+	doc, _ := e.Marshal(gc)
+	return doc
 }
@@ -2,8 +2,8 @@ package wmts100
 
 import (
 	"encoding/xml"
-	"regexp"
 
+	"github.com/pdok/ogc-specifications/pkg/utils"
 	"github.com/pdok/ogc-specifications/pkg/wsc110"
 )
 
@@ -27,11 +27,24 @@ func (gc GetCapabilitiesResponse) Validate() wsc110.Exceptions {
 	return nil
 }
 
+// capabilitiesPrefixes contains the prefixes of the namespaces used in the struct tags of the WMTS 1.0.0 capabilities
+var capabilitiesPrefixes = utils.Prefixes{
+	`http://www.opengis.net/wmts/1.0`: ``,
+	`http://www.opengis.net/ows/1.1`:  `ows`,
+	`http://www.opengis.net/gml`:      `gml`,
+}
+
+// ParseXML builds a GetCapabilitiesResponse from a WMTS 1.0.0 capabilities document,
+// regardless of the namespace prefixes used in the document
+func (gc *GetCapabilitiesResponse) ParseXML(doc []byte) error {
+	return utils.UnmarshalPrefixed(doc, gc, capabilitiesPrefixes)
+}
+
 // ToXML builds a GetCapabilities response object
 func (gc GetCapabilitiesResponse) ToXML() []byte {
-	si, _ := xml.MarshalIndent(gc, "", "")
-	re := regexp.MustCompile(`><.*>`)
-	return []byte(xml.Header + re.ReplaceAllString(string(si), "/>"))
+	e := utils.NewEncoder(capabilitiesPrefixes)
+	doc, _ := e.Marshal(gc)
+	return doc
 }
 
 // GetCapabilitiesResponse base struct
@@ -42,6 +55,7 @@ type GetCapabilitiesResponse struct {
 	ServiceProvider       *wsc110.ServiceProvider `xml:"ows:ServiceProvider,omitempty" yaml:"serviceProvider"`
 	OperationsMetadata    *OperationsMetadata     `xml:"ows:OperationsMetadata,omitempty" yaml:"operationsMetadata"`
 	Contents              Contents                `xml:"Contents" yaml:"contents"`
+	Themes                *Themes                 `xml:"Themes,omitempty" yaml:"themes"`
 	ServiceMetadataURL    *ServiceMetadataURL     `xml:"ServiceMetadataURL,omitempty" yaml:"serviceMetadataUrl"`
 }
 
@@ -53,6 +67,7 @@ type Namespaces struct {
 	XmlnsXSI       string `xml:"xmlns:xsi,attr" yaml:"xsi"`     //http://www.w3.org/2001/XMLSchema-instance
 	XmlnsGml       string `xml:"xmlns:gml,attr" yaml:"gml"`     //http://www.opengis.net/gml
 	Version        string `xml:"version,attr" yaml:"version"`
+	UpdateSequence string `xml:"updateSequence,attr,omitempty" yaml:"updateSequence,omitempty"`
 	SchemaLocation string `xml:"xsi:schemaLocation,attr" yaml:"schemaLocation"`
 }
 
@@ -0,0 +1,128 @@
+package wmts100
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGetCapabilitiesResponseParseXML(t *testing.T) {
+	var tests = []struct {
+		doc string
+	}{
+		0: {doc: `<?xml version="1.0" encoding="UTF-8"?>
+<Capabilities xmlns="http://www.opengis.net/wmts/1.0" xmlns:ows="http://www.opengis.net/ows/1.1" xmlns:xlink="http://www.w3.org/1999/xlink" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xmlns:gml="http://www.opengis.net/gml" version="1.0.0">
+ <ows:ServiceIdentification>
+  <ows:Title>Tiles</ows:Title>
+  <ows:ServiceType>OGC WMTS</ows:ServiceType>
+  <ows:ServiceTypeVersion>1.0.0</ows:ServiceTypeVersion>
+ </ows:ServiceIdentification>
+ <Contents>
+  <Layer>
+   <ows:Title>Roads</ows:Title>
+   <ows:WGS84BoundingBox>
+    <ows:LowerCorner>3.05 50.67</ows:LowerCorner>
+    <ows:UpperCorner>7.28 53.61</ows:UpperCorner>
+   </ows:WGS84BoundingBox>
+   <ows:Identifier>roads</ows:Identifier>
+   <Style isDefault="true"><ows:Identifier>default</ows:Identifier></Style>
+   <Format>image/png</Format>
+   <TileMatrixSetLink><TileMatrixSet>EPSG:28992</TileMatrixSet></TileMatrixSetLink>
+   <ResourceURL format="image/png" resourceType="tile" template="https://example.com/{TileMatrix}/{TileCol}/{TileRow}.png"/>
+  </Layer>
+  <TileMatrixSet>
+   <ows:Identifier>EPSG:28992</ows:Identifier>
+   <ows:SupportedCRS>urn:ogc:def:crs:EPSG::28992</ows:SupportedCRS>
+   <TileMatrix>
+    <ows:Identifier>00</ows:Identifier>
+    <ScaleDenominator>12288000.0</ScaleDenominator>
+    <TopLeftCorner>-285401.92 903401.92</TopLeftCorner>
+    <TileWidth>256</TileWidth>
+    <TileHeight>256</TileHeight>
+    <MatrixWidth>1</MatrixWidth>
+    <MatrixHeight>1</MatrixHeight>
+   </TileMatrix>
+  </TileMatrixSet>
+ </Contents>
+ <ServiceMetadataURL xlink:href="https://example.com/WMTSCapabilities.xml"/>
+</Capabilities>`},
+		1: {doc: `<?xml version="1.0" encoding="UTF-8"?>
+<wmts:Capabilities xmlns:wmts="http://www.opengis.net/wmts/1.0" xmlns:o="http://www.opengis.net/ows/1.1" xmlns:xlink="http://www.w3.org/1999/xlink" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xmlns:gml="http://www.opengis.net/gml" version="1.0.0">
+ <o:ServiceIdentification>
+  <o:Title>Tiles</o:Title>
+  <o:ServiceType>OGC WMTS</o:ServiceType>
+  <o:ServiceTypeVersion>1.0.0</o:ServiceTypeVersion>
+ </o:ServiceIdentification>
+ <wmts:Contents>
+  <wmts:Layer>
+   <o:Title>Roads</o:Title>
+   <o:WGS84BoundingBox>
+    <o:LowerCorner>3.05 50.67</o:LowerCorner>
+    <o:UpperCorner>7.28 53.61</o:UpperCorner>
+   </o:WGS84BoundingBox>
+   <o:Identifier>roads</o:Identifier>
+   <wmts:Style isDefault="true"><o:Identifier>default</o:Identifier></wmts:Style>
+   <wmts:Format>image/png</wmts:Format>
+   <wmts:TileMatrixSetLink><wmts:TileMatrixSet>EPSG:28992</wmts:TileMatrixSet></wmts:TileMatrixSetLink>
+   <wmts:ResourceURL format="image/png" resourceType="tile" template="https://example.com/{TileMatrix}/{TileCol}/{TileRow}.png"/>
+  </wmts:Layer>
+  <wmts:TileMatrixSet>
+   <o:Identifier>EPSG:28992</o:Identifier>
+   <o:SupportedCRS>urn:ogc:def:crs:EPSG::28992</o:SupportedCRS>
+   <wmts:TileMatrix>
+    <o:Identifier>00</o:Identifier>
+    <wmts:ScaleDenominator>12288000.0</wmts:ScaleDenominator>
+    <wmts:TopLeftCorner>-285401.92 903401.92</wmts:TopLeftCorner>
+    <wmts:TileWidth>256</wmts:TileWidth>
+    <wmts:TileHeight>256</wmts:TileHeight>
+    <wmts:MatrixWidth>1</wmts:MatrixWidth>
+    <wmts:MatrixHeight>1</wmts:MatrixHeight>
+   </wmts:TileMatrix>
+  </wmts:TileMatrixSet>
+ </wmts:Contents>
+ <wmts:ServiceMetadataURL xlink:href="https://example.com/WMTSCapabilities.xml"/>
+</wmts:Capabilities>`},
+	}
+
+	var expected GetCapabilitiesResponse
+	for k, test := range tests {
+		var gc GetCapabilitiesResponse
+		if err := gc.ParseXML([]byte(test.doc)); err != nil {
+			t.Errorf("test: %d, expected no error,\n got: %v", k, err)
+			continue
+		}
+
+		if gc.Namespaces.Xmlns != `http://www.opengis.net/wmts/1.0` || gc.Namespaces.XmlnsOws != `http://www.opengis.net/ows/1.1` {
+			t.Errorf("test: %d, expected the wmts and ows namespaces,\n got: %+v", k, gc.Namespaces)
+		}
+		if gc.ServiceIdentification.Title != `Tiles` || gc.ServiceMetadataURL == nil || gc.ServiceMetadataURL.Href != `https://example.com/WMTSCapabilities.xml` {
+			t.Errorf("test: %d, expected the service identification and metadata url,\n got: %+v %+v", k, gc.ServiceIdentification, gc.ServiceMetadataURL)
+		}
+		if len(gc.Contents.Layer) != 1 || gc.Contents.Layer[0].Identifier != `roads` || gc.Contents.Layer[0].WGS84BoundingBox.UpperCorner[0] != 7.28 {
+			t.Errorf("test: %d, expected the roads layer,\n got: %+v", k, gc.Contents.Layer)
+		}
+		if tms := gc.Contents.GetTilematrixsets(); !tms[`EPSG:28992`] {
+			t.Errorf("test: %d, expected the EPSG:28992 tilematrixset,\n got: %v", k, tms)
+		}
+		if len(gc.Contents.TileMatrixSet) != 1 || len(gc.Contents.TileMatrixSet[0].TileMatrix) != 1 || gc.Contents.TileMatrixSet[0].TileMatrix[0].TopLeftCorner != `-285401.92 903401.92` {
+			t.Errorf("test: %d, expected the EPSG:28992 tilematrix,\n got: %+v", k, gc.Contents.TileMatrixSet)
+		}
+
+		if k == 0 {
+			expected = gc
+		} else if !reflect.DeepEqual(gc, expected) {
+			t.Errorf("test: %d, expected: %+v,\n got: %+v", k, expected, gc)
+		}
+
+		var c Contents
+		if err := c.ParseXML([]byte(test.doc)); err != nil || !reflect.DeepEqual(c, gc.Contents) {
+			t.Errorf("test: %d, expected: %+v,\n got: %+v %v", k, gc.Contents, c, err)
+		}
+
+		// marshalled back it is parsed to the same capabilities
+		b := gc.ToXML()
+		var roundtrip GetCapabilitiesResponse
+		if err := roundtrip.ParseXML(b); err != nil || !reflect.DeepEqual(roundtrip, gc) {
+			t.Errorf("test: %d, expected: %+v,\n got: %+v %v", k, gc, roundtrip, err)
+		}
+	}
+}
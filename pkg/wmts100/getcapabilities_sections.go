@@ -0,0 +1,68 @@
+package wmts100
+
+import (
+	"encoding/xml"
+
+	"github.com/pdok/ogc-specifications/pkg/utils"
+	"github.com/pdok/ogc-specifications/pkg/wsc110"
+)
+
+// ThemesSection is the section of the WMTS 1.0.0 capabilities next to the OWS Common sections
+const ThemesSection = `Themes`
+
+// sections contains the sections that can be requested
+var sections = []string{
+	wsc110.ServiceIdentificationSection,
+	wsc110.ServiceProviderSection,
+	wsc110.OperationsMetadataSection,
+	wsc110.ContentsSection,
+	ThemesSection,
+}
+
+// Trim returns the capabilities for the GetCapabilities request: the requested sections, or only the version and
+// update sequence when the requested update sequence is the current one.
+// InvalidUpdateSequence is returned when the requested update sequence is greater than the current one.
+func (gc GetCapabilitiesResponse) Trim(r GetCapabilitiesRequest) (GetCapabilitiesResponse, wsc110.Exceptions) {
+	if exceptions := r.ValidateSections(sections...); len(exceptions) > 0 {
+		return GetCapabilitiesResponse{}, exceptions
+	}
+
+	equal, exception := r.CheckUpdateSequence(gc.Namespaces.UpdateSequence)
+	if exception != nil {
+		return GetCapabilitiesResponse{}, exception.ToExceptions()
+	}
+
+	trimmed := GetCapabilitiesResponse{XMLName: gc.XMLName, Namespaces: gc.Namespaces}
+	if equal {
+		return trimmed, nil
+	}
+	if r.HasSection(wsc110.ServiceIdentificationSection) {
+		trimmed.ServiceIdentification = gc.ServiceIdentification
+	}
+	if r.HasSection(wsc110.ServiceProviderSection) {
+		trimmed.ServiceProvider = gc.ServiceProvider
+	}
+	if r.HasSection(wsc110.OperationsMetadataSection) {
+		trimmed.OperationsMetadata = gc.OperationsMetadata
+	}
+	if r.HasSection(wsc110.ContentsSection) {
+		trimmed.Contents = gc.Contents
+	}
+	if r.HasSection(ThemesSection) {
+		trimmed.Themes = gc.Themes
+	}
+	trimmed.ServiceMetadataURL = gc.ServiceMetadataURL
+	return trimmed, nil
+}
+
+// MarshalXML leaves out an empty ServiceIdentification, like one that isn't requested
+func (s ServiceIdentification) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type section ServiceIdentification
+	return utils.EncodeNonZero(e, section(s), start)
+}
+
+// MarshalXML leaves out empty Contents
+func (c Contents) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type section Contents
+	return utils.EncodeNonZero(e, section(c), start)
+}
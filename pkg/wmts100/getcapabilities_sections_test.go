@@ -0,0 +1,75 @@
+package wmts100
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/pdok/ogc-specifications/pkg/wsc110"
+)
+
+func TestGetCapabilitiesResponseTrim(t *testing.T) {
+	gc := GetCapabilitiesResponse{
+		Namespaces:            Namespaces{Xmlns: `http://www.opengis.net/wmts/1.0`, XmlnsOws: `http://www.opengis.net/ows/1.1`, Version: Version, UpdateSequence: `2021-03-01T12:00:00Z`},
+		ServiceIdentification: ServiceIdentification{Title: `Tiles`},
+		ServiceProvider:       &wsc110.ServiceProvider{ProviderName: `PDOK`},
+		Contents:              Contents{Layer: []Layer{{Identifier: `roads`}}},
+		Themes:                &Themes{Theme: []Theme{{Identifier: `infrastructure`, LayerRef: []string{`roads`}}}},
+	}
+
+	var tests = []struct {
+		query    url.Values
+		contains []string
+		omits    []string
+		code     string
+	}{
+		0: {query: url.Values{`SECTIONS`: {`Themes`}},
+			contains: []string{`<Themes>`, `<LayerRef>roads</LayerRef>`},
+			omits:    []string{`ows:ServiceIdentification`, `ows:ServiceProvider`, `<Contents>`}},
+		1: {query: url.Values{`SECTIONS`: {`Contents`}},
+			contains: []string{`<Contents>`},
+			omits:    []string{`ows:ServiceIdentification`, `<Themes>`}},
+		2: {query: url.Values{`SECTIONS`: {`All`}},
+			contains: []string{`<ows:ServiceIdentification>`, `<ows:ServiceProvider>`, `<Contents>`, `<Themes>`}},
+		3: {query: url.Values{`UPDATESEQUENCE`: {`2021-03-01T12:00:00Z`}},
+			contains: []string{`updateSequence="2021-03-01T12:00:00Z"`},
+			omits:    []string{`ows:ServiceIdentification`, `<Contents>`, `<Themes>`}},
+		4: {query: url.Values{`UPDATESEQUENCE`: {`2022-01-01T00:00:00Z`}},
+			code: `InvalidUpdateSequence`},
+		5: {query: url.Values{`SECTIONS`: {`FeatureTypeList`}},
+			code: `InvalidParameterValue`},
+	}
+
+	for k, test := range tests {
+		query := url.Values{`SERVICE`: {Service}, `REQUEST`: {getcapabilities}}
+		for key, value := range test.query {
+			query[key] = value
+		}
+
+		var r GetCapabilitiesRequest
+		if exceptions := r.ParseQueryParameters(query); exceptions != nil {
+			t.Errorf("test: %d, expected no exceptions,\n got: %v", k, exceptions)
+			continue
+		}
+
+		trimmed, exceptions := gc.Trim(r)
+		if test.code != `` {
+			if len(exceptions) != 1 || exceptions[0].Code() != test.code {
+				t.Errorf("test: %d, expected: %s,\n got: %v", k, test.code, exceptions)
+			}
+			continue
+		}
+
+		doc := string(trimmed.ToXML())
+		for _, s := range test.contains {
+			if !strings.Contains(doc, s) {
+				t.Errorf("test: %d, expected %s in:\n %s", k, s, doc)
+			}
+		}
+		for _, s := range test.omits {
+			if strings.Contains(doc, s) {
+				t.Errorf("test: %d, expected no %s in:\n %s", k, s, doc)
+			}
+		}
+	}
+}
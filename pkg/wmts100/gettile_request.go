@@ -1 +1,207 @@
 package wmts100
+
+import (
+	"encoding/xml"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/pdok/ogc-specifications/pkg/utils"
+	"github.com/pdok/ogc-specifications/pkg/wsc110"
+)
+
+// WMTS 1.0.0 GetTile Tokens
+const (
+	LAYER         = `LAYER`
+	STYLE         = `STYLE`
+	FORMAT        = `FORMAT`
+	TILEMATRIXSET = `TILEMATRIXSET`
+	TILEMATRIX    = `TILEMATRIX`
+	TILEROW       = `TILEROW`
+	TILECOL       = `TILECOL`
+	TIME          = `TIME`
+)
+
+// GetTileRequest struct with the needed parameters/attributes needed for making a GetTile request
+type GetTileRequest struct {
+	XMLName            xml.Name             `xml:"GetTile" yaml:"getTile"`
+	Service            string               `xml:"service,attr" yaml:"service"`
+	Version            string               `xml:"version,attr" yaml:"version"`
+	Attr               utils.XMLAttribute   `xml:",attr" yaml:"attr"`
+	Layer              string               `xml:"Layer" yaml:"layer"`
+	Style              string               `xml:"Style" yaml:"style"`
+	Format             string               `xml:"Format" yaml:"format"`
+	DimensionNameValue []DimensionNameValue `xml:"DimensionNameValue,omitempty" yaml:"dimensionNameValue,omitempty"`
+	TileMatrixSet      string               `xml:"TileMatrixSet" yaml:"tileMatrixSet"`
+	TileMatrix         string               `xml:"TileMatrix" yaml:"tileMatrix"`
+	TileRow            int                  `xml:"TileRow" yaml:"tileRow"`
+	TileCol            int                  `xml:"TileCol" yaml:"tileCol"`
+}
+
+// Type returns GetTile
+func (t GetTileRequest) Type() string {
+	return gettile
+}
+
+// Validate checks the GetTile request against the Contents of the capabilities: the layer must offer the style,
+// format, dimension values and TileMatrixSet and the tile must lie within the TileMatrix
+//
+//nolint:cyclop
+func (t GetTileRequest) Validate(c Contents) wsc110.Exceptions {
+	layer, ok := c.layer(t.Layer)
+	if !ok {
+		return wsc110.InvalidParameterValue(t.Layer, LAYER).ToExceptions()
+	}
+
+	var exceptions wsc110.Exceptions
+	if _, ok := layer.style(t.Style); !ok {
+		exceptions = append(exceptions, wsc110.InvalidParameterValue(t.Style, STYLE))
+	}
+	if !contains(layer.Format, t.Format) {
+		exceptions = append(exceptions, wsc110.InvalidParameterValue(t.Format, FORMAT))
+	}
+	exceptions = append(exceptions, validateDimensions(t.DimensionNameValue, layer)...)
+
+	linked := false
+	for _, link := range layer.TileMatrixSetLink {
+		linked = linked || link.TileMatrixSet == t.TileMatrixSet
+	}
+	tms, ok := c.tileMatrixSet(t.TileMatrixSet)
+	if !linked || !ok {
+		return append(exceptions, wsc110.InvalidParameterValue(t.TileMatrixSet, TILEMATRIXSET))
+	}
+	tm, ok := tms.tileMatrix(t.TileMatrix)
+	if !ok {
+		return append(exceptions, wsc110.InvalidParameterValue(t.TileMatrix, TILEMATRIX))
+	}
+	if height, err := strconv.Atoi(tm.MatrixHeight); err == nil && (t.TileRow < 0 || t.TileRow >= height) {
+		exceptions = append(exceptions, TileOutOfRange(TILEROW))
+	}
+	if width, err := strconv.Atoi(tm.MatrixWidth); err == nil && (t.TileCol < 0 || t.TileCol >= width) {
+		exceptions = append(exceptions, TileOutOfRange(TILECOL))
+	}
+
+	if len(exceptions) > 0 {
+		return exceptions
+	}
+	return nil
+}
+
+// ParseXML builds a GetTile object based on a XML document
+func (t *GetTileRequest) ParseXML(doc []byte) wsc110.Exceptions {
+	var xmlattributes utils.XMLAttribute
+	if err := xml.Unmarshal(doc, &xmlattributes); err != nil {
+		return wsc110.NoApplicableCode("Could not process XML, is it XML?").ToExceptions()
+	}
+	if err := xml.Unmarshal(doc, &t); err != nil {
+		return wsc110.NoApplicableCode(err.Error()).ToExceptions()
+	}
+	var n []xml.Attr
+	for _, a := range xmlattributes {
+		switch strings.ToUpper(a.Name.Local) {
+		case VERSION:
+		case SERVICE:
+		default:
+			n = append(n, a)
+		}
+	}
+
+	t.Attr = utils.StripDuplicateAttr(n)
+	return nil
+}
+
+// ParseQueryParameters builds a GetTile object based on the available query parameters,
+// the TIME is the only dimension that is known without the capabilities
+func (t *GetTileRequest) ParseQueryParameters(query url.Values) wsc110.Exceptions {
+	tpv := getTileRequestParameterValue{}
+	if exceptions := tpv.parseQueryParameters(query); exceptions != nil {
+		return exceptions
+	}
+	return t.parseGetTileRequestParameterValue(tpv)
+}
+
+func (t *GetTileRequest) parseGetTileRequestParameterValue(tpv getTileRequestParameterValue) wsc110.Exceptions {
+	var exceptions wsc110.Exceptions
+
+	t.XMLName.Local = gettile
+	t.Service = tpv.service
+	t.Version = tpv.version
+	t.Layer = tpv.layer
+	t.Style = tpv.style
+	t.Format = tpv.format
+	t.TileMatrixSet = tpv.tileMatrixSet
+	t.TileMatrix = tpv.tileMatrix
+	t.DimensionNameValue = tpv.dimensions
+
+	var err error
+	if t.TileRow, err = strconv.Atoi(tpv.tileRow); err != nil {
+		exceptions = append(exceptions, wsc110.InvalidParameterValue(tpv.tileRow, TILEROW))
+	}
+	if t.TileCol, err = strconv.Atoi(tpv.tileCol); err != nil {
+		exceptions = append(exceptions, wsc110.InvalidParameterValue(tpv.tileCol, TILECOL))
+	}
+
+	if len(exceptions) > 0 {
+		return exceptions
+	}
+	return nil
+}
+
+// ToQueryParameters builds a new query string that will be proxied
+func (t GetTileRequest) ToQueryParameters() url.Values {
+	tpv := getTileRequestParameterValue{}
+	tpv.parseGetTileRequest(t)
+	return tpv.toQueryParameters()
+}
+
+// ToXML builds a 'new' XML document 'based' on the 'original' XML document
+func (t GetTileRequest) ToXML() []byte {
+	e := utils.NewEncoder(nil)
+	doc, _ := e.Marshal(&t)
+	return doc
+}
+
+func (c Contents) layer(identifier string) (Layer, bool) {
+	for _, l := range c.Layer {
+		if l.Identifier == identifier {
+			return l, true
+		}
+	}
+	return Layer{}, false
+}
+
+func (c Contents) tileMatrixSet(identifier string) (TileMatrixSet, bool) {
+	for _, tms := range c.TileMatrixSet {
+		if tms.Identifier == identifier {
+			return tms, true
+		}
+	}
+	return TileMatrixSet{}, false
+}
+
+func (tms TileMatrixSet) tileMatrix(identifier string) (TileMatrix, bool) {
+	for _, tm := range tms.TileMatrix {
+		if tm.Identifier == identifier {
+			return tm, true
+		}
+	}
+	return TileMatrix{}, false
+}
+
+func (l Layer) style(identifier string) (Style, bool) {
+	for _, s := range l.Style {
+		if s.Identifier == identifier {
+			return s, true
+		}
+	}
+	return Style{}, false
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -1 +1,94 @@
 package wmts100
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/pdok/ogc-specifications/pkg/wsc110"
+)
+
+// getTileRequestParameterValue contains the KVP encoded GetTile parameters, all of them are mandatory except the TIME
+// and the other dimensions
+type getTileRequestParameterValue struct {
+	service       string               `yaml:"service"`
+	version       string               `yaml:"version"`
+	request       string               `yaml:"request"`
+	layer         string               `yaml:"layer"`
+	style         string               `yaml:"style"`
+	format        string               `yaml:"format"`
+	tileMatrixSet string               `yaml:"tileMatrixSet"`
+	tileMatrix    string               `yaml:"tileMatrix"`
+	tileRow       string               `yaml:"tileRow"`
+	tileCol       string               `yaml:"tileCol"`
+	dimensions    []DimensionNameValue `yaml:"dimensions"`
+}
+
+func (tpv *getTileRequestParameterValue) parseQueryParameters(query url.Values) wsc110.Exceptions {
+	fields := map[string]*string{
+		SERVICE:       &tpv.service,
+		VERSION:       &tpv.version,
+		REQUEST:       &tpv.request,
+		LAYER:         &tpv.layer,
+		STYLE:         &tpv.style,
+		FORMAT:        &tpv.format,
+		TILEMATRIXSET: &tpv.tileMatrixSet,
+		TILEMATRIX:    &tpv.tileMatrix,
+		TILEROW:       &tpv.tileRow,
+		TILECOL:       &tpv.tileCol,
+	}
+	found := map[string]bool{}
+	for k, v := range query {
+		if field, ok := fields[strings.ToUpper(k)]; ok && len(v) > 0 {
+			*field = v[0]
+			found[strings.ToUpper(k)] = true
+		}
+		if strings.EqualFold(k, TIME) && len(v) > 0 && v[0] != `` {
+			tpv.dimensions = []DimensionNameValue{{Name: TimeDimension, Value: v[0]}}
+		}
+	}
+
+	// the STYLE is mandatory but can be empty
+	var exceptions wsc110.Exceptions
+	for _, key := range []string{SERVICE, VERSION, REQUEST, LAYER, STYLE, FORMAT, TILEMATRIXSET, TILEMATRIX, TILEROW, TILECOL} {
+		if !found[key] || (key != STYLE && *fields[key] == ``) {
+			exceptions = append(exceptions, wsc110.MissingParameterValue(key))
+		}
+	}
+	if len(exceptions) > 0 {
+		return exceptions
+	}
+	return nil
+}
+
+func (tpv *getTileRequestParameterValue) parseGetTileRequest(t GetTileRequest) {
+	tpv.service = Service
+	tpv.version = Version
+	tpv.request = gettile
+	tpv.layer = t.Layer
+	tpv.style = t.Style
+	tpv.format = t.Format
+	tpv.tileMatrixSet = t.TileMatrixSet
+	tpv.tileMatrix = t.TileMatrix
+	tpv.tileRow = strconv.Itoa(t.TileRow)
+	tpv.tileCol = strconv.Itoa(t.TileCol)
+	tpv.dimensions = t.DimensionNameValue
+}
+
+func (tpv getTileRequestParameterValue) toQueryParameters() url.Values {
+	query := make(map[string][]string)
+	query[SERVICE] = []string{tpv.service}
+	query[VERSION] = []string{tpv.version}
+	query[REQUEST] = []string{tpv.request}
+	query[LAYER] = []string{tpv.layer}
+	query[STYLE] = []string{tpv.style}
+	query[FORMAT] = []string{tpv.format}
+	query[TILEMATRIXSET] = []string{tpv.tileMatrixSet}
+	query[TILEMATRIX] = []string{tpv.tileMatrix}
+	query[TILEROW] = []string{tpv.tileRow}
+	query[TILECOL] = []string{tpv.tileCol}
+	for _, d := range tpv.dimensions {
+		query[strings.ToUpper(d.Name)] = []string{d.Value}
+	}
+	return query
+}
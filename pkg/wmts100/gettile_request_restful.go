@@ -1 +1,124 @@
 package wmts100
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pdok/ogc-specifications/pkg/wsc110"
+)
+
+// tileResourceType is the resourceType of the ResourceURL templates of the tiles
+const tileResourceType = `tile`
+
+// templateVariable matches the variables of a ResourceURL template, like {TileMatrix}
+var templateVariable = regexp.MustCompile(`\{([A-Za-z]+)\}`)
+
+// ParseRESTful builds a GetTile object from the path of a RESTful request for a tile of the Layer,
+// the path is matched against the path of the tile ResourceURL templates of the Layer
+func (t *GetTileRequest) ParseRESTful(l Layer, path string) wsc110.Exceptions {
+	for _, r := range l.ResourceURL {
+		if r.ResourceType != tileResourceType {
+			continue
+		}
+		values, ok := matchTemplate(templatePath(r.Template), path)
+		if !ok {
+			continue
+		}
+
+		t.XMLName.Local = gettile
+		t.Service = Service
+		t.Version = Version
+		t.Layer = l.Identifier
+		t.Format = r.Format
+		t.Style = values[`Style`]
+		t.TileMatrixSet = values[`TileMatrixSet`]
+		t.TileMatrix = values[`TileMatrix`]
+		for _, d := range l.Dimension {
+			if v, ok := values[d.Identifier]; ok {
+				t.DimensionNameValue = append(t.DimensionNameValue, DimensionNameValue{Name: d.Identifier, Value: v})
+			}
+		}
+
+		var exceptions wsc110.Exceptions
+		var err error
+		if t.TileRow, err = strconv.Atoi(values[`TileRow`]); err != nil {
+			exceptions = append(exceptions, wsc110.InvalidParameterValue(values[`TileRow`], TILEROW))
+		}
+		if t.TileCol, err = strconv.Atoi(values[`TileCol`]); err != nil {
+			exceptions = append(exceptions, wsc110.InvalidParameterValue(values[`TileCol`], TILECOL))
+		}
+		if len(exceptions) > 0 {
+			return exceptions
+		}
+		return nil
+	}
+	return wsc110.InvalidParameterValue(path, LAYER).ToExceptions()
+}
+
+// ToRESTful returns the URL of the tile, from the tile ResourceURL template of the Layer for the requested format,
+// a dimension without a value in the request gets its default
+func (t GetTileRequest) ToRESTful(l Layer) (string, bool) {
+	for _, r := range l.ResourceURL {
+		if r.ResourceType != tileResourceType || r.Format != t.Format {
+			continue
+		}
+		values := map[string]string{
+			`Style`:         t.Style,
+			`TileMatrixSet`: t.TileMatrixSet,
+			`TileMatrix`:    t.TileMatrix,
+			`TileRow`:       strconv.Itoa(t.TileRow),
+			`TileCol`:       strconv.Itoa(t.TileCol),
+		}
+		for _, d := range l.Dimension {
+			values[d.Identifier] = d.Default
+			for _, v := range t.DimensionNameValue {
+				if strings.EqualFold(v.Name, d.Identifier) {
+					values[d.Identifier] = v.Value
+				}
+			}
+		}
+		return templateVariable.ReplaceAllStringFunc(r.Template, func(v string) string {
+			return values[v[1:len(v)-1]]
+		}), true
+	}
+	return ``, false
+}
+
+// templatePath returns the path of the template, without the scheme and host
+func templatePath(template string) string {
+	if i := strings.Index(template, `://`); i >= 0 {
+		template = template[i+3:]
+		if j := strings.Index(template, `/`); j >= 0 {
+			return template[j:]
+		}
+		return `/`
+	}
+	return template
+}
+
+// matchTemplate returns the values of the variables when the path matches the template
+func matchTemplate(template, path string) (map[string]string, bool) {
+	var pattern strings.Builder
+	pattern.WriteString(`^`)
+	var names []string
+	last := 0
+	for _, m := range templateVariable.FindAllStringSubmatchIndex(template, -1) {
+		pattern.WriteString(regexp.QuoteMeta(template[last:m[0]]))
+		pattern.WriteString(`([^/]+)`)
+		names = append(names, template[m[2]:m[3]])
+		last = m[1]
+	}
+	pattern.WriteString(regexp.QuoteMeta(template[last:]))
+	pattern.WriteString(`$`)
+
+	match := regexp.MustCompile(pattern.String()).FindStringSubmatch(path)
+	if match == nil {
+		return nil, false
+	}
+	values := make(map[string]string, len(names))
+	for i, name := range names {
+		values[name] = match[i+1]
+	}
+	return values, true
+}
@@ -0,0 +1,221 @@
+package wmts100
+
+import (
+	"encoding/xml"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+var tileContents = Contents{
+	Layer: []Layer{{
+		Identifier:        `brt`,
+		Style:             []Style{{Identifier: `default`, IsDefault: bp(true)}},
+		Format:            []string{`image/png`},
+		TileMatrixSetLink: []TileMatrixSetLink{{TileMatrixSet: `EPSG:28992`}},
+		ResourceURL: []ResourceURL{{Format: `image/png`, ResourceType: `tile`,
+			Template: `https://example.org/tiles/brt/{Style}/{TileMatrixSet}/{TileMatrix}/{TileCol}/{TileRow}.png`}},
+	}, {
+		Identifier:        `brt-time`,
+		Style:             []Style{{Identifier: `default`, IsDefault: bp(true)}},
+		Format:            []string{`image/png`},
+		Dimension:         []Dimension{{Identifier: `Time`, Default: `2020-12-01`, Value: []string{`2020-01-01/2020-12-01/P1M`}}},
+		TileMatrixSetLink: []TileMatrixSetLink{{TileMatrixSet: `EPSG:28992`}},
+		ResourceURL: []ResourceURL{{Format: `image/png`, ResourceType: `tile`,
+			Template: `https://example.org/tiles/brt-time/{Style}/{Time}/{TileMatrixSet}/{TileMatrix}/{TileCol}/{TileRow}.png`}},
+	}},
+	TileMatrixSet: []TileMatrixSet{{Identifier: `EPSG:28992`, SupportedCRS: `urn:ogc:def:crs:EPSG::28992`,
+		TileMatrix: []TileMatrix{
+			{Identifier: `00`, ScaleDenominator: `12288000`, TopLeftCorner: `-285401.92 903401.92`, TileWidth: `256`, TileHeight: `256`, MatrixWidth: `1`, MatrixHeight: `1`},
+			{Identifier: `01`, ScaleDenominator: `6144000`, TopLeftCorner: `-285401.92 903401.92`, TileWidth: `256`, TileHeight: `256`, MatrixWidth: `2`, MatrixHeight: `2`},
+		}}},
+}
+
+var tileRequest = GetTileRequest{XMLName: xml.Name{Local: `GetTile`}, Service: Service, Version: Version,
+	Layer: `brt`, Style: `default`, Format: `image/png`, TileMatrixSet: `EPSG:28992`, TileMatrix: `01`, TileRow: 1, TileCol: 0}
+
+var timeTileRequest = GetTileRequest{XMLName: xml.Name{Local: `GetTile`}, Service: Service, Version: Version,
+	Layer: `brt-time`, Style: `default`, Format: `image/png`, DimensionNameValue: []DimensionNameValue{{Name: `Time`, Value: `2020-03-01`}},
+	TileMatrixSet: `EPSG:28992`, TileMatrix: `01`, TileRow: 1, TileCol: 0}
+
+func TestGetTileParseQueryParameters(t *testing.T) {
+	var tests = []struct {
+		query      url.Values
+		result     GetTileRequest
+		exceptions []string
+	}{
+		0: {query: url.Values{SERVICE: {`WMTS`}, VERSION: {`1.0.0`}, REQUEST: {`GetTile`}, LAYER: {`brt`}, STYLE: {`default`}, FORMAT: {`image/png`},
+			TILEMATRIXSET: {`EPSG:28992`}, TILEMATRIX: {`01`}, TILEROW: {`1`}, TILECOL: {`0`}},
+			result: tileRequest},
+		1: {query: url.Values{`service`: {`WMTS`}, `version`: {`1.0.0`}, `request`: {`GetTile`}, `layer`: {`brt`}, `format`: {`image/png`},
+			`tilematrixset`: {`EPSG:28992`}, `tilematrix`: {`01`}, `tilerow`: {`a`}},
+			exceptions: []string{`MissingParameterValue`, `MissingParameterValue`}},
+		2: {query: url.Values{SERVICE: {`WMTS`}, VERSION: {`1.0.0`}, REQUEST: {`GetTile`}, LAYER: {`brt`}, STYLE: {``}, FORMAT: {`image/png`},
+			TILEMATRIXSET: {`EPSG:28992`}, TILEMATRIX: {`01`}, TILEROW: {`a`}, TILECOL: {`0`}},
+			exceptions: []string{`InvalidParameterValue`}},
+		3: {query: url.Values{SERVICE: {`WMTS`}, VERSION: {`1.0.0`}, REQUEST: {`GetTile`}, LAYER: {`brt-time`}, STYLE: {`default`}, FORMAT: {`image/png`},
+			TILEMATRIXSET: {`EPSG:28992`}, TILEMATRIX: {`01`}, TILEROW: {`1`}, TILECOL: {`0`}, TIME: {`2020-03-01`}},
+			result: timeTileRequest},
+	}
+
+	for k, test := range tests {
+		var r GetTileRequest
+		exceptions := r.ParseQueryParameters(test.query)
+		if len(test.exceptions) > 0 {
+			if len(exceptions) != len(test.exceptions) {
+				t.Errorf("test: %d, expected: %v,\n got: %v", k, test.exceptions, exceptions)
+				continue
+			}
+			for i, exception := range exceptions {
+				if exception.Code() != test.exceptions[i] {
+					t.Errorf("test: %d, expected: %s,\n got: %s", k, test.exceptions[i], exception.Code())
+				}
+			}
+			continue
+		}
+		if exceptions != nil {
+			t.Errorf("test: %d, expected no exceptions,\n got: %v", k, exceptions)
+		} else if !reflect.DeepEqual(r, test.result) {
+			t.Errorf("test: %d, expected: %+v,\n got: %+v", k, test.result, r)
+		}
+		if !reflect.DeepEqual(r.ToQueryParameters(), test.query) {
+			t.Errorf("test: %d, expected: %v,\n got: %v", k, test.query, r.ToQueryParameters())
+		}
+	}
+}
+
+func TestGetTileParseXML(t *testing.T) {
+	var tests = []struct {
+		doc    string
+		result GetTileRequest
+	}{
+		0: {doc: `<GetTile service="WMTS" version="1.0.0" xmlns="http://www.opengis.net/wmts/1.0">
+	<Layer>brt</Layer><Style>default</Style><Format>image/png</Format>
+	<TileMatrixSet>EPSG:28992</TileMatrixSet><TileMatrix>01</TileMatrix><TileRow>1</TileRow><TileCol>0</TileCol>
+	</GetTile>`,
+			result: tileRequest},
+		1: {doc: `<GetTile service="WMTS" version="1.0.0" xmlns="http://www.opengis.net/wmts/1.0">
+	<Layer>brt-time</Layer><Style>default</Style><Format>image/png</Format><DimensionNameValue name="Time">2020-03-01</DimensionNameValue>
+	<TileMatrixSet>EPSG:28992</TileMatrixSet><TileMatrix>01</TileMatrix><TileRow>1</TileRow><TileCol>0</TileCol>
+	</GetTile>`,
+			result: timeTileRequest},
+	}
+
+	for k, test := range tests {
+		var r GetTileRequest
+		if exceptions := r.ParseXML([]byte(test.doc)); exceptions != nil {
+			t.Errorf("test: %d, expected no exceptions,\n got: %v", k, exceptions)
+		}
+		expected := test.result
+		expected.XMLName.Space = `http://www.opengis.net/wmts/1.0`
+		expected.Attr = []xml.Attr{{Name: xml.Name{Local: `xmlns`}, Value: `http://www.opengis.net/wmts/1.0`}}
+		if !reflect.DeepEqual(r, expected) {
+			t.Errorf("test: %d, expected: %+v,\n got: %+v", k, expected, r)
+		}
+	}
+}
+
+func TestGetTileValidate(t *testing.T) {
+	var tests = []struct {
+		request    GetTileRequest
+		exceptions []string
+	}{
+		0: {request: tileRequest},
+		1: {request: func() GetTileRequest { r := tileRequest; r.Layer = `top10`; return r }(),
+			exceptions: []string{`InvalidParameterValue`}},
+		2: {request: func() GetTileRequest {
+			r := tileRequest
+			r.Style, r.Format, r.TileMatrixSet = `dark`, `image/jpeg`, `EPSG:3857`
+			return r
+		}(),
+			exceptions: []string{`InvalidParameterValue`, `InvalidParameterValue`, `InvalidParameterValue`}},
+		3: {request: func() GetTileRequest { r := tileRequest; r.TileMatrix = `02`; return r }(),
+			exceptions: []string{`InvalidParameterValue`}},
+		4: {request: func() GetTileRequest { r := tileRequest; r.TileRow, r.TileCol = 2, -1; return r }(),
+			exceptions: []string{`TileOutOfRange`, `TileOutOfRange`}},
+		5: {request: timeTileRequest},
+		// without a TIME the default is used
+		6: {request: func() GetTileRequest { r := timeTileRequest; r.DimensionNameValue = nil; return r }()},
+		7: {request: func() GetTileRequest {
+			r := timeTileRequest
+			r.DimensionNameValue = []DimensionNameValue{{Name: `TIME`, Value: `2020-03-15`}}
+			return r
+		}(),
+			exceptions: []string{`InvalidParameterValue`}},
+		8: {request: func() GetTileRequest {
+			r := timeTileRequest
+			r.DimensionNameValue = []DimensionNameValue{{Name: `TIME`, Value: `current`}}
+			return r
+		}(),
+			exceptions: []string{`InvalidParameterValue`}},
+		// brt has no time dimension
+		9: {request: func() GetTileRequest {
+			r := tileRequest
+			r.DimensionNameValue = []DimensionNameValue{{Name: `TIME`, Value: `2020-03-01`}}
+			return r
+		}(),
+			exceptions: []string{`InvalidParameterValue`}},
+	}
+
+	for k, test := range tests {
+		exceptions := test.request.Validate(tileContents)
+		if len(exceptions) != len(test.exceptions) {
+			t.Errorf("test: %d, expected: %v,\n got: %v", k, test.exceptions, exceptions)
+			continue
+		}
+		for i, exception := range exceptions {
+			if exception.Code() != test.exceptions[i] {
+				t.Errorf("test: %d, expected: %s,\n got: %s", k, test.exceptions[i], exception.Code())
+			}
+		}
+	}
+}
+
+func TestGetTileRESTful(t *testing.T) {
+	var tests = []struct {
+		layer      Layer
+		path       string
+		result     GetTileRequest
+		url        string
+		exceptions []string
+	}{
+		0: {layer: tileContents.Layer[0], path: `/tiles/brt/default/EPSG:28992/01/0/1.png`,
+			result: tileRequest,
+			url:    `https://example.org/tiles/brt/default/EPSG:28992/01/0/1.png`},
+		1: {layer: tileContents.Layer[0], path: `/tiles/brt/default/EPSG:28992/01/0/1.jpeg`,
+			exceptions: []string{`InvalidParameterValue`}},
+		2: {layer: tileContents.Layer[0], path: `/tiles/brt/default/EPSG:28992/01/0/a.png`,
+			exceptions: []string{`InvalidParameterValue`}},
+		3: {layer: tileContents.Layer[1], path: `/tiles/brt-time/default/2020-03-01/EPSG:28992/01/0/1.png`,
+			result: timeTileRequest,
+			url:    `https://example.org/tiles/brt-time/default/2020-03-01/EPSG:28992/01/0/1.png`},
+	}
+
+	for k, test := range tests {
+		var r GetTileRequest
+		exceptions := r.ParseRESTful(test.layer, test.path)
+		if len(test.exceptions) > 0 {
+			if len(exceptions) != 1 || exceptions[0].Code() != test.exceptions[0] {
+				t.Errorf("test: %d, expected: %v,\n got: %v", k, test.exceptions, exceptions)
+			}
+			continue
+		}
+		if exceptions != nil {
+			t.Errorf("test: %d, expected no exceptions,\n got: %v", k, exceptions)
+			continue
+		}
+		if !reflect.DeepEqual(r, test.result) {
+			t.Errorf("test: %d, expected: %+v,\n got: %+v", k, test.result, r)
+		}
+		if u, ok := r.ToRESTful(test.layer); !ok || u != test.url {
+			t.Errorf("test: %d, expected: %s,\n got: %s", k, test.url, u)
+		}
+	}
+
+	// a request without the TIME gets the default in the URL
+	r := timeTileRequest
+	r.DimensionNameValue = nil
+	if u, _ := r.ToRESTful(tileContents.Layer[1]); u != `https://example.org/tiles/brt-time/default/2020-12-01/EPSG:28992/01/0/1.png` {
+		t.Errorf("test: %d, expected the default time,\n got: %s", 0, u)
+	}
+}
@@ -0,0 +1,84 @@
+package wps100
+
+import (
+	"github.com/pdok/ogc-specifications/pkg/utils"
+)
+
+// Capabilities contains the processes and the languages of the WPS 1.0.0 capabilities the requests are validated against
+type Capabilities struct {
+	ProcessOfferings ProcessOfferings `xml:"wps:ProcessOfferings" yaml:"processOfferings"`
+	Languages        Languages        `xml:"wps:Languages" yaml:"languages"`
+}
+
+// ParseXML builds the Capabilities from a WPS 1.0.0 capabilities document
+func (c *Capabilities) ParseXML(doc []byte) error {
+	var gc GetCapabilitiesResponse
+	if err := gc.ParseXML(doc); err != nil {
+		return err
+	}
+	*c = gc.Capabilities
+	return nil
+}
+
+// ParseYAML builds the Capabilities from a YAML document, unknown keys are ignored
+func (c *Capabilities) ParseYAML(doc []byte) error {
+	return utils.UnmarshalYAML(doc, c, utils.Lenient)
+}
+
+// ProcessOfferings contains the brief descriptions of the processes
+type ProcessOfferings struct {
+	Process []ProcessBrief `xml:"wps:Process" yaml:"process"`
+}
+
+// ProcessBrief is the brief description of a process
+type ProcessBrief struct {
+	ProcessVersion *string    `xml:"wps:processVersion,attr,omitempty" yaml:"processVersion,omitempty"`
+	Identifier     string     `xml:"ows:Identifier" yaml:"identifier"`
+	Title          string     `xml:"ows:Title" yaml:"title"`
+	Abstract       *string    `xml:"ows:Abstract,omitempty" yaml:"abstract,omitempty"`
+	Metadata       []Metadata `xml:"ows:Metadata" yaml:"metadata,omitempty"`
+	Profile        []string   `xml:"wps:Profile" yaml:"profile,omitempty"`
+	WSDL           *Reference `xml:"wps:WSDL,omitempty" yaml:"wsdl,omitempty"`
+}
+
+// Metadata is a reference to metadata of a process or its inputs and outputs
+type Metadata struct {
+	Title *string `xml:"xlink:title,attr,omitempty" yaml:"title,omitempty"`
+	Href  *string `xml:"xlink:href,attr,omitempty" yaml:"href,omitempty"`
+}
+
+// Languages contains the default and the other supported languages, identified by RFC 4646 codes
+type Languages struct {
+	Default   Language           `xml:"wps:Default" yaml:"default"`
+	Supported SupportedLanguages `xml:"wps:Supported" yaml:"supported"`
+}
+
+// supports returns whether the language is supported
+func (l Languages) supports(language string) bool {
+	return language == l.Default.Language || contains(l.Supported.Language, language)
+}
+
+// Language contains the default language
+type Language struct {
+	Language string `xml:"ows:Language" yaml:"language"`
+}
+
+// SupportedLanguages contains the supported languages, including the default language
+type SupportedLanguages struct {
+	Language []string `xml:"ows:Language" yaml:"language"`
+}
+
+// Reference is a link to a document, like the WSDL of a process
+type Reference struct {
+	Href string `xml:"xlink:href,attr" yaml:"href"`
+}
+
+// process returns the brief description of the process with the identifier, or nil when it isn't offered
+func (o ProcessOfferings) process(identifier string) *ProcessBrief {
+	for i, p := range o.Process {
+		if p.Identifier == identifier {
+			return &o.Process[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package wps100
+
+import (
+	"encoding/xml"
+	"net/url"
+
+	"github.com/pdok/ogc-specifications/pkg/utils"
+	"github.com/pdok/ogc-specifications/pkg/wsc110"
+)
+
+// All is the identifier that requests the description of all processes
+const All = `ALL`
+
+// DescribeProcessRequest struct with the needed parameters/attributes needed for making a DescribeProcess request
+type DescribeProcessRequest struct {
+	XMLName xml.Name `xml:"wps:DescribeProcess" yaml:"describeProcess"`
+	BaseRequest
+	Identifier []string `xml:"ows:Identifier" yaml:"identifier"`
+}
+
+// Type returns DescribeProcess
+func (r DescribeProcessRequest) Type() string {
+	return describeprocess
+}
+
+// Validate checks whether the requested processes and the language are offered by the service
+func (r DescribeProcessRequest) Validate(c Capabilities) wsc110.Exceptions {
+	var exceptions wsc110.Exceptions
+	if len(r.Identifier) == 0 {
+		exceptions = append(exceptions, wsc110.MissingParameterValue(IDENTIFIER))
+	}
+	for _, identifier := range r.Identifier {
+		if identifier != All && c.ProcessOfferings.process(identifier) == nil {
+			exceptions = append(exceptions, wsc110.InvalidParameterValue(identifier, IDENTIFIER))
+		}
+	}
+	if r.Language != nil && !c.Languages.supports(*r.Language) {
+		exceptions = append(exceptions, wsc110.InvalidParameterValue(*r.Language, LANGUAGE))
+	}
+	if len(exceptions) > 0 {
+		return exceptions
+	}
+	return nil
+}
+
+// ParseXML builds a DescribeProcess object based on a XML document
+func (r *DescribeProcessRequest) ParseXML(doc []byte) wsc110.Exceptions {
+	var xmlattributes utils.XMLAttribute
+	if err := xml.Unmarshal(doc, &xmlattributes); err != nil {
+		return wsc110.Exceptions{wsc110.MissingParameterValue()}
+	}
+	if err := utils.UnmarshalPrefixed(doc, r, prefixes); err != nil {
+		return wsc110.Exceptions{wsc110.NoApplicableCode(err.Error())}
+	}
+	r.Attr = stripAttr(xmlattributes, `service`, `version`, `language`)
+	return nil
+}
+
+// ParseQueryParameters builds a DescribeProcess object based on the available query parameters
+func (r *DescribeProcessRequest) ParseQueryParameters(query url.Values) wsc110.Exceptions {
+	var dpv describeProcessRequestParameterValue
+	if exceptions := dpv.parseQueryParameters(query); exceptions != nil {
+		return exceptions
+	}
+	r.parseDescribeProcessRequestParameterValue(dpv)
+	return nil
+}
+
+// ToQueryParameters builds a new query string that will be proxied
+func (r DescribeProcessRequest) ToQueryParameters() url.Values {
+	var dpv describeProcessRequestParameterValue
+	dpv.parseDescribeProcessRequest(r)
+	return dpv.toQueryParameters()
+}
+
+// ToXML builds a 'new' XML document 'based' on the 'original' XML document
+func (r DescribeProcessRequest) ToXML() []byte {
+	r.XMLName = xml.Name{}
+	doc, _ := utils.NewEncoder(prefixes).Marshal(r)
+	return doc
+}
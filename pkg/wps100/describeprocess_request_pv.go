@@ -0,0 +1,44 @@
+package wps100
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/pdok/ogc-specifications/pkg/wsc110"
+)
+
+type describeProcessRequestParameterValue struct {
+	BaseRequest
+	identifier string `yaml:"identifier"`
+}
+
+func (dpv *describeProcessRequestParameterValue) parseQueryParameters(query url.Values) wsc110.Exceptions {
+	exceptions := dpv.BaseRequest.parseQueryParameters(query)
+	dpv.identifier = first(query, IDENTIFIER)
+	if dpv.identifier == `` {
+		exceptions = append(exceptions, wsc110.MissingParameterValue(IDENTIFIER))
+	}
+
+	if len(exceptions) > 0 {
+		return exceptions
+	}
+	return nil
+}
+
+func (r *DescribeProcessRequest) parseDescribeProcessRequestParameterValue(dpv describeProcessRequestParameterValue) {
+	r.XMLName.Local = describeprocess
+	r.BaseRequest = BaseRequest{Service: dpv.Service, Version: dpv.Version, Language: dpv.Language}
+	r.Identifier = list(dpv.identifier)
+}
+
+func (dpv *describeProcessRequestParameterValue) parseDescribeProcessRequest(r DescribeProcessRequest) {
+	dpv.BaseRequest = BaseRequest{Service: r.Service, Version: r.Version, Language: r.Language}
+	dpv.identifier = strings.Join(r.Identifier, `,`)
+}
+
+func (dpv describeProcessRequestParameterValue) toQueryParameters() url.Values {
+	query := url.Values{}
+	dpv.BaseRequest.setQueryParameters(query, describeprocess)
+	query[IDENTIFIER] = []string{dpv.identifier}
+	return query
+}
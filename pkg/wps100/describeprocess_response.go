@@ -0,0 +1,216 @@
+package wps100
+
+import (
+	"encoding/xml"
+
+	"github.com/pdok/ogc-specifications/pkg/utils"
+)
+
+// ProcessDescriptions is the DescribeProcess response, the elements of the descriptions are unqualified
+// except those from OWS Common
+type ProcessDescriptions struct {
+	XMLName            xml.Name             `xml:"wps:ProcessDescriptions" yaml:"processDescriptions"`
+	Service            string               `xml:"service,attr" yaml:"service"`
+	Version            string               `xml:"version,attr" yaml:"version"`
+	Lang               string               `xml:"xml:lang,attr" yaml:"lang"`
+	SchemaLocation     *string              `xml:"xsi:schemaLocation,attr,omitempty" yaml:"schemaLocation,omitempty"`
+	ProcessDescription []ProcessDescription `xml:"ProcessDescription" yaml:"processDescription"`
+}
+
+// ParseXML builds the ProcessDescriptions from a DescribeProcess response
+func (d *ProcessDescriptions) ParseXML(doc []byte) error {
+	return utils.UnmarshalPrefixed(doc, d, prefixes)
+}
+
+// ParseYAML builds the ProcessDescriptions from a YAML document, unknown keys are ignored
+func (d *ProcessDescriptions) ParseYAML(doc []byte) error {
+	return utils.UnmarshalYAML(doc, d, utils.Lenient)
+}
+
+// ToXML builds the DescribeProcess response
+func (d ProcessDescriptions) ToXML() []byte {
+	doc, _ := utils.NewEncoder(prefixes).Marshal(d)
+	return doc
+}
+
+// process returns the description of the process with the identifier, or nil when it isn't described
+func (d ProcessDescriptions) process(identifier string) *ProcessDescription {
+	for i, p := range d.ProcessDescription {
+		if p.Identifier == identifier {
+			return &d.ProcessDescription[i]
+		}
+	}
+	return nil
+}
+
+// ProcessDescription is the full description of a process, with its inputs and outputs
+type ProcessDescription struct {
+	StoreSupported  *bool `xml:"storeSupported,attr,omitempty" yaml:"storeSupported,omitempty"`
+	StatusSupported *bool `xml:"statusSupported,attr,omitempty" yaml:"statusSupported,omitempty"`
+	ProcessBrief    `yaml:",inline"`
+	DataInputs      *DataInputDescriptions    `xml:"DataInputs,omitempty" yaml:"dataInputs,omitempty"`
+	ProcessOutputs  ProcessOutputDescriptions `xml:"ProcessOutputs" yaml:"processOutputs"`
+}
+
+// input returns the description of the input with the identifier, or nil when the process has no such input
+func (p ProcessDescription) input(identifier string) *InputDescription {
+	if p.DataInputs == nil {
+		return nil
+	}
+	for i, input := range p.DataInputs.Input {
+		if input.Identifier == identifier {
+			return &p.DataInputs.Input[i]
+		}
+	}
+	return nil
+}
+
+// output returns the description of the output with the identifier, or nil when the process has no such output
+func (p ProcessDescription) output(identifier string) *OutputDescription {
+	for i, output := range p.ProcessOutputs.Output {
+		if output.Identifier == identifier {
+			return &p.ProcessOutputs.Output[i]
+		}
+	}
+	return nil
+}
+
+// DataInputDescriptions contains the descriptions of the inputs of a process
+type DataInputDescriptions struct {
+	Input []InputDescription `xml:"Input" yaml:"input"`
+}
+
+// ProcessOutputDescriptions contains the descriptions of the outputs of a process
+type ProcessOutputDescriptions struct {
+	Output []OutputDescription `xml:"Output" yaml:"output"`
+}
+
+// Description contains the identification shared by the inputs and outputs
+type Description struct {
+	Identifier string     `xml:"ows:Identifier" yaml:"identifier"`
+	Title      string     `xml:"ows:Title" yaml:"title"`
+	Abstract   *string    `xml:"ows:Abstract,omitempty" yaml:"abstract,omitempty"`
+	Metadata   []Metadata `xml:"ows:Metadata" yaml:"metadata,omitempty"`
+}
+
+// InputDescription describes an input, which is literal, complex or bounding box data
+type InputDescription struct {
+	MinOccurs       int `xml:"minOccurs,attr" yaml:"minOccurs"`
+	MaxOccurs       int `xml:"maxOccurs,attr" yaml:"maxOccurs"`
+	Description     `yaml:",inline"`
+	LiteralData     *LiteralInput         `xml:"LiteralData,omitempty" yaml:"literalData,omitempty"`
+	ComplexData     *SupportedComplexData `xml:"ComplexData,omitempty" yaml:"complexData,omitempty"`
+	BoundingBoxData *SupportedCRSs        `xml:"BoundingBoxData,omitempty" yaml:"boundingBoxData,omitempty"`
+}
+
+// OutputDescription describes an output, which is literal, complex or bounding box data
+type OutputDescription struct {
+	Description       `yaml:",inline"`
+	LiteralOutput     *LiteralOutput        `xml:"LiteralOutput,omitempty" yaml:"literalOutput,omitempty"`
+	ComplexOutput     *SupportedComplexData `xml:"ComplexOutput,omitempty" yaml:"complexOutput,omitempty"`
+	BoundingBoxOutput *SupportedCRSs        `xml:"BoundingBoxOutput,omitempty" yaml:"boundingBoxOutput,omitempty"`
+}
+
+// LiteralOutput describes the data type and units of measure of a literal output
+type LiteralOutput struct {
+	DataType *DataType      `xml:"ows:DataType,omitempty" yaml:"dataType,omitempty"`
+	UOMs     *SupportedUOMs `xml:"UOMs,omitempty" yaml:"uoms,omitempty"`
+}
+
+// LiteralInput describes a literal input, the allowed values are given by AllowedValues, AnyValue or ValuesReference
+type LiteralInput struct {
+	LiteralOutput   `yaml:",inline"`
+	AllowedValues   *AllowedValues   `xml:"ows:AllowedValues,omitempty" yaml:"allowedValues,omitempty"`
+	AnyValue        *AnyValue        `xml:"ows:AnyValue,omitempty" yaml:"anyValue,omitempty"`
+	ValuesReference *ValuesReference `xml:"ValuesReference,omitempty" yaml:"valuesReference,omitempty"`
+	DefaultValue    *string          `xml:"DefaultValue,omitempty" yaml:"defaultValue,omitempty"`
+}
+
+// DataType is the name and the reference of a data type, like xs:double
+type DataType struct {
+	Reference *string `xml:"ows:reference,attr,omitempty" yaml:"reference,omitempty"`
+	Value     string  `xml:",chardata" yaml:"value"`
+}
+
+// SupportedUOMs contains the default and the supported units of measure
+type SupportedUOMs struct {
+	Default struct {
+		UOM string `xml:"ows:UOM" yaml:"uom"`
+	} `xml:"Default" yaml:"default"`
+	Supported struct {
+		UOM []string `xml:"ows:UOM" yaml:"uom"`
+	} `xml:"Supported" yaml:"supported"`
+}
+
+// AllowedValues contains the values and ranges a literal input is restricted to
+type AllowedValues struct {
+	Value []string `xml:"ows:Value" yaml:"value,omitempty"`
+	Range []Range  `xml:"ows:Range" yaml:"range,omitempty"`
+}
+
+// Range of allowed values, the closure is closed, open, open-closed or closed-open
+type Range struct {
+	Closure      *string `xml:"ows:rangeClosure,attr,omitempty" yaml:"closure,omitempty"`
+	MinimumValue *string `xml:"ows:MinimumValue,omitempty" yaml:"minimumValue,omitempty"`
+	MaximumValue *string `xml:"ows:MaximumValue,omitempty" yaml:"maximumValue,omitempty"`
+	Spacing      *string `xml:"ows:Spacing,omitempty" yaml:"spacing,omitempty"`
+}
+
+// AnyValue marks a literal input that accepts any value
+type AnyValue struct{}
+
+// ValuesReference refers to the list of allowed values of a literal input
+type ValuesReference struct {
+	Reference  string `xml:"ows:reference,attr" yaml:"reference"`
+	ValuesForm string `xml:"valuesForm,attr" yaml:"valuesForm"`
+}
+
+// SupportedComplexData contains the default and the supported formats of a complex input or output
+type SupportedComplexData struct {
+	MaximumMegabytes *int `xml:"maximumMegabytes,attr,omitempty" yaml:"maximumMegabytes,omitempty"`
+	Default          struct {
+		Format Format `xml:"Format" yaml:"format"`
+	} `xml:"Default" yaml:"default"`
+	Supported struct {
+		Format []Format `xml:"Format" yaml:"format"`
+	} `xml:"Supported" yaml:"supported"`
+}
+
+// supports returns whether the combination of mime type, encoding and schema is one of the supported formats,
+// an empty value matches the format that doesn't restrict it
+func (s SupportedComplexData) supports(f Format) bool {
+	for _, format := range append([]Format{s.Default.Format}, s.Supported.Format...) {
+		if format.matches(f) {
+			return true
+		}
+	}
+	return false
+}
+
+// Format is a combination of a mime type, an encoding and a schema
+type Format struct {
+	MimeType string  `xml:"MimeType" yaml:"mimeType"`
+	Encoding *string `xml:"Encoding,omitempty" yaml:"encoding,omitempty"`
+	Schema   *string `xml:"Schema,omitempty" yaml:"schema,omitempty"`
+}
+
+// matches returns whether the requested format f is this format, the omitted values of f match any value
+func (format Format) matches(f Format) bool {
+	equal := func(a, b *string) bool { return b == nil || (a != nil && *a == *b) }
+	return (f.MimeType == `` || f.MimeType == format.MimeType) && equal(format.Encoding, f.Encoding) && equal(format.Schema, f.Schema)
+}
+
+// SupportedCRSs contains the default and the supported CRSs of a bounding box input or output
+type SupportedCRSs struct {
+	Default struct {
+		CRS string `xml:"CRS" yaml:"crs"`
+	} `xml:"Default" yaml:"default"`
+	Supported struct {
+		CRS []string `xml:"CRS" yaml:"crs"`
+	} `xml:"Supported" yaml:"supported"`
+}
+
+// supports returns whether the CRS is the default or one of the supported CRSs
+func (s SupportedCRSs) supports(crs string) bool {
+	return crs == s.Default.CRS || contains(s.Supported.CRS, crs)
+}
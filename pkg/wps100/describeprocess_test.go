@@ -0,0 +1,165 @@
+package wps100
+
+import (
+	"encoding/xml"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/pdok/ogc-specifications/pkg/wsc110"
+)
+
+const processDescriptionsDocument = `<?xml version="1.0" encoding="UTF-8"?>
+<wps:ProcessDescriptions xmlns:wps="http://www.opengis.net/wps/1.0.0" xmlns:ows="http://www.opengis.net/ows/1.1" service="WPS" version="1.0.0" xml:lang="en">
+  <ProcessDescription wps:processVersion="1.0" storeSupported="true" statusSupported="true">
+    <ows:Identifier>buffer</ows:Identifier>
+    <ows:Title>Buffer</ows:Title>
+    <DataInputs>
+      <Input minOccurs="1" maxOccurs="1">
+        <ows:Identifier>geometry</ows:Identifier>
+        <ows:Title>Geometry</ows:Title>
+        <ComplexData maximumMegabytes="5">
+          <Default><Format><MimeType>text/xml</MimeType><Schema>http://schemas.opengis.net/gml/3.1.1/base/geometryBasic2d.xsd</Schema></Format></Default>
+          <Supported><Format><MimeType>application/json</MimeType></Format></Supported>
+        </ComplexData>
+      </Input>
+      <Input minOccurs="1" maxOccurs="1">
+        <ows:Identifier>distance</ows:Identifier>
+        <ows:Title>Distance</ows:Title>
+        <LiteralData>
+          <ows:DataType ows:reference="http://www.w3.org/TR/xmlschema-2/#double">xs:double</ows:DataType>
+          <UOMs><Default><ows:UOM>m</ows:UOM></Default><Supported><ows:UOM>m</ows:UOM><ows:UOM>km</ows:UOM></Supported></UOMs>
+          <ows:AllowedValues><ows:Range ows:rangeClosure="open-closed"><ows:MinimumValue>0</ows:MinimumValue><ows:MaximumValue>1000</ows:MaximumValue></ows:Range></ows:AllowedValues>
+        </LiteralData>
+      </Input>
+      <Input minOccurs="0" maxOccurs="2">
+        <ows:Identifier>extent</ows:Identifier>
+        <ows:Title>Extent</ows:Title>
+        <BoundingBoxData>
+          <Default><CRS>EPSG:28992</CRS></Default>
+          <Supported><CRS>EPSG:28992</CRS><CRS>EPSG:4326</CRS></Supported>
+        </BoundingBoxData>
+      </Input>
+    </DataInputs>
+    <ProcessOutputs>
+      <Output>
+        <ows:Identifier>result</ows:Identifier>
+        <ows:Title>Result</ows:Title>
+        <ComplexOutput>
+          <Default><Format><MimeType>text/xml</MimeType></Format></Default>
+          <Supported><Format><MimeType>application/json</MimeType></Format></Supported>
+        </ComplexOutput>
+      </Output>
+      <Output>
+        <ows:Identifier>area</ows:Identifier>
+        <ows:Title>Area</ows:Title>
+        <LiteralOutput><ows:DataType>xs:double</ows:DataType></LiteralOutput>
+      </Output>
+    </ProcessOutputs>
+  </ProcessDescription>
+</wps:ProcessDescriptions>`
+
+func processDescriptions(t *testing.T) ProcessDescriptions {
+	var d ProcessDescriptions
+	if err := d.ParseXML([]byte(processDescriptionsDocument)); err != nil {
+		t.Fatalf("expected no error,\n got: %v", err)
+	}
+	return d
+}
+
+func TestDescribeProcessParseQueryParameters(t *testing.T) {
+	var tests = []struct {
+		query     url.Values
+		result    DescribeProcessRequest
+		exception string
+	}{
+		0: {query: url.Values{`service`: {`WPS`}, `version`: {`1.0.0`}, `request`: {`DescribeProcess`}, `identifier`: {`buffer,intersect`}},
+			result: DescribeProcessRequest{BaseRequest: BaseRequest{Service: Service, Version: Version}, Identifier: []string{`buffer`, `intersect`}}},
+		1: {query: url.Values{SERVICE: {`WPS`}, VERSION: {`1.0.0`}, REQUEST: {`DescribeProcess`}}, exception: `MissingParameterValue`},
+		2: {query: url.Values{SERVICE: {`WPS`}, VERSION: {`0.4.0`}, REQUEST: {`DescribeProcess`}, IDENTIFIER: {All}}, exception: `InvalidParameterValue`},
+	}
+
+	for k, test := range tests {
+		var r DescribeProcessRequest
+		exceptions := r.ParseQueryParameters(test.query)
+		if test.exception != `` {
+			if len(exceptions) != 1 || exceptions[0].Code() != test.exception {
+				t.Errorf("test: %d, expected: %s,\n got: %v", k, test.exception, exceptions)
+			}
+			continue
+		}
+		test.result.XMLName.Local = describeprocess
+		if !reflect.DeepEqual(r, test.result) {
+			t.Errorf("test: %d, expected: %+v,\n got: %+v", k, test.result, r)
+		}
+		if query := r.ToQueryParameters(); query.Get(IDENTIFIER) != `buffer,intersect` || query.Get(REQUEST) != describeprocess {
+			t.Errorf("test: %d, expected: %v,\n got: %v", k, test.query, query)
+		}
+	}
+}
+
+func TestDescribeProcessParseXML(t *testing.T) {
+	doc := `<wps:DescribeProcess xmlns:wps="http://www.opengis.net/wps/1.0.0" xmlns:o="http://www.opengis.net/ows/1.1" service="WPS" version="1.0.0" language="nl"><o:Identifier>buffer</o:Identifier><o:Identifier>intersect</o:Identifier></wps:DescribeProcess>`
+	expected := DescribeProcessRequest{BaseRequest: BaseRequest{Service: Service, Version: Version, Language: sp(`nl`)}, Identifier: []string{`buffer`, `intersect`}}
+
+	var r DescribeProcessRequest
+	if exceptions := r.ParseXML([]byte(doc)); exceptions != nil {
+		t.Fatalf("test: %d, expected no exceptions,\n got: %v", 0, exceptions)
+	}
+	r.XMLName, r.Attr = xml.Name{}, nil
+	if !reflect.DeepEqual(r, expected) {
+		t.Errorf("test: %d, expected: %+v,\n got: %+v", 0, expected, r)
+	}
+
+	body := `<wps:DescribeProcess xmlns:ows="http://www.opengis.net/ows/1.1" xmlns:wps="http://www.opengis.net/wps/1.0.0" service="WPS" version="1.0.0" language="nl"><ows:Identifier>buffer</ows:Identifier><ows:Identifier>intersect</ows:Identifier></wps:DescribeProcess>`
+	if result := string(r.ToXML()); !strings.HasSuffix(result, body) {
+		t.Errorf("test: %d, expected: %s,\n got: %s", 0, body, result)
+	}
+}
+
+func TestDescribeProcessValidate(t *testing.T) {
+	var tests = []struct {
+		request    DescribeProcessRequest
+		exceptions wsc110.Exceptions
+	}{
+		0: {request: DescribeProcessRequest{Identifier: []string{`buffer`}}},
+		1: {request: DescribeProcessRequest{Identifier: []string{All}, BaseRequest: BaseRequest{Language: sp(`en`)}}},
+		2: {request: DescribeProcessRequest{Identifier: []string{`buffer`, `clip`}, BaseRequest: BaseRequest{Language: sp(`fr`)}},
+			exceptions: wsc110.Exceptions{wsc110.InvalidParameterValue(`clip`, IDENTIFIER), wsc110.InvalidParameterValue(`fr`, LANGUAGE)}},
+		3: {request: DescribeProcessRequest{}, exceptions: wsc110.Exceptions{wsc110.MissingParameterValue(IDENTIFIER)}},
+	}
+
+	for k, test := range tests {
+		if exceptions := test.request.Validate(capabilities); !reflect.DeepEqual(exceptions, test.exceptions) {
+			t.Errorf("test: %d, expected: %v,\n got: %v", k, test.exceptions, exceptions)
+		}
+	}
+}
+
+func TestProcessDescriptionsParseXML(t *testing.T) {
+	d := processDescriptions(t)
+	if len(d.ProcessDescription) != 1 {
+		t.Fatalf("test: %d, expected: 1 process,\n got: %d", 0, len(d.ProcessDescription))
+	}
+	p := d.ProcessDescription[0]
+	if p.Identifier != `buffer` || p.ProcessVersion == nil || *p.ProcessVersion != `1.0` || p.StoreSupported == nil || !*p.StoreSupported {
+		t.Errorf("test: %d, expected: %s,\n got: %+v", 0, `buffer`, p)
+	}
+	distance := p.input(`distance`)
+	if distance == nil || distance.LiteralData == nil || distance.LiteralData.DataType.Value != `xs:double` ||
+		*distance.LiteralData.AllowedValues.Range[0].Closure != `open-closed` || distance.LiteralData.UOMs.Supported.UOM[1] != `km` {
+		t.Errorf("test: %d, expected: %s,\n got: %+v", 0, `distance`, distance)
+	}
+	if extent := p.input(`extent`); extent == nil || extent.MaxOccurs != 2 || !extent.BoundingBoxData.supports(`EPSG:4326`) {
+		t.Errorf("test: %d, expected: %s,\n got: %+v", 0, `extent`, extent)
+	}
+	if result := p.output(`result`); result == nil || !result.ComplexOutput.supports(Format{MimeType: `application/json`}) {
+		t.Errorf("test: %d, expected: %s,\n got: %+v", 0, `result`, result)
+	}
+
+	var n ProcessDescriptions
+	if err := n.ParseXML(d.ToXML()); err != nil || !reflect.DeepEqual(n, d) {
+		t.Errorf("test: %d, expected: %+v,\n got: %+v, %v", 0, d, n, err)
+	}
+}
@@ -0,0 +1,509 @@
+package wps100
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/pdok/ogc-specifications/pkg/utils"
+	"github.com/pdok/ogc-specifications/pkg/wsc110"
+)
+
+// Execute Keys
+const (
+	DATAINPUTS           = `DATAINPUTS`
+	RESPONSEDOCUMENT     = `RESPONSEDOCUMENT`
+	RAWDATAOUTPUT        = `RAWDATAOUTPUT`
+	STOREEXECUTERESPONSE = `STOREEXECUTERESPONSE`
+	LINEAGE              = `LINEAGE`
+	STATUS               = `STATUS`
+)
+
+// ExecuteRequest struct with the needed parameters/attributes needed for making a Execute request
+type ExecuteRequest struct {
+	XMLName xml.Name `xml:"wps:Execute" yaml:"execute"`
+	BaseRequest
+	Identifier   string        `xml:"ows:Identifier" yaml:"identifier"`
+	DataInputs   *DataInputs   `xml:"wps:DataInputs,omitempty" yaml:"dataInputs,omitempty"`
+	ResponseForm *ResponseForm `xml:"wps:ResponseForm,omitempty" yaml:"responseForm,omitempty"`
+}
+
+// DataInputs contains the inputs of the process
+type DataInputs struct {
+	Input []Input `xml:"wps:Input" yaml:"input"`
+}
+
+// Input is the value of an input, given as data or as a reference to a web accessible resource
+type Input struct {
+	Identifier string          `xml:"ows:Identifier" yaml:"identifier"`
+	Title      *string         `xml:"ows:Title,omitempty" yaml:"title,omitempty"`
+	Abstract   *string         `xml:"ows:Abstract,omitempty" yaml:"abstract,omitempty"`
+	Reference  *InputReference `xml:"wps:Reference,omitempty" yaml:"reference,omitempty"`
+	Data       *Data           `xml:"wps:Data,omitempty" yaml:"data,omitempty"`
+}
+
+// InputReference is the reference to the resource of an input, that is retrieved by the service
+type InputReference struct {
+	Href          string     `xml:"xlink:href,attr" yaml:"href"`
+	Method        *string    `xml:"method,attr,omitempty" yaml:"method,omitempty"`
+	MimeType      *string    `xml:"mimeType,attr,omitempty" yaml:"mimeType,omitempty"`
+	Encoding      *string    `xml:"encoding,attr,omitempty" yaml:"encoding,omitempty"`
+	Schema        *string    `xml:"schema,attr,omitempty" yaml:"schema,omitempty"`
+	Header        []Header   `xml:"wps:Header" yaml:"header,omitempty"`
+	Body          *Content   `xml:"wps:Body,omitempty" yaml:"body,omitempty"`
+	BodyReference *Reference `xml:"wps:BodyReference,omitempty" yaml:"bodyReference,omitempty"`
+}
+
+// Header is a HTTP header of the request for the referenced input
+type Header struct {
+	Key   string `xml:"key,attr" yaml:"key"`
+	Value string `xml:"value,attr" yaml:"value"`
+}
+
+// Data is the value of an input or output, one of the fields is set
+type Data struct {
+	ComplexData     *ComplexData        `xml:"wps:ComplexData,omitempty" yaml:"complexData,omitempty"`
+	LiteralData     *LiteralData        `xml:"wps:LiteralData,omitempty" yaml:"literalData,omitempty"`
+	BoundingBoxData *wsc110.BoundingBox `xml:"wps:BoundingBoxData,omitempty" yaml:"boundingBoxData,omitempty"`
+}
+
+// LiteralData is a single value, like a number or a string
+type LiteralData struct {
+	DataType *string `xml:"dataType,attr,omitempty" yaml:"dataType,omitempty"`
+	UOM      *string `xml:"uom,attr,omitempty" yaml:"uom,omitempty"`
+	Value    string  `xml:",chardata" yaml:"value"`
+}
+
+// ComplexData is a document, like a GML geometry or a GeoJSON feature collection, in the given format
+type ComplexData struct {
+	MimeType *string `yaml:"mimeType,omitempty"`
+	Encoding *string `yaml:"encoding,omitempty"`
+	Schema   *string `yaml:"schema,omitempty"`
+	Content  `yaml:",inline"`
+}
+
+// format returns the format of the complex data
+func (c ComplexData) format() Format {
+	f := Format{Encoding: c.Encoding, Schema: c.Schema}
+	if c.MimeType != nil {
+		f.MimeType = *c.MimeType
+	}
+	return f
+}
+
+// UnmarshalXML keeps the content of the complex data as is
+func (c *ComplexData) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, a := range start.Attr {
+		value := a.Value
+		switch a.Name.Local {
+		case `mimeType`:
+			c.MimeType = &value
+		case `encoding`:
+			c.Encoding = &value
+		case `schema`:
+			c.Schema = &value
+		}
+	}
+	return c.Content.UnmarshalXML(d, start)
+}
+
+// MarshalXML writes the content of the complex data as is
+func (c ComplexData) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(struct {
+		MimeType *string `xml:"mimeType,attr,omitempty"`
+		Encoding *string `xml:"encoding,attr,omitempty"`
+		Schema   *string `xml:"schema,attr,omitempty"`
+		Content  string  `xml:",innerxml"`
+	}{c.MimeType, c.Encoding, c.Schema, c.Content.Content}, start)
+}
+
+// Content is XML content that is kept as is, the namespaces of the content have to be known by the service
+type Content struct {
+	Content string `yaml:"content"`
+}
+
+// UnmarshalXML keeps the inner XML of the element
+func (c *Content) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	content, err := utils.InnerXML(d, start)
+	c.Content = content
+	return err
+}
+
+// MarshalXML writes the content as is
+func (c Content) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(struct {
+		Content string `xml:",innerxml"`
+	}{c.Content}, start)
+}
+
+// ResponseForm is a response document or the raw data of a single output
+type ResponseForm struct {
+	ResponseDocument *ResponseDocument `xml:"wps:ResponseDocument,omitempty" yaml:"responseDocument,omitempty"`
+	RawDataOutput    *OutputDefinition `xml:"wps:RawDataOutput,omitempty" yaml:"rawDataOutput,omitempty"`
+}
+
+// ResponseDocument selects the outputs of the ExecuteResponse and whether the process runs asynchronously,
+// all outputs are returned when none are given
+type ResponseDocument struct {
+	StoreExecuteResponse *bool                      `xml:"storeExecuteResponse,attr,omitempty" yaml:"storeExecuteResponse,omitempty"`
+	Lineage              *bool                      `xml:"lineage,attr,omitempty" yaml:"lineage,omitempty"`
+	Status               *bool                      `xml:"status,attr,omitempty" yaml:"status,omitempty"`
+	Output               []DocumentOutputDefinition `xml:"wps:Output" yaml:"output,omitempty"`
+}
+
+// OutputDefinition is the requested format of an output
+type OutputDefinition struct {
+	MimeType   *string `xml:"mimeType,attr,omitempty" yaml:"mimeType,omitempty"`
+	Encoding   *string `xml:"encoding,attr,omitempty" yaml:"encoding,omitempty"`
+	Schema     *string `xml:"schema,attr,omitempty" yaml:"schema,omitempty"`
+	UOM        *string `xml:"uom,attr,omitempty" yaml:"uom,omitempty"`
+	Identifier string  `xml:"ows:Identifier" yaml:"identifier"`
+}
+
+// format returns the requested format of the output
+func (o OutputDefinition) format() Format {
+	f := Format{Encoding: o.Encoding, Schema: o.Schema}
+	if o.MimeType != nil {
+		f.MimeType = *o.MimeType
+	}
+	return f
+}
+
+// DocumentOutputDefinition is an output of the response document, that is embedded or stored and referenced
+type DocumentOutputDefinition struct {
+	AsReference      *bool `xml:"asReference,attr,omitempty" yaml:"asReference,omitempty"`
+	OutputDefinition `yaml:",inline"`
+	Title            *string `xml:"ows:Title,omitempty" yaml:"title,omitempty"`
+	Abstract         *string `xml:"ows:Abstract,omitempty" yaml:"abstract,omitempty"`
+}
+
+// Type returns Execute
+func (r ExecuteRequest) Type() string {
+	return execute
+}
+
+// Async returns whether the process runs asynchronously, the response is stored and its status updated
+// while the process runs
+func (r ExecuteRequest) Async() bool {
+	if r.ResponseForm == nil || r.ResponseForm.ResponseDocument == nil {
+		return false
+	}
+	d := r.ResponseForm.ResponseDocument
+	return d.StoreExecuteResponse != nil && *d.StoreExecuteResponse && d.Status != nil && *d.Status
+}
+
+// inputs returns the inputs of the request
+func (r ExecuteRequest) inputs() []Input {
+	if r.DataInputs == nil {
+		return nil
+	}
+	return r.DataInputs.Input
+}
+
+// Validate checks the inputs and requested outputs of the Execute request against the description of the process
+func (r ExecuteRequest) Validate(d ProcessDescriptions) wsc110.Exceptions {
+	p := d.process(r.Identifier)
+	if p == nil {
+		if r.Identifier == `` {
+			return wsc110.Exceptions{wsc110.MissingParameterValue(IDENTIFIER)}
+		}
+		return wsc110.Exceptions{wsc110.InvalidParameterValue(r.Identifier, IDENTIFIER)}
+	}
+
+	exceptions := validateInputs(r.inputs(), *p)
+	if r.ResponseForm != nil {
+		exceptions = append(exceptions, r.ResponseForm.validate(*p)...)
+	}
+	if len(exceptions) > 0 {
+		return exceptions
+	}
+	return nil
+}
+
+// validateInputs checks the occurrences and the values of the inputs
+func validateInputs(inputs []Input, p ProcessDescription) wsc110.Exceptions {
+	var exceptions wsc110.Exceptions
+	occurs := map[string]int{}
+	for _, input := range inputs {
+		description := p.input(input.Identifier)
+		if description == nil {
+			exceptions = append(exceptions, wsc110.InvalidParameterValue(input.Identifier, DATAINPUTS))
+			continue
+		}
+		if occurs[input.Identifier]++; occurs[input.Identifier] == description.MaxOccurs+1 {
+			exceptions = append(exceptions, wsc110.InvalidParameterValue(input.Identifier, DATAINPUTS))
+		}
+		if err := input.validate(*description); err != nil {
+			exceptions = append(exceptions, wsc110.InvalidParameterValue(err.Error(), input.Identifier))
+		}
+	}
+	if p.DataInputs != nil {
+		for _, description := range p.DataInputs.Input {
+			if occurs[description.Identifier] < description.MinOccurs {
+				exceptions = append(exceptions, wsc110.MissingParameterValue(description.Identifier))
+			}
+		}
+	}
+	return exceptions
+}
+
+// validate checks the value of the input against its description
+func (i Input) validate(d InputDescription) error {
+	if i.Reference != nil {
+		if d.ComplexData == nil {
+			return nil
+		}
+		f := Format{Encoding: i.Reference.Encoding, Schema: i.Reference.Schema}
+		if i.Reference.MimeType != nil {
+			f.MimeType = *i.Reference.MimeType
+		}
+		if !d.ComplexData.supports(f) {
+			return fmt.Errorf("unsupported format: %s", f.MimeType)
+		}
+		return nil
+	}
+	if i.Data == nil {
+		return fmt.Errorf("no data")
+	}
+
+	switch {
+	case i.Data.LiteralData != nil && d.LiteralData != nil:
+		return d.LiteralData.validate(*i.Data.LiteralData)
+	case i.Data.ComplexData != nil && d.ComplexData != nil:
+		if !d.ComplexData.supports(i.Data.ComplexData.format()) {
+			return fmt.Errorf("unsupported format: %s", i.Data.ComplexData.format().MimeType)
+		}
+		return nil
+	case i.Data.BoundingBoxData != nil && d.BoundingBoxData != nil:
+		if crs := i.Data.BoundingBoxData.Crs; crs != `` && !d.BoundingBoxData.supports(crs) {
+			return fmt.Errorf("unsupported crs: %s", crs)
+		}
+		return nil
+	}
+	return fmt.Errorf("data doesn't match the input description")
+}
+
+// validate checks the data type, the allowed values and the unit of measure of a literal value
+func (l LiteralInput) validate(data LiteralData) error {
+	if l.DataType != nil && !validLiteral(l.DataType.Value, data.Value) {
+		return fmt.Errorf("%s isn't a %s", data.Value, l.DataType.Value)
+	}
+	if l.AllowedValues != nil && !l.AllowedValues.allows(data.Value) {
+		return fmt.Errorf("%s isn't allowed", data.Value)
+	}
+	if data.UOM != nil && (l.UOMs == nil || (*data.UOM != l.UOMs.Default.UOM && !contains(l.UOMs.Supported.UOM, *data.UOM))) {
+		return fmt.Errorf("unsupported uom: %s", *data.UOM)
+	}
+	return nil
+}
+
+// validLiteral returns whether the value is valid for the XML schema data type, unknown data types accept every value
+func validLiteral(dataType, value string) bool {
+	var err error
+	switch dataType[strings.Index(dataType, `:`)+1:] {
+	case `double`, `float`, `decimal`:
+		_, err = strconv.ParseFloat(value, 64)
+	case `integer`, `int`, `long`, `short`, `nonNegativeInteger`, `positiveInteger`:
+		_, err = strconv.ParseInt(value, 10, 64)
+	case `boolean`:
+		_, err = strconv.ParseBool(value)
+	}
+	return err == nil
+}
+
+// allows returns whether the value is one of the allowed values or within one of the ranges
+func (a AllowedValues) allows(value string) bool {
+	if contains(a.Value, value) {
+		return true
+	}
+	for _, r := range a.Range {
+		if r.contains(value) {
+			return true
+		}
+	}
+	return false
+}
+
+// contains returns whether the numeric value is within the range, the closure defaults to closed
+func (r Range) contains(value string) bool {
+	v, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return false
+	}
+	closure := `closed`
+	if r.Closure != nil {
+		closure = *r.Closure
+	}
+	if r.MinimumValue != nil {
+		minimum, err := strconv.ParseFloat(*r.MinimumValue, 64)
+		if err != nil || v < minimum || (v == minimum && (closure == `open` || closure == `open-closed`)) {
+			return false
+		}
+	}
+	if r.MaximumValue != nil {
+		maximum, err := strconv.ParseFloat(*r.MaximumValue, 64)
+		if err != nil || v > maximum || (v == maximum && (closure == `open` || closure == `closed-open`)) {
+			return false
+		}
+	}
+	return true
+}
+
+// validate checks the requested outputs and whether storing the response and updating the status are supported,
+// the RawDataOutput and ResponseDocument exclude each other
+func (f ResponseForm) validate(p ProcessDescription) wsc110.Exceptions {
+	if f.RawDataOutput != nil && f.ResponseDocument != nil {
+		return wsc110.InvalidParameterValue(f.RawDataOutput.Identifier, RAWDATAOUTPUT).ToExceptions()
+	}
+
+	var exceptions wsc110.Exceptions
+	if f.RawDataOutput != nil {
+		if o := p.output(f.RawDataOutput.Identifier); o == nil || !o.supports(f.RawDataOutput.format()) {
+			exceptions = append(exceptions, wsc110.InvalidParameterValue(f.RawDataOutput.Identifier, RAWDATAOUTPUT))
+		}
+	}
+	if f.ResponseDocument == nil {
+		return exceptions
+	}
+
+	d := f.ResponseDocument
+	for _, output := range d.Output {
+		if o := p.output(output.Identifier); o == nil || !o.supports(output.format()) {
+			exceptions = append(exceptions, wsc110.InvalidParameterValue(output.Identifier, RESPONSEDOCUMENT))
+		}
+	}
+	store, status := d.StoreExecuteResponse != nil && *d.StoreExecuteResponse, d.Status != nil && *d.Status
+	if store && (p.StoreSupported == nil || !*p.StoreSupported) {
+		exceptions = append(exceptions, wsc110.OptionNotSupported(`storeExecuteResponse isn't supported by process: `+p.Identifier))
+	}
+	if status && (p.StatusSupported == nil || !*p.StatusSupported) {
+		exceptions = append(exceptions, wsc110.OptionNotSupported(`status isn't supported by process: `+p.Identifier))
+	}
+	if status && !store {
+		exceptions = append(exceptions, wsc110.InvalidParameterValue(strconv.FormatBool(status), STATUS))
+	}
+	return exceptions
+}
+
+// supports returns whether the output can be returned in the format, the format only applies to complex outputs
+func (o OutputDescription) supports(f Format) bool {
+	if o.ComplexOutput == nil || (f == Format{}) {
+		return true
+	}
+	return o.ComplexOutput.supports(f)
+}
+
+// ResolveInputs returns the request with the literal values of the key-value-pair encoding turned into the
+// bounding box or complex data the process describes the inputs as, a bounding box is given as
+// minx,miny,maxx,maxy[,crs[,dimensions]]
+func (r ExecuteRequest) ResolveInputs(d ProcessDescription) (ExecuteRequest, wsc110.Exceptions) {
+	if r.DataInputs == nil {
+		return r, nil
+	}
+
+	var exceptions wsc110.Exceptions
+	inputs := make([]Input, 0, len(r.DataInputs.Input))
+	for _, input := range r.DataInputs.Input {
+		description := d.input(input.Identifier)
+		if description == nil || input.Data == nil || input.Data.LiteralData == nil {
+			inputs = append(inputs, input)
+			continue
+		}
+		literal := *input.Data.LiteralData
+		switch {
+		case description.BoundingBoxData != nil:
+			bbox, err := parseBoundingBox(literal.Value)
+			if err != nil {
+				exceptions = append(exceptions, wsc110.InvalidParameterValue(literal.Value, input.Identifier))
+				break
+			}
+			input.Data = &Data{BoundingBoxData: &bbox}
+		case description.ComplexData != nil:
+			input.Data = &Data{ComplexData: &ComplexData{Content: Content{Content: literal.Value}}}
+		}
+		inputs = append(inputs, input)
+	}
+	r.DataInputs = &DataInputs{Input: inputs}
+	if len(exceptions) > 0 {
+		return r, exceptions
+	}
+	return r, nil
+}
+
+// parseBoundingBox parses a minx,miny,maxx,maxy[,crs[,dimensions]] bounding box
+func parseBoundingBox(s string) (wsc110.BoundingBox, error) {
+	values := strings.Split(s, `,`)
+	if len(values) < 4 || len(values) > 6 {
+		return wsc110.BoundingBox{}, fmt.Errorf("bounding box needs 4 coordinates, got: %s", s)
+	}
+	var coordinates [4]float64
+	for i := range coordinates {
+		f, err := strconv.ParseFloat(strings.TrimSpace(values[i]), 64)
+		if err != nil {
+			return wsc110.BoundingBox{}, err
+		}
+		coordinates[i] = f
+	}
+	bbox := wsc110.BoundingBox{LowerCorner: wsc110.Position{coordinates[0], coordinates[1]}, UpperCorner: wsc110.Position{coordinates[2], coordinates[3]}}
+	if len(values) > 4 {
+		bbox.Crs = values[4]
+	}
+	if len(values) > 5 {
+		bbox.Dimensions = values[5]
+	}
+	return bbox, nil
+}
+
+// formatBoundingBox formats the bounding box as minx,miny,maxx,maxy[,crs[,dimensions]]
+func formatBoundingBox(b wsc110.BoundingBox) string {
+	values := []string{}
+	for _, f := range []float64{b.LowerCorner[0], b.LowerCorner[1], b.UpperCorner[0], b.UpperCorner[1]} {
+		values = append(values, strconv.FormatFloat(f, 'f', -1, 64))
+	}
+	if b.Crs != `` || b.Dimensions != `` {
+		values = append(values, b.Crs)
+	}
+	if b.Dimensions != `` {
+		values = append(values, b.Dimensions)
+	}
+	return strings.Join(values, `,`)
+}
+
+// ParseXML builds a Execute object based on a XML document
+func (r *ExecuteRequest) ParseXML(doc []byte) wsc110.Exceptions {
+	var xmlattributes utils.XMLAttribute
+	if err := xml.Unmarshal(doc, &xmlattributes); err != nil {
+		return wsc110.Exceptions{wsc110.MissingParameterValue()}
+	}
+	if err := utils.UnmarshalPrefixed(doc, r, prefixes); err != nil {
+		return wsc110.Exceptions{wsc110.NoApplicableCode(err.Error())}
+	}
+	r.Attr = stripAttr(xmlattributes, `service`, `version`, `language`)
+	return nil
+}
+
+// ParseQueryParameters builds a Execute object based on the available query parameters,
+// the values of bounding box and complex inputs are literal data until they are resolved by ResolveInputs
+func (r *ExecuteRequest) ParseQueryParameters(query url.Values) wsc110.Exceptions {
+	var epv executeRequestParameterValue
+	if exceptions := epv.parseQueryParameters(query); exceptions != nil {
+		return exceptions
+	}
+	return r.parseExecuteRequestParameterValue(epv)
+}
+
+// ToQueryParameters builds a new query string that will be proxied
+func (r ExecuteRequest) ToQueryParameters() url.Values {
+	var epv executeRequestParameterValue
+	epv.parseExecuteRequest(r)
+	return epv.toQueryParameters()
+}
+
+// ToXML builds a 'new' XML document 'based' on the 'original' XML document
+func (r ExecuteRequest) ToXML() []byte {
+	r.XMLName = xml.Name{}
+	doc, _ := utils.NewEncoder(prefixes).Marshal(r)
+	return doc
+}
@@ -0,0 +1,294 @@
+package wps100
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/pdok/ogc-specifications/pkg/wsc110"
+)
+
+// kvpEscaper escapes the characters that separate the inputs, outputs and their attributes in the
+// DataInputs, ResponseDocument and RawDataOutput parameters
+var kvpEscaper = strings.NewReplacer(`%`, `%25`, `;`, `%3B`, `@`, `%40`, `=`, `%3D`)
+
+type executeRequestParameterValue struct {
+	BaseRequest
+	identifier           string  `yaml:"identifier"`
+	dataInputs           *string `yaml:"dataInputs"`
+	responseDocument     *string `yaml:"responseDocument"`
+	rawDataOutput        *string `yaml:"rawDataOutput"`
+	storeExecuteResponse *string `yaml:"storeExecuteResponse"` // default: false
+	lineage              *string `yaml:"lineage"`              // default: false
+	status               *string `yaml:"status"`               // default: false
+}
+
+func (epv *executeRequestParameterValue) parseQueryParameters(query url.Values) wsc110.Exceptions {
+	exceptions := epv.BaseRequest.parseQueryParameters(query)
+	epv.identifier = first(query, IDENTIFIER)
+	if epv.identifier == `` {
+		exceptions = append(exceptions, wsc110.MissingParameterValue(IDENTIFIER))
+	}
+	for _, p := range []struct {
+		key   string
+		field **string
+	}{{DATAINPUTS, &epv.dataInputs}, {RESPONSEDOCUMENT, &epv.responseDocument}, {RAWDATAOUTPUT, &epv.rawDataOutput},
+		{STOREEXECUTERESPONSE, &epv.storeExecuteResponse}, {LINEAGE, &epv.lineage}, {STATUS, &epv.status}} {
+		if value := first(query, p.key); value != `` {
+			*p.field = &value
+		}
+	}
+	// the RawDataOutput is a choice next to the ResponseDocument, storeExecuteResponse, lineage and status are part of the latter
+	if epv.rawDataOutput != nil && (epv.responseDocument != nil || epv.storeExecuteResponse != nil || epv.lineage != nil || epv.status != nil) {
+		exceptions = append(exceptions, wsc110.InvalidParameterValue(*epv.rawDataOutput, RAWDATAOUTPUT))
+	}
+
+	if len(exceptions) > 0 {
+		return exceptions
+	}
+	return nil
+}
+
+// kvpItem is an input or output of the key-value-pair encoding, like id=value@uom=m
+type kvpItem struct {
+	identifier string
+	value      *string
+	// attr contains the attributes by their lower case name
+	attr map[string]string
+}
+
+// parseKVPItems parses the ; separated items, the value is mandatory for the inputs
+func parseKVPItems(s string, withValue bool) ([]kvpItem, error) {
+	var items []kvpItem
+	for _, part := range strings.Split(s, `;`) {
+		if part == `` {
+			continue
+		}
+		attrs := strings.Split(part, `@`)
+		item := kvpItem{attr: map[string]string{}}
+		identifier, value, found := strings.Cut(attrs[0], `=`)
+		if found != withValue {
+			return nil, fmt.Errorf("invalid item: %s", part)
+		}
+		var err error
+		if item.identifier, err = url.PathUnescape(identifier); err != nil || item.identifier == `` {
+			return nil, fmt.Errorf("invalid item: %s", part)
+		}
+		if withValue {
+			v, err := url.PathUnescape(value)
+			if err != nil {
+				return nil, err
+			}
+			item.value = &v
+		}
+		for _, attr := range attrs[1:] {
+			key, value, found := strings.Cut(attr, `=`)
+			v, err := url.PathUnescape(value)
+			if !found || err != nil {
+				return nil, fmt.Errorf("invalid attribute: %s", attr)
+			}
+			item.attr[strings.ToLower(key)] = v
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// get returns the attribute of the item with the lower case name
+func (i kvpItem) get(name string) *string {
+	if v, ok := i.attr[name]; ok {
+		return &v
+	}
+	return nil
+}
+
+// kvpAttr is an attribute of a formatted item
+type kvpAttr struct {
+	name  string
+	value *string
+}
+
+// formatKVPItem formats an item with the attributes in the given order, the attributes without a value are left out
+func formatKVPItem(identifier string, value *string, attr ...kvpAttr) string {
+	s := kvpEscaper.Replace(identifier)
+	if value != nil {
+		s += `=` + kvpEscaper.Replace(*value)
+	}
+	for _, a := range attr {
+		if a.value != nil {
+			s += `@` + a.name + `=` + kvpEscaper.Replace(*a.value)
+		}
+	}
+	return s
+}
+
+func (r *ExecuteRequest) parseExecuteRequestParameterValue(epv executeRequestParameterValue) wsc110.Exceptions { //nolint:cyclop
+	r.XMLName.Local = execute
+	r.BaseRequest = BaseRequest{Service: epv.Service, Version: epv.Version, Language: epv.Language}
+	r.Identifier = epv.identifier
+
+	var exceptions wsc110.Exceptions
+	if epv.dataInputs != nil {
+		items, err := parseKVPItems(*epv.dataInputs, true)
+		if err != nil {
+			exceptions = append(exceptions, wsc110.InvalidParameterValue(*epv.dataInputs, DATAINPUTS))
+		}
+		r.DataInputs = &DataInputs{}
+		for _, item := range items {
+			r.DataInputs.Input = append(r.DataInputs.Input, item.input())
+		}
+	}
+
+	if epv.rawDataOutput != nil {
+		items, err := parseKVPItems(*epv.rawDataOutput, false)
+		if err != nil || len(items) != 1 {
+			exceptions = append(exceptions, wsc110.InvalidParameterValue(*epv.rawDataOutput, RAWDATAOUTPUT))
+		} else {
+			o := items[0].output()
+			r.ResponseForm = &ResponseForm{RawDataOutput: &o.OutputDefinition}
+		}
+	}
+	if epv.responseDocument != nil || epv.storeExecuteResponse != nil || epv.lineage != nil || epv.status != nil {
+		d := ResponseDocument{}
+		if epv.responseDocument != nil {
+			items, err := parseKVPItems(*epv.responseDocument, false)
+			if err != nil {
+				exceptions = append(exceptions, wsc110.InvalidParameterValue(*epv.responseDocument, RESPONSEDOCUMENT))
+			}
+			for _, item := range items {
+				d.Output = append(d.Output, item.output())
+			}
+		}
+		for _, b := range []struct {
+			key   string
+			value *string
+			field **bool
+		}{{STOREEXECUTERESPONSE, epv.storeExecuteResponse, &d.StoreExecuteResponse}, {LINEAGE, epv.lineage, &d.Lineage}, {STATUS, epv.status, &d.Status}} {
+			if b.value == nil {
+				continue
+			}
+			v, err := strconv.ParseBool(*b.value)
+			if err != nil {
+				exceptions = append(exceptions, wsc110.InvalidParameterValue(*b.value, b.key))
+			}
+			*b.field = &v
+		}
+		if r.ResponseForm == nil {
+			r.ResponseForm = &ResponseForm{}
+		}
+		r.ResponseForm.ResponseDocument = &d
+	}
+
+	if len(exceptions) > 0 {
+		return exceptions
+	}
+	return nil
+}
+
+// input returns the reference, complex data or literal data the attributes of the item point to
+func (i kvpItem) input() Input {
+	input := Input{Identifier: i.identifier}
+	href := i.get(`xlink:href`)
+	if href == nil {
+		href = i.get(`href`)
+	}
+	switch {
+	case href != nil:
+		input.Reference = &InputReference{Href: *href, Method: i.get(`method`), MimeType: i.get(`mimetype`), Encoding: i.get(`encoding`), Schema: i.get(`schema`)}
+	case i.get(`mimetype`) != nil || i.get(`encoding`) != nil || i.get(`schema`) != nil:
+		input.Data = &Data{ComplexData: &ComplexData{MimeType: i.get(`mimetype`), Encoding: i.get(`encoding`), Schema: i.get(`schema`), Content: Content{Content: *i.value}}}
+	default:
+		input.Data = &Data{LiteralData: &LiteralData{DataType: i.get(`datatype`), UOM: i.get(`uom`), Value: *i.value}}
+	}
+	return input
+}
+
+// output returns the output definition of the item
+func (i kvpItem) output() DocumentOutputDefinition {
+	o := DocumentOutputDefinition{OutputDefinition: OutputDefinition{Identifier: i.identifier,
+		MimeType: i.get(`mimetype`), Encoding: i.get(`encoding`), Schema: i.get(`schema`), UOM: i.get(`uom`)}}
+	if asReference := i.get(`asreference`); asReference != nil {
+		b, _ := strconv.ParseBool(*asReference)
+		o.AsReference = &b
+	}
+	return o
+}
+
+func (epv *executeRequestParameterValue) parseExecuteRequest(r ExecuteRequest) {
+	epv.BaseRequest = BaseRequest{Service: r.Service, Version: r.Version, Language: r.Language}
+	epv.identifier = r.Identifier
+	if r.DataInputs != nil {
+		inputs := make([]string, 0, len(r.DataInputs.Input))
+		for _, input := range r.DataInputs.Input {
+			inputs = append(inputs, formatInput(input))
+		}
+		epv.dataInputs = sp(strings.Join(inputs, `;`))
+	}
+	if r.ResponseForm == nil {
+		return
+	}
+	if o := r.ResponseForm.RawDataOutput; o != nil {
+		epv.rawDataOutput = sp(formatOutput(DocumentOutputDefinition{OutputDefinition: *o}))
+	}
+	if d := r.ResponseForm.ResponseDocument; d != nil {
+		if len(d.Output) > 0 {
+			outputs := make([]string, 0, len(d.Output))
+			for _, o := range d.Output {
+				outputs = append(outputs, formatOutput(o))
+			}
+			epv.responseDocument = sp(strings.Join(outputs, `;`))
+		}
+		epv.storeExecuteResponse, epv.lineage, epv.status = formatBool(d.StoreExecuteResponse), formatBool(d.Lineage), formatBool(d.Status)
+	}
+}
+
+// formatInput formats the input as id=value@attribute=value
+func formatInput(input Input) string {
+	switch {
+	case input.Reference != nil:
+		ref := input.Reference
+		return formatKVPItem(input.Identifier, sp(``), kvpAttr{`xlink:href`, &ref.Href}, kvpAttr{`method`, ref.Method},
+			kvpAttr{`mimeType`, ref.MimeType}, kvpAttr{`encoding`, ref.Encoding}, kvpAttr{`schema`, ref.Schema})
+	case input.Data == nil:
+		return formatKVPItem(input.Identifier, sp(``))
+	case input.Data.ComplexData != nil:
+		c := input.Data.ComplexData
+		return formatKVPItem(input.Identifier, &c.Content.Content, kvpAttr{`mimeType`, c.MimeType}, kvpAttr{`encoding`, c.Encoding}, kvpAttr{`schema`, c.Schema})
+	case input.Data.BoundingBoxData != nil:
+		return formatKVPItem(input.Identifier, sp(formatBoundingBox(*input.Data.BoundingBoxData)))
+	case input.Data.LiteralData != nil:
+		l := input.Data.LiteralData
+		return formatKVPItem(input.Identifier, &l.Value, kvpAttr{`dataType`, l.DataType}, kvpAttr{`uom`, l.UOM})
+	}
+	return formatKVPItem(input.Identifier, sp(``))
+}
+
+// formatOutput formats the output as id@attribute=value
+func formatOutput(o DocumentOutputDefinition) string {
+	return formatKVPItem(o.Identifier, nil, kvpAttr{`asReference`, formatBool(o.AsReference)}, kvpAttr{`mimeType`, o.MimeType},
+		kvpAttr{`encoding`, o.Encoding}, kvpAttr{`schema`, o.Schema}, kvpAttr{`uom`, o.UOM})
+}
+
+func formatBool(b *bool) *string {
+	if b == nil {
+		return nil
+	}
+	return sp(strconv.FormatBool(*b))
+}
+
+func (epv executeRequestParameterValue) toQueryParameters() url.Values {
+	query := url.Values{}
+	epv.BaseRequest.setQueryParameters(query, execute)
+	query[IDENTIFIER] = []string{epv.identifier}
+	for _, p := range []struct {
+		key   string
+		value *string
+	}{{DATAINPUTS, epv.dataInputs}, {RESPONSEDOCUMENT, epv.responseDocument}, {RAWDATAOUTPUT, epv.rawDataOutput},
+		{STOREEXECUTERESPONSE, epv.storeExecuteResponse}, {LINEAGE, epv.lineage}, {STATUS, epv.status}} {
+		if p.value != nil {
+			query[p.key] = []string{*p.value}
+		}
+	}
+	return query
+}
@@ -0,0 +1,143 @@
+package wps100
+
+import (
+	"encoding/xml"
+
+	"github.com/pdok/ogc-specifications/pkg/utils"
+	"github.com/pdok/ogc-specifications/pkg/wsc110"
+)
+
+// The states of a process in the ExecuteResponse
+const (
+	ProcessAccepted  = `ProcessAccepted`
+	ProcessStarted   = `ProcessStarted`
+	ProcessPaused    = `ProcessPaused`
+	ProcessSucceeded = `ProcessSucceeded`
+	ProcessFailed    = `ProcessFailed`
+)
+
+// ExecuteResponse is the response document of an Execute request, for an asynchronous request it is stored
+// at the status location and updated while the process runs
+type ExecuteResponse struct {
+	XMLName           xml.Name           `xml:"wps:ExecuteResponse" yaml:"executeResponse"`
+	Service           string             `xml:"service,attr" yaml:"service"`
+	Version           string             `xml:"version,attr" yaml:"version"`
+	Lang              string             `xml:"xml:lang,attr" yaml:"lang"`
+	ServiceInstance   string             `xml:"serviceInstance,attr" yaml:"serviceInstance"`
+	StatusLocation    *string            `xml:"statusLocation,attr,omitempty" yaml:"statusLocation,omitempty"`
+	SchemaLocation    *string            `xml:"xsi:schemaLocation,attr,omitempty" yaml:"schemaLocation,omitempty"`
+	Process           ProcessBrief       `xml:"wps:Process" yaml:"process"`
+	Status            Status             `xml:"wps:Status" yaml:"status"`
+	DataInputs        *DataInputs        `xml:"wps:DataInputs,omitempty" yaml:"dataInputs,omitempty"`
+	OutputDefinitions *OutputDefinitions `xml:"wps:OutputDefinitions,omitempty" yaml:"outputDefinitions,omitempty"`
+	ProcessOutputs    *ProcessOutputs    `xml:"wps:ProcessOutputs,omitempty" yaml:"processOutputs,omitempty"`
+}
+
+// ParseXML builds the ExecuteResponse from a XML document
+func (r *ExecuteResponse) ParseXML(doc []byte) error {
+	return utils.UnmarshalPrefixed(doc, r, prefixes)
+}
+
+// ToXML builds the ExecuteResponse document
+func (r ExecuteResponse) ToXML() []byte {
+	doc, _ := utils.NewEncoder(prefixes).Marshal(r)
+	return doc
+}
+
+// State returns the state of the process, like ProcessStarted
+func (r ExecuteResponse) State() string {
+	return r.Status.State()
+}
+
+// Status is the state of the process at the creation time, one of the fields is set
+type Status struct {
+	CreationTime     string           `xml:"creationTime,attr" yaml:"creationTime"`
+	ProcessAccepted  *string          `xml:"wps:ProcessAccepted,omitempty" yaml:"processAccepted,omitempty"`
+	ProcessStarted   *ProcessProgress `xml:"wps:ProcessStarted,omitempty" yaml:"processStarted,omitempty"`
+	ProcessPaused    *ProcessProgress `xml:"wps:ProcessPaused,omitempty" yaml:"processPaused,omitempty"`
+	ProcessSucceeded *string          `xml:"wps:ProcessSucceeded,omitempty" yaml:"processSucceeded,omitempty"`
+	ProcessFailed    *Failure         `xml:"wps:ProcessFailed,omitempty" yaml:"processFailed,omitempty"`
+}
+
+// State returns the state of the process, or an empty string when no state is set
+func (s Status) State() string {
+	switch {
+	case s.ProcessFailed != nil:
+		return ProcessFailed
+	case s.ProcessSucceeded != nil:
+		return ProcessSucceeded
+	case s.ProcessPaused != nil:
+		return ProcessPaused
+	case s.ProcessStarted != nil:
+		return ProcessStarted
+	case s.ProcessAccepted != nil:
+		return ProcessAccepted
+	}
+	return ``
+}
+
+// ProcessProgress is the message and the progress of a started or paused process
+type ProcessProgress struct {
+	PercentCompleted *int   `xml:"percentCompleted,attr,omitempty" yaml:"percentCompleted,omitempty"`
+	Message          string `xml:",chardata" yaml:"message"`
+}
+
+// Failure contains the exceptions of the failed process
+type Failure struct {
+	ExceptionReport ExceptionReport `xml:"ows:ExceptionReport" yaml:"exceptionReport"`
+}
+
+// NewFailure builds the Failure of a process from the exceptions
+func NewFailure(exceptions wsc110.Exceptions) *Failure {
+	report := ExceptionReport{Version: Version}
+	for _, e := range exceptions {
+		exception := Exception{ExceptionCode: e.Code(), ExceptionText: []string{e.Error()}}
+		if locator := e.Locator(); locator != `` {
+			exception.Locator = &locator
+		}
+		report.Exception = append(report.Exception, exception)
+	}
+	return &Failure{ExceptionReport: report}
+}
+
+// ExceptionReport is the OWS Common exception report of a failed process, that unlike the wsc110.ExceptionReport
+// can be parsed
+type ExceptionReport struct {
+	Version   string      `xml:"version,attr" yaml:"version"`
+	Lang      *string     `xml:"xml:lang,attr,omitempty" yaml:"lang,omitempty"`
+	Exception []Exception `xml:"ows:Exception" yaml:"exception"`
+}
+
+// Exception of the ExceptionReport
+type Exception struct {
+	ExceptionCode string   `xml:"exceptionCode,attr" yaml:"exceptionCode"`
+	Locator       *string  `xml:"locator,attr,omitempty" yaml:"locator,omitempty"`
+	ExceptionText []string `xml:"ows:ExceptionText" yaml:"exceptionText,omitempty"`
+}
+
+// OutputDefinitions contains the outputs that were requested in the response document
+type OutputDefinitions struct {
+	Output []DocumentOutputDefinition `xml:"wps:Output" yaml:"output"`
+}
+
+// ProcessOutputs contains the outputs of the succeeded process
+type ProcessOutputs struct {
+	Output []Output `xml:"wps:Output" yaml:"output"`
+}
+
+// Output is the value of an output, embedded as data or as a reference to the stored output
+type Output struct {
+	Identifier string           `xml:"ows:Identifier" yaml:"identifier"`
+	Title      string           `xml:"ows:Title" yaml:"title"`
+	Abstract   *string          `xml:"ows:Abstract,omitempty" yaml:"abstract,omitempty"`
+	Reference  *OutputReference `xml:"wps:Reference,omitempty" yaml:"reference,omitempty"`
+	Data       *Data            `xml:"wps:Data,omitempty" yaml:"data,omitempty"`
+}
+
+// OutputReference is the location of a stored output, the href of an output isn't a xlink attribute
+type OutputReference struct {
+	Href     string  `xml:"href,attr" yaml:"href"`
+	MimeType *string `xml:"mimeType,attr,omitempty" yaml:"mimeType,omitempty"`
+	Encoding *string `xml:"encoding,attr,omitempty" yaml:"encoding,omitempty"`
+	Schema   *string `xml:"schema,attr,omitempty" yaml:"schema,omitempty"`
+}
@@ -0,0 +1,59 @@
+package wps100
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/pdok/ogc-specifications/pkg/wsc110"
+)
+
+func TestExecuteResponseParseXML(t *testing.T) {
+	var tests = []struct {
+		doc   string
+		state string
+	}{
+		0: {doc: `<wps:ExecuteResponse xmlns:wps="http://www.opengis.net/wps/1.0.0" xmlns:ows="http://www.opengis.net/ows/1.1" service="WPS" version="1.0.0" xml:lang="en" serviceInstance="https://example.com/wps?request=GetCapabilities&amp;service=WPS" statusLocation="https://example.com/status/1.xml">` +
+			`<wps:Process wps:processVersion="1.0"><ows:Identifier>buffer</ows:Identifier><ows:Title>Buffer</ows:Title></wps:Process>` +
+			`<wps:Status creationTime="2024-05-01T12:00:00Z"><wps:ProcessStarted percentCompleted="40">Buffering</wps:ProcessStarted></wps:Status></wps:ExecuteResponse>`,
+			state: ProcessStarted},
+		1: {doc: `<wps:ExecuteResponse xmlns:wps="http://www.opengis.net/wps/1.0.0" xmlns:ows="http://www.opengis.net/ows/1.1" service="WPS" version="1.0.0" xml:lang="en" serviceInstance="https://example.com/wps">` +
+			`<wps:Process><ows:Identifier>buffer</ows:Identifier><ows:Title>Buffer</ows:Title></wps:Process>` +
+			`<wps:Status creationTime="2024-05-01T12:01:00Z"><wps:ProcessSucceeded>Done</wps:ProcessSucceeded></wps:Status>` +
+			`<wps:ProcessOutputs><wps:Output><ows:Identifier>area</ows:Identifier><ows:Title>Area</ows:Title><wps:Data><wps:LiteralData dataType="xs:double">12.5</wps:LiteralData></wps:Data></wps:Output>` +
+			`<wps:Output><ows:Identifier>result</ows:Identifier><ows:Title>Result</ows:Title><wps:Reference href="https://example.com/outputs/1.json" mimeType="application/json"/></wps:Output></wps:ProcessOutputs></wps:ExecuteResponse>`,
+			state: ProcessSucceeded},
+		2: {doc: `<wps:ExecuteResponse xmlns:wps="http://www.opengis.net/wps/1.0.0" xmlns:ows="http://www.opengis.net/ows/1.1" service="WPS" version="1.0.0" xml:lang="en" serviceInstance="https://example.com/wps">` +
+			`<wps:Process><ows:Identifier>buffer</ows:Identifier><ows:Title>Buffer</ows:Title></wps:Process>` +
+			`<wps:Status creationTime="2024-05-01T12:01:00Z"><wps:ProcessFailed><ows:ExceptionReport version="1.0.0"><ows:Exception exceptionCode="NoApplicableCode"><ows:ExceptionText>Out of memory</ows:ExceptionText></ows:Exception></ows:ExceptionReport></wps:ProcessFailed></wps:Status></wps:ExecuteResponse>`,
+			state: ProcessFailed},
+	}
+
+	for k, test := range tests {
+		var r ExecuteResponse
+		if err := r.ParseXML([]byte(test.doc)); err != nil {
+			t.Errorf("test: %d, expected no error,\n got: %v", k, err)
+			continue
+		}
+		if r.State() != test.state {
+			t.Errorf("test: %d, expected: %s,\n got: %s", k, test.state, r.State())
+		}
+
+		var n ExecuteResponse
+		if err := n.ParseXML(r.ToXML()); err != nil || !reflect.DeepEqual(n, r) {
+			t.Errorf("test: %d, expected: %+v,\n got: %+v, %v", k, r, n, err)
+		}
+	}
+}
+
+func TestNewFailure(t *testing.T) {
+	f := NewFailure(wsc110.Exceptions{wsc110.InvalidParameterValue(`ten`, `distance`)})
+	expected := ExceptionReport{Version: Version, Exception: []Exception{{ExceptionCode: `InvalidParameterValue`, Locator: sp(`ten`),
+		ExceptionText: []string{`distance contains a invalid value: ten`}}}}
+
+	if !reflect.DeepEqual(f.ExceptionReport, expected) {
+		t.Errorf("test: %d, expected: %+v,\n got: %+v", 0, expected, f.ExceptionReport)
+	}
+	if s := (Status{ProcessFailed: f}); s.State() != ProcessFailed {
+		t.Errorf("test: %d, expected: %s,\n got: %s", 0, ProcessFailed, s.State())
+	}
+}
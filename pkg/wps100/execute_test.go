@@ -0,0 +1,228 @@
+package wps100
+
+import (
+	"encoding/xml"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/pdok/ogc-specifications/pkg/wsc110"
+)
+
+func bp(b bool) *bool {
+	return &b
+}
+
+func TestExecuteParseQueryParameters(t *testing.T) {
+	var tests = []struct {
+		query     url.Values
+		result    ExecuteRequest
+		exception string
+	}{
+		0: {query: url.Values{`service`: {`WPS`}, `version`: {`1.0.0`}, `request`: {`Execute`}, `identifier`: {`buffer`},
+			`DataInputs`:       {`distance=10@uom=m;geometry=@xlink:href=https%3A%2F%2Fexample.com%2Fwfs%3Fid%3D1@mimeType=text/xml;extent=1,2,3,4,EPSG:28992`},
+			`ResponseDocument`: {`result@asReference=true@mimeType=application/json`}, `storeExecuteResponse`: {`true`}, `status`: {`true`}},
+			result: ExecuteRequest{BaseRequest: BaseRequest{Service: Service, Version: Version}, Identifier: `buffer`,
+				DataInputs: &DataInputs{Input: []Input{
+					{Identifier: `distance`, Data: &Data{LiteralData: &LiteralData{UOM: sp(`m`), Value: `10`}}},
+					{Identifier: `geometry`, Reference: &InputReference{Href: `https://example.com/wfs?id=1`, MimeType: sp(`text/xml`)}},
+					{Identifier: `extent`, Data: &Data{LiteralData: &LiteralData{Value: `1,2,3,4,EPSG:28992`}}},
+				}},
+				ResponseForm: &ResponseForm{ResponseDocument: &ResponseDocument{StoreExecuteResponse: bp(true), Status: bp(true),
+					Output: []DocumentOutputDefinition{{AsReference: bp(true), OutputDefinition: OutputDefinition{Identifier: `result`, MimeType: sp(`application/json`)}}}}}}},
+		1: {query: url.Values{SERVICE: {`WPS`}, VERSION: {`1.0.0`}, REQUEST: {`Execute`}, IDENTIFIER: {`buffer`},
+			DATAINPUTS: {`geometry=<gml:Point><gml:pos>1 2</gml:pos></gml:Point>@mimeType=text/xml`}, RAWDATAOUTPUT: {`result@mimeType=application/json`}},
+			result: ExecuteRequest{BaseRequest: BaseRequest{Service: Service, Version: Version}, Identifier: `buffer`,
+				DataInputs: &DataInputs{Input: []Input{
+					{Identifier: `geometry`, Data: &Data{ComplexData: &ComplexData{MimeType: sp(`text/xml`), Content: Content{Content: `<gml:Point><gml:pos>1 2</gml:pos></gml:Point>`}}}},
+				}},
+				ResponseForm: &ResponseForm{RawDataOutput: &OutputDefinition{Identifier: `result`, MimeType: sp(`application/json`)}}}},
+		2: {query: url.Values{SERVICE: {`WPS`}, VERSION: {`1.0.0`}, REQUEST: {`Execute`}}, exception: `MissingParameterValue`},
+		3: {query: url.Values{SERVICE: {`WPS`}, VERSION: {`1.0.0`}, REQUEST: {`Execute`}, IDENTIFIER: {`buffer`}, DATAINPUTS: {`distance`}}, exception: `InvalidParameterValue`},
+		4: {query: url.Values{SERVICE: {`WPS`}, VERSION: {`1.0.0`}, REQUEST: {`Execute`}, IDENTIFIER: {`buffer`}, STATUS: {`yes`}}, exception: `InvalidParameterValue`},
+		5: {query: url.Values{SERVICE: {`WPS`}, VERSION: {`1.0.0`}, REQUEST: {`Execute`}, IDENTIFIER: {`buffer`}, RESPONSEDOCUMENT: {`result`}, RAWDATAOUTPUT: {`result`}},
+			exception: `InvalidParameterValue`},
+		6: {query: url.Values{SERVICE: {`WPS`}, VERSION: {`1.0.0`}, REQUEST: {`Execute`}, IDENTIFIER: {`buffer`}, RAWDATAOUTPUT: {`result`}, STOREEXECUTERESPONSE: {`true`}},
+			exception: `InvalidParameterValue`},
+	}
+
+	for k, test := range tests {
+		var r ExecuteRequest
+		exceptions := r.ParseQueryParameters(test.query)
+		if test.exception != `` {
+			if len(exceptions) != 1 || exceptions[0].Code() != test.exception {
+				t.Errorf("test: %d, expected: %s,\n got: %v", k, test.exception, exceptions)
+			}
+			continue
+		}
+		if exceptions != nil {
+			t.Errorf("test: %d, expected no exceptions,\n got: %v", k, exceptions)
+			continue
+		}
+		test.result.XMLName.Local = execute
+		if !reflect.DeepEqual(r, test.result) {
+			t.Errorf("test: %d, expected: %+v,\n got: %+v", k, test.result, r)
+		}
+
+		var n ExecuteRequest
+		if exceptions := n.ParseQueryParameters(r.ToQueryParameters()); exceptions != nil || !reflect.DeepEqual(n, r) {
+			t.Errorf("test: %d, expected: %+v,\n got: %+v, %v", k, r, n, exceptions)
+		}
+	}
+}
+
+func TestExecuteToQueryParameters(t *testing.T) {
+	r := ExecuteRequest{BaseRequest: BaseRequest{Service: Service, Version: Version}, Identifier: `buffer`,
+		DataInputs: &DataInputs{Input: []Input{
+			{Identifier: `distance`, Data: &Data{LiteralData: &LiteralData{DataType: sp(`xs:double`), Value: `10`}}},
+			{Identifier: `extent`, Data: &Data{BoundingBoxData: &wsc110.BoundingBox{Crs: `EPSG:28992`, LowerCorner: wsc110.Position{1, 2}, UpperCorner: wsc110.Position{3.5, 4}}}},
+			{Identifier: `name`, Data: &Data{LiteralData: &LiteralData{Value: `a=b;c@d`}}},
+		}},
+		ResponseForm: &ResponseForm{ResponseDocument: &ResponseDocument{Lineage: bp(true)}}}
+	expected := url.Values{SERVICE: {Service}, VERSION: {Version}, REQUEST: {execute}, IDENTIFIER: {`buffer`},
+		DATAINPUTS: {`distance=10@dataType=xs:double;extent=1,2,3.5,4,EPSG:28992;name=a%3Db%3Bc%40d`}, LINEAGE: {`true`}}
+
+	if query := r.ToQueryParameters(); !reflect.DeepEqual(query, expected) {
+		t.Errorf("test: %d, expected: %v,\n got: %v", 0, expected, query)
+	}
+}
+
+func TestExecuteParseXML(t *testing.T) {
+	doc := `<?xml version="1.0" encoding="UTF-8"?>
+<wps:Execute service="WPS" version="1.0.0" xmlns:wps="http://www.opengis.net/wps/1.0.0" xmlns:ows="http://www.opengis.net/ows/1.1" xmlns:xlink="http://www.w3.org/1999/xlink" xmlns:g="http://www.opengis.net/gml">
+  <ows:Identifier>buffer</ows:Identifier>
+  <wps:DataInputs>
+    <wps:Input>
+      <ows:Identifier>geometry</ows:Identifier>
+      <wps:Data><wps:ComplexData mimeType="text/xml"><g:Point srsName="EPSG:28992"><g:pos>1 2</g:pos></g:Point></wps:ComplexData></wps:Data>
+    </wps:Input>
+    <wps:Input>
+      <ows:Identifier>distance</ows:Identifier>
+      <wps:Data><wps:LiteralData uom="m">10</wps:LiteralData></wps:Data>
+    </wps:Input>
+    <wps:Input>
+      <ows:Identifier>extent</ows:Identifier>
+      <wps:Data><wps:BoundingBoxData crs="EPSG:28992"><ows:LowerCorner>1 2</ows:LowerCorner><ows:UpperCorner>3 4</ows:UpperCorner></wps:BoundingBoxData></wps:Data>
+    </wps:Input>
+  </wps:DataInputs>
+  <wps:ResponseForm>
+    <wps:ResponseDocument storeExecuteResponse="true" status="true">
+      <wps:Output asReference="true"><ows:Identifier>result</ows:Identifier></wps:Output>
+    </wps:ResponseDocument>
+  </wps:ResponseForm>
+</wps:Execute>`
+	expected := ExecuteRequest{BaseRequest: BaseRequest{Service: Service, Version: Version}, Identifier: `buffer`,
+		DataInputs: &DataInputs{Input: []Input{
+			{Identifier: `geometry`, Data: &Data{ComplexData: &ComplexData{MimeType: sp(`text/xml`), Content: Content{Content: `<gml:Point srsName="EPSG:28992"><gml:pos>1 2</gml:pos></gml:Point>`}}}},
+			{Identifier: `distance`, Data: &Data{LiteralData: &LiteralData{UOM: sp(`m`), Value: `10`}}},
+			{Identifier: `extent`, Data: &Data{BoundingBoxData: &wsc110.BoundingBox{Crs: `EPSG:28992`, LowerCorner: wsc110.Position{1, 2}, UpperCorner: wsc110.Position{3, 4}}}},
+		}},
+		ResponseForm: &ResponseForm{ResponseDocument: &ResponseDocument{StoreExecuteResponse: bp(true), Status: bp(true),
+			Output: []DocumentOutputDefinition{{AsReference: bp(true), OutputDefinition: OutputDefinition{Identifier: `result`}}}}}}
+
+	var r ExecuteRequest
+	if exceptions := r.ParseXML([]byte(doc)); exceptions != nil {
+		t.Fatalf("test: %d, expected no exceptions,\n got: %v", 0, exceptions)
+	}
+	r.XMLName, r.Attr = xml.Name{}, nil
+	if !reflect.DeepEqual(r, expected) {
+		t.Errorf("test: %d, expected: %+v,\n got: %+v", 0, expected, r)
+	}
+	if !r.Async() {
+		t.Errorf("test: %d, expected: %t,\n got: %t", 0, true, r.Async())
+	}
+
+	body := `<wps:Execute xmlns:gml="http://www.opengis.net/gml" xmlns:ows="http://www.opengis.net/ows/1.1" xmlns:wps="http://www.opengis.net/wps/1.0.0" service="WPS" version="1.0.0">` +
+		`<ows:Identifier>buffer</ows:Identifier><wps:DataInputs>` +
+		`<wps:Input><ows:Identifier>geometry</ows:Identifier><wps:Data><wps:ComplexData mimeType="text/xml"><gml:Point srsName="EPSG:28992"><gml:pos>1 2</gml:pos></gml:Point></wps:ComplexData></wps:Data></wps:Input>` +
+		`<wps:Input><ows:Identifier>distance</ows:Identifier><wps:Data><wps:LiteralData uom="m">10</wps:LiteralData></wps:Data></wps:Input>` +
+		`<wps:Input><ows:Identifier>extent</ows:Identifier><wps:Data><wps:BoundingBoxData crs="EPSG:28992"><ows:LowerCorner>1.000000 2.000000</ows:LowerCorner><ows:UpperCorner>3.000000 4.000000</ows:UpperCorner></wps:BoundingBoxData></wps:Data></wps:Input>` +
+		`</wps:DataInputs><wps:ResponseForm><wps:ResponseDocument storeExecuteResponse="true" status="true"><wps:Output asReference="true"><ows:Identifier>result</ows:Identifier></wps:Output></wps:ResponseDocument></wps:ResponseForm></wps:Execute>`
+	if result := string(r.ToXML()); !strings.HasSuffix(result, body) {
+		t.Errorf("test: %d, expected: %s,\n got: %s", 0, body, result)
+	}
+}
+
+func TestExecuteValidate(t *testing.T) {
+	geometry := Input{Identifier: `geometry`, Data: &Data{ComplexData: &ComplexData{MimeType: sp(`application/json`)}}}
+	distance := func(value string, uom *string) Input {
+		return Input{Identifier: `distance`, Data: &Data{LiteralData: &LiteralData{Value: value, UOM: uom}}}
+	}
+	extent := func(crs string) Input {
+		return Input{Identifier: `extent`, Data: &Data{BoundingBoxData: &wsc110.BoundingBox{Crs: crs}}}
+	}
+
+	var tests = []struct {
+		request    ExecuteRequest
+		exceptions []string
+	}{
+		0: {request: ExecuteRequest{Identifier: `buffer`, DataInputs: &DataInputs{Input: []Input{geometry, distance(`1000`, sp(`km`)), extent(`EPSG:4326`)}},
+			ResponseForm: &ResponseForm{ResponseDocument: &ResponseDocument{StoreExecuteResponse: bp(true), Status: bp(true),
+				Output: []DocumentOutputDefinition{{OutputDefinition: OutputDefinition{Identifier: `result`, MimeType: sp(`application/json`)}}}}}}},
+		1: {request: ExecuteRequest{Identifier: `clip`}, exceptions: []string{`InvalidParameterValue`}},
+		2: {request: ExecuteRequest{Identifier: `buffer`, DataInputs: &DataInputs{Input: []Input{distance(`0`, nil)}}},
+			exceptions: []string{`InvalidParameterValue`, `MissingParameterValue`}},
+		3: {request: ExecuteRequest{Identifier: `buffer`, DataInputs: &DataInputs{Input: []Input{geometry, distance(`ten`, sp(`mi`)), extent(`EPSG:3857`), {Identifier: `width`}}}},
+			exceptions: []string{`InvalidParameterValue`, `InvalidParameterValue`, `InvalidParameterValue`}},
+		4: {request: ExecuteRequest{Identifier: `buffer`, DataInputs: &DataInputs{Input: []Input{geometry, geometry, distance(`5`, nil), {Identifier: `extent`, Data: &Data{LiteralData: &LiteralData{Value: `1`}}}}}},
+			exceptions: []string{`InvalidParameterValue`, `InvalidParameterValue`}},
+		5: {request: ExecuteRequest{Identifier: `buffer`, DataInputs: &DataInputs{Input: []Input{geometry, distance(`5`, nil)}},
+			ResponseForm: &ResponseForm{RawDataOutput: &OutputDefinition{Identifier: `result`, MimeType: sp(`image/png`)}}},
+			exceptions: []string{`InvalidParameterValue`}},
+		6: {request: ExecuteRequest{Identifier: `buffer`, DataInputs: &DataInputs{Input: []Input{geometry, distance(`5`, nil)}},
+			ResponseForm: &ResponseForm{ResponseDocument: &ResponseDocument{Status: bp(true), Output: []DocumentOutputDefinition{{OutputDefinition: OutputDefinition{Identifier: `length`}}}}}},
+			exceptions: []string{`InvalidParameterValue`, `InvalidParameterValue`}},
+		7: {request: ExecuteRequest{Identifier: `buffer`, DataInputs: &DataInputs{Input: []Input{geometry, distance(`5`, nil)}},
+			ResponseForm: &ResponseForm{RawDataOutput: &OutputDefinition{Identifier: `result`, MimeType: sp(`application/json`)},
+				ResponseDocument: &ResponseDocument{StoreExecuteResponse: bp(true)}}},
+			exceptions: []string{`InvalidParameterValue`}},
+	}
+
+	d := processDescriptions(t)
+	for k, test := range tests {
+		var codes []string
+		for _, exception := range test.request.Validate(d) {
+			codes = append(codes, exception.Code())
+		}
+		if !reflect.DeepEqual(codes, test.exceptions) {
+			t.Errorf("test: %d, expected: %v,\n got: %v", k, test.exceptions, codes)
+		}
+	}
+
+	d.ProcessDescription[0].StoreSupported = bp(false)
+	request := tests[0].request
+	if exceptions := request.Validate(d); len(exceptions) != 1 || exceptions[0].Code() != `OptionNotSupported` {
+		t.Errorf("test: %d, expected: %s,\n got: %v", 0, `OptionNotSupported`, exceptions)
+	}
+}
+
+func TestExecuteResolveInputs(t *testing.T) {
+	var tests = []struct {
+		input     Input
+		result    Input
+		exception bool
+	}{
+		0: {input: Input{Identifier: `extent`, Data: &Data{LiteralData: &LiteralData{Value: `1,2,3,4,EPSG:4326`}}},
+			result: Input{Identifier: `extent`, Data: &Data{BoundingBoxData: &wsc110.BoundingBox{Crs: `EPSG:4326`, LowerCorner: wsc110.Position{1, 2}, UpperCorner: wsc110.Position{3, 4}}}}},
+		1: {input: Input{Identifier: `geometry`, Data: &Data{LiteralData: &LiteralData{Value: `{"type":"Point","coordinates":[1,2]}`}}},
+			result: Input{Identifier: `geometry`, Data: &Data{ComplexData: &ComplexData{Content: Content{Content: `{"type":"Point","coordinates":[1,2]}`}}}}},
+		2: {input: Input{Identifier: `distance`, Data: &Data{LiteralData: &LiteralData{Value: `10`}}},
+			result: Input{Identifier: `distance`, Data: &Data{LiteralData: &LiteralData{Value: `10`}}}},
+		3: {input: Input{Identifier: `extent`, Data: &Data{LiteralData: &LiteralData{Value: `1,2,3`}}}, exception: true},
+	}
+
+	p := processDescriptions(t).ProcessDescription[0]
+	for k, test := range tests {
+		r, exceptions := ExecuteRequest{DataInputs: &DataInputs{Input: []Input{test.input}}}.ResolveInputs(p)
+		if test.exception {
+			if len(exceptions) != 1 || exceptions[0].Code() != `InvalidParameterValue` {
+				t.Errorf("test: %d, expected: %s,\n got: %v", k, `InvalidParameterValue`, exceptions)
+			}
+			continue
+		}
+		if exceptions != nil || !reflect.DeepEqual(r.DataInputs.Input[0], test.result) {
+			t.Errorf("test: %d, expected: %+v,\n got: %+v, %v", k, test.result, r.DataInputs.Input[0], exceptions)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package wps100
+
+import (
+	"encoding/xml"
+	"net/url"
+	"strings"
+
+	"github.com/pdok/ogc-specifications/pkg/utils"
+	"github.com/pdok/ogc-specifications/pkg/wsc110"
+)
+
+// GetCapabilitiesRequest struct with the needed parameters/attributes needed for making a GetCapabilities request,
+// WPS 1.0.0 only has the accepted versions of the OWS Common parameters
+type GetCapabilitiesRequest struct {
+	XMLName        xml.Name               `xml:"wps:GetCapabilities" yaml:"getCapabilities"`
+	Service        string                 `xml:"service,attr" yaml:"service"`
+	Language       *string                `xml:"language,attr,omitempty" yaml:"language,omitempty"`
+	Attr           utils.XMLAttribute     `xml:",attr" yaml:"attr"`
+	AcceptVersions *wsc110.AcceptVersions `xml:"wps:AcceptVersions,omitempty" yaml:"acceptVersions,omitempty"`
+}
+
+// Type returns GetCapabilities
+func (gc GetCapabilitiesRequest) Type() string {
+	return getcapabilities
+}
+
+// Validate validates the accepted versions and the language of the GetCapabilities request
+func (gc GetCapabilitiesRequest) Validate(c Capabilities) wsc110.Exceptions {
+	var exceptions wsc110.Exceptions
+	if _, exception := (wsc110.GetCapabilitiesParameters{AcceptVersions: gc.AcceptVersions}).NegotiateVersion(Version); exception != nil {
+		exceptions = append(exceptions, exception)
+	}
+	if gc.Language != nil && !c.Languages.supports(*gc.Language) {
+		exceptions = append(exceptions, wsc110.InvalidParameterValue(*gc.Language, LANGUAGE))
+	}
+	return exceptions
+}
+
+// ParseXML builds a GetCapabilities object based on a XML document
+func (gc *GetCapabilitiesRequest) ParseXML(doc []byte) wsc110.Exceptions {
+	var xmlattributes utils.XMLAttribute
+	if err := xml.Unmarshal(doc, &xmlattributes); err != nil {
+		return wsc110.Exceptions{wsc110.MissingParameterValue()}
+	}
+	if err := utils.UnmarshalPrefixed(doc, gc, prefixes); err != nil {
+		return wsc110.Exceptions{wsc110.NoApplicableCode(err.Error())}
+	}
+	gc.Attr = stripAttr(xmlattributes, `service`, `language`)
+	return nil
+}
+
+// ParseQueryParameters builds a GetCapabilities object based on the available query parameters
+func (gc *GetCapabilitiesRequest) ParseQueryParameters(query url.Values) wsc110.Exceptions {
+	gc.XMLName.Local = getcapabilities
+	gc.Service = strings.ToUpper(first(query, SERVICE))
+	if gc.Service == `` {
+		return wsc110.Exceptions{wsc110.MissingParameterValue(SERVICE)}
+	}
+	if language := first(query, LANGUAGE); language != `` {
+		gc.Language = &language
+	}
+	if versions := first(query, wsc110.ACCEPTVERSIONS); versions != `` {
+		gc.AcceptVersions = &wsc110.AcceptVersions{Version: list(versions)}
+	}
+	return nil
+}
+
+// ToQueryParameters builds a new query string that will be proxied
+func (gc GetCapabilitiesRequest) ToQueryParameters() url.Values {
+	query := url.Values{}
+	query[REQUEST] = []string{getcapabilities}
+	query[SERVICE] = []string{gc.Service}
+	if gc.Language != nil {
+		query[LANGUAGE] = []string{*gc.Language}
+	}
+	if gc.AcceptVersions != nil {
+		query[wsc110.ACCEPTVERSIONS] = []string{strings.Join(gc.AcceptVersions.Version, `,`)}
+	}
+	return query
+}
+
+// ToXML builds a 'new' XML document 'based' on the 'original' XML document
+func (gc GetCapabilitiesRequest) ToXML() []byte {
+	gc.XMLName = xml.Name{}
+	doc, _ := utils.NewEncoder(prefixes).Marshal(gc)
+	return doc
+}
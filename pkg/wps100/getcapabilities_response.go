@@ -0,0 +1,97 @@
+package wps100
+
+import (
+	"encoding/xml"
+
+	"github.com/pdok/ogc-specifications/pkg/utils"
+	"github.com/pdok/ogc-specifications/pkg/wsc110"
+)
+
+// Type function needed for the interface
+func (gc GetCapabilitiesResponse) Type() string {
+	return getcapabilities
+}
+
+// Service function needed for the interface
+func (gc GetCapabilitiesResponse) Service() string {
+	return Service
+}
+
+// Version function needed for the interface
+func (gc GetCapabilitiesResponse) Version() string {
+	return Version
+}
+
+// Validate function of the wps100 spec
+func (gc GetCapabilitiesResponse) Validate() wsc110.Exceptions {
+	return nil
+}
+
+// ParseXML builds a GetCapabilitiesResponse from a WPS 1.0.0 capabilities document,
+// regardless of the namespace prefixes used in the document
+func (gc *GetCapabilitiesResponse) ParseXML(doc []byte) error {
+	return utils.UnmarshalPrefixed(doc, gc, prefixes)
+}
+
+// ParseYAML builds a GetCapabilitiesResponse from a YAML document, unknown keys are ignored
+func (gc *GetCapabilitiesResponse) ParseYAML(doc []byte) error {
+	return utils.UnmarshalYAML(doc, gc, utils.Lenient)
+}
+
+// ToXML builds a GetCapabilities response object
+func (gc GetCapabilitiesResponse) ToXML() []byte {
+	doc, _ := utils.NewEncoder(prefixes).Marshal(gc)
+	return doc
+}
+
+// GetCapabilitiesResponse base struct
+type GetCapabilitiesResponse struct {
+	XMLName               xml.Name `xml:"wps:Capabilities" yaml:"capabilities"`
+	Namespaces            `yaml:"namespaces"`
+	ServiceIdentification ServiceIdentification   `xml:"ows:ServiceIdentification" yaml:"serviceIdentification"`
+	ServiceProvider       *wsc110.ServiceProvider `xml:"ows:ServiceProvider,omitempty" yaml:"serviceProvider"`
+	OperationsMetadata    *OperationsMetadata     `xml:"ows:OperationsMetadata,omitempty" yaml:"operationsMetadata"`
+	Capabilities          `yaml:",inline"`
+	WSDL                  *Reference `xml:"wps:WSDL,omitempty" yaml:"wsdl,omitempty"`
+}
+
+// Namespaces struct containing the attributes of the root element, the namespaces are declared when they are used
+type Namespaces struct {
+	Service        string `xml:"service,attr" yaml:"service"`
+	Version        string `xml:"version,attr" yaml:"version"`
+	Lang           string `xml:"xml:lang,attr" yaml:"lang"`
+	UpdateSequence string `xml:"updateSequence,attr,omitempty" yaml:"updateSequence,omitempty"`
+	SchemaLocation string `xml:"xsi:schemaLocation,attr,omitempty" yaml:"schemaLocation"`
+}
+
+// ServiceIdentification struct should only be fill by the "template" configuration wps100.yaml
+type ServiceIdentification struct {
+	Title              string           `xml:"ows:Title" yaml:"title"`
+	Abstract           string           `xml:"ows:Abstract" yaml:"abstract"`
+	Keywords           *wsc110.Keywords `xml:"ows:Keywords,omitempty" yaml:"keywords"`
+	ServiceType        string           `xml:"ows:ServiceType" yaml:"serviceType"`
+	ServiceTypeVersion string           `xml:"ows:ServiceTypeVersion" yaml:"serviceTypeVersion"`
+	Fees               string           `xml:"ows:Fees" yaml:"fees"`
+	AccessConstraints  string           `xml:"ows:AccessConstraints" yaml:"accessConstraints"`
+}
+
+// OperationsMetadata contains the GetCapabilities, DescribeProcess and Execute operations
+type OperationsMetadata struct {
+	Operation []Operation `xml:"ows:Operation" yaml:"operation"`
+}
+
+// Operation struct for the WPS 1.0.0
+type Operation struct {
+	Name string `xml:"name,attr" yaml:"name"`
+	DCP  struct {
+		HTTP struct {
+			Get  []Method `xml:"ows:Get" yaml:"get,omitempty"`
+			Post []Method `xml:"ows:Post" yaml:"post,omitempty"`
+		} `xml:"ows:HTTP" yaml:"http"`
+	} `xml:"ows:DCP" yaml:"dcp"`
+}
+
+// Method is the URL of the operation for a HTTP method
+type Method struct {
+	Href string `xml:"xlink:href,attr" yaml:"href"`
+}
@@ -0,0 +1,127 @@
+package wps100
+
+import (
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/pdok/ogc-specifications/pkg/wsc110"
+)
+
+const capabilitiesDocument = `<?xml version="1.0" encoding="UTF-8"?>
+<wps:Capabilities xmlns:wps="http://www.opengis.net/wps/1.0.0" xmlns:ows="http://www.opengis.net/ows/1.1" xmlns:xlink="http://www.w3.org/1999/xlink" service="WPS" version="1.0.0" xml:lang="en">
+  <ows:ServiceIdentification>
+    <ows:Title>Processes</ows:Title>
+    <ows:Abstract>Geometry processes</ows:Abstract>
+    <ows:ServiceType>WPS</ows:ServiceType>
+    <ows:ServiceTypeVersion>1.0.0</ows:ServiceTypeVersion>
+    <ows:Fees>NONE</ows:Fees>
+    <ows:AccessConstraints>NONE</ows:AccessConstraints>
+  </ows:ServiceIdentification>
+  <ows:OperationsMetadata>
+    <ows:Operation name="Execute"><ows:DCP><ows:HTTP><ows:Get xlink:href="https://example.com/wps"/><ows:Post xlink:href="https://example.com/wps"/></ows:HTTP></ows:DCP></ows:Operation>
+  </ows:OperationsMetadata>
+  <wps:ProcessOfferings>
+    <wps:Process wps:processVersion="1.0">
+      <ows:Identifier>buffer</ows:Identifier>
+      <ows:Title>Buffer</ows:Title>
+    </wps:Process>
+  </wps:ProcessOfferings>
+  <wps:Languages>
+    <wps:Default><ows:Language>en</ows:Language></wps:Default>
+    <wps:Supported><ows:Language>en</ows:Language><ows:Language>nl</ows:Language></wps:Supported>
+  </wps:Languages>
+</wps:Capabilities>`
+
+var capabilities = Capabilities{
+	ProcessOfferings: ProcessOfferings{Process: []ProcessBrief{{ProcessVersion: sp(`1.0`), Identifier: `buffer`, Title: `Buffer`}}},
+	Languages:        Languages{Default: Language{Language: `en`}, Supported: SupportedLanguages{Language: []string{`en`, `nl`}}},
+}
+
+func TestGetCapabilitiesParseQueryParameters(t *testing.T) {
+	var tests = []struct {
+		query     url.Values
+		result    GetCapabilitiesRequest
+		exception string
+	}{
+		0: {query: url.Values{`service`: {`wps`}, `request`: {`GetCapabilities`}, `AcceptVersions`: {`1.0.0,0.4.0`}, `language`: {`nl`}},
+			result: GetCapabilitiesRequest{Service: Service, Language: sp(`nl`), AcceptVersions: &wsc110.AcceptVersions{Version: []string{`1.0.0`, `0.4.0`}}}},
+		1: {query: url.Values{REQUEST: {`GetCapabilities`}}, exception: `MissingParameterValue`},
+	}
+
+	for k, test := range tests {
+		var gc GetCapabilitiesRequest
+		exceptions := gc.ParseQueryParameters(test.query)
+		if test.exception != `` {
+			if len(exceptions) != 1 || exceptions[0].Code() != test.exception {
+				t.Errorf("test: %d, expected: %s,\n got: %v", k, test.exception, exceptions)
+			}
+			continue
+		}
+		test.result.XMLName.Local = getcapabilities
+		if !reflect.DeepEqual(gc, test.result) {
+			t.Errorf("test: %d, expected: %+v,\n got: %+v", k, test.result, gc)
+		}
+		if query := gc.ToQueryParameters(); query.Get(wsc110.ACCEPTVERSIONS) != `1.0.0,0.4.0` || query.Get(LANGUAGE) != `nl` {
+			t.Errorf("test: %d, expected: %v,\n got: %v", k, test.query, query)
+		}
+	}
+}
+
+func TestGetCapabilitiesParseXML(t *testing.T) {
+	doc := `<GetCapabilities xmlns="http://www.opengis.net/wps/1.0.0" xmlns:ows="http://www.opengis.net/ows/1.1" service="WPS" language="en"><AcceptVersions><ows:Version>1.0.0</ows:Version></AcceptVersions></GetCapabilities>`
+
+	var gc GetCapabilitiesRequest
+	if exceptions := gc.ParseXML([]byte(doc)); exceptions != nil {
+		t.Fatalf("test: %d, expected no exceptions,\n got: %v", 0, exceptions)
+	}
+	if gc.Service != Service || gc.Language == nil || *gc.Language != `en` || gc.AcceptVersions == nil || gc.AcceptVersions.Version[0] != Version {
+		t.Errorf("test: %d, expected: %s,\n got: %+v", 0, doc, gc)
+	}
+
+	gc.Attr = nil
+	body := `<wps:GetCapabilities xmlns:ows="http://www.opengis.net/ows/1.1" xmlns:wps="http://www.opengis.net/wps/1.0.0" service="WPS" language="en"><wps:AcceptVersions><ows:Version>1.0.0</ows:Version></wps:AcceptVersions></wps:GetCapabilities>`
+	if result := string(gc.ToXML()); !strings.HasSuffix(result, body) {
+		t.Errorf("test: %d, expected: %s,\n got: %s", 0, body, result)
+	}
+}
+
+func TestGetCapabilitiesValidate(t *testing.T) {
+	var tests = []struct {
+		request    GetCapabilitiesRequest
+		exceptions []string
+	}{
+		0: {request: GetCapabilitiesRequest{Service: Service, Language: sp(`nl`), AcceptVersions: &wsc110.AcceptVersions{Version: []string{`2.0.0`, `1.0.0`}}}},
+		1: {request: GetCapabilitiesRequest{Service: Service, Language: sp(`de`), AcceptVersions: &wsc110.AcceptVersions{Version: []string{`2.0.0`}}},
+			exceptions: []string{`VersionNegotiationFailed`, `InvalidParameterValue`}},
+	}
+
+	for k, test := range tests {
+		var codes []string
+		for _, exception := range test.request.Validate(capabilities) {
+			codes = append(codes, exception.Code())
+		}
+		if !reflect.DeepEqual(codes, test.exceptions) {
+			t.Errorf("test: %d, expected: %v,\n got: %v", k, test.exceptions, codes)
+		}
+	}
+}
+
+func TestGetCapabilitiesResponseParseXML(t *testing.T) {
+	var gc GetCapabilitiesResponse
+	if err := gc.ParseXML([]byte(capabilitiesDocument)); err != nil {
+		t.Fatalf("test: %d, expected no error,\n got: %v", 0, err)
+	}
+	if !reflect.DeepEqual(gc.Capabilities, capabilities) {
+		t.Errorf("test: %d, expected: %+v,\n got: %+v", 0, capabilities, gc.Capabilities)
+	}
+	if gc.ServiceIdentification.Title != `Processes` || gc.OperationsMetadata == nil || gc.OperationsMetadata.Operation[0].DCP.HTTP.Get[0].Href != `https://example.com/wps` {
+		t.Errorf("test: %d, expected: %s,\n got: %+v", 0, capabilitiesDocument, gc)
+	}
+
+	var c Capabilities
+	if err := c.ParseXML(gc.ToXML()); err != nil || !reflect.DeepEqual(c, capabilities) {
+		t.Errorf("test: %d, expected: %+v,\n got: %+v, %v", 0, capabilities, c, err)
+	}
+}
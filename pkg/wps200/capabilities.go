@@ -0,0 +1,94 @@
+package wps200
+
+import (
+	"strings"
+
+	"github.com/pdok/ogc-specifications/pkg/utils"
+	"github.com/pdok/ogc-specifications/pkg/wsc200"
+)
+
+// Job control options of a process
+const (
+	SyncExecute  = `sync-execute`
+	AsyncExecute = `async-execute`
+	Dismiss      = `dismiss`
+)
+
+// Output transmission modes of a process
+const (
+	TransmissionValue     = `value`
+	TransmissionReference = `reference`
+)
+
+// Capabilities contains the process summaries of the WPS 2.0 capabilities the requests are validated against
+type Capabilities struct {
+	Contents Contents `xml:"wps:Contents" yaml:"contents"`
+}
+
+// ParseXML builds the Capabilities from a WPS 2.0 capabilities document
+func (c *Capabilities) ParseXML(doc []byte) error {
+	var gc GetCapabilitiesResponse
+	if err := gc.ParseXML(doc); err != nil {
+		return err
+	}
+	*c = gc.Capabilities
+	return nil
+}
+
+// ParseYAML builds the Capabilities from a YAML document, unknown keys are ignored
+func (c *Capabilities) ParseYAML(doc []byte) error {
+	return utils.UnmarshalYAML(doc, c, utils.Lenient)
+}
+
+// Contents contains the summaries of the offered processes
+type Contents struct {
+	ProcessSummary []ProcessSummary `xml:"wps:ProcessSummary" yaml:"processSummary"`
+}
+
+// process returns the summary of the process with the identifier, or nil when it isn't offered
+func (c Contents) process(identifier string) *ProcessSummary {
+	for i, p := range c.ProcessSummary {
+		if p.Identifier == identifier {
+			return &c.ProcessSummary[i]
+		}
+	}
+	return nil
+}
+
+// ProcessProperties are the job control options and output transmissions of a process
+type ProcessProperties struct {
+	// JobControlOptions is a space separated list, like "sync-execute async-execute"
+	JobControlOptions  string  `xml:"jobControlOptions,attr" yaml:"jobControlOptions"`
+	OutputTransmission *string `xml:"outputTransmission,attr,omitempty" yaml:"outputTransmission,omitempty"`
+	ProcessVersion     *string `xml:"processVersion,attr,omitempty" yaml:"processVersion,omitempty"`
+	ProcessModel       *string `xml:"processModel,attr,omitempty" yaml:"processModel,omitempty"`
+}
+
+// controls returns whether the process supports the job control option
+func (p ProcessProperties) controls(option string) bool {
+	return contains(strings.Fields(p.JobControlOptions), option)
+}
+
+// transmits returns whether the process supports the output transmission, value and reference are supported when
+// the output transmissions aren't given
+func (p ProcessProperties) transmits(transmission string) bool {
+	if p.OutputTransmission == nil {
+		return transmission == TransmissionValue || transmission == TransmissionReference
+	}
+	return contains(strings.Fields(*p.OutputTransmission), transmission)
+}
+
+// ProcessSummary is the brief description of a process
+type ProcessSummary struct {
+	ProcessProperties `yaml:",inline"`
+	Description       `yaml:",inline"`
+}
+
+// Description contains the identification shared by the processes, inputs and outputs
+type Description struct {
+	Title      wsc200.LanguageStrings `xml:"ows:Title" yaml:"title"`
+	Abstract   wsc200.LanguageStrings `xml:"ows:Abstract" yaml:"abstract,omitempty"`
+	Keywords   []wsc200.Keywords      `xml:"ows:Keywords" yaml:"keywords,omitempty"`
+	Identifier string                 `xml:"ows:Identifier" yaml:"identifier"`
+	Metadata   []wsc200.Metadata      `xml:"ows:Metadata" yaml:"metadata,omitempty"`
+}
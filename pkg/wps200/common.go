@@ -0,0 +1,121 @@
+// Package wps200 contains the requests and responses of the Web Processing Service 2.0, built on the OWS Common 2.0
+// package wsc200. Processes are executed synchronously or asynchronously, the status and the result of an asynchronous
+// job are requested with GetStatus and GetResult.
+package wps200
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/pdok/ogc-specifications/pkg/utils"
+	"github.com/pdok/ogc-specifications/pkg/wsc200"
+)
+
+const (
+	getcapabilities = `GetCapabilities`
+	describeprocess = `DescribeProcess`
+	execute         = `Execute`
+	getstatus       = `GetStatus`
+	getresult       = `GetResult`
+
+	Service = `WPS`
+	Version = `2.0.0`
+)
+
+// WPS 2.0 Keys
+const (
+	SERVICE    = `SERVICE`
+	REQUEST    = `REQUEST`
+	VERSION    = `VERSION`
+	IDENTIFIER = `IDENTIFIER`
+	LANG       = `LANG`
+	JOBID      = `JOBID`
+)
+
+// Namespaces of the WPS 2.0 documents
+const (
+	Namespace    = `http://www.opengis.net/wps/2.0`
+	GMLNamespace = `http://www.opengis.net/gml/3.2`
+)
+
+// prefixes contains the prefixes used in the struct tags, GML is registered so the geometries in the complex data
+// keep their prefix
+var prefixes = utils.Prefixes{
+	Namespace:        `wps`,
+	wsc200.Namespace: `ows`,
+	GMLNamespace:     `gml`,
+}
+
+// BaseRequest contains the attributes every WPS 2.0 request, except GetCapabilities, has
+type BaseRequest struct {
+	Service string             `xml:"service,attr" yaml:"service"`
+	Version string             `xml:"version,attr" yaml:"version"`
+	Attr    utils.XMLAttribute `xml:",attr" yaml:"attr"`
+}
+
+// parseQueryParameters builds the BaseRequest from the query parameters, the SERVICE and VERSION are mandatory
+func (b *BaseRequest) parseQueryParameters(query url.Values) wsc200.Exceptions {
+	var exceptions wsc200.Exceptions
+	b.Service, b.Version = first(query, SERVICE), first(query, VERSION)
+	for _, k := range []struct{ key, value, expected string }{{SERVICE, b.Service, Service}, {VERSION, b.Version, Version}} {
+		switch {
+		case k.value == ``:
+			exceptions = append(exceptions, wsc200.MissingParameterValue(k.key))
+		case !strings.EqualFold(k.value, k.expected):
+			exceptions = append(exceptions, wsc200.InvalidParameterValue(k.value, k.key))
+		}
+	}
+	return exceptions
+}
+
+// setQueryParameters adds the base parameters and the request to the query
+func (b BaseRequest) setQueryParameters(query url.Values, request string) {
+	query[SERVICE] = []string{b.Service}
+	query[VERSION] = []string{b.Version}
+	query[REQUEST] = []string{request}
+}
+
+// stripAttr returns the attributes of a XML request that aren't parameters of the request
+func stripAttr(attr utils.XMLAttribute, parameters ...string) utils.XMLAttribute {
+	var n utils.XMLAttribute
+	for _, a := range attr {
+		if !contains(parameters, a.Name.Local) {
+			n = append(n, a)
+		}
+	}
+	return utils.StripDuplicateAttr(n)
+}
+
+// first returns the first value of the key in the query, the keys are case insensitive
+func first(query url.Values, key string) string {
+	for k, v := range query {
+		if strings.EqualFold(k, key) && len(v) > 0 {
+			return v[0]
+		}
+	}
+	return ``
+}
+
+// list splits a comma separated list, leaving out the empty items
+func list(s string) []string {
+	var items []string
+	for _, item := range strings.Split(s, `,`) {
+		if item = strings.TrimSpace(item); item != `` {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func sp(s string) *string {
+	return &s
+}
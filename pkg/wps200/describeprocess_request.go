@@ -0,0 +1,79 @@
+package wps200
+
+import (
+	"encoding/xml"
+	"net/url"
+
+	"github.com/pdok/ogc-specifications/pkg/utils"
+	"github.com/pdok/ogc-specifications/pkg/wsc200"
+)
+
+// All is the identifier that requests the description of all processes
+const All = `ALL`
+
+// DescribeProcessRequest struct with the needed parameters/attributes needed for making a DescribeProcess request
+type DescribeProcessRequest struct {
+	XMLName xml.Name `xml:"wps:DescribeProcess" yaml:"describeProcess"`
+	BaseRequest
+	Lang       *string  `xml:"lang,attr,omitempty" yaml:"lang,omitempty"`
+	Identifier []string `xml:"ows:Identifier" yaml:"identifier"`
+}
+
+// Type returns DescribeProcess
+func (r DescribeProcessRequest) Type() string {
+	return describeprocess
+}
+
+// Validate checks whether the requested processes are offered by the service
+func (r DescribeProcessRequest) Validate(c Capabilities) wsc200.Exceptions {
+	var exceptions wsc200.Exceptions
+	if len(r.Identifier) == 0 {
+		exceptions = append(exceptions, wsc200.MissingParameterValue(IDENTIFIER))
+	}
+	for _, identifier := range r.Identifier {
+		if identifier != All && c.Contents.process(identifier) == nil {
+			exceptions = append(exceptions, NoSuchProcess(identifier))
+		}
+	}
+	if len(exceptions) > 0 {
+		return exceptions
+	}
+	return nil
+}
+
+// ParseXML builds a DescribeProcess object based on a XML document
+func (r *DescribeProcessRequest) ParseXML(doc []byte) wsc200.Exceptions {
+	var xmlattributes utils.XMLAttribute
+	if err := xml.Unmarshal(doc, &xmlattributes); err != nil {
+		return wsc200.Exceptions{wsc200.MissingParameterValue()}
+	}
+	if err := utils.UnmarshalPrefixed(doc, r, prefixes); err != nil {
+		return wsc200.Exceptions{wsc200.NoApplicableCode(err.Error())}
+	}
+	r.Attr = stripAttr(xmlattributes, `service`, `version`, `lang`)
+	return nil
+}
+
+// ParseQueryParameters builds a DescribeProcess object based on the available query parameters
+func (r *DescribeProcessRequest) ParseQueryParameters(query url.Values) wsc200.Exceptions {
+	var dpv describeProcessRequestParameterValue
+	if exceptions := dpv.parseQueryParameters(query); exceptions != nil {
+		return exceptions
+	}
+	r.parseDescribeProcessRequestParameterValue(dpv)
+	return nil
+}
+
+// ToQueryParameters builds a new query string that will be proxied
+func (r DescribeProcessRequest) ToQueryParameters() url.Values {
+	var dpv describeProcessRequestParameterValue
+	dpv.parseDescribeProcessRequest(r)
+	return dpv.toQueryParameters()
+}
+
+// ToXML builds a 'new' XML document 'based' on the 'original' XML document
+func (r DescribeProcessRequest) ToXML() []byte {
+	r.XMLName = xml.Name{}
+	doc, _ := utils.NewEncoder(prefixes).Marshal(r)
+	return doc
+}
@@ -0,0 +1,53 @@
+package wps200
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/pdok/ogc-specifications/pkg/wsc200"
+)
+
+type describeProcessRequestParameterValue struct {
+	BaseRequest
+	identifier string  `yaml:"identifier"`
+	lang       *string `yaml:"lang"`
+}
+
+func (dpv *describeProcessRequestParameterValue) parseQueryParameters(query url.Values) wsc200.Exceptions {
+	exceptions := dpv.BaseRequest.parseQueryParameters(query)
+	dpv.identifier = first(query, IDENTIFIER)
+	if dpv.identifier == `` {
+		exceptions = append(exceptions, wsc200.MissingParameterValue(IDENTIFIER))
+	}
+	if lang := first(query, LANG); lang != `` {
+		dpv.lang = &lang
+	}
+
+	if len(exceptions) > 0 {
+		return exceptions
+	}
+	return nil
+}
+
+func (r *DescribeProcessRequest) parseDescribeProcessRequestParameterValue(dpv describeProcessRequestParameterValue) {
+	r.XMLName.Local = describeprocess
+	r.BaseRequest = BaseRequest{Service: dpv.Service, Version: dpv.Version}
+	r.Lang = dpv.lang
+	r.Identifier = list(dpv.identifier)
+}
+
+func (dpv *describeProcessRequestParameterValue) parseDescribeProcessRequest(r DescribeProcessRequest) {
+	dpv.BaseRequest = BaseRequest{Service: r.Service, Version: r.Version}
+	dpv.identifier = strings.Join(r.Identifier, `,`)
+	dpv.lang = r.Lang
+}
+
+func (dpv describeProcessRequestParameterValue) toQueryParameters() url.Values {
+	query := url.Values{}
+	dpv.BaseRequest.setQueryParameters(query, describeprocess)
+	query[IDENTIFIER] = []string{dpv.identifier}
+	if dpv.lang != nil {
+		query[LANG] = []string{*dpv.lang}
+	}
+	return query
+}
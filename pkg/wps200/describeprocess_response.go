@@ -0,0 +1,199 @@
+package wps200
+
+import (
+	"encoding/xml"
+	"strconv"
+
+	"github.com/pdok/ogc-specifications/pkg/utils"
+	"github.com/pdok/ogc-specifications/pkg/wsc200"
+)
+
+// Unbounded is the maxOccurs of an input that occurs any number of times
+const Unbounded = `unbounded`
+
+// ProcessOfferings is the DescribeProcess response
+type ProcessOfferings struct {
+	XMLName         xml.Name          `xml:"wps:ProcessOfferings" yaml:"processOfferings"`
+	SchemaLocation  *string           `xml:"xsi:schemaLocation,attr,omitempty" yaml:"schemaLocation,omitempty"`
+	ProcessOffering []ProcessOffering `xml:"wps:ProcessOffering" yaml:"processOffering"`
+}
+
+// ParseXML builds the ProcessOfferings from a DescribeProcess response
+func (o *ProcessOfferings) ParseXML(doc []byte) error {
+	return utils.UnmarshalPrefixed(doc, o, prefixes)
+}
+
+// ParseYAML builds the ProcessOfferings from a YAML document, unknown keys are ignored
+func (o *ProcessOfferings) ParseYAML(doc []byte) error {
+	return utils.UnmarshalYAML(doc, o, utils.Lenient)
+}
+
+// ToXML builds the DescribeProcess response
+func (o ProcessOfferings) ToXML() []byte {
+	doc, _ := utils.NewEncoder(prefixes).Marshal(o)
+	return doc
+}
+
+// offering returns the offering of the process with the identifier, or nil when it isn't offered
+func (o ProcessOfferings) offering(identifier string) *ProcessOffering {
+	for i, p := range o.ProcessOffering {
+		if p.Process.Identifier == identifier {
+			return &o.ProcessOffering[i]
+		}
+	}
+	return nil
+}
+
+// ProcessOffering is the description of a process with its job control options and output transmissions
+type ProcessOffering struct {
+	ProcessProperties `yaml:",inline"`
+	Process           Process `xml:"wps:Process" yaml:"process"`
+}
+
+// Process describes the inputs and outputs of a process
+type Process struct {
+	Description `yaml:",inline"`
+	Input       []InputDescription  `xml:"wps:Input" yaml:"input,omitempty"`
+	Output      []OutputDescription `xml:"wps:Output" yaml:"output"`
+}
+
+// InputDescription describes an input, which is literal, complex or bounding box data or a group of nested inputs
+type InputDescription struct {
+	MinOccurs       *int    `xml:"minOccurs,attr,omitempty" yaml:"minOccurs,omitempty"`
+	MaxOccurs       *string `xml:"maxOccurs,attr,omitempty" yaml:"maxOccurs,omitempty"`
+	Description     `yaml:",inline"`
+	LiteralData     *LiteralDataDescription     `xml:"wps:LiteralData,omitempty" yaml:"literalData,omitempty"`
+	ComplexData     *DataDescription            `xml:"wps:ComplexData,omitempty" yaml:"complexData,omitempty"`
+	BoundingBoxData *BoundingBoxDataDescription `xml:"wps:BoundingBoxData,omitempty" yaml:"boundingBoxData,omitempty"`
+	Input           []InputDescription          `xml:"wps:Input" yaml:"input,omitempty"`
+}
+
+// occurs returns the minimum and maximum number of occurrences, the maximum is -1 for unbounded inputs
+func (d InputDescription) occurs() (int, int) {
+	minimum, maximum := 1, 1
+	if d.MinOccurs != nil {
+		minimum = *d.MinOccurs
+	}
+	if d.MaxOccurs != nil {
+		if *d.MaxOccurs == Unbounded {
+			maximum = -1
+		} else if m, err := strconv.Atoi(*d.MaxOccurs); err == nil {
+			maximum = m
+		}
+	}
+	return minimum, maximum
+}
+
+// OutputDescription describes an output, which is literal, complex or bounding box data or a group of nested outputs
+type OutputDescription struct {
+	Description     `yaml:",inline"`
+	LiteralData     *LiteralDataDescription     `xml:"wps:LiteralData,omitempty" yaml:"literalData,omitempty"`
+	ComplexData     *DataDescription            `xml:"wps:ComplexData,omitempty" yaml:"complexData,omitempty"`
+	BoundingBoxData *BoundingBoxDataDescription `xml:"wps:BoundingBoxData,omitempty" yaml:"boundingBoxData,omitempty"`
+	Output          []OutputDescription         `xml:"wps:Output" yaml:"output,omitempty"`
+}
+
+// formats returns the supported formats of the output
+func (d OutputDescription) formats() []Format {
+	switch {
+	case d.LiteralData != nil:
+		return d.LiteralData.Format
+	case d.ComplexData != nil:
+		return d.ComplexData.Format
+	case d.BoundingBoxData != nil:
+		return d.BoundingBoxData.Format
+	}
+	return nil
+}
+
+// inputDescription returns the description of the input with the identifier, or nil when there is no such input
+func inputDescription(descriptions []InputDescription, identifier string) *InputDescription {
+	for i, d := range descriptions {
+		if d.Identifier == identifier {
+			return &descriptions[i]
+		}
+	}
+	return nil
+}
+
+// outputDescription returns the description of the output with the identifier, or nil when there is no such output
+func outputDescription(descriptions []OutputDescription, identifier string) *OutputDescription {
+	for i, d := range descriptions {
+		if d.Identifier == identifier {
+			return &descriptions[i]
+		}
+	}
+	return nil
+}
+
+// DataDescription contains the formats of the data, one of the formats is the default
+type DataDescription struct {
+	Format []Format `xml:"wps:Format" yaml:"format"`
+}
+
+// supports returns whether the combination of mime type, encoding and schema is one of the supported formats,
+// an empty value matches any value
+func (d DataDescription) supports(mimeType, encoding, schema *string) bool {
+	return supports(d.Format, mimeType, encoding, schema)
+}
+
+// supports returns whether the combination of mime type, encoding and schema is one of the formats,
+// an empty value matches any value
+func supports(formats []Format, mimeType, encoding, schema *string) bool {
+	equal := func(a, b *string) bool { return b == nil || (a != nil && *a == *b) }
+	for _, f := range formats {
+		if equal(f.MimeType, mimeType) && equal(f.Encoding, encoding) && equal(f.Schema, schema) {
+			return true
+		}
+	}
+	return false
+}
+
+// Format is a combination of a mime type, an encoding and a schema
+type Format struct {
+	MimeType         *string `xml:"mimeType,attr,omitempty" yaml:"mimeType,omitempty"`
+	Encoding         *string `xml:"encoding,attr,omitempty" yaml:"encoding,omitempty"`
+	Schema           *string `xml:"schema,attr,omitempty" yaml:"schema,omitempty"`
+	MaximumMegabytes *int    `xml:"maximumMegabytes,attr,omitempty" yaml:"maximumMegabytes,omitempty"`
+	Default          *bool   `xml:"default,attr,omitempty" yaml:"default,omitempty"`
+}
+
+// LiteralDataDescription describes the formats and the domains of literal data
+type LiteralDataDescription struct {
+	DataDescription   `yaml:",inline"`
+	LiteralDataDomain []LiteralDataDomain `xml:"LiteralDataDomain" yaml:"literalDataDomain"`
+}
+
+// LiteralDataDomain describes the allowed values, data type and unit of measure of literal data,
+// the allowed values are given by AllowedValues, AnyValue or ValuesReference
+type LiteralDataDomain struct {
+	Default         *bool                   `xml:"default,attr,omitempty" yaml:"default,omitempty"`
+	AllowedValues   *wsc200.AllowedValues   `xml:"ows:AllowedValues,omitempty" yaml:"allowedValues,omitempty"`
+	AnyValue        *wsc200.AnyValue        `xml:"ows:AnyValue,omitempty" yaml:"anyValue,omitempty"`
+	ValuesReference *wsc200.ValuesReference `xml:"ows:ValuesReference,omitempty" yaml:"valuesReference,omitempty"`
+	DataType        *wsc200.DomainMetadata  `xml:"ows:DataType,omitempty" yaml:"dataType,omitempty"`
+	UOM             *wsc200.DomainMetadata  `xml:"ows:UOM,omitempty" yaml:"uom,omitempty"`
+	DefaultValue    *string                 `xml:"ows:DefaultValue,omitempty" yaml:"defaultValue,omitempty"`
+}
+
+// BoundingBoxDataDescription describes the formats and the supported CRSs of a bounding box
+type BoundingBoxDataDescription struct {
+	DataDescription `yaml:",inline"`
+	SupportedCRS    []SupportedCRS `xml:"wps:SupportedCRS" yaml:"supportedCRS"`
+}
+
+// supports returns whether the CRS is one of the supported CRSs
+func (d BoundingBoxDataDescription) supports(crs string) bool {
+	for _, s := range d.SupportedCRS {
+		if s.Value == crs {
+			return true
+		}
+	}
+	return false
+}
+
+// SupportedCRS is a CRS of a bounding box, one of the CRSs is the default
+type SupportedCRS struct {
+	Default *bool  `xml:"default,attr,omitempty" yaml:"default,omitempty"`
+	Value   string `xml:",chardata" yaml:"value"`
+}
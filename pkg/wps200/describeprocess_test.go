@@ -0,0 +1,169 @@
+package wps200
+
+import (
+	"encoding/xml"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/pdok/ogc-specifications/pkg/wsc200"
+)
+
+const processOfferingsDocument = `<?xml version="1.0" encoding="UTF-8"?>
+<wps:ProcessOfferings xmlns:wps="http://www.opengis.net/wps/2.0" xmlns:ows="http://www.opengis.net/ows/2.0">
+  <wps:ProcessOffering jobControlOptions="sync-execute async-execute" outputTransmission="value reference" processVersion="1.0">
+    <wps:Process>
+      <ows:Title>Buffer</ows:Title>
+      <ows:Identifier>buffer</ows:Identifier>
+      <wps:Input>
+        <ows:Title>Geometry</ows:Title>
+        <ows:Identifier>geometry</ows:Identifier>
+        <wps:ComplexData>
+          <wps:Format mimeType="application/gml+xml" schema="http://schemas.opengis.net/gml/3.2.1/gml.xsd" default="true"/>
+          <wps:Format mimeType="application/geo+json"/>
+        </wps:ComplexData>
+      </wps:Input>
+      <wps:Input minOccurs="0">
+        <ows:Title>Distance</ows:Title>
+        <ows:Identifier>distance</ows:Identifier>
+        <wps:LiteralData>
+          <wps:Format mimeType="text/plain" default="true"/>
+          <LiteralDataDomain default="true">
+            <ows:AllowedValues><ows:Range ows:rangeClosure="open-closed"><ows:MinimumValue>0</ows:MinimumValue><ows:MaximumValue>1000</ows:MaximumValue></ows:Range></ows:AllowedValues>
+            <ows:DataType ows:reference="http://www.w3.org/2001/XMLSchema#double">xs:double</ows:DataType>
+            <ows:UOM>m</ows:UOM>
+            <ows:DefaultValue>10</ows:DefaultValue>
+          </LiteralDataDomain>
+        </wps:LiteralData>
+      </wps:Input>
+      <wps:Input minOccurs="0" maxOccurs="unbounded">
+        <ows:Title>Extent</ows:Title>
+        <ows:Identifier>extent</ows:Identifier>
+        <wps:BoundingBoxData>
+          <wps:Format mimeType="text/plain" default="true"/>
+          <wps:SupportedCRS default="true">http://www.opengis.net/def/crs/EPSG/0/28992</wps:SupportedCRS>
+          <wps:SupportedCRS>http://www.opengis.net/def/crs/EPSG/0/4326</wps:SupportedCRS>
+        </wps:BoundingBoxData>
+      </wps:Input>
+      <wps:Input minOccurs="0">
+        <ows:Title>Options</ows:Title>
+        <ows:Identifier>options</ows:Identifier>
+        <wps:Input>
+          <ows:Title>Segments</ows:Title>
+          <ows:Identifier>segments</ows:Identifier>
+          <wps:LiteralData><wps:Format mimeType="text/plain" default="true"/><LiteralDataDomain><ows:AnyValue/><ows:DataType>xs:integer</ows:DataType></LiteralDataDomain></wps:LiteralData>
+        </wps:Input>
+      </wps:Input>
+      <wps:Output>
+        <ows:Title>Result</ows:Title>
+        <ows:Identifier>result</ows:Identifier>
+        <wps:ComplexData><wps:Format mimeType="application/gml+xml" default="true"/><wps:Format mimeType="application/geo+json"/></wps:ComplexData>
+      </wps:Output>
+    </wps:Process>
+  </wps:ProcessOffering>
+</wps:ProcessOfferings>`
+
+func processOfferings(t *testing.T) ProcessOfferings {
+	var o ProcessOfferings
+	if err := o.ParseXML([]byte(processOfferingsDocument)); err != nil {
+		t.Fatalf("expected no error,\n got: %v", err)
+	}
+	return o
+}
+
+func TestDescribeProcessParseQueryParameters(t *testing.T) {
+	var tests = []struct {
+		query     url.Values
+		result    DescribeProcessRequest
+		exception string
+	}{
+		0: {query: url.Values{`service`: {`WPS`}, `version`: {`2.0.0`}, `request`: {`DescribeProcess`}, `identifier`: {`buffer,intersect`}, `lang`: {`en`}},
+			result: DescribeProcessRequest{BaseRequest: BaseRequest{Service: Service, Version: Version}, Lang: sp(`en`), Identifier: []string{`buffer`, `intersect`}}},
+		1: {query: url.Values{SERVICE: {`WPS`}, VERSION: {`2.0.0`}, REQUEST: {`DescribeProcess`}}, exception: `MissingParameterValue`},
+		2: {query: url.Values{SERVICE: {`WPS`}, VERSION: {`1.0.0`}, REQUEST: {`DescribeProcess`}, IDENTIFIER: {All}}, exception: `InvalidParameterValue`},
+	}
+
+	for k, test := range tests {
+		var r DescribeProcessRequest
+		exceptions := r.ParseQueryParameters(test.query)
+		if test.exception != `` {
+			if len(exceptions) != 1 || exceptions[0].Code() != test.exception {
+				t.Errorf("test: %d, expected: %s,\n got: %v", k, test.exception, exceptions)
+			}
+			continue
+		}
+		test.result.XMLName.Local = describeprocess
+		if !reflect.DeepEqual(r, test.result) {
+			t.Errorf("test: %d, expected: %+v,\n got: %+v", k, test.result, r)
+		}
+		if query := r.ToQueryParameters(); query.Get(IDENTIFIER) != `buffer,intersect` || query.Get(LANG) != `en` {
+			t.Errorf("test: %d, expected: %v,\n got: %v", k, test.query, query)
+		}
+	}
+}
+
+func TestDescribeProcessParseXML(t *testing.T) {
+	doc := `<wps:DescribeProcess xmlns:wps="http://www.opengis.net/wps/2.0" xmlns:o="http://www.opengis.net/ows/2.0" service="WPS" version="2.0.0" lang="en"><o:Identifier>buffer</o:Identifier></wps:DescribeProcess>`
+	expected := DescribeProcessRequest{BaseRequest: BaseRequest{Service: Service, Version: Version}, Lang: sp(`en`), Identifier: []string{`buffer`}}
+
+	var r DescribeProcessRequest
+	if exceptions := r.ParseXML([]byte(doc)); exceptions != nil {
+		t.Fatalf("test: %d, expected no exceptions,\n got: %v", 0, exceptions)
+	}
+	r.XMLName, r.Attr = xml.Name{}, nil
+	if !reflect.DeepEqual(r, expected) {
+		t.Errorf("test: %d, expected: %+v,\n got: %+v", 0, expected, r)
+	}
+
+	body := `<wps:DescribeProcess xmlns:ows="http://www.opengis.net/ows/2.0" xmlns:wps="http://www.opengis.net/wps/2.0" service="WPS" version="2.0.0" lang="en"><ows:Identifier>buffer</ows:Identifier></wps:DescribeProcess>`
+	if result := string(r.ToXML()); !strings.HasSuffix(result, body) {
+		t.Errorf("test: %d, expected: %s,\n got: %s", 0, body, result)
+	}
+}
+
+func TestDescribeProcessValidate(t *testing.T) {
+	var tests = []struct {
+		request    DescribeProcessRequest
+		exceptions wsc200.Exceptions
+	}{
+		0: {request: DescribeProcessRequest{Identifier: []string{`buffer`}}},
+		1: {request: DescribeProcessRequest{Identifier: []string{All}}},
+		2: {request: DescribeProcessRequest{Identifier: []string{`buffer`, `clip`}}, exceptions: wsc200.Exceptions{NoSuchProcess(`clip`)}},
+		3: {request: DescribeProcessRequest{}, exceptions: wsc200.Exceptions{wsc200.MissingParameterValue(IDENTIFIER)}},
+	}
+
+	for k, test := range tests {
+		if exceptions := test.request.Validate(capabilities); !reflect.DeepEqual(exceptions, test.exceptions) {
+			t.Errorf("test: %d, expected: %v,\n got: %v", k, test.exceptions, exceptions)
+		}
+	}
+}
+
+func TestProcessOfferingsParseXML(t *testing.T) {
+	o := processOfferings(t)
+	p := o.offering(`buffer`)
+	if p == nil || !p.controls(AsyncExecute) || !p.transmits(TransmissionReference) {
+		t.Fatalf("test: %d, expected: %s,\n got: %+v", 0, `buffer`, p)
+	}
+	distance := inputDescription(p.Process.Input, `distance`)
+	if distance == nil || distance.LiteralData == nil || len(distance.LiteralData.LiteralDataDomain) != 1 ||
+		distance.LiteralData.LiteralDataDomain[0].UOM.Value != `m` || *distance.LiteralData.LiteralDataDomain[0].DefaultValue != `10` {
+		t.Errorf("test: %d, expected: %s,\n got: %+v", 0, `distance`, distance)
+	}
+	if minimum, maximum := distance.occurs(); minimum != 0 || maximum != 1 {
+		t.Errorf("test: %d, expected: %d, %d,\n got: %d, %d", 0, 0, 1, minimum, maximum)
+	}
+	extent := inputDescription(p.Process.Input, `extent`)
+	if minimum, maximum := extent.occurs(); minimum != 0 || maximum != -1 || !extent.BoundingBoxData.supports(`http://www.opengis.net/def/crs/EPSG/0/4326`) {
+		t.Errorf("test: %d, expected: %s,\n got: %+v", 0, `extent`, extent)
+	}
+	if options := inputDescription(p.Process.Input, `options`); options == nil || inputDescription(options.Input, `segments`) == nil {
+		t.Errorf("test: %d, expected: %s,\n got: %+v", 0, `options`, options)
+	}
+
+	var n ProcessOfferings
+	if err := n.ParseXML(o.ToXML()); err != nil || !reflect.DeepEqual(n, o) {
+		t.Errorf("test: %d, expected: %+v,\n got: %+v, %v", 0, o, n, err)
+	}
+}
@@ -0,0 +1,34 @@
+package wps200
+
+import (
+	"encoding/xml"
+
+	"github.com/pdok/ogc-specifications/pkg/wsc200"
+)
+
+type exception struct {
+	XMLName       xml.Name `xml:"ows:Exception" yaml:"owsException"`
+	ExceptionText string   `xml:",chardata" yaml:"exception"`
+	ExceptionCode string   `xml:"exceptionCode,attr" yaml:"exceptionCode"`
+	LocatorCode   string   `xml:"locator,attr,omitempty" yaml:"locatorCode"`
+}
+
+// ToExceptions promotes a single exception to an array of one
+func (e exception) ToExceptions() []wsc200.Exception {
+	return []wsc200.Exception{e}
+}
+
+// Error returns available ExceptionText
+func (e exception) Error() string {
+	return e.ExceptionText
+}
+
+// Code returns available ExceptionCode
+func (e exception) Code() string {
+	return e.ExceptionCode
+}
+
+// Locator returns available ExceptionCode
+func (e exception) Locator() string {
+	return e.LocatorCode
+}
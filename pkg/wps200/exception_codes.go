@@ -0,0 +1,95 @@
+package wps200
+
+import (
+	"github.com/pdok/ogc-specifications/pkg/wsc200"
+)
+
+// NoSuchProcess exception
+func NoSuchProcess(identifier string) wsc200.Exception {
+	return exception{ExceptionText: "One of the identifiers passed does not match with any of the processes offered by this server: " + identifier,
+		ExceptionCode: `NoSuchProcess`,
+		LocatorCode:   identifier}
+}
+
+// NoSuchMode exception
+func NoSuchMode(mode string) wsc200.Exception {
+	return exception{ExceptionText: "The process does not permit the desired execution mode: " + mode,
+		ExceptionCode: `NoSuchMode`,
+		LocatorCode:   mode}
+}
+
+// NoSuchInput exception
+func NoSuchInput(id string) wsc200.Exception {
+	return exception{ExceptionText: "One or more of the input identifiers passed does not match with any of the input identifiers of this process: " + id,
+		ExceptionCode: `NoSuchInput`,
+		LocatorCode:   id}
+}
+
+// NoSuchOutput exception
+func NoSuchOutput(id string) wsc200.Exception {
+	return exception{ExceptionText: "One or more of the output identifiers passed does not match with any of the output identifiers of this process: " + id,
+		ExceptionCode: `NoSuchOutput`,
+		LocatorCode:   id}
+}
+
+// NoSuchFormat exception
+func NoSuchFormat(id string) wsc200.Exception {
+	return exception{ExceptionText: "One or more of the input or output formats specified in the request did not match with any of the formats defined for that particular input or output: " + id,
+		ExceptionCode: `NoSuchFormat`,
+		LocatorCode:   id}
+}
+
+// TooManyInputs exception
+func TooManyInputs(id string) wsc200.Exception {
+	return exception{ExceptionText: "Too many input items have been specified: " + id,
+		ExceptionCode: `TooManyInputs`,
+		LocatorCode:   id}
+}
+
+// TooFewInputs exception
+func TooFewInputs(id string) wsc200.Exception {
+	return exception{ExceptionText: "Too few input items have been specified: " + id,
+		ExceptionCode: `TooFewInputs`,
+		LocatorCode:   id}
+}
+
+// WrongInputData exception
+func WrongInputData(id string) wsc200.Exception {
+	return exception{ExceptionText: "The input data does not match the description of the input: " + id,
+		ExceptionCode: `WrongInputData`,
+		LocatorCode:   id}
+}
+
+// DataNotAccessible exception
+func DataNotAccessible(id string) wsc200.Exception {
+	return exception{ExceptionText: "One of the referenced input data sets was inaccessible: " + id,
+		ExceptionCode: `DataNotAccessible`,
+		LocatorCode:   id}
+}
+
+// SizeExceeded exception
+func SizeExceeded(id string) wsc200.Exception {
+	return exception{ExceptionText: "The size of one of the input parameters was too large for this process to handle: " + id,
+		ExceptionCode: `SizeExceeded`,
+		LocatorCode:   id}
+}
+
+// NoSuchJob exception
+func NoSuchJob(jobID string) wsc200.Exception {
+	return exception{ExceptionText: "The JobID from the request does not match any of the Jobs running on this server: " + jobID,
+		ExceptionCode: `NoSuchJob`,
+		LocatorCode:   jobID}
+}
+
+// ResultNotReady exception
+func ResultNotReady(jobID string) wsc200.Exception {
+	return exception{ExceptionText: "The result for the requested JobID has not yet been generated: " + jobID,
+		ExceptionCode: `ResultNotReady`,
+		LocatorCode:   jobID}
+}
+
+// InternalServerError exception
+func InternalServerError(message string) wsc200.Exception {
+	return exception{ExceptionText: message,
+		ExceptionCode: `InternalServerError`}
+}
@@ -0,0 +1,31 @@
+package wps200
+
+import (
+	"net/http"
+
+	"github.com/pdok/ogc-specifications/pkg/common"
+	"github.com/pdok/ogc-specifications/pkg/wsc200"
+)
+
+// statusCodes contains the HTTP status codes for the WPS 2.0 specific exception codes
+var statusCodes = map[string]int{
+	`NoSuchProcess`:       http.StatusBadRequest,
+	`NoSuchMode`:          http.StatusBadRequest,
+	`NoSuchInput`:         http.StatusBadRequest,
+	`NoSuchOutput`:        http.StatusBadRequest,
+	`NoSuchFormat`:        http.StatusBadRequest,
+	`TooManyInputs`:       http.StatusBadRequest,
+	`TooFewInputs`:        http.StatusBadRequest,
+	`WrongInputData`:      http.StatusBadRequest,
+	`DataNotAccessible`:   http.StatusBadRequest,
+	`SizeExceeded`:        http.StatusBadRequest,
+	`NoSuchJob`:           http.StatusBadRequest,
+	`ResultNotReady`:      http.StatusBadRequest,
+	`InternalServerError`: http.StatusInternalServerError,
+}
+
+// StatusCode returns the HTTP status code for a report with the given exceptions,
+// exception codes that are not defined by WPS 2.0 are looked up in the shared OWS Common codes
+func StatusCode(exceptions ...wsc200.Exception) int {
+	return common.StatusCode(statusCodes, exceptions...)
+}
@@ -0,0 +1,71 @@
+package wps200
+
+import (
+	"testing"
+
+	"github.com/pdok/ogc-specifications/pkg/wsc200"
+)
+
+func TestWPSException(t *testing.T) {
+	var tests = []struct {
+		exception     wsc200.Exception
+		exceptionText string
+		exceptionCode string
+		locatorCode   string
+	}{
+		0: {exception: NoSuchProcess(`buffer`),
+			exceptionCode: `NoSuchProcess`,
+			exceptionText: `One of the identifiers passed does not match with any of the processes offered by this server: buffer`,
+			locatorCode:   `buffer`,
+		},
+		1: {exception: NoSuchMode(`async`),
+			exceptionCode: `NoSuchMode`,
+			exceptionText: `The process does not permit the desired execution mode: async`,
+			locatorCode:   `async`,
+		},
+		2: {exception: TooFewInputs(`distance`),
+			exceptionCode: `TooFewInputs`,
+			exceptionText: `Too few input items have been specified: distance`,
+			locatorCode:   `distance`,
+		},
+		3: {exception: ResultNotReady(`job-1`),
+			exceptionCode: `ResultNotReady`,
+			exceptionText: `The result for the requested JobID has not yet been generated: job-1`,
+			locatorCode:   `job-1`,
+		},
+		4: {exception: InternalServerError(`out of memory`),
+			exceptionCode: `InternalServerError`,
+			exceptionText: `out of memory`,
+		},
+	}
+
+	for k, test := range tests {
+		if test.exception.Error() != test.exceptionText {
+			t.Errorf("test: %d, expected: %s\n got: %s", k, test.exceptionText, test.exception.Error())
+		}
+		if test.exception.Code() != test.exceptionCode {
+			t.Errorf("test: %d, expected: %s\n got: %s", k, test.exceptionCode, test.exception.Code())
+		}
+		if test.exception.Locator() != test.locatorCode {
+			t.Errorf("test: %d, expected: %s\n got: %s", k, test.locatorCode, test.exception.Locator())
+		}
+	}
+}
+
+func TestStatusCode(t *testing.T) {
+	var tests = []struct {
+		exceptions []wsc200.Exception
+		status     int
+	}{
+		0: {exceptions: nil, status: 200},
+		1: {exceptions: []wsc200.Exception{NoSuchJob(`job-1`)}, status: 400},
+		2: {exceptions: []wsc200.Exception{InternalServerError(`out of memory`)}, status: 500},
+		3: {exceptions: []wsc200.Exception{NoSuchInput(`width`), wsc200.MissingParameterValue(`VERSION`)}, status: 400},
+	}
+
+	for k, test := range tests {
+		if status := StatusCode(test.exceptions...); status != test.status {
+			t.Errorf("test: %d, expected: %d,\n got: %d", k, test.status, status)
+		}
+	}
+}
@@ -0,0 +1,357 @@
+package wps200
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pdok/ogc-specifications/pkg/utils"
+	"github.com/pdok/ogc-specifications/pkg/wsc200"
+)
+
+// Execute attributes
+const (
+	RESPONSE     = `response`
+	MODE         = `mode`
+	TRANSMISSION = `transmission`
+)
+
+// Execution modes
+const (
+	ModeSync  = `sync`
+	ModeAsync = `async`
+	ModeAuto  = `auto`
+)
+
+// Response forms
+const (
+	ResponseDocument = `document`
+	ResponseRaw      = `raw`
+)
+
+// ExecuteRequest struct with the needed parameters/attributes needed for making a Execute request,
+// WPS 2.0 only has a XML encoding for Execute
+type ExecuteRequest struct {
+	XMLName xml.Name `xml:"wps:Execute" yaml:"execute"`
+	BaseRequest
+	Response   string             `xml:"response,attr" yaml:"response"`
+	Mode       string             `xml:"mode,attr" yaml:"mode"`
+	Identifier string             `xml:"ows:Identifier" yaml:"identifier"`
+	Input      []DataInput        `xml:"wps:Input" yaml:"input,omitempty"`
+	Output     []OutputDefinition `xml:"wps:Output" yaml:"output,omitempty"`
+}
+
+// DataInput is the value of an input, given as data, as a reference to a web accessible resource or as nested inputs
+type DataInput struct {
+	ID        string         `xml:"id,attr" yaml:"id"`
+	Data      *Data          `xml:"wps:Data,omitempty" yaml:"data,omitempty"`
+	Reference *DataReference `xml:"wps:Reference,omitempty" yaml:"reference,omitempty"`
+	Input     []DataInput    `xml:"wps:Input" yaml:"input,omitempty"`
+}
+
+// DataReference is the reference to the resource of an input, that is retrieved by the service, or to a stored output
+type DataReference struct {
+	Href          string     `xml:"xlink:href,attr" yaml:"href"`
+	MimeType      *string    `xml:"mimeType,attr,omitempty" yaml:"mimeType,omitempty"`
+	Encoding      *string    `xml:"encoding,attr,omitempty" yaml:"encoding,omitempty"`
+	Schema        *string    `xml:"schema,attr,omitempty" yaml:"schema,omitempty"`
+	Body          *Content   `xml:"wps:Body,omitempty" yaml:"body,omitempty"`
+	BodyReference *Reference `xml:"wps:BodyReference,omitempty" yaml:"bodyReference,omitempty"`
+}
+
+// Data is the value of an input or output: a literal value, a bounding box or complex content that is kept as is
+type Data struct {
+	MimeType     *string             `yaml:"mimeType,omitempty"`
+	Encoding     *string             `yaml:"encoding,omitempty"`
+	Schema       *string             `yaml:"schema,omitempty"`
+	LiteralValue *LiteralValue       `yaml:"literalValue,omitempty"`
+	BoundingBox  *wsc200.BoundingBox `yaml:"boundingBox,omitempty"`
+	Content      `yaml:",inline"`
+}
+
+// typedData contains the literal value or bounding box the content of the data can be
+type typedData struct {
+	LiteralValue *LiteralValue       `xml:"wps:LiteralValue"`
+	BoundingBox  *wsc200.BoundingBox `xml:"ows:BoundingBox"`
+}
+
+// UnmarshalXML decodes a literal value or a bounding box, other content is kept as is
+func (d *Data) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	for _, a := range start.Attr {
+		value := a.Value
+		switch a.Name.Local {
+		case `mimeType`:
+			d.MimeType = &value
+		case `encoding`:
+			d.Encoding = &value
+		case `schema`:
+			d.Schema = &value
+		}
+	}
+	content, err := utils.InnerXML(decoder, start)
+	if err != nil {
+		return err
+	}
+
+	// the inner XML uses the prefixes of the struct tags without declaring them
+	var typed typedData
+	doc := `<Data xmlns:wps="` + Namespace + `" xmlns:ows="` + wsc200.Namespace + `">` + content + `</Data>`
+	if err := utils.UnmarshalPrefixed([]byte(doc), &typed, prefixes); err == nil && (typed.LiteralValue != nil || typed.BoundingBox != nil) {
+		d.LiteralValue, d.BoundingBox = typed.LiteralValue, typed.BoundingBox
+		return nil
+	}
+	d.Content.Content = content
+	return nil
+}
+
+// MarshalXML writes the literal value, the bounding box or the content as is
+func (d Data) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(struct {
+		MimeType     *string             `xml:"mimeType,attr,omitempty"`
+		Encoding     *string             `xml:"encoding,attr,omitempty"`
+		Schema       *string             `xml:"schema,attr,omitempty"`
+		LiteralValue *LiteralValue       `xml:"wps:LiteralValue,omitempty"`
+		BoundingBox  *wsc200.BoundingBox `xml:"ows:BoundingBox,omitempty"`
+		Content      string              `xml:",innerxml"`
+	}{d.MimeType, d.Encoding, d.Schema, d.LiteralValue, d.BoundingBox, d.Content.Content}, start)
+}
+
+// Content is XML content that is kept as is, the namespaces of the content have to be known by the service
+type Content struct {
+	Content string `yaml:"content,omitempty"`
+}
+
+// UnmarshalXML keeps the inner XML of the element
+func (c *Content) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	content, err := utils.InnerXML(d, start)
+	c.Content = content
+	return err
+}
+
+// MarshalXML writes the content as is
+func (c Content) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(struct {
+		Content string `xml:",innerxml"`
+	}{c.Content}, start)
+}
+
+// LiteralValue is a single value, like a number or a string
+type LiteralValue struct {
+	DataType *string `xml:"dataType,attr,omitempty" yaml:"dataType,omitempty"`
+	UOM      *string `xml:"uom,attr,omitempty" yaml:"uom,omitempty"`
+	Value    string  `xml:",chardata" yaml:"value"`
+}
+
+// OutputDefinition is the requested transmission and format of an output, or of its nested outputs
+type OutputDefinition struct {
+	ID           string             `xml:"id,attr" yaml:"id"`
+	Transmission *string            `xml:"transmission,attr,omitempty" yaml:"transmission,omitempty"`
+	MimeType     *string            `xml:"mimeType,attr,omitempty" yaml:"mimeType,omitempty"`
+	Encoding     *string            `xml:"encoding,attr,omitempty" yaml:"encoding,omitempty"`
+	Schema       *string            `xml:"schema,attr,omitempty" yaml:"schema,omitempty"`
+	Output       []OutputDefinition `xml:"wps:Output" yaml:"output,omitempty"`
+}
+
+// Type returns Execute
+func (r ExecuteRequest) Type() string {
+	return execute
+}
+
+// Async returns whether the process runs asynchronously, the client polls the status with GetStatus and
+// retrieves the outputs with GetResult
+func (r ExecuteRequest) Async() bool {
+	return r.Mode == ModeAsync
+}
+
+// Validate checks the mode, inputs and requested outputs of the Execute request against the offering of the process
+func (r ExecuteRequest) Validate(o ProcessOfferings) wsc200.Exceptions {
+	p := o.offering(r.Identifier)
+	if p == nil {
+		if r.Identifier == `` {
+			return wsc200.Exceptions{wsc200.MissingParameterValue(IDENTIFIER)}
+		}
+		return wsc200.Exceptions{NoSuchProcess(r.Identifier)}
+	}
+
+	var exceptions wsc200.Exceptions
+	switch r.Mode {
+	case ModeSync, ModeAsync:
+		if !p.controls(r.Mode + `-execute`) {
+			exceptions = append(exceptions, NoSuchMode(r.Mode))
+		}
+	case ModeAuto:
+	default:
+		exceptions = append(exceptions, NoSuchMode(r.Mode))
+	}
+	switch r.Response {
+	case ResponseDocument:
+	case ResponseRaw:
+		if len(r.Output) > 1 {
+			exceptions = append(exceptions, wsc200.InvalidParameterValue(r.Response, RESPONSE))
+		}
+	default:
+		exceptions = append(exceptions, wsc200.InvalidParameterValue(r.Response, RESPONSE))
+	}
+
+	exceptions = append(exceptions, validateInputs(r.Input, p.Process.Input)...)
+	exceptions = append(exceptions, validateOutputs(r.Output, p.Process.Output, p.ProcessProperties)...)
+	if len(exceptions) > 0 {
+		return exceptions
+	}
+	return nil
+}
+
+// validateInputs checks the occurrences and the values of the inputs, and of the nested inputs
+func validateInputs(inputs []DataInput, descriptions []InputDescription) wsc200.Exceptions {
+	var exceptions wsc200.Exceptions
+	occurs := map[string]int{}
+	for _, input := range inputs {
+		description := inputDescription(descriptions, input.ID)
+		if description == nil {
+			exceptions = append(exceptions, NoSuchInput(input.ID))
+			continue
+		}
+		occurs[input.ID]++
+		if _, maximum := description.occurs(); occurs[input.ID] == maximum+1 {
+			exceptions = append(exceptions, TooManyInputs(input.ID))
+		}
+		exceptions = append(exceptions, input.validate(*description)...)
+	}
+	for _, description := range descriptions {
+		if minimum, _ := description.occurs(); occurs[description.Identifier] < minimum {
+			exceptions = append(exceptions, TooFewInputs(description.Identifier))
+		}
+	}
+	return exceptions
+}
+
+// validate checks the value of the input against its description
+func (i DataInput) validate(d InputDescription) wsc200.Exceptions {
+	switch {
+	case len(d.Input) > 0:
+		if i.Data != nil || i.Reference != nil {
+			return wsc200.Exceptions{WrongInputData(i.ID)}
+		}
+		return validateInputs(i.Input, d.Input)
+	case i.Reference != nil:
+		if !d.formats().supports(i.Reference.MimeType, i.Reference.Encoding, i.Reference.Schema) {
+			return wsc200.Exceptions{NoSuchFormat(i.ID)}
+		}
+		return nil
+	case i.Data == nil:
+		return wsc200.Exceptions{WrongInputData(i.ID)}
+	}
+
+	if !d.formats().supports(i.Data.MimeType, i.Data.Encoding, i.Data.Schema) {
+		return wsc200.Exceptions{NoSuchFormat(i.ID)}
+	}
+	var err error
+	switch {
+	case d.LiteralData != nil && i.Data.LiteralValue != nil:
+		err = d.LiteralData.validate(*i.Data.LiteralValue)
+	case d.BoundingBoxData != nil && i.Data.BoundingBox != nil:
+		if crs := i.Data.BoundingBox.Crs; crs != nil && !d.BoundingBoxData.supports(*crs) {
+			err = fmt.Errorf("unsupported crs: %s", *crs)
+		}
+	case d.ComplexData != nil && i.Data.LiteralValue == nil && i.Data.BoundingBox == nil:
+	default:
+		err = fmt.Errorf("data doesn't match the input description")
+	}
+	if err != nil {
+		return wsc200.Exceptions{WrongInputData(i.ID)}
+	}
+	return nil
+}
+
+// formats returns the formats of the input description
+func (d InputDescription) formats() DataDescription {
+	switch {
+	case d.LiteralData != nil:
+		return d.LiteralData.DataDescription
+	case d.ComplexData != nil:
+		return *d.ComplexData
+	case d.BoundingBoxData != nil:
+		return d.BoundingBoxData.DataDescription
+	}
+	return DataDescription{}
+}
+
+// validate checks the literal value against the domains, the value has to match one of them
+func (l LiteralDataDescription) validate(v LiteralValue) error {
+	if len(l.LiteralDataDomain) == 0 {
+		return nil
+	}
+	for _, domain := range l.LiteralDataDomain {
+		if domain.allows(v) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%s isn't allowed", v.Value)
+}
+
+// allows returns whether the value matches the data type, the allowed values and the unit of measure of the domain
+func (d LiteralDataDomain) allows(v LiteralValue) bool {
+	if d.DataType != nil && !validLiteral(d.DataType.Value, v.Value) {
+		return false
+	}
+	if d.AllowedValues != nil && !d.AllowedValues.Allows(v.Value) {
+		return false
+	}
+	return v.UOM == nil || (d.UOM != nil && d.UOM.Value == *v.UOM)
+}
+
+// validLiteral returns whether the value is valid for the XML schema data type, unknown data types accept every value
+func validLiteral(dataType, value string) bool {
+	var err error
+	switch dataType[strings.Index(dataType, `:`)+1:] {
+	case `double`, `float`, `decimal`:
+		_, err = strconv.ParseFloat(value, 64)
+	case `integer`, `int`, `long`, `short`, `nonNegativeInteger`, `positiveInteger`:
+		_, err = strconv.ParseInt(value, 10, 64)
+	case `boolean`:
+		_, err = strconv.ParseBool(value)
+	}
+	return err == nil
+}
+
+// validateOutputs checks whether the requested outputs, and their nested outputs, exist and support the
+// transmission and format
+func validateOutputs(outputs []OutputDefinition, descriptions []OutputDescription, p ProcessProperties) wsc200.Exceptions {
+	var exceptions wsc200.Exceptions
+	for _, output := range outputs {
+		description := outputDescription(descriptions, output.ID)
+		switch {
+		case description == nil:
+			exceptions = append(exceptions, NoSuchOutput(output.ID))
+			continue
+		case output.Transmission != nil && !p.transmits(*output.Transmission):
+			exceptions = append(exceptions, wsc200.InvalidParameterValue(*output.Transmission, TRANSMISSION))
+		case (output.MimeType != nil || output.Encoding != nil || output.Schema != nil) &&
+			!supports(description.formats(), output.MimeType, output.Encoding, output.Schema):
+			exceptions = append(exceptions, NoSuchFormat(output.ID))
+		}
+		exceptions = append(exceptions, validateOutputs(output.Output, description.Output, p)...)
+	}
+	return exceptions
+}
+
+// ParseXML builds a Execute object based on a XML document
+func (r *ExecuteRequest) ParseXML(doc []byte) wsc200.Exceptions {
+	var xmlattributes utils.XMLAttribute
+	if err := xml.Unmarshal(doc, &xmlattributes); err != nil {
+		return wsc200.Exceptions{wsc200.MissingParameterValue()}
+	}
+	if err := utils.UnmarshalPrefixed(doc, r, prefixes); err != nil {
+		return wsc200.Exceptions{wsc200.NoApplicableCode(err.Error())}
+	}
+	r.Attr = stripAttr(xmlattributes, `service`, `version`, RESPONSE, MODE)
+	return nil
+}
+
+// ToXML builds a 'new' XML document 'based' on the 'original' XML document
+func (r ExecuteRequest) ToXML() []byte {
+	r.XMLName = xml.Name{}
+	doc, _ := utils.NewEncoder(prefixes).Marshal(r)
+	return doc
+}
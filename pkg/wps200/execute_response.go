@@ -0,0 +1,70 @@
+package wps200
+
+import (
+	"encoding/xml"
+
+	"github.com/pdok/ogc-specifications/pkg/utils"
+)
+
+// The states of a job in the StatusInfo
+const (
+	StatusAccepted  = `Accepted`
+	StatusRunning   = `Running`
+	StatusSucceeded = `Succeeded`
+	StatusFailed    = `Failed`
+	StatusDismissed = `Dismissed`
+)
+
+// StatusInfo is the response of an asynchronous Execute and GetStatus request
+type StatusInfo struct {
+	XMLName             xml.Name `xml:"wps:StatusInfo" yaml:"statusInfo"`
+	JobID               string   `xml:"wps:JobID" yaml:"jobId"`
+	Status              string   `xml:"wps:Status" yaml:"status"`
+	ExpirationDate      *string  `xml:"wps:ExpirationDate,omitempty" yaml:"expirationDate,omitempty"`
+	EstimatedCompletion *string  `xml:"wps:EstimatedCompletion,omitempty" yaml:"estimatedCompletion,omitempty"`
+	NextPoll            *string  `xml:"wps:NextPoll,omitempty" yaml:"nextPoll,omitempty"`
+	PercentCompleted    *int     `xml:"wps:PercentCompleted,omitempty" yaml:"percentCompleted,omitempty"`
+}
+
+// ParseXML builds the StatusInfo from a XML document
+func (s *StatusInfo) ParseXML(doc []byte) error {
+	return utils.UnmarshalPrefixed(doc, s, prefixes)
+}
+
+// ToXML builds the StatusInfo document
+func (s StatusInfo) ToXML() []byte {
+	doc, _ := utils.NewEncoder(prefixes).Marshal(s)
+	return doc
+}
+
+// Done returns whether the job is finished, the result of a succeeded job can be requested with GetResult
+func (s StatusInfo) Done() bool {
+	return s.Status == StatusSucceeded || s.Status == StatusFailed || s.Status == StatusDismissed
+}
+
+// Result is the response document of a synchronous Execute and GetResult request
+type Result struct {
+	XMLName        xml.Name     `xml:"wps:Result" yaml:"result"`
+	JobID          *string      `xml:"wps:JobID,omitempty" yaml:"jobId,omitempty"`
+	ExpirationDate *string      `xml:"wps:ExpirationDate,omitempty" yaml:"expirationDate,omitempty"`
+	Output         []DataOutput `xml:"wps:Output" yaml:"output"`
+}
+
+// ParseXML builds the Result from a XML document
+func (r *Result) ParseXML(doc []byte) error {
+	return utils.UnmarshalPrefixed(doc, r, prefixes)
+}
+
+// ToXML builds the Result document
+func (r Result) ToXML() []byte {
+	doc, _ := utils.NewEncoder(prefixes).Marshal(r)
+	return doc
+}
+
+// DataOutput is the value of an output, given as data, as a reference to the stored output or as nested outputs
+type DataOutput struct {
+	ID        string         `xml:"id,attr" yaml:"id"`
+	Data      *Data          `xml:"wps:Data,omitempty" yaml:"data,omitempty"`
+	Reference *DataReference `xml:"wps:Reference,omitempty" yaml:"reference,omitempty"`
+	Output    []DataOutput   `xml:"wps:Output" yaml:"output,omitempty"`
+}
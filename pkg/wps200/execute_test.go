@@ -0,0 +1,140 @@
+package wps200
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/pdok/ogc-specifications/pkg/wsc200"
+)
+
+const executeDocument = `<?xml version="1.0" encoding="UTF-8"?>
+<wps:Execute xmlns:wps="http://www.opengis.net/wps/2.0" xmlns:ows="http://www.opengis.net/ows/2.0" xmlns:xlink="http://www.w3.org/1999/xlink" xmlns:gml="http://www.opengis.net/gml/3.2" service="WPS" version="2.0.0" response="document" mode="async">
+  <ows:Identifier>buffer</ows:Identifier>
+  <wps:Input id="geometry">
+    <wps:Data mimeType="application/gml+xml" schema="http://schemas.opengis.net/gml/3.2.1/gml.xsd"><gml:Point gml:id="p1"><gml:pos>1 2</gml:pos></gml:Point></wps:Data>
+  </wps:Input>
+  <wps:Input id="distance">
+    <wps:Data><wps:LiteralValue dataType="xs:double" uom="m">10.5</wps:LiteralValue></wps:Data>
+  </wps:Input>
+  <wps:Input id="extent">
+    <wps:Data><ows:BoundingBox crs="http://www.opengis.net/def/crs/EPSG/0/28992"><ows:LowerCorner>1 2</ows:LowerCorner><ows:UpperCorner>3 4.5</ows:UpperCorner></ows:BoundingBox></wps:Data>
+  </wps:Input>
+  <wps:Input id="extent">
+    <wps:Reference xlink:href="http://example.com/extent" mimeType="text/plain"/>
+  </wps:Input>
+  <wps:Output id="result" transmission="reference" mimeType="application/geo+json"/>
+</wps:Execute>`
+
+func TestExecuteParseXML(t *testing.T) {
+	var r ExecuteRequest
+	if exceptions := r.ParseXML([]byte(executeDocument)); exceptions != nil {
+		t.Fatalf("test: %d, expected no exceptions,\n got: %v", 0, exceptions)
+	}
+
+	if r.Service != Service || r.Version != Version || r.Response != ResponseDocument || !r.Async() || r.Identifier != `buffer` || len(r.Input) != 4 {
+		t.Fatalf("test: %d, expected: %s,\n got: %+v", 0, `buffer`, r)
+	}
+	if geometry := r.Input[0].Data; geometry == nil || *geometry.MimeType != `application/gml+xml` ||
+		!strings.Contains(geometry.Content.Content, `<gml:pos>1 2</gml:pos>`) || geometry.LiteralValue != nil {
+		t.Errorf("test: %d, expected: %s,\n got: %+v", 0, `gml:Point`, geometry)
+	}
+	expectedLiteral := &LiteralValue{DataType: sp(`xs:double`), UOM: sp(`m`), Value: `10.5`}
+	if distance := r.Input[1].Data; distance == nil || !reflect.DeepEqual(distance.LiteralValue, expectedLiteral) {
+		t.Errorf("test: %d, expected: %+v,\n got: %+v", 0, expectedLiteral, distance)
+	}
+	expectedBox := &wsc200.BoundingBox{Crs: sp(`http://www.opengis.net/def/crs/EPSG/0/28992`), LowerCorner: wsc200.Position{1, 2}, UpperCorner: wsc200.Position{3, 4.5}}
+	if extent := r.Input[2].Data; extent == nil || !reflect.DeepEqual(extent.BoundingBox, expectedBox) {
+		t.Errorf("test: %d, expected: %+v,\n got: %+v", 0, expectedBox, extent)
+	}
+	if reference := r.Input[3].Reference; reference == nil || reference.Href != `http://example.com/extent` || *reference.MimeType != `text/plain` {
+		t.Errorf("test: %d, expected: %s,\n got: %+v", 0, `http://example.com/extent`, reference)
+	}
+	expectedOutput := []OutputDefinition{{ID: `result`, Transmission: sp(TransmissionReference), MimeType: sp(`application/geo+json`)}}
+	if !reflect.DeepEqual(r.Output, expectedOutput) {
+		t.Errorf("test: %d, expected: %+v,\n got: %+v", 0, expectedOutput, r.Output)
+	}
+
+	var n ExecuteRequest
+	if exceptions := n.ParseXML(r.ToXML()); exceptions != nil {
+		t.Fatalf("test: %d, expected no exceptions,\n got: %v", 0, exceptions)
+	}
+	n.Attr, r.Attr = nil, nil
+	if !reflect.DeepEqual(n, r) {
+		t.Errorf("test: %d, expected: %+v,\n got: %+v", 0, r, n)
+	}
+}
+
+func TestExecuteToXML(t *testing.T) {
+	r := ExecuteRequest{
+		BaseRequest: BaseRequest{Service: Service, Version: Version},
+		Response:    ResponseRaw,
+		Mode:        ModeSync,
+		Identifier:  `buffer`,
+		Input: []DataInput{
+			{ID: `distance`, Data: &Data{LiteralValue: &LiteralValue{Value: `10`}}},
+			{ID: `extent`, Data: &Data{BoundingBox: &wsc200.BoundingBox{LowerCorner: wsc200.Position{1, 2}, UpperCorner: wsc200.Position{3, 4.5}}}},
+		},
+		Output: []OutputDefinition{{ID: `result`}},
+	}
+	expected := `<wps:Execute xmlns:ows="http://www.opengis.net/ows/2.0" xmlns:wps="http://www.opengis.net/wps/2.0" service="WPS" version="2.0.0" response="raw" mode="sync">` +
+		`<ows:Identifier>buffer</ows:Identifier>` +
+		`<wps:Input id="distance"><wps:Data><wps:LiteralValue>10</wps:LiteralValue></wps:Data></wps:Input>` +
+		`<wps:Input id="extent"><wps:Data><ows:BoundingBox><ows:LowerCorner>1 2</ows:LowerCorner><ows:UpperCorner>3 4.5</ows:UpperCorner></ows:BoundingBox></wps:Data></wps:Input>` +
+		`<wps:Output id="result"/></wps:Execute>`
+
+	if result := string(r.ToXML()); !strings.HasSuffix(result, expected) {
+		t.Errorf("test: %d, expected: %s,\n got: %s", 0, expected, result)
+	}
+}
+
+func TestExecuteValidate(t *testing.T) {
+	geometry := DataInput{ID: `geometry`, Data: &Data{MimeType: sp(`application/geo+json`), Content: Content{Content: `{"type":"Point","coordinates":[1,2]}`}}}
+	literal := func(value string) DataInput {
+		return DataInput{ID: `distance`, Data: &Data{LiteralValue: &LiteralValue{Value: value}}}
+	}
+	request := func(mode, response string, inputs []DataInput, outputs ...OutputDefinition) ExecuteRequest {
+		return ExecuteRequest{Identifier: `buffer`, Mode: mode, Response: response, Input: inputs, Output: outputs}
+	}
+
+	var tests = []struct {
+		request    ExecuteRequest
+		exceptions wsc200.Exceptions
+	}{
+		0: {request: request(ModeAsync, ResponseDocument, []DataInput{geometry, literal(`1000`)}, OutputDefinition{ID: `result`, Transmission: sp(TransmissionReference)})},
+		1: {request: request(ModeAuto, ResponseRaw, []DataInput{geometry,
+			{ID: `extent`, Data: &Data{BoundingBox: &wsc200.BoundingBox{Crs: sp(`http://www.opengis.net/def/crs/EPSG/0/4326`), LowerCorner: wsc200.Position{1, 2}, UpperCorner: wsc200.Position{3, 4}}}},
+			{ID: `extent`, Reference: &DataReference{Href: `http://example.com/extent`, MimeType: sp(`text/plain`)}},
+			{ID: `options`, Input: []DataInput{{ID: `segments`, Data: &Data{LiteralValue: &LiteralValue{Value: `8`}}}}},
+		})},
+		2: {request: ExecuteRequest{Identifier: `clip`, Mode: ModeSync, Response: ResponseDocument}, exceptions: wsc200.Exceptions{NoSuchProcess(`clip`)}},
+		3: {request: ExecuteRequest{}, exceptions: wsc200.Exceptions{wsc200.MissingParameterValue(IDENTIFIER)}},
+		4: {request: request(`batch`, ResponseDocument, []DataInput{geometry}), exceptions: wsc200.Exceptions{NoSuchMode(`batch`)}},
+		5: {request: request(ModeSync, `json`, []DataInput{geometry}), exceptions: wsc200.Exceptions{wsc200.InvalidParameterValue(`json`, RESPONSE)}},
+		6: {request: request(ModeSync, ResponseRaw, []DataInput{geometry}, OutputDefinition{ID: `result`}, OutputDefinition{ID: `result`}),
+			exceptions: wsc200.Exceptions{wsc200.InvalidParameterValue(ResponseRaw, RESPONSE)}},
+		7:  {request: request(ModeSync, ResponseDocument, nil), exceptions: wsc200.Exceptions{TooFewInputs(`geometry`)}},
+		8:  {request: request(ModeSync, ResponseDocument, []DataInput{geometry, geometry}), exceptions: wsc200.Exceptions{TooManyInputs(`geometry`)}},
+		9:  {request: request(ModeSync, ResponseDocument, []DataInput{geometry, {ID: `width`}}), exceptions: wsc200.Exceptions{NoSuchInput(`width`)}},
+		10: {request: request(ModeSync, ResponseDocument, []DataInput{geometry, literal(`0`)}), exceptions: wsc200.Exceptions{WrongInputData(`distance`)}},
+		11: {request: request(ModeSync, ResponseDocument, []DataInput{geometry, literal(`ten`)}), exceptions: wsc200.Exceptions{WrongInputData(`distance`)}},
+		12: {request: request(ModeSync, ResponseDocument, []DataInput{{ID: `geometry`, Data: &Data{MimeType: sp(`image/png`)}}}),
+			exceptions: wsc200.Exceptions{NoSuchFormat(`geometry`)}},
+		13: {request: request(ModeSync, ResponseDocument, []DataInput{geometry, {ID: `extent`, Data: &Data{BoundingBox: &wsc200.BoundingBox{Crs: sp(`EPSG:3857`)}}}}),
+			exceptions: wsc200.Exceptions{WrongInputData(`extent`)}},
+		14: {request: request(ModeSync, ResponseDocument, []DataInput{geometry, {ID: `options`, Input: []DataInput{{ID: `segments`, Data: &Data{LiteralValue: &LiteralValue{Value: `8.5`}}}}}}),
+			exceptions: wsc200.Exceptions{WrongInputData(`segments`)}},
+		15: {request: request(ModeSync, ResponseDocument, []DataInput{geometry}, OutputDefinition{ID: `area`}), exceptions: wsc200.Exceptions{NoSuchOutput(`area`)}},
+		16: {request: request(ModeSync, ResponseDocument, []DataInput{geometry}, OutputDefinition{ID: `result`, Transmission: sp(`stream`)}),
+			exceptions: wsc200.Exceptions{wsc200.InvalidParameterValue(`stream`, TRANSMISSION)}},
+		17: {request: request(ModeSync, ResponseDocument, []DataInput{geometry}, OutputDefinition{ID: `result`, MimeType: sp(`image/png`)}),
+			exceptions: wsc200.Exceptions{NoSuchFormat(`result`)}},
+	}
+
+	o := processOfferings(t)
+	for k, test := range tests {
+		if exceptions := test.request.Validate(o); !reflect.DeepEqual(exceptions, test.exceptions) {
+			t.Errorf("test: %d, expected: %v,\n got: %v", k, test.exceptions, exceptions)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package wps200
+
+import (
+	"encoding/xml"
+	"net/url"
+	"strings"
+
+	"github.com/pdok/ogc-specifications/pkg/utils"
+	"github.com/pdok/ogc-specifications/pkg/wsc200"
+)
+
+// GetCapabilitiesRequest struct with the needed parameters/attributes needed for making a GetCapabilities request
+type GetCapabilitiesRequest struct {
+	XMLName                          xml.Name           `xml:"wps:GetCapabilities" yaml:"getCapabilities"`
+	Service                          string             `xml:"service,attr" yaml:"service"`
+	Attr                             utils.XMLAttribute `xml:",attr" yaml:"attr"`
+	wsc200.GetCapabilitiesParameters `yaml:",inline"`
+}
+
+// Type returns GetCapabilities
+func (gc GetCapabilitiesRequest) Type() string {
+	return getcapabilities
+}
+
+// Validate validates the OWS Common parameters of the GetCapabilities request
+func (gc GetCapabilitiesRequest) Validate(_ Capabilities) wsc200.Exceptions {
+	var exceptions wsc200.Exceptions
+	if _, exception := gc.NegotiateVersion(Version); exception != nil {
+		exceptions = append(exceptions, exception)
+	}
+	exceptions = append(exceptions, gc.ValidateSections(sections...)...)
+	return exceptions
+}
+
+// ParseXML builds a GetCapabilities object based on a XML document
+func (gc *GetCapabilitiesRequest) ParseXML(doc []byte) wsc200.Exceptions {
+	var xmlattributes utils.XMLAttribute
+	if err := xml.Unmarshal(doc, &xmlattributes); err != nil {
+		return wsc200.Exceptions{wsc200.MissingParameterValue()}
+	}
+	if err := utils.UnmarshalPrefixed(doc, gc, prefixes); err != nil {
+		return wsc200.Exceptions{wsc200.NoApplicableCode(err.Error())}
+	}
+	gc.Attr = stripAttr(xmlattributes, `service`, `updateSequence`)
+	return nil
+}
+
+// ParseQueryParameters builds a GetCapabilities object based on the available query parameters
+func (gc *GetCapabilitiesRequest) ParseQueryParameters(query url.Values) wsc200.Exceptions {
+	gc.XMLName.Local = getcapabilities
+	gc.Service = strings.ToUpper(first(query, SERVICE))
+	if gc.Service == `` {
+		return wsc200.Exceptions{wsc200.MissingParameterValue(SERVICE)}
+	}
+	return gc.GetCapabilitiesParameters.ParseQueryParameters(query)
+}
+
+// ToQueryParameters builds a new query string that will be proxied
+func (gc GetCapabilitiesRequest) ToQueryParameters() url.Values {
+	query := url.Values{}
+	query[REQUEST] = []string{getcapabilities}
+	query[SERVICE] = []string{gc.Service}
+	gc.GetCapabilitiesParameters.SetQueryParameters(query)
+	return query
+}
+
+// ToXML builds a 'new' XML document 'based' on the 'original' XML document
+func (gc GetCapabilitiesRequest) ToXML() []byte {
+	gc.XMLName = xml.Name{}
+	doc, _ := utils.NewEncoder(prefixes).Marshal(gc)
+	return doc
+}
@@ -0,0 +1,75 @@
+package wps200
+
+import (
+	"encoding/xml"
+
+	"github.com/pdok/ogc-specifications/pkg/utils"
+	"github.com/pdok/ogc-specifications/pkg/wsc200"
+)
+
+// Type function needed for the interface
+func (gc GetCapabilitiesResponse) Type() string {
+	return getcapabilities
+}
+
+// Service function needed for the interface
+func (gc GetCapabilitiesResponse) Service() string {
+	return Service
+}
+
+// Version function needed for the interface
+func (gc GetCapabilitiesResponse) Version() string {
+	return Version
+}
+
+// Validate function of the wps200 spec
+func (gc GetCapabilitiesResponse) Validate() wsc200.Exceptions {
+	return nil
+}
+
+// ParseXML builds a GetCapabilitiesResponse from a WPS 2.0 capabilities document,
+// regardless of the namespace prefixes used in the document
+func (gc *GetCapabilitiesResponse) ParseXML(doc []byte) error {
+	return utils.UnmarshalPrefixed(doc, gc, prefixes)
+}
+
+// ParseYAML builds a GetCapabilitiesResponse from a YAML document, unknown keys are ignored
+func (gc *GetCapabilitiesResponse) ParseYAML(doc []byte) error {
+	return utils.UnmarshalYAML(doc, gc, utils.Lenient)
+}
+
+// ToXML builds a GetCapabilities response object
+func (gc GetCapabilitiesResponse) ToXML() []byte {
+	doc, _ := utils.NewEncoder(prefixes).Marshal(gc)
+	return doc
+}
+
+// GetCapabilitiesResponse base struct
+type GetCapabilitiesResponse struct {
+	XMLName               xml.Name `xml:"wps:Capabilities" yaml:"capabilities"`
+	Namespaces            `yaml:"namespaces"`
+	ServiceIdentification *wsc200.ServiceIdentification `xml:"ows:ServiceIdentification,omitempty" yaml:"serviceIdentification"`
+	ServiceProvider       *wsc200.ServiceProvider       `xml:"ows:ServiceProvider,omitempty" yaml:"serviceProvider"`
+	OperationsMetadata    *wsc200.OperationsMetadata    `xml:"ows:OperationsMetadata,omitempty" yaml:"operationsMetadata"`
+	Languages             *wsc200.Languages             `xml:"ows:Languages,omitempty" yaml:"languages,omitempty"`
+	Capabilities          `yaml:",inline"`
+}
+
+// MarshalXML leaves out empty Contents, like when they aren't requested
+func (c Contents) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type section Contents
+	return utils.EncodeNonZero(e, section(c), start)
+}
+
+// Namespaces struct containing the attributes of the root element, the namespaces are declared when they are used
+type Namespaces struct {
+	Service        string `xml:"service,attr" yaml:"service"`
+	Version        string `xml:"version,attr" yaml:"version"`
+	UpdateSequence string `xml:"updateSequence,attr,omitempty" yaml:"updateSequence,omitempty"`
+	SchemaLocation string `xml:"xsi:schemaLocation,attr,omitempty" yaml:"schemaLocation"`
+}
+
+// Reference is a link to a web accessible resource
+type Reference struct {
+	Href string `xml:"xlink:href,attr" yaml:"href"`
+}
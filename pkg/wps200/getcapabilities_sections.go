@@ -0,0 +1,49 @@
+package wps200
+
+import (
+	"github.com/pdok/ogc-specifications/pkg/wsc200"
+)
+
+// sections contains the sections that can be requested
+var sections = []string{
+	wsc200.ServiceIdentificationSection,
+	wsc200.ServiceProviderSection,
+	wsc200.OperationsMetadataSection,
+	wsc200.ContentsSection,
+	wsc200.LanguagesSection,
+}
+
+// Trim returns the capabilities for the GetCapabilities request: the requested sections, or only the version and
+// update sequence when the requested update sequence is the current one.
+// InvalidUpdateSequence is returned when the requested update sequence is greater than the current one.
+func (gc GetCapabilitiesResponse) Trim(r GetCapabilitiesRequest) (GetCapabilitiesResponse, wsc200.Exceptions) {
+	if exceptions := r.ValidateSections(sections...); len(exceptions) > 0 {
+		return GetCapabilitiesResponse{}, exceptions
+	}
+
+	equal, exception := r.CheckUpdateSequence(gc.Namespaces.UpdateSequence)
+	if exception != nil {
+		return GetCapabilitiesResponse{}, exception.ToExceptions()
+	}
+
+	trimmed := GetCapabilitiesResponse{XMLName: gc.XMLName, Namespaces: gc.Namespaces}
+	if equal {
+		return trimmed, nil
+	}
+	if r.HasSection(wsc200.ServiceIdentificationSection) {
+		trimmed.ServiceIdentification = gc.ServiceIdentification
+	}
+	if r.HasSection(wsc200.ServiceProviderSection) {
+		trimmed.ServiceProvider = gc.ServiceProvider
+	}
+	if r.HasSection(wsc200.OperationsMetadataSection) {
+		trimmed.OperationsMetadata = gc.OperationsMetadata
+	}
+	if r.HasSection(wsc200.ContentsSection) {
+		trimmed.Contents = gc.Contents
+	}
+	if r.HasSection(wsc200.LanguagesSection) {
+		trimmed.Languages = gc.Languages
+	}
+	return trimmed, nil
+}
@@ -0,0 +1,161 @@
+package wps200
+
+import (
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/pdok/ogc-specifications/pkg/wsc200"
+)
+
+const capabilitiesDocument = `<?xml version="1.0" encoding="UTF-8"?>
+<wps:Capabilities xmlns:wps="http://www.opengis.net/wps/2.0" xmlns:ows="http://www.opengis.net/ows/2.0" xmlns:xlink="http://www.w3.org/1999/xlink" service="WPS" version="2.0.0" updateSequence="3">
+  <ows:ServiceIdentification>
+    <ows:Title>Processes</ows:Title>
+    <ows:Abstract>Geometry processes</ows:Abstract>
+    <ows:ServiceType>WPS</ows:ServiceType>
+    <ows:ServiceTypeVersion>2.0.0</ows:ServiceTypeVersion>
+    <ows:Fees>NONE</ows:Fees>
+    <ows:AccessConstraints>NONE</ows:AccessConstraints>
+  </ows:ServiceIdentification>
+  <ows:ServiceProvider><ows:ProviderName>PDOK</ows:ProviderName><ows:ProviderSite xlink:href="https://www.pdok.nl"/></ows:ServiceProvider>
+  <ows:OperationsMetadata>
+    <ows:Operation name="Execute"><ows:DCP><ows:HTTP><ows:Post xlink:href="https://example.com/wps"/></ows:HTTP></ows:DCP></ows:Operation>
+  </ows:OperationsMetadata>
+  <ows:Languages><ows:Language>en</ows:Language></ows:Languages>
+  <wps:Contents>
+    <wps:ProcessSummary jobControlOptions="sync-execute async-execute" processVersion="1.0">
+      <ows:Title>Buffer</ows:Title>
+      <ows:Identifier>buffer</ows:Identifier>
+    </wps:ProcessSummary>
+  </wps:Contents>
+</wps:Capabilities>`
+
+var capabilities = Capabilities{Contents: Contents{ProcessSummary: []ProcessSummary{{
+	ProcessProperties: ProcessProperties{JobControlOptions: `sync-execute async-execute`, ProcessVersion: sp(`1.0`)},
+	Description:       Description{Title: wsc200.LanguageStrings{{Value: `Buffer`}}, Identifier: `buffer`}}}}}
+
+func TestGetCapabilitiesParseQueryParameters(t *testing.T) {
+	var tests = []struct {
+		query     url.Values
+		result    GetCapabilitiesRequest
+		exception string
+	}{
+		0: {query: url.Values{`service`: {`wps`}, `request`: {`GetCapabilities`}, `AcceptVersions`: {`2.0.0,1.0.0`}, `sections`: {`Contents`}},
+			result: GetCapabilitiesRequest{Service: Service, GetCapabilitiesParameters: wsc200.GetCapabilitiesParameters{
+				AcceptVersions: &wsc200.AcceptVersions{Version: []string{`2.0.0`, `1.0.0`}}, Sections: &wsc200.Sections{Section: []string{wsc200.ContentsSection}}}}},
+		1: {query: url.Values{REQUEST: {`GetCapabilities`}}, exception: `MissingParameterValue`},
+	}
+
+	for k, test := range tests {
+		var gc GetCapabilitiesRequest
+		exceptions := gc.ParseQueryParameters(test.query)
+		if test.exception != `` {
+			if len(exceptions) != 1 || exceptions[0].Code() != test.exception {
+				t.Errorf("test: %d, expected: %s,\n got: %v", k, test.exception, exceptions)
+			}
+			continue
+		}
+		test.result.XMLName.Local = getcapabilities
+		if !reflect.DeepEqual(gc, test.result) {
+			t.Errorf("test: %d, expected: %+v,\n got: %+v", k, test.result, gc)
+		}
+		if query := gc.ToQueryParameters(); query.Get(wsc200.ACCEPTVERSIONS) != `2.0.0,1.0.0` || query.Get(wsc200.SECTIONS) != wsc200.ContentsSection {
+			t.Errorf("test: %d, expected: %v,\n got: %v", k, test.query, query)
+		}
+	}
+}
+
+func TestGetCapabilitiesParseXML(t *testing.T) {
+	doc := `<GetCapabilities xmlns="http://www.opengis.net/wps/2.0" xmlns:ows="http://www.opengis.net/ows/2.0" service="WPS"><ows:AcceptVersions><ows:Version>2.0.0</ows:Version></ows:AcceptVersions><ows:Sections><ows:Section>Contents</ows:Section></ows:Sections></GetCapabilities>`
+
+	var gc GetCapabilitiesRequest
+	if exceptions := gc.ParseXML([]byte(doc)); exceptions != nil {
+		t.Fatalf("test: %d, expected no exceptions,\n got: %v", 0, exceptions)
+	}
+	if gc.Service != Service || gc.AcceptVersions == nil || gc.AcceptVersions.Version[0] != Version || !gc.HasSection(wsc200.ContentsSection) || gc.HasSection(wsc200.LanguagesSection) {
+		t.Errorf("test: %d, expected: %s,\n got: %+v", 0, doc, gc)
+	}
+
+	gc.Attr = nil
+	body := `<wps:GetCapabilities xmlns:ows="http://www.opengis.net/ows/2.0" xmlns:wps="http://www.opengis.net/wps/2.0" service="WPS"><ows:AcceptVersions><ows:Version>2.0.0</ows:Version></ows:AcceptVersions><ows:Sections><ows:Section>Contents</ows:Section></ows:Sections></wps:GetCapabilities>`
+	if result := string(gc.ToXML()); !strings.HasSuffix(result, body) {
+		t.Errorf("test: %d, expected: %s,\n got: %s", 0, body, result)
+	}
+}
+
+func TestGetCapabilitiesValidate(t *testing.T) {
+	var tests = []struct {
+		request    GetCapabilitiesRequest
+		exceptions []string
+	}{
+		0: {request: GetCapabilitiesRequest{GetCapabilitiesParameters: wsc200.GetCapabilitiesParameters{Sections: &wsc200.Sections{Section: []string{wsc200.LanguagesSection}}}}},
+		1: {request: GetCapabilitiesRequest{GetCapabilitiesParameters: wsc200.GetCapabilitiesParameters{AcceptVersions: &wsc200.AcceptVersions{Version: []string{`1.0.0`}},
+			Sections: &wsc200.Sections{Section: []string{`ServiceMetadata`}}}},
+			exceptions: []string{`VersionNegotiationFailed`, `InvalidParameterValue`}},
+	}
+
+	for k, test := range tests {
+		var codes []string
+		for _, exception := range test.request.Validate(capabilities) {
+			codes = append(codes, exception.Code())
+		}
+		if !reflect.DeepEqual(codes, test.exceptions) {
+			t.Errorf("test: %d, expected: %v,\n got: %v", k, test.exceptions, codes)
+		}
+	}
+}
+
+func TestGetCapabilitiesResponseParseXML(t *testing.T) {
+	var gc GetCapabilitiesResponse
+	if err := gc.ParseXML([]byte(capabilitiesDocument)); err != nil {
+		t.Fatalf("test: %d, expected no error,\n got: %v", 0, err)
+	}
+	if !reflect.DeepEqual(gc.Capabilities, capabilities) {
+		t.Errorf("test: %d, expected: %+v,\n got: %+v", 0, capabilities, gc.Capabilities)
+	}
+	if gc.ServiceProvider == nil || gc.ServiceProvider.ProviderSite.Href != `https://www.pdok.nl` || gc.Languages == nil || gc.Languages.Language[0] != `en` {
+		t.Errorf("test: %d, expected: %s,\n got: %+v", 0, capabilitiesDocument, gc)
+	}
+
+	var c Capabilities
+	if err := c.ParseXML(gc.ToXML()); err != nil || !reflect.DeepEqual(c, capabilities) {
+		t.Errorf("test: %d, expected: %+v,\n got: %+v, %v", 0, capabilities, c, err)
+	}
+}
+
+func TestGetCapabilitiesResponseTrim(t *testing.T) {
+	var gc GetCapabilitiesResponse
+	if err := gc.ParseXML([]byte(capabilitiesDocument)); err != nil {
+		t.Fatalf("test: %d, expected no error,\n got: %v", 0, err)
+	}
+
+	var tests = []struct {
+		parameters wsc200.GetCapabilitiesParameters
+		contents   bool
+		languages  bool
+		exception  string
+	}{
+		0: {parameters: wsc200.GetCapabilitiesParameters{}, contents: true, languages: true},
+		1: {parameters: wsc200.GetCapabilitiesParameters{Sections: &wsc200.Sections{Section: []string{wsc200.LanguagesSection}}}, languages: true},
+		2: {parameters: wsc200.GetCapabilitiesParameters{UpdateSequence: `3`}},
+		3: {parameters: wsc200.GetCapabilitiesParameters{UpdateSequence: `4`}, exception: `InvalidUpdateSequence`},
+	}
+
+	for k, test := range tests {
+		trimmed, exceptions := gc.Trim(GetCapabilitiesRequest{GetCapabilitiesParameters: test.parameters})
+		if test.exception != `` {
+			if len(exceptions) != 1 || exceptions[0].Code() != test.exception {
+				t.Errorf("test: %d, expected: %s,\n got: %v", k, test.exception, exceptions)
+			}
+			continue
+		}
+		if (len(trimmed.Contents.ProcessSummary) > 0) != test.contents || (trimmed.Languages != nil) != test.languages {
+			t.Errorf("test: %d, expected: contents %t and languages %t,\n got: %+v", k, test.contents, test.languages, trimmed)
+		}
+		if doc := string(trimmed.ToXML()); strings.Contains(doc, `wps:Contents`) != test.contents {
+			t.Errorf("test: %d, expected: contents %t,\n got: %s", k, test.contents, doc)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package wps200
+
+import (
+	"encoding/xml"
+	"net/url"
+
+	"github.com/pdok/ogc-specifications/pkg/utils"
+	"github.com/pdok/ogc-specifications/pkg/wsc200"
+)
+
+// GetResultRequest struct with the needed parameters/attributes needed for making a GetResult request
+type GetResultRequest struct {
+	XMLName xml.Name `xml:"wps:GetResult" yaml:"getResult"`
+	BaseRequest
+	JobID string `xml:"wps:JobID" yaml:"jobId"`
+}
+
+// Type returns GetResult
+func (r GetResultRequest) Type() string {
+	return getresult
+}
+
+// Validate checks whether the job is known, jobs is the list of the current job ids
+func (r GetResultRequest) Validate(jobs []string) wsc200.Exceptions {
+	return validateJobID(r.JobID, jobs)
+}
+
+// ParseXML builds a GetResult object based on a XML document
+func (r *GetResultRequest) ParseXML(doc []byte) wsc200.Exceptions {
+	var xmlattributes utils.XMLAttribute
+	if err := xml.Unmarshal(doc, &xmlattributes); err != nil {
+		return wsc200.Exceptions{wsc200.MissingParameterValue()}
+	}
+	if err := utils.UnmarshalPrefixed(doc, r, prefixes); err != nil {
+		return wsc200.Exceptions{wsc200.NoApplicableCode(err.Error())}
+	}
+	r.Attr = stripAttr(xmlattributes, `service`, `version`)
+	return nil
+}
+
+// ParseQueryParameters builds a GetResult object based on the available query parameters
+func (r *GetResultRequest) ParseQueryParameters(query url.Values) wsc200.Exceptions {
+	r.XMLName.Local = getresult
+	jobID, exceptions := r.BaseRequest.parseJobQueryParameters(query)
+	r.JobID = jobID
+	return exceptions
+}
+
+// ToQueryParameters builds a new query string that will be proxied
+func (r GetResultRequest) ToQueryParameters() url.Values {
+	query := url.Values{}
+	r.BaseRequest.setQueryParameters(query, getresult)
+	query[JOBID] = []string{r.JobID}
+	return query
+}
+
+// ToXML builds a 'new' XML document 'based' on the 'original' XML document
+func (r GetResultRequest) ToXML() []byte {
+	r.XMLName = xml.Name{}
+	doc, _ := utils.NewEncoder(prefixes).Marshal(r)
+	return doc
+}
@@ -0,0 +1,86 @@
+package wps200
+
+import (
+	"encoding/xml"
+	"net/url"
+
+	"github.com/pdok/ogc-specifications/pkg/utils"
+	"github.com/pdok/ogc-specifications/pkg/wsc200"
+)
+
+// GetStatusRequest struct with the needed parameters/attributes needed for making a GetStatus request
+type GetStatusRequest struct {
+	XMLName xml.Name `xml:"wps:GetStatus" yaml:"getStatus"`
+	BaseRequest
+	JobID string `xml:"wps:JobID" yaml:"jobId"`
+}
+
+// Type returns GetStatus
+func (r GetStatusRequest) Type() string {
+	return getstatus
+}
+
+// Validate checks whether the job is known, jobs is the list of the current job ids
+func (r GetStatusRequest) Validate(jobs []string) wsc200.Exceptions {
+	return validateJobID(r.JobID, jobs)
+}
+
+// validateJobID checks whether the job id is one of the jobs
+func validateJobID(jobID string, jobs []string) wsc200.Exceptions {
+	if jobID == `` {
+		return wsc200.Exceptions{wsc200.MissingParameterValue(JOBID)}
+	}
+	if !contains(jobs, jobID) {
+		return wsc200.Exceptions{NoSuchJob(jobID)}
+	}
+	return nil
+}
+
+// ParseXML builds a GetStatus object based on a XML document
+func (r *GetStatusRequest) ParseXML(doc []byte) wsc200.Exceptions {
+	var xmlattributes utils.XMLAttribute
+	if err := xml.Unmarshal(doc, &xmlattributes); err != nil {
+		return wsc200.Exceptions{wsc200.MissingParameterValue()}
+	}
+	if err := utils.UnmarshalPrefixed(doc, r, prefixes); err != nil {
+		return wsc200.Exceptions{wsc200.NoApplicableCode(err.Error())}
+	}
+	r.Attr = stripAttr(xmlattributes, `service`, `version`)
+	return nil
+}
+
+// ParseQueryParameters builds a GetStatus object based on the available query parameters
+func (r *GetStatusRequest) ParseQueryParameters(query url.Values) wsc200.Exceptions {
+	r.XMLName.Local = getstatus
+	jobID, exceptions := r.BaseRequest.parseJobQueryParameters(query)
+	r.JobID = jobID
+	return exceptions
+}
+
+// parseJobQueryParameters returns the mandatory JOBID of a GetStatus or GetResult request
+func (b *BaseRequest) parseJobQueryParameters(query url.Values) (string, wsc200.Exceptions) {
+	exceptions := b.parseQueryParameters(query)
+	jobID := first(query, JOBID)
+	if jobID == `` {
+		exceptions = append(exceptions, wsc200.MissingParameterValue(JOBID))
+	}
+	if len(exceptions) > 0 {
+		return jobID, exceptions
+	}
+	return jobID, nil
+}
+
+// ToQueryParameters builds a new query string that will be proxied
+func (r GetStatusRequest) ToQueryParameters() url.Values {
+	query := url.Values{}
+	r.BaseRequest.setQueryParameters(query, getstatus)
+	query[JOBID] = []string{r.JobID}
+	return query
+}
+
+// ToXML builds a 'new' XML document 'based' on the 'original' XML document
+func (r GetStatusRequest) ToXML() []byte {
+	r.XMLName = xml.Name{}
+	doc, _ := utils.NewEncoder(prefixes).Marshal(r)
+	return doc
+}
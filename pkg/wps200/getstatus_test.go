@@ -0,0 +1,144 @@
+package wps200
+
+import (
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/pdok/ogc-specifications/pkg/wsc200"
+)
+
+var jobs = []string{`job-1`, `job-2`}
+
+func TestGetStatusParseQueryParameters(t *testing.T) {
+	var tests = []struct {
+		query      url.Values
+		result     GetStatusRequest
+		exceptions wsc200.Exceptions
+	}{
+		0: {query: url.Values{`service`: {`WPS`}, `version`: {`2.0.0`}, `request`: {`GetStatus`}, `jobid`: {`job-1`}},
+			result: GetStatusRequest{BaseRequest: BaseRequest{Service: Service, Version: Version}, JobID: `job-1`}},
+		1: {query: url.Values{SERVICE: {`WPS`}, VERSION: {`2.0.0`}, REQUEST: {`GetStatus`}},
+			result:     GetStatusRequest{BaseRequest: BaseRequest{Service: Service, Version: Version}},
+			exceptions: wsc200.Exceptions{wsc200.MissingParameterValue(JOBID)}},
+		2: {query: url.Values{SERVICE: {`WPS`}, REQUEST: {`GetStatus`}, JOBID: {`job-1`}},
+			result:     GetStatusRequest{BaseRequest: BaseRequest{Service: Service}, JobID: `job-1`},
+			exceptions: wsc200.Exceptions{wsc200.MissingParameterValue(VERSION)}},
+	}
+
+	for k, test := range tests {
+		var r GetStatusRequest
+		exceptions := r.ParseQueryParameters(test.query)
+		if !reflect.DeepEqual(exceptions, test.exceptions) {
+			t.Errorf("test: %d, expected: %v,\n got: %v", k, test.exceptions, exceptions)
+		}
+		test.result.XMLName.Local = getstatus
+		if !reflect.DeepEqual(r, test.result) {
+			t.Errorf("test: %d, expected: %+v,\n got: %+v", k, test.result, r)
+		}
+	}
+}
+
+func TestGetStatusToQueryParameters(t *testing.T) {
+	r := GetStatusRequest{BaseRequest: BaseRequest{Service: Service, Version: Version}, JobID: `job-1`}
+	expected := url.Values{SERVICE: {Service}, VERSION: {Version}, REQUEST: {getstatus}, JOBID: {`job-1`}}
+	if query := r.ToQueryParameters(); !reflect.DeepEqual(query, expected) {
+		t.Errorf("test: %d, expected: %v,\n got: %v", 0, expected, query)
+	}
+}
+
+func TestGetStatusParseXML(t *testing.T) {
+	doc := `<wps:GetStatus xmlns:wps="http://www.opengis.net/wps/2.0" service="WPS" version="2.0.0"><wps:JobID>job-1</wps:JobID></wps:GetStatus>`
+
+	var r GetStatusRequest
+	if exceptions := r.ParseXML([]byte(doc)); exceptions != nil {
+		t.Fatalf("test: %d, expected no exceptions,\n got: %v", 0, exceptions)
+	}
+	if r.JobID != `job-1` || r.Service != Service || r.Version != Version {
+		t.Errorf("test: %d, expected: %s,\n got: %+v", 0, `job-1`, r)
+	}
+	r.Attr = nil
+	if result := string(r.ToXML()); !strings.HasSuffix(result, doc) {
+		t.Errorf("test: %d, expected: %s,\n got: %s", 0, doc, result)
+	}
+}
+
+func TestGetResultParseXML(t *testing.T) {
+	doc := `<wps:GetResult xmlns:wps="http://www.opengis.net/wps/2.0" service="WPS" version="2.0.0"><wps:JobID>job-2</wps:JobID></wps:GetResult>`
+
+	var r GetResultRequest
+	if exceptions := r.ParseXML([]byte(doc)); exceptions != nil {
+		t.Fatalf("test: %d, expected no exceptions,\n got: %v", 0, exceptions)
+	}
+	r.Attr = nil
+	if result := string(r.ToXML()); !strings.HasSuffix(result, doc) {
+		t.Errorf("test: %d, expected: %s,\n got: %s", 0, doc, result)
+	}
+
+	var q GetResultRequest
+	if exceptions := q.ParseQueryParameters(r.ToQueryParameters()); exceptions != nil || q.JobID != `job-2` {
+		t.Errorf("test: %d, expected: %s,\n got: %+v, %v", 0, `job-2`, q, exceptions)
+	}
+}
+
+func TestJobValidate(t *testing.T) {
+	var tests = []struct {
+		jobID      string
+		exceptions wsc200.Exceptions
+	}{
+		0: {jobID: `job-1`},
+		1: {jobID: `job-3`, exceptions: wsc200.Exceptions{NoSuchJob(`job-3`)}},
+		2: {exceptions: wsc200.Exceptions{wsc200.MissingParameterValue(JOBID)}},
+	}
+
+	for k, test := range tests {
+		if exceptions := (GetStatusRequest{JobID: test.jobID}).Validate(jobs); !reflect.DeepEqual(exceptions, test.exceptions) {
+			t.Errorf("test: %d, expected: %v,\n got: %v", k, test.exceptions, exceptions)
+		}
+		if exceptions := (GetResultRequest{JobID: test.jobID}).Validate(jobs); !reflect.DeepEqual(exceptions, test.exceptions) {
+			t.Errorf("test: %d, expected: %v,\n got: %v", k, test.exceptions, exceptions)
+		}
+	}
+}
+
+func TestStatusInfoParseXML(t *testing.T) {
+	doc := `<wps:StatusInfo xmlns:wps="http://www.opengis.net/wps/2.0"><wps:JobID>job-1</wps:JobID><wps:Status>Running</wps:Status>` +
+		`<wps:NextPoll>2026-10-19T12:00:00Z</wps:NextPoll><wps:PercentCompleted>40</wps:PercentCompleted></wps:StatusInfo>`
+
+	var s StatusInfo
+	if err := s.ParseXML([]byte(doc)); err != nil {
+		t.Fatalf("test: %d, expected no error,\n got: %v", 0, err)
+	}
+	if s.JobID != `job-1` || s.Status != StatusRunning || *s.PercentCompleted != 40 || s.Done() {
+		t.Errorf("test: %d, expected: %s,\n got: %+v", 0, StatusRunning, s)
+	}
+	if result := string(s.ToXML()); !strings.HasSuffix(result, doc) {
+		t.Errorf("test: %d, expected: %s,\n got: %s", 0, doc, result)
+	}
+}
+
+func TestResultParseXML(t *testing.T) {
+	doc := `<wps:Result xmlns:wps="http://www.opengis.net/wps/2.0" xmlns:xlink="http://www.w3.org/1999/xlink"><wps:JobID>job-2</wps:JobID>` +
+		`<wps:Output id="result"><wps:Reference xlink:href="http://example.com/jobs/job-2/result" mimeType="application/geo+json"/></wps:Output>` +
+		`<wps:Output id="area"><wps:Data><wps:LiteralValue uom="m2">12.5</wps:LiteralValue></wps:Data></wps:Output></wps:Result>`
+
+	var r Result
+	if err := r.ParseXML([]byte(doc)); err != nil {
+		t.Fatalf("test: %d, expected no error,\n got: %v", 0, err)
+	}
+	expected := Result{
+		XMLName: r.XMLName,
+		JobID:   sp(`job-2`),
+		Output: []DataOutput{
+			{ID: `result`, Reference: &DataReference{Href: `http://example.com/jobs/job-2/result`, MimeType: sp(`application/geo+json`)}},
+			{ID: `area`, Data: &Data{LiteralValue: &LiteralValue{UOM: sp(`m2`), Value: `12.5`}}},
+		},
+	}
+	if !reflect.DeepEqual(r, expected) {
+		t.Errorf("test: %d, expected: %+v,\n got: %+v", 0, expected, r)
+	}
+	if result := string(r.ToXML()); !strings.HasSuffix(result, doc) {
+		t.Errorf("test: %d, expected: %s,\n got: %s", 0, doc, result)
+	}
+}
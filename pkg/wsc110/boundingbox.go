@@ -38,6 +38,31 @@ func (b *BoundingBox) ToQueryParameters() string {
 	return fmt.Sprintf("%f,%f,%f,%f", b.LowerCorner[0], b.LowerCorner[1], b.UpperCorner[0], b.UpperCorner[1])
 }
 
+// SwapAxis returns the BoundingBox with the first and second axis swapped
+func (b BoundingBox) SwapAxis() BoundingBox {
+	b.LowerCorner = Position{b.LowerCorner[1], b.LowerCorner[0]}
+	b.UpperCorner = Position{b.UpperCorner[1], b.UpperCorner[0]}
+	return b
+}
+
+// EastNorth returns the BoundingBox with the coordinates in the x/y (east/north) axis order.
+// The coordinates of a BoundingBox follow the axis order of its crs,
+// so for a crs like urn:ogc:def:crs:EPSG::4326 the latitude/longitude axes are swapped.
+// Swapping is its own inverse, so it also turns x/y coordinates back into the axis order of the crs.
+func (b BoundingBox) EastNorth() BoundingBox {
+	var c CRS
+	c.parseString(b.Crs)
+	if c.NorthEast() {
+		return b.SwapAxis()
+	}
+	return b
+}
+
+// EastNorth returns the WGS84BoundingBox, that is always in the longitude/latitude axis order
+func (b WGS84BoundingBox) EastNorth() BoundingBox {
+	return BoundingBox(b)
+}
+
 // ParseString builds a BoundingBox based on a string
 func (b *BoundingBox) ParseString(boundingbox string) Exception {
 	result := strings.Split(boundingbox, ",")
@@ -0,0 +1,67 @@
+package wsc110
+
+import (
+	"math"
+)
+
+// Valid checks that the BoundingBox has finite coordinates and that
+// the LowerCorner is below and to the left of the UpperCorner
+func (b BoundingBox) Valid() bool {
+	for _, v := range []float64{b.LowerCorner[0], b.LowerCorner[1], b.UpperCorner[0], b.UpperCorner[1]} {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return false
+		}
+	}
+	return b.LowerCorner[0] < b.UpperCorner[0] && b.LowerCorner[1] < b.UpperCorner[1]
+}
+
+// Validate raises an InvalidParameterValue exception for an inverted or degenerate BoundingBox
+func (b BoundingBox) Validate() Exception {
+	if !b.Valid() {
+		return InvalidParameterValue(b.ToQueryParameters(), `boundingbox`)
+	}
+	return nil
+}
+
+// Intersects checks if the BoundingBox shares any point with the other BoundingBox, like the OGC Intersects operator
+// BoundingBoxes that only touch along an edge or in a corner intersect. Both BoundingBoxes need to be in the same crs
+func (b BoundingBox) Intersects(other BoundingBox) bool {
+	return b.LowerCorner[0] <= other.UpperCorner[0] && b.UpperCorner[0] >= other.LowerCorner[0] &&
+		b.LowerCorner[1] <= other.UpperCorner[1] && b.UpperCorner[1] >= other.LowerCorner[1]
+}
+
+// Contains checks if the other BoundingBox lies completely within the BoundingBox,
+// both BoundingBoxes need to be in the same crs
+func (b BoundingBox) Contains(other BoundingBox) bool {
+	return b.LowerCorner[0] <= other.LowerCorner[0] && b.UpperCorner[0] >= other.UpperCorner[0] &&
+		b.LowerCorner[1] <= other.LowerCorner[1] && b.UpperCorner[1] >= other.UpperCorner[1]
+}
+
+// Union returns the smallest BoundingBox that contains both BoundingBoxes,
+// both BoundingBoxes need to be in the same crs
+func (b BoundingBox) Union(other BoundingBox) BoundingBox {
+	b.LowerCorner = Position{math.Min(b.LowerCorner[0], other.LowerCorner[0]), math.Min(b.LowerCorner[1], other.LowerCorner[1])}
+	b.UpperCorner = Position{math.Max(b.UpperCorner[0], other.UpperCorner[0]), math.Max(b.UpperCorner[1], other.UpperCorner[1])}
+	return b
+}
+
+// Area returns the area of the BoundingBox in the units of its crs, an invalid BoundingBox has no area
+func (b BoundingBox) Area() float64 {
+	if !b.Valid() {
+		return 0
+	}
+	return (b.UpperCorner[0] - b.LowerCorner[0]) * (b.UpperCorner[1] - b.LowerCorner[1])
+}
+
+// Intersects checks if the given BoundingBox shares any point with the WGS84BoundingBox.
+// A BoundingBox with a crs is transformed to WGS84 first, without a crs it is considered to be WGS84.
+func (b WGS84BoundingBox) Intersects(bbox BoundingBox) (bool, error) {
+	if bbox.Crs != `` {
+		transformed, err := bbox.Transform(`urn:ogc:def:crs:OGC:1.3:CRS84`)
+		if err != nil {
+			return false, err
+		}
+		bbox = transformed
+	}
+	return BoundingBox(b).Intersects(bbox), nil
+}
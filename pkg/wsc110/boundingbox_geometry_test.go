@@ -0,0 +1,70 @@
+package wsc110
+
+import (
+	"testing"
+)
+
+func TestBoundingBoxGeometry(t *testing.T) {
+	var tests = []struct {
+		a, b       BoundingBox
+		valid      bool
+		intersects bool
+		contains   bool
+		area       float64
+	}{
+		0: {a: BoundingBox{LowerCorner: Position{0, 0}, UpperCorner: Position{10, 10}},
+			b:     BoundingBox{LowerCorner: Position{2, 2}, UpperCorner: Position{4, 4}},
+			valid: true, intersects: true, contains: true, area: 100},
+		1: {a: BoundingBox{LowerCorner: Position{0, 0}, UpperCorner: Position{10, 10}},
+			b:     BoundingBox{LowerCorner: Position{20, 20}, UpperCorner: Position{30, 30}},
+			valid: true, intersects: false, contains: false, area: 100},
+		2: {a: BoundingBox{LowerCorner: Position{10, 0}, UpperCorner: Position{0, 10}},
+			b:     BoundingBox{LowerCorner: Position{20, 20}, UpperCorner: Position{30, 30}},
+			valid: false, intersects: false, contains: false, area: 0},
+		// touching along an edge
+		3: {a: BoundingBox{LowerCorner: Position{0, 0}, UpperCorner: Position{10, 10}},
+			b:     BoundingBox{LowerCorner: Position{0, 10}, UpperCorner: Position{10, 20}},
+			valid: true, intersects: true, contains: false, area: 100},
+	}
+
+	for k, test := range tests {
+		if valid := test.a.Valid(); valid != test.valid {
+			t.Errorf("test: %d, expected valid: %t,\n got: %t", k, test.valid, valid)
+		}
+		if exception := test.a.Validate(); (exception == nil) != test.valid {
+			t.Errorf("test: %d, expected valid: %t,\n got exception: %v", k, test.valid, exception)
+		}
+		if intersects := test.a.Intersects(test.b); intersects != test.intersects {
+			t.Errorf("test: %d, expected intersects: %t,\n got: %t", k, test.intersects, intersects)
+		}
+		if contains := test.a.Contains(test.b); contains != test.contains {
+			t.Errorf("test: %d, expected contains: %t,\n got: %t", k, test.contains, contains)
+		}
+		if area := test.a.Area(); area != test.area {
+			t.Errorf("test: %d, expected area: %f,\n got: %f", k, test.area, area)
+		}
+	}
+}
+
+func TestWGS84BoundingBoxIntersects(t *testing.T) {
+	extent := WGS84BoundingBox{LowerCorner: Position{3.05, 50.67}, UpperCorner: Position{7.28, 53.61}}
+
+	var tests = []struct {
+		bbox       BoundingBox
+		intersects bool
+	}{
+		0: {bbox: BoundingBox{LowerCorner: Position{4, 52}, UpperCorner: Position{5, 53}}, intersects: true},
+		1: {bbox: BoundingBox{Crs: `urn:ogc:def:crs:EPSG::28992`, LowerCorner: Position{110000, 476000}, UpperCorner: Position{135000, 496000}}, intersects: true},
+		2: {bbox: BoundingBox{Crs: `urn:ogc:def:crs:EPSG::4326`, LowerCorner: Position{40, -10}, UpperCorner: Position{45, -5}}, intersects: false},
+	}
+
+	for k, test := range tests {
+		intersects, err := extent.Intersects(test.bbox)
+		if err != nil {
+			t.Errorf("test: %d, expected no error,\n got: %v", k, err)
+		}
+		if intersects != test.intersects {
+			t.Errorf("test: %d, expected intersects: %t,\n got: %t", k, test.intersects, intersects)
+		}
+	}
+}
@@ -48,3 +48,22 @@ func TestBuildBoundingBox(t *testing.T) {
 		}
 	}
 }
+
+func TestBoundingBoxEastNorth(t *testing.T) {
+	var tests = []struct {
+		boundingbox BoundingBox
+		expected    BoundingBox
+	}{
+		0: {boundingbox: BoundingBox{Crs: `urn:ogc:def:crs:EPSG::4258`, LowerCorner: [2]float64{50.5, 3.2}, UpperCorner: [2]float64{53.7, 7.3}},
+			expected: BoundingBox{Crs: `urn:ogc:def:crs:EPSG::4258`, LowerCorner: [2]float64{3.2, 50.5}, UpperCorner: [2]float64{7.3, 53.7}}},
+		1: {boundingbox: BoundingBox{Crs: `http://www.opengis.net/def/crs/EPSG/0/28992`, LowerCorner: [2]float64{0, 300000}, UpperCorner: [2]float64{280000, 625000}},
+			expected: BoundingBox{Crs: `http://www.opengis.net/def/crs/EPSG/0/28992`, LowerCorner: [2]float64{0, 300000}, UpperCorner: [2]float64{280000, 625000}}},
+		2: {boundingbox: BoundingBox{LowerCorner: [2]float64{0, 0}, UpperCorner: [2]float64{100, 100}},
+			expected: BoundingBox{LowerCorner: [2]float64{0, 0}, UpperCorner: [2]float64{100, 100}}},
+	}
+	for k, test := range tests {
+		if eastnorth := test.boundingbox.EastNorth(); eastnorth != test.expected {
+			t.Errorf("test: %d, expected: %v+,\n got: %v+", k, test.expected, eastnorth)
+		}
+	}
+}
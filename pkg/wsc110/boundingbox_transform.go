@@ -0,0 +1,31 @@
+package wsc110
+
+import (
+	"github.com/pdok/ogc-specifications/pkg/crs"
+)
+
+// Transform converts the BoundingBox from its crs to the given crs.
+// The coordinates of the BoundingBox, and the result, follow the axis order of the crs.
+func (b BoundingBox) Transform(to string) (BoundingBox, error) {
+	eastnorth := b.EastNorth()
+	bounds, err := crs.TransformBounds(b.Crs, to,
+		eastnorth.LowerCorner[0], eastnorth.LowerCorner[1], eastnorth.UpperCorner[0], eastnorth.UpperCorner[1], crs.DefaultDensify)
+	if err != nil {
+		return BoundingBox{}, err
+	}
+
+	result := BoundingBox{
+		Crs:         to,
+		Dimensions:  b.Dimensions,
+		LowerCorner: Position{bounds[0], bounds[1]},
+		UpperCorner: Position{bounds[2], bounds[3]},
+	}
+	return result.EastNorth(), nil
+}
+
+// Transform converts the WGS84BoundingBox to a BoundingBox in the given crs
+func (b WGS84BoundingBox) Transform(to string) (BoundingBox, error) {
+	bbox := BoundingBox(b)
+	bbox.Crs = `urn:ogc:def:crs:OGC:1.3:CRS84`
+	return bbox.Transform(to)
+}
@@ -0,0 +1,30 @@
+package wsc110
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBoundingBoxTransform(t *testing.T) {
+	bbox := BoundingBox{Crs: `urn:ogc:def:crs:EPSG::4326`, LowerCorner: Position{50.67, 3.05}, UpperCorner: Position{53.61, 7.28}}
+
+	result, err := bbox.Transform(`urn:ogc:def:crs:EPSG::3857`)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if result.Crs != `urn:ogc:def:crs:EPSG::3857` {
+		t.Errorf("expected crs: urn:ogc:def:crs:EPSG::3857,\n got: %s", result.Crs)
+	}
+	if math.Abs(result.LowerCorner[0]-339524) > 1 || math.Abs(result.UpperCorner[0]-810406) > 1 {
+		t.Errorf("expected: about 339524 ... 810406,\n got: %v", result)
+	}
+
+	wgs84 := WGS84BoundingBox{LowerCorner: Position{3.05, 50.67}, UpperCorner: Position{7.28, 53.61}}
+	result, err = wgs84.Transform(`EPSG:4258`)
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if result.LowerCorner != (Position{50.67, 3.05}) || result.UpperCorner != (Position{53.61, 7.28}) {
+		t.Errorf("expected the axes to be swapped,\n got: %v", result)
+	}
+}
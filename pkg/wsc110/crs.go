@@ -1,9 +1,9 @@
 package wsc110
 
 import (
-	"regexp"
 	"strconv"
-	"strings"
+
+	"github.com/pdok/ogc-specifications/pkg/crs"
 )
 
 const (
@@ -22,29 +22,41 @@ func (c *CRS) String() string {
 	return c.Namespace + `:` + strconv.Itoa(c.Code)
 }
 
-// Identifier returns the EPSG
+// Identifier returns the URN of the CRS
 func (c *CRS) Identifier() string {
+	if d, ok := c.Definition(); ok {
+		return d.URN()
+	}
 	return codeSpace + strconv.Itoa(c.Code)
 }
 
+// Definition returns the registered definition of the CRS, containing the axis order and units
+func (c CRS) Definition() (crs.Definition, bool) {
+	return crs.Lookup(c.Namespace, c.Code)
+}
+
+// NorthEast returns if the first axis of the CRS is the northing/latitude
+func (c CRS) NorthEast() bool {
+	d, ok := c.Definition()
+	return ok && d.NorthEast()
+}
+
+// Geographic returns if the coordinates of the CRS are expressed in degrees
+func (c CRS) Geographic() bool {
+	d, ok := c.Definition()
+	return ok && d.Geographic
+}
+
 // ParseString build CRS struct from input string
 func (c *CRS) ParseString(s string) {
 	c.parseString(s)
 }
 
+// parseString normalises the different CRS notations, like EPSG:4326, CRS:84,
+// urn:ogc:def:crs:EPSG::4326 and http://www.opengis.net/def/crs/EPSG/0/4326
 func (c *CRS) parseString(s string) {
-	regex := regexp.MustCompile(`(^.*):([0-9]+)`)
-	code := regex.FindStringSubmatch(s)
-	if len(code) == 3 { // code[0] is the full match, the other the parts
-		f := strings.Index(code[1], EPSG)
-		if f > -1 {
-			c.Namespace = EPSG
-		} else {
-			c.Namespace = code[1]
-		}
-
-		// the regex already checks if it [0-9]
-		i, _ := strconv.Atoi(code[2])
-		c.Code = i
+	if authority, code, ok := crs.Parse(s); ok {
+		c.Namespace = authority
+		c.Code = code
 	}
 }
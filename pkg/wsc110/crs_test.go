@@ -14,6 +14,8 @@ func TestCRSParseString(t *testing.T) {
 		0: {}, // Empty input == empty struct
 		1: {input: `urn:ogc:def:crs:EPSG::4326`, expectedCRS: CRS{Code: 4326, Namespace: `EPSG`}},
 		2: {input: `EPSG:4326`, expectedCRS: CRS{Code: 4326, Namespace: `EPSG`}},
+		3: {input: `http://www.opengis.net/def/crs/EPSG/0/4326`, expectedCRS: CRS{Code: 4326, Namespace: `EPSG`}},
+		4: {input: `urn:ogc:def:crs:OGC:1.3:CRS84`, expectedCRS: CRS{Code: 84, Namespace: `CRS`}},
 	}
 
 	for k, test := range tests {
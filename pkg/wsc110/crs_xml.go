@@ -17,7 +17,7 @@ func (c *CRS) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 
 // UnmarshalXML Position
 func (c *CRS) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
-	var crs CRS
+	var result CRS
 	for {
 		token, err := d.Token()
 		if err != nil {
@@ -25,10 +25,10 @@ func (c *CRS) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
 		}
 		switch el := token.(type) {
 		case xml.CharData:
-			crs.parseString(string([]byte(el)))
+			result.parseString(string([]byte(el)))
 		case xml.EndElement:
 			if el == start.End() {
-				*c = crs
+				*c = result
 				return nil
 			}
 		}
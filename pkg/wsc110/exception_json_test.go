@@ -0,0 +1,66 @@
+package wsc110
+
+import (
+	"testing"
+)
+
+func TestExceptionsEncode(t *testing.T) {
+	exceptions := Exceptions{MissingParameterValue(`VERSION`)}
+
+	var tests = []struct {
+		format      string
+		status      int
+		contentType string
+		body        string
+	}{
+		0: {format: `application/json`, contentType: `application/json`,
+			body: `{
+ "version": "2.0.0",
+ "lang": "en",
+ "exceptions": [
+  {
+   "text": "Missing key: VERSION",
+   "code": "MissingParameterValue",
+   "locator": "VERSION"
+  }
+ ]
+}`},
+		1: {format: `application/problem+json`, status: 400, contentType: `application/problem+json`,
+			body: `{
+ "title": "MissingParameterValue",
+ "status": 400,
+ "detail": "Missing key: VERSION",
+ "exceptions": [
+  {
+   "text": "Missing key: VERSION",
+   "code": "MissingParameterValue",
+   "locator": "VERSION"
+  }
+ ]
+}`},
+		2: {format: ``, contentType: `text/xml`, body: string(exceptions.ToReport(`2.0.0`).ToBytes())},
+		3: {format: `application/problem+json`, status: 404, contentType: `application/problem+json`,
+			body: `{
+ "title": "MissingParameterValue",
+ "status": 404,
+ "detail": "Missing key: VERSION",
+ "exceptions": [
+  {
+   "text": "Missing key: VERSION",
+   "code": "MissingParameterValue",
+   "locator": "VERSION"
+  }
+ ]
+}`},
+	}
+
+	for k, test := range tests {
+		body, contentType := exceptions.Encode(test.format, `2.0.0`, test.status)
+		if contentType != test.contentType {
+			t.Errorf("test: %d, expected: %s,\n got: %s", k, test.contentType, contentType)
+		}
+		if string(body) != test.body {
+			t.Errorf("test: %d, expected: %s,\n got: %s", k, test.body, body)
+		}
+	}
+}
@@ -0,0 +1,26 @@
+package wsc110
+
+import (
+	"testing"
+)
+
+func TestStatusCode(t *testing.T) {
+	var tests = []struct {
+		exceptions Exceptions
+		status     int
+	}{
+		0: {exceptions: nil, status: 200},
+		1: {exceptions: Exceptions{MissingParameterValue(`VERSION`)}, status: 400},
+		2: {exceptions: Exceptions{OperationNotSupported(`GetCoconut`)}, status: 501},
+		3: {exceptions: Exceptions{NoApplicableCode(`Oops`)}, status: 500},
+		4: {exceptions: Exceptions{MissingParameterValue(`VERSION`), InvalidParameterValue(`WKS`, `SERVICE`)}, status: 400},
+		5: {exceptions: Exceptions{MissingParameterValue(`VERSION`), OptionNotSupported()}, status: 500},
+		6: {exceptions: Exceptions{exception{}}, status: 500},
+	}
+
+	for k, test := range tests {
+		if status := test.exceptions.StatusCode(); status != test.status {
+			t.Errorf("test: %d, expected: %d,\n got: %d", k, test.status, status)
+		}
+	}
+}
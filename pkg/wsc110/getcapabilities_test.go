@@ -0,0 +1,160 @@
+package wsc110
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestGetCapabilitiesParametersParseQueryParameters(t *testing.T) {
+	var tests = []struct {
+		query      url.Values
+		result     GetCapabilitiesParameters
+		exceptions Exceptions
+	}{
+		0: {query: url.Values{`SERVICE`: {`WFS`}, `REQUEST`: {`GetCapabilities`}}},
+		1: {query: url.Values{`acceptversions`: {`2.0.0, 1.1.0`}, `Sections`: {`Contents`}, `ACCEPTFORMATS`: {`text/xml`}, `AcceptLanguages`: {`nl,en`}, `updateSequence`: {`42`}},
+			result: GetCapabilitiesParameters{
+				UpdateSequence:  `42`,
+				AcceptVersions:  &AcceptVersions{Version: []string{`2.0.0`, `1.1.0`}},
+				Sections:        &Sections{Section: []string{`Contents`}},
+				AcceptFormats:   &AcceptFormats{OutputFormat: []string{`text/xml`}},
+				AcceptLanguages: &AcceptLanguages{Language: []string{`nl`, `en`}},
+			}},
+		2: {query: url.Values{`SECTIONS`: {`Contents,,All`}},
+			exceptions: Exceptions{InvalidParameterValue(`Contents,,All`, SECTIONS)}},
+		3: {query: url.Values{`SERVICE`: {`WFS`}, `REQUEST`: {`GetCapabilities`}, `VERSION`: {`2.0.0`}, `map`: {``}}},
+		4: {query: url.Values{`ACCEPTLANGUAGES`: {`nl,`}, `map`: {``}, `ACCEPTVERSIONS`: {`,2.0.0`}},
+			exceptions: Exceptions{InvalidParameterValue(`,2.0.0`, ACCEPTVERSIONS), InvalidParameterValue(`nl,`, ACCEPTLANGUAGES)}},
+	}
+
+	for k, test := range tests {
+		var p GetCapabilitiesParameters
+		exceptions := p.ParseQueryParameters(test.query)
+		if !reflect.DeepEqual(exceptions, test.exceptions) {
+			t.Errorf("test: %d, expected: %v,\n got: %v", k, test.exceptions, exceptions)
+		} else if test.exceptions == nil && !reflect.DeepEqual(p, test.result) {
+			t.Errorf("test: %d, expected: %+v,\n got: %+v", k, test.result, p)
+		}
+	}
+}
+
+func TestGetCapabilitiesParametersParseXML(t *testing.T) {
+	var tests = []struct {
+		doc    string
+		result GetCapabilitiesParameters
+	}{
+		0: {doc: `<GetCapabilities service="WFS"/>`},
+		1: {doc: `<wfs:GetCapabilities xmlns:wfs="http://www.opengis.net/wfs/2.0" xmlns:o="http://www.opengis.net/ows/1.1" service="WFS" updateSequence="2021-03-01">
+  <o:AcceptVersions><o:Version>2.0.0</o:Version><o:Version>1.1.0</o:Version></o:AcceptVersions>
+  <o:Sections><o:Section>ServiceIdentification</o:Section><o:Section>Contents</o:Section></o:Sections>
+  <o:AcceptFormats><o:OutputFormat>text/xml</o:OutputFormat></o:AcceptFormats>
+</wfs:GetCapabilities>`,
+			result: GetCapabilitiesParameters{
+				UpdateSequence: `2021-03-01`,
+				AcceptVersions: &AcceptVersions{Version: []string{`2.0.0`, `1.1.0`}},
+				Sections:       &Sections{Section: []string{`ServiceIdentification`, `Contents`}},
+				AcceptFormats:  &AcceptFormats{OutputFormat: []string{`text/xml`}},
+			}},
+	}
+
+	for k, test := range tests {
+		var p GetCapabilitiesParameters
+		if err := p.ParseXML([]byte(test.doc)); err != nil {
+			t.Errorf("test: %d, expected no error,\n got: %s", k, err)
+		} else if !reflect.DeepEqual(p, test.result) {
+			t.Errorf("test: %d, expected: %+v,\n got: %+v", k, test.result, p)
+		}
+	}
+}
+
+func TestGetCapabilitiesParametersSetQueryParameters(t *testing.T) {
+	p := GetCapabilitiesParameters{UpdateSequence: `42`, AcceptVersions: &AcceptVersions{Version: []string{`2.0.0`, `1.1.0`}}, Sections: &Sections{Section: []string{`All`}}}
+	expected := url.Values{UPDATESEQUENCE: {`42`}, ACCEPTVERSIONS: {`2.0.0,1.1.0`}, SECTIONS: {`All`}}
+
+	query := url.Values{}
+	p.SetQueryParameters(query)
+	if !reflect.DeepEqual(query, expected) {
+		t.Errorf("test: %d, expected: %v,\n got: %v", 0, expected, query)
+	}
+}
+
+func TestNegotiateVersion(t *testing.T) {
+	var tests = []struct {
+		acceptVersions []string
+		supported      []string
+		version        string
+		exception      Exception
+	}{
+		0: {version: `2.0.0`},
+		1: {acceptVersions: []string{`1.1.0`, `2.0.0`}, version: `1.1.0`},
+		2: {acceptVersions: []string{`3.0.0`, `2.0.0`}, version: `2.0.0`},
+		3: {acceptVersions: []string{`3.0.0`, `1.0.0`}, exception: VersionNegotiationFailed(`3.0.0,1.0.0`)},
+		4: {supported: []string{}, exception: VersionNegotiationFailed(``)},
+		5: {acceptVersions: []string{`2.0.0`}, supported: []string{}, exception: VersionNegotiationFailed(`2.0.0`)},
+	}
+
+	for k, test := range tests {
+		var p GetCapabilitiesParameters
+		if test.acceptVersions != nil {
+			p.AcceptVersions = &AcceptVersions{Version: test.acceptVersions}
+		}
+		supported := []string{`2.0.0`, `1.1.0`}
+		if test.supported != nil {
+			supported = test.supported
+		}
+		version, exception := p.NegotiateVersion(supported...)
+		if version != test.version || !reflect.DeepEqual(exception, test.exception) {
+			t.Errorf("test: %d, expected: %s %v,\n got: %s %v", k, test.version, test.exception, version, exception)
+		}
+	}
+}
+
+func TestSections(t *testing.T) {
+	var tests = []struct {
+		sections   []string
+		contents   bool
+		exceptions Exceptions
+	}{
+		0: {contents: true},
+		1: {sections: []string{`All`}, contents: true},
+		2: {sections: []string{`ServiceIdentification`}, contents: false},
+		3: {sections: []string{`Contents`, `Unknown`}, contents: true, exceptions: Exceptions{InvalidParameterValue(`Unknown`, SECTIONS)}},
+	}
+
+	for k, test := range tests {
+		var p GetCapabilitiesParameters
+		if test.sections != nil {
+			p.Sections = &Sections{Section: test.sections}
+		}
+		if contents := p.HasSection(ContentsSection); contents != test.contents {
+			t.Errorf("test: %d, expected: %t,\n got: %t", k, test.contents, contents)
+		}
+		if exceptions := p.ValidateSections(ServiceIdentificationSection, ContentsSection); !reflect.DeepEqual(exceptions, test.exceptions) {
+			t.Errorf("test: %d, expected: %v,\n got: %v", k, test.exceptions, exceptions)
+		}
+	}
+}
+
+func TestCheckUpdateSequence(t *testing.T) {
+	var tests = []struct {
+		requested string
+		current   string
+		equal     bool
+		exception Exception
+	}{
+		0: {requested: ``, current: `5`},
+		1: {requested: `5`, current: ``},
+		2: {requested: `4`, current: `5`},
+		3: {requested: `5`, current: `5`, equal: true},
+		4: {requested: `6`, current: `5`, exception: InvalidUpdateSequence()},
+	}
+
+	for k, test := range tests {
+		p := GetCapabilitiesParameters{UpdateSequence: test.requested}
+		equal, exception := p.CheckUpdateSequence(test.current)
+		if equal != test.equal || !reflect.DeepEqual(exception, test.exception) {
+			t.Errorf("test: %d, expected: %t %v,\n got: %t %v", k, test.equal, test.exception, equal, exception)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package wsc200
+
+import (
+	"strconv"
+	"strings"
+)
+
+// BoundingBox is an OWS Common 2.0 bounding box, the coordinates are in the axis order of the CRS
+// and the number of coordinates of a corner is the number of dimensions
+type BoundingBox struct {
+	Crs         *string  `xml:"crs,attr,omitempty" yaml:"crs,omitempty"`
+	Dimensions  *int     `xml:"dimensions,attr,omitempty" yaml:"dimensions,omitempty"`
+	LowerCorner Position `xml:"ows:LowerCorner" yaml:"lowerCorner"`
+	UpperCorner Position `xml:"ows:UpperCorner" yaml:"upperCorner"`
+}
+
+// WGS84BoundingBox is a BoundingBox in urn:ogc:def:crs:OGC:2:84, in the longitude/latitude axis order
+type WGS84BoundingBox BoundingBox
+
+// Position is a space separated list of coordinates
+type Position []float64
+
+// MarshalText writes the coordinates separated by spaces
+func (p Position) MarshalText() ([]byte, error) {
+	values := make([]string, 0, len(p))
+	for _, f := range p {
+		values = append(values, strconv.FormatFloat(f, 'f', -1, 64))
+	}
+	return []byte(strings.Join(values, ` `)), nil
+}
+
+// UnmarshalText parses the coordinates separated by spaces
+func (p *Position) UnmarshalText(text []byte) error {
+	var position Position
+	for _, value := range strings.Fields(string(text)) {
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return err
+		}
+		position = append(position, f)
+	}
+	*p = position
+	return nil
+}
+
+// Contains returns whether the position is within the bounding box, it needs the same number of coordinates
+func (b BoundingBox) Contains(p Position) bool {
+	if len(p) != len(b.LowerCorner) || len(p) != len(b.UpperCorner) {
+		return false
+	}
+	for i, c := range p {
+		if c < b.LowerCorner[i] || c > b.UpperCorner[i] {
+			return false
+		}
+	}
+	return true
+}
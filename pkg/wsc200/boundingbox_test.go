@@ -0,0 +1,63 @@
+package wsc200
+
+import (
+	"encoding/xml"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/pdok/ogc-specifications/pkg/utils"
+)
+
+func TestBoundingBoxXML(t *testing.T) {
+	var tests = []struct {
+		doc      string
+		expected BoundingBox
+	}{
+		0: {doc: `<ows:BoundingBox xmlns:ows="http://www.opengis.net/ows/2.0" crs="http://www.opengis.net/def/crs/EPSG/0/28992"><ows:LowerCorner>-285401.92 22598.08</ows:LowerCorner><ows:UpperCorner>595401.92 903401.92</ows:UpperCorner></ows:BoundingBox>`,
+			expected: BoundingBox{Crs: sp(`http://www.opengis.net/def/crs/EPSG/0/28992`), LowerCorner: Position{-285401.92, 22598.08}, UpperCorner: Position{595401.92, 903401.92}}},
+		1: {doc: `<ows:BoundingBox xmlns:ows="http://www.opengis.net/ows/2.0" dimensions="3"><ows:LowerCorner>3 50 -10</ows:LowerCorner><ows:UpperCorner>7 54 300</ows:UpperCorner></ows:BoundingBox>`,
+			expected: BoundingBox{Dimensions: ip(3), LowerCorner: Position{3, 50, -10}, UpperCorner: Position{7, 54, 300}}},
+	}
+
+	for k, test := range tests {
+		var b struct {
+			XMLName xml.Name `xml:"ows:BoundingBox"`
+			BoundingBox
+		}
+		if err := utils.UnmarshalPrefixed([]byte(test.doc), &b, Prefixes); err != nil {
+			t.Errorf("test: %d, expected no error,\n got: %s", k, err)
+			continue
+		}
+		if !reflect.DeepEqual(b.BoundingBox, test.expected) {
+			t.Errorf("test: %d, expected: %+v,\n got: %+v", k, test.expected, b.BoundingBox)
+		}
+		if doc, err := utils.NewEncoder(Prefixes).Marshal(b); err != nil || !strings.HasSuffix(string(doc), test.doc) {
+			t.Errorf("test: %d, expected: %s,\n got: %s %v", k, test.doc, doc, err)
+		}
+	}
+}
+
+func TestBoundingBoxContains(t *testing.T) {
+	b := BoundingBox{LowerCorner: Position{0, 0}, UpperCorner: Position{10, 20}}
+
+	var tests = []struct {
+		position Position
+		expected bool
+	}{
+		0: {position: Position{5, 15}, expected: true},
+		1: {position: Position{10, 20}, expected: true},
+		2: {position: Position{11, 5}, expected: false},
+		3: {position: Position{5, 5, 5}, expected: false},
+	}
+
+	for k, test := range tests {
+		if contains := b.Contains(test.position); contains != test.expected {
+			t.Errorf("test: %d, expected: %t,\n got: %t", k, test.expected, contains)
+		}
+	}
+}
+
+func ip(i int) *int {
+	return &i
+}
@@ -0,0 +1,118 @@
+package wsc200
+
+import "strconv"
+
+// Range closures
+const (
+	Closed     = `closed`
+	Open       = `open`
+	OpenClosed = `open-closed`
+	ClosedOpen = `closed-open`
+)
+
+// Domain is a parameter or constraint and its possible values: the allowed values, any value, no value or
+// a reference to the list of values
+type Domain struct {
+	Name            string           `xml:"name,attr" yaml:"name"`
+	AllowedValues   *AllowedValues   `xml:"ows:AllowedValues,omitempty" yaml:"allowedValues,omitempty"`
+	AnyValue        *AnyValue        `xml:"ows:AnyValue,omitempty" yaml:"anyValue,omitempty"`
+	NoValues        *NoValues        `xml:"ows:NoValues,omitempty" yaml:"noValues,omitempty"`
+	ValuesReference *ValuesReference `xml:"ows:ValuesReference,omitempty" yaml:"valuesReference,omitempty"`
+	DefaultValue    *string          `xml:"ows:DefaultValue,omitempty" yaml:"defaultValue,omitempty"`
+	Meaning         *DomainMetadata  `xml:"ows:Meaning,omitempty" yaml:"meaning,omitempty"`
+	DataType        *DomainMetadata  `xml:"ows:DataType,omitempty" yaml:"dataType,omitempty"`
+	UOM             *DomainMetadata  `xml:"ows:UOM,omitempty" yaml:"uom,omitempty"`
+	Metadata        []Metadata       `xml:"ows:Metadata" yaml:"metadata,omitempty"`
+}
+
+// AllowedValues contains the values and ranges a domain is restricted to
+type AllowedValues struct {
+	Value []string `xml:"ows:Value" yaml:"value,omitempty"`
+	Range []Range  `xml:"ows:Range" yaml:"range,omitempty"`
+}
+
+// Range of allowed values, the closure is closed, open, open-closed or closed-open and defaults to closed
+type Range struct {
+	Closure      *string `xml:"ows:rangeClosure,attr,omitempty" yaml:"closure,omitempty"`
+	MinimumValue *string `xml:"ows:MinimumValue,omitempty" yaml:"minimumValue,omitempty"`
+	MaximumValue *string `xml:"ows:MaximumValue,omitempty" yaml:"maximumValue,omitempty"`
+	Spacing      *string `xml:"ows:Spacing,omitempty" yaml:"spacing,omitempty"`
+}
+
+// AnyValue marks a domain that accepts any value
+type AnyValue struct{}
+
+// NoValues marks a domain that has no values, like a constraint that only exists by its name
+type NoValues struct{}
+
+// ValuesReference refers to the list of values of a domain
+type ValuesReference struct {
+	Reference string `xml:"ows:reference,attr" yaml:"reference"`
+	Value     string `xml:",chardata" yaml:"value"`
+}
+
+// DomainMetadata is the name and the reference of the meaning, data type or unit of measure of a domain, like xs:double
+type DomainMetadata struct {
+	Reference *string `xml:"ows:reference,attr,omitempty" yaml:"reference,omitempty"`
+	Value     string  `xml:",chardata" yaml:"value"`
+}
+
+// Metadata is a reference to metadata, the about attribute is the subject the metadata is about
+type Metadata struct {
+	Role  *string `xml:"xlink:role,attr,omitempty" yaml:"role,omitempty"`
+	Title *string `xml:"xlink:title,attr,omitempty" yaml:"title,omitempty"`
+	Href  *string `xml:"xlink:href,attr,omitempty" yaml:"href,omitempty"`
+	About *string `xml:"about,attr,omitempty" yaml:"about,omitempty"`
+}
+
+// Allows returns whether the value is a possible value of the domain,
+// the values of a ValuesReference aren't known so every value is accepted
+func (d Domain) Allows(value string) bool {
+	switch {
+	case d.AllowedValues != nil:
+		return d.AllowedValues.Allows(value)
+	case d.NoValues != nil:
+		return value == ``
+	}
+	return true
+}
+
+// Allows returns whether the value is one of the allowed values or within one of the ranges
+func (a AllowedValues) Allows(value string) bool {
+	for _, v := range a.Value {
+		if v == value {
+			return true
+		}
+	}
+	for _, r := range a.Range {
+		if r.Contains(value) {
+			return true
+		}
+	}
+	return false
+}
+
+// Contains returns whether the numeric value is within the range, an open end without a value is unbounded
+func (r Range) Contains(value string) bool {
+	v, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return false
+	}
+	closure := Closed
+	if r.Closure != nil {
+		closure = *r.Closure
+	}
+	if r.MinimumValue != nil {
+		minimum, err := strconv.ParseFloat(*r.MinimumValue, 64)
+		if err != nil || v < minimum || (v == minimum && (closure == Open || closure == OpenClosed)) {
+			return false
+		}
+	}
+	if r.MaximumValue != nil {
+		maximum, err := strconv.ParseFloat(*r.MaximumValue, 64)
+		if err != nil || v > maximum || (v == maximum && (closure == Open || closure == ClosedOpen)) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,34 @@
+package wsc200
+
+import "testing"
+
+func TestDomainAllows(t *testing.T) {
+	scale := Domain{Name: `scaleFactor`, AllowedValues: &AllowedValues{Range: []Range{{Closure: sp(OpenClosed), MinimumValue: sp(`0`), MaximumValue: sp(`1`)}}}}
+	format := Domain{Name: `format`, AllowedValues: &AllowedValues{Value: []string{`image/tiff`, `image/png`}}}
+	unbounded := Domain{Name: `size`, AllowedValues: &AllowedValues{Range: []Range{{MinimumValue: sp(`1`)}}}}
+
+	var tests = []struct {
+		domain   Domain
+		value    string
+		expected bool
+	}{
+		0:  {domain: scale, value: `0.5`, expected: true},
+		1:  {domain: scale, value: `1`, expected: true},
+		2:  {domain: scale, value: `0`, expected: false},
+		3:  {domain: scale, value: `1.5`, expected: false},
+		4:  {domain: scale, value: `half`, expected: false},
+		5:  {domain: format, value: `image/png`, expected: true},
+		6:  {domain: format, value: `image/jpeg`, expected: false},
+		7:  {domain: unbounded, value: `1000000`, expected: true},
+		8:  {domain: unbounded, value: `0`, expected: false},
+		9:  {domain: Domain{Name: `any`, AnyValue: &AnyValue{}}, value: `anything`, expected: true},
+		10: {domain: Domain{Name: `none`, NoValues: &NoValues{}}, value: `anything`, expected: false},
+		11: {domain: Domain{Name: `reference`, ValuesReference: &ValuesReference{Reference: `https://example.com/values`}}, value: `anything`, expected: true},
+	}
+
+	for k, test := range tests {
+		if allows := test.domain.Allows(test.value); allows != test.expected {
+			t.Errorf("test: %d, expected: %t,\n got: %t", k, test.expected, allows)
+		}
+	}
+}
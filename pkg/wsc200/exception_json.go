@@ -0,0 +1,28 @@
+package wsc200
+
+import (
+	"github.com/pdok/ogc-specifications/pkg/common"
+)
+
+// ToJSON makes from a ExceptionReport a JSON []byte
+func (r ExceptionReport) ToJSON() []byte {
+	return common.ExceptionReportJSON{Version: r.Version, Language: r.Language, Exceptions: common.Details(r.Exception)}.ToBytes()
+}
+
+// ToProblemJSON makes from a ExceptionReport a RFC 7807 problem+json []byte,
+// the status is the HTTP status code the service returns for the exceptions
+func (r ExceptionReport) ToProblemJSON(status int) []byte {
+	p := common.NewProblem(common.Details(r.Exception))
+	p.Status = status
+	return p.ToBytes()
+}
+
+// Encode returns the Exceptions as a XML, JSON or problem+json report depending on the format,
+// together with its content type. The format is a value as accepted by common.ReportContentType.
+// The status is the HTTP status code the service returns for the exceptions, as the services
+// using OWS Common have their own exception codes.
+func (e Exceptions) Encode(format, version string, status int) ([]byte, string) {
+	r := e.ToReport(version)
+	return common.EncodeExceptions(common.ExceptionReportJSON{Version: r.Version, Language: r.Language, Exceptions: common.Details(e)},
+		format, status, r.ToBytes)
+}
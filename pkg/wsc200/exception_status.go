@@ -0,0 +1,22 @@
+package wsc200
+
+import (
+	"github.com/pdok/ogc-specifications/pkg/common"
+)
+
+// ExceptionStatusCode returns the HTTP status code for an exception code,
+// the returned bool is false when the exception code is not defined by OWS Common 2.0
+func ExceptionStatusCode(code string) (int, bool) {
+	return common.ExceptionStatusCode(code, nil)
+}
+
+// StatusCode returns the HTTP status code for a report with the given exceptions,
+// unknown exception codes are considered to be server errors
+func StatusCode(exceptions ...Exception) int {
+	return common.StatusCode(nil, exceptions...)
+}
+
+// StatusCode returns the HTTP status code for a report with these Exceptions
+func (e Exceptions) StatusCode() int {
+	return StatusCode(e...)
+}
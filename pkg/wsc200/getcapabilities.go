@@ -0,0 +1,213 @@
+package wsc200
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/pdok/ogc-specifications/pkg/common"
+	"github.com/pdok/ogc-specifications/pkg/utils"
+)
+
+// Namespace of OWS Common 2.0
+const Namespace = `http://www.opengis.net/ows/2.0`
+
+// Prefixes contains the prefix used in the struct tags for the OWS Common 2.0 namespace
+var Prefixes = utils.Prefixes{Namespace: `ows`}
+
+// OWS Common 2.0 GetCapabilities keys
+const (
+	ACCEPTVERSIONS  = `ACCEPTVERSIONS`
+	SECTIONS        = `SECTIONS`
+	UPDATESEQUENCE  = `UPDATESEQUENCE`
+	ACCEPTFORMATS   = `ACCEPTFORMATS`
+	ACCEPTLANGUAGES = `ACCEPTLANGUAGES`
+)
+
+// Sections of the capabilities defined by OWS Common 2.0, the All section requests the complete document
+const (
+	AllSection                   = `All`
+	ServiceIdentificationSection = `ServiceIdentification`
+	ServiceProviderSection       = `ServiceProvider`
+	OperationsMetadataSection    = `OperationsMetadata`
+	ContentsSection              = `Contents`
+	LanguagesSection             = `Languages`
+)
+
+// GetCapabilitiesParameters contains the optional parameters of the OWS Common 2.0 GetCapabilities request
+type GetCapabilitiesParameters struct {
+	UpdateSequence  string           `xml:"updateSequence,attr,omitempty" yaml:"updateSequence,omitempty"`
+	AcceptVersions  *AcceptVersions  `xml:"ows:AcceptVersions,omitempty" yaml:"acceptVersions,omitempty"`
+	Sections        *Sections        `xml:"ows:Sections,omitempty" yaml:"sections,omitempty"`
+	AcceptFormats   *AcceptFormats   `xml:"ows:AcceptFormats,omitempty" yaml:"acceptFormats,omitempty"`
+	AcceptLanguages *AcceptLanguages `xml:"ows:AcceptLanguages,omitempty" yaml:"acceptLanguages,omitempty"`
+}
+
+// AcceptVersions contains the versions the client accepts, in order of preference
+type AcceptVersions struct {
+	Version []string `xml:"ows:Version" yaml:"version"`
+}
+
+// Sections contains the requested sections of the capabilities
+type Sections struct {
+	Section []string `xml:"ows:Section" yaml:"section"`
+}
+
+// AcceptFormats contains the formats of the capabilities the client accepts, in order of preference
+type AcceptFormats struct {
+	OutputFormat []string `xml:"ows:OutputFormat" yaml:"outputFormat"`
+}
+
+// AcceptLanguages contains the languages the client accepts, in order of preference
+type AcceptLanguages struct {
+	Language []string `xml:"ows:Language" yaml:"language"`
+}
+
+// Languages contains the languages the capabilities are available in
+type Languages struct {
+	Language []string `xml:"ows:Language" yaml:"language"`
+}
+
+// ParseQueryParameters builds the GetCapabilitiesParameters from the query parameters, the lists are comma separated.
+// Other parameters of the query are ignored.
+func (p *GetCapabilitiesParameters) ParseQueryParameters(query url.Values) Exceptions {
+	q := utils.KeysToUpper(query)
+	if v := q[UPDATESEQUENCE]; len(v) > 0 {
+		p.UpdateSequence = v[0]
+	}
+
+	var exceptions Exceptions
+	for _, k := range []string{ACCEPTVERSIONS, SECTIONS, ACCEPTFORMATS, ACCEPTLANGUAGES} {
+		v := q[k]
+		if len(v) == 0 {
+			continue
+		}
+
+		list, exception := parseList(k, v[0])
+		if exception != nil {
+			exceptions = append(exceptions, exception)
+			continue
+		}
+		switch k {
+		case ACCEPTVERSIONS:
+			p.AcceptVersions = &AcceptVersions{Version: list}
+		case SECTIONS:
+			p.Sections = &Sections{Section: list}
+		case ACCEPTFORMATS:
+			p.AcceptFormats = &AcceptFormats{OutputFormat: list}
+		case ACCEPTLANGUAGES:
+			p.AcceptLanguages = &AcceptLanguages{Language: list}
+		}
+	}
+	return exceptions
+}
+
+// parseList splits a comma separated list, empty items are invalid
+func parseList(key, value string) ([]string, Exception) {
+	var list []string
+	for _, item := range strings.Split(value, `,`) {
+		if item = strings.TrimSpace(item); item == `` {
+			return nil, InvalidParameterValue(value, key)
+		}
+		list = append(list, item)
+	}
+	return list, nil
+}
+
+// ParseXML builds the GetCapabilitiesParameters from a XML encoded GetCapabilities request
+func (p *GetCapabilitiesParameters) ParseXML(doc []byte) error {
+	return utils.UnmarshalPrefixed(doc, p, Prefixes)
+}
+
+// SetQueryParameters adds the parameters that are set to the query
+func (p GetCapabilitiesParameters) SetQueryParameters(query url.Values) {
+	if p.UpdateSequence != `` {
+		query[UPDATESEQUENCE] = []string{p.UpdateSequence}
+	}
+	if p.AcceptVersions != nil {
+		query[ACCEPTVERSIONS] = []string{strings.Join(p.AcceptVersions.Version, `,`)}
+	}
+	if p.Sections != nil {
+		query[SECTIONS] = []string{strings.Join(p.Sections.Section, `,`)}
+	}
+	if p.AcceptFormats != nil {
+		query[ACCEPTFORMATS] = []string{strings.Join(p.AcceptFormats.OutputFormat, `,`)}
+	}
+	if p.AcceptLanguages != nil {
+		query[ACCEPTLANGUAGES] = []string{strings.Join(p.AcceptLanguages.Language, `,`)}
+	}
+}
+
+// NegotiateVersion returns the first of the accepted versions that is supported, or VersionNegotiationFailed
+// when none are. Without accepted versions the first, and highest, supported version is returned.
+func (p GetCapabilitiesParameters) NegotiateVersion(supported ...string) (string, Exception) {
+	if len(supported) == 0 {
+		return ``, VersionNegotiationFailed(strings.Join(p.acceptedVersions(), `,`))
+	}
+	if p.AcceptVersions == nil || len(p.AcceptVersions.Version) == 0 {
+		return supported[0], nil
+	}
+	for _, accepted := range p.AcceptVersions.Version {
+		for _, version := range supported {
+			if accepted == version {
+				return version, nil
+			}
+		}
+	}
+	return ``, VersionNegotiationFailed(strings.Join(p.AcceptVersions.Version, `,`))
+}
+
+// acceptedVersions returns the accepted versions of the request, if any
+func (p GetCapabilitiesParameters) acceptedVersions() []string {
+	if p.AcceptVersions == nil {
+		return nil
+	}
+	return p.AcceptVersions.Version
+}
+
+// ValidateSections returns an InvalidParameterValue for every requested section that isn't one of the sections
+func (p GetCapabilitiesParameters) ValidateSections(sections ...string) Exceptions {
+	if p.Sections == nil {
+		return nil
+	}
+
+	var exceptions Exceptions
+	for _, requested := range p.Sections.Section {
+		valid := requested == AllSection
+		for _, section := range sections {
+			valid = valid || requested == section
+		}
+		if !valid {
+			exceptions = append(exceptions, InvalidParameterValue(requested, SECTIONS))
+		}
+	}
+	return exceptions
+}
+
+// HasSection returns whether the section is requested, all sections are when no sections are given
+func (p GetCapabilitiesParameters) HasSection(section string) bool {
+	if p.Sections == nil || len(p.Sections.Section) == 0 {
+		return true
+	}
+	for _, requested := range p.Sections.Section {
+		if requested == AllSection || requested == section {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckUpdateSequence compares the requested update sequence with the current update sequence of the capabilities.
+// It returns whether they are equal, then only the version and update sequence of the capabilities are returned,
+// and InvalidUpdateSequence when the requested update sequence is greater than the current one.
+func (p GetCapabilitiesParameters) CheckUpdateSequence(current string) (bool, Exception) {
+	if p.UpdateSequence == `` || current == `` {
+		return false, nil
+	}
+	switch common.CompareUpdateSequence(p.UpdateSequence, current) {
+	case 0:
+		return true, nil
+	case 1:
+		return false, InvalidUpdateSequence()
+	}
+	return false, nil
+}
@@ -0,0 +1,88 @@
+package wsc200
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestGetCapabilitiesParametersParseQueryParameters(t *testing.T) {
+	var tests = []struct {
+		query      url.Values
+		result     GetCapabilitiesParameters
+		exceptions Exceptions
+	}{
+		0: {query: url.Values{`acceptversions`: {`2.0.1`}, `Sections`: {`Languages`}, `AcceptLanguages`: {`nl,en`}},
+			result: GetCapabilitiesParameters{
+				AcceptVersions:  &AcceptVersions{Version: []string{`2.0.1`}},
+				Sections:        &Sections{Section: []string{`Languages`}},
+				AcceptLanguages: &AcceptLanguages{Language: []string{`nl`, `en`}},
+			}},
+		1: {query: url.Values{`SERVICE`: {`WCS`}, `REQUEST`: {`GetCapabilities`}, `VERSION`: {`2.0.1`}, `map`: {``}}},
+		2: {query: url.Values{`ACCEPTLANGUAGES`: {`nl,`}, `map`: {``}, `ACCEPTVERSIONS`: {`,2.0.1`}},
+			exceptions: Exceptions{InvalidParameterValue(`,2.0.1`, ACCEPTVERSIONS), InvalidParameterValue(`nl,`, ACCEPTLANGUAGES)}},
+	}
+
+	for k, test := range tests {
+		var p GetCapabilitiesParameters
+		exceptions := p.ParseQueryParameters(test.query)
+		if !reflect.DeepEqual(exceptions, test.exceptions) {
+			t.Errorf("test: %d, expected: %v,\n got: %v", k, test.exceptions, exceptions)
+		} else if test.exceptions == nil && !reflect.DeepEqual(p, test.result) {
+			t.Errorf("test: %d, expected: %+v,\n got: %+v", k, test.result, p)
+		}
+	}
+}
+
+func TestGetCapabilitiesParametersParseXML(t *testing.T) {
+	doc := []byte(`<wcs:GetCapabilities xmlns:wcs="http://www.opengis.net/wcs/2.0" xmlns:ows="http://www.opengis.net/ows/2.0" service="WCS">
+  <ows:AcceptVersions><ows:Version>2.0.1</ows:Version></ows:AcceptVersions>
+  <ows:Sections><ows:Section>Languages</ows:Section></ows:Sections>
+  <ows:AcceptLanguages><ows:Language>nl</ows:Language></ows:AcceptLanguages>
+</wcs:GetCapabilities>`)
+	expected := GetCapabilitiesParameters{
+		AcceptVersions:  &AcceptVersions{Version: []string{`2.0.1`}},
+		Sections:        &Sections{Section: []string{`Languages`}},
+		AcceptLanguages: &AcceptLanguages{Language: []string{`nl`}},
+	}
+
+	var p GetCapabilitiesParameters
+	if err := p.ParseXML(doc); err != nil {
+		t.Fatalf("test: %d, expected no error,\n got: %s", 0, err)
+	}
+	if !reflect.DeepEqual(p, expected) {
+		t.Errorf("test: %d, expected: %+v,\n got: %+v", 0, expected, p)
+	}
+	if exceptions := p.ValidateSections(LanguagesSection); exceptions != nil {
+		t.Errorf("test: %d, expected no exceptions,\n got: %v", 0, exceptions)
+	}
+	if _, exception := p.NegotiateVersion(`2.0.1`); exception != nil {
+		t.Errorf("test: %d, expected no exception,\n got: %v", 0, exception)
+	}
+}
+
+func TestNegotiateVersion(t *testing.T) {
+	var tests = []struct {
+		acceptVersions []string
+		supported      []string
+		version        string
+		exception      Exception
+	}{
+		0: {supported: []string{`2.0.1`, `2.0.0`}, version: `2.0.1`},
+		1: {acceptVersions: []string{`2.0.0`}, supported: []string{`2.0.1`, `2.0.0`}, version: `2.0.0`},
+		2: {acceptVersions: []string{`1.1.0`}, supported: []string{`2.0.1`}, exception: VersionNegotiationFailed(`1.1.0`)},
+		3: {exception: VersionNegotiationFailed(``)},
+		4: {acceptVersions: []string{`2.0.1`}, exception: VersionNegotiationFailed(`2.0.1`)},
+	}
+
+	for k, test := range tests {
+		var p GetCapabilitiesParameters
+		if test.acceptVersions != nil {
+			p.AcceptVersions = &AcceptVersions{Version: test.acceptVersions}
+		}
+		version, exception := p.NegotiateVersion(test.supported...)
+		if version != test.version || !reflect.DeepEqual(exception, test.exception) {
+			t.Errorf("test: %d, expected: %s %v,\n got: %s %v", k, test.version, test.exception, version, exception)
+		}
+	}
+}
@@ -1,6 +1,13 @@
 package wsc200
 
-// Keywords in struct for repeatability
+// Keywords in struct for repeatability, the keywords can be given in more than one language
 type Keywords struct {
-	Keyword []string `xml:"ows:Keyword" yaml:"keyword"`
+	Keyword []LanguageString `xml:"ows:Keyword" yaml:"keyword"`
+	Type    *Code            `xml:"ows:Type,omitempty" yaml:"type,omitempty"`
+}
+
+// Code is a value from the dictionary or authority of the code space
+type Code struct {
+	Value     string  `xml:",chardata" yaml:"text"`
+	CodeSpace *string `xml:"codeSpace,attr,omitempty" yaml:"codeSpace,omitempty"`
 }
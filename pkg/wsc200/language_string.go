@@ -0,0 +1,61 @@
+package wsc200
+
+// LanguageString is a human readable text, the xml:lang attribute is the language of the text
+type LanguageString struct {
+	Value string  `xml:",chardata" yaml:"value"`
+	Lang  *string `xml:"xml:lang,attr,omitempty" yaml:"lang,omitempty"`
+}
+
+// UnmarshalYAML accepts a plain text as well as a value with a language
+func (s *LanguageString) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var text string
+	if err := unmarshal(&text); err == nil {
+		*s = LanguageString{Value: text}
+		return nil
+	}
+	type languageString LanguageString
+	var ls languageString
+	if err := unmarshal(&ls); err != nil {
+		return err
+	}
+	*s = LanguageString(ls)
+	return nil
+}
+
+// LanguageStrings is a multilingual text, like a Title or Abstract given once per language
+type LanguageStrings []LanguageString
+
+// Text returns the text in the language, falling back to the text without a language and then to the first text
+func (s LanguageStrings) Text(language string) string {
+	fallback := -1
+	for i, ls := range s {
+		switch {
+		case ls.Lang != nil && *ls.Lang == language:
+			return ls.Value
+		case ls.Lang == nil && fallback < 0:
+			fallback = i
+		}
+	}
+	if fallback >= 0 {
+		return s[fallback].Value
+	}
+	if len(s) > 0 {
+		return s[0].Value
+	}
+	return ``
+}
+
+// UnmarshalYAML accepts a single text as well as a list of texts
+func (s *LanguageStrings) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var single LanguageString
+	if err := unmarshal(&single); err == nil {
+		*s = LanguageStrings{single}
+		return nil
+	}
+	var list []LanguageString
+	if err := unmarshal(&list); err != nil {
+		return err
+	}
+	*s = list
+	return nil
+}
@@ -0,0 +1,52 @@
+package wsc200
+
+import (
+	"reflect"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestLanguageStringsText(t *testing.T) {
+	var tests = []struct {
+		strings  LanguageStrings
+		language string
+		expected string
+	}{
+		0: {strings: LanguageStrings{{Value: `Elevation`, Lang: sp(`en`)}, {Value: `Hoogte`, Lang: sp(`nl`)}}, language: `nl`, expected: `Hoogte`},
+		1: {strings: LanguageStrings{{Value: `Elevation`, Lang: sp(`en`)}, {Value: `Hoogte`}}, language: `de`, expected: `Hoogte`},
+		2: {strings: LanguageStrings{{Value: `Elevation`, Lang: sp(`en`)}, {Value: `Hoogte`, Lang: sp(`nl`)}}, language: `de`, expected: `Elevation`},
+		3: {strings: LanguageStrings{{Value: `Elevation`}}, language: ``, expected: `Elevation`},
+		4: {language: `en`, expected: ``},
+	}
+
+	for k, test := range tests {
+		if text := test.strings.Text(test.language); text != test.expected {
+			t.Errorf("test: %d, expected: %s,\n got: %s", k, test.expected, text)
+		}
+	}
+}
+
+func TestLanguageStringsUnmarshalYAML(t *testing.T) {
+	var tests = []struct {
+		yaml     string
+		expected LanguageStrings
+	}{
+		0: {yaml: `title: Elevation`, expected: LanguageStrings{{Value: `Elevation`}}},
+		1: {yaml: `title: {value: Hoogte, lang: nl}`, expected: LanguageStrings{{Value: `Hoogte`, Lang: sp(`nl`)}}},
+		2: {yaml: "title:\n- value: Elevation\n  lang: en\n- Hoogte", expected: LanguageStrings{{Value: `Elevation`, Lang: sp(`en`)}, {Value: `Hoogte`}}},
+	}
+
+	for k, test := range tests {
+		var s struct {
+			Title LanguageStrings `yaml:"title"`
+		}
+		if err := yaml.Unmarshal([]byte(test.yaml), &s); err != nil {
+			t.Errorf("test: %d, expected no error,\n got: %s", k, err)
+			continue
+		}
+		if !reflect.DeepEqual(s.Title, test.expected) {
+			t.Errorf("test: %d, expected: %+v,\n got: %+v", k, test.expected, s.Title)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package wsc200
+
+// OperationsMetadata contains the operations of the service and the parameters and constraints that apply to all of
+// them. The ExtendedCapabilities are specific to a service, or a profile like INSPIRE, so they are left to the services.
+type OperationsMetadata struct {
+	Operation  []Operation `xml:"ows:Operation" yaml:"operation"`
+	Parameter  []Domain    `xml:"ows:Parameter" yaml:"parameter,omitempty"`
+	Constraint []Domain    `xml:"ows:Constraint" yaml:"constraint,omitempty"`
+}
+
+// Operation is a request of the service with the endpoints it is available on
+type Operation struct {
+	Name       string     `xml:"name,attr" yaml:"name"`
+	DCP        []DCP      `xml:"ows:DCP" yaml:"dcp"`
+	Parameter  []Domain   `xml:"ows:Parameter" yaml:"parameter,omitempty"`
+	Constraint []Domain   `xml:"ows:Constraint" yaml:"constraint,omitempty"`
+	Metadata   []Metadata `xml:"ows:Metadata" yaml:"metadata,omitempty"`
+}
+
+// DCP is the distributed computing platform of an operation, only HTTP is defined
+type DCP struct {
+	HTTP HTTP `xml:"ows:HTTP" yaml:"http"`
+}
+
+// HTTP contains the GET and POST endpoints of an operation
+type HTTP struct {
+	Get  []RequestMethod `xml:"ows:Get" yaml:"get,omitempty"`
+	Post []RequestMethod `xml:"ows:Post" yaml:"post,omitempty"`
+}
+
+// RequestMethod is an endpoint of an operation, the constraints restrict the requests sent to it, like the encoding
+type RequestMethod struct {
+	OnlineResource `yaml:",inline"`
+	Constraint     []Domain `xml:"ows:Constraint" yaml:"constraint,omitempty"`
+}
+
+// Lookup returns the operation with the name
+func (m OperationsMetadata) Lookup(name string) (Operation, bool) {
+	for _, o := range m.Operation {
+		if o.Name == name {
+			return o, true
+		}
+	}
+	return Operation{}, false
+}
+
+// Get returns the GET endpoints of the operation
+func (o Operation) Get() []RequestMethod {
+	var endpoints []RequestMethod
+	for _, dcp := range o.DCP {
+		endpoints = append(endpoints, dcp.HTTP.Get...)
+	}
+	return endpoints
+}
+
+// Post returns the POST endpoints of the operation
+func (o Operation) Post() []RequestMethod {
+	var endpoints []RequestMethod
+	for _, dcp := range o.DCP {
+		endpoints = append(endpoints, dcp.HTTP.Post...)
+	}
+	return endpoints
+}
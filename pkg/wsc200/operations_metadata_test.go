@@ -0,0 +1,157 @@
+package wsc200
+
+import (
+	"encoding/xml"
+	"reflect"
+	"testing"
+
+	"github.com/pdok/ogc-specifications/pkg/utils"
+)
+
+// capabilities holds the OWS Common 2.0 sections like the capabilities of a service
+type capabilities struct {
+	XMLName               xml.Name               `xml:"ows:Capabilities"`
+	ServiceIdentification *ServiceIdentification `xml:"ows:ServiceIdentification,omitempty"`
+	ServiceProvider       *ServiceProvider       `xml:"ows:ServiceProvider,omitempty"`
+	OperationsMetadata    *OperationsMetadata    `xml:"ows:OperationsMetadata,omitempty"`
+	Languages             *Languages             `xml:"ows:Languages,omitempty"`
+}
+
+func sp(s string) *string {
+	return &s
+}
+
+func TestCapabilitiesXML(t *testing.T) {
+	doc := []byte(`<Capabilities xmlns="http://www.opengis.net/ows/2.0" xmlns:o="http://www.opengis.net/ows/2.0" xmlns:xl="http://www.w3.org/1999/xlink">
+  <ServiceIdentification>
+    <Title xml:lang="en">Elevation</Title>
+    <Title xml:lang="nl">Hoogte</Title>
+    <Abstract>Elevation model of the Netherlands</Abstract>
+    <Keywords><Keyword xml:lang="en">elevation</Keyword><Keyword xml:lang="nl">hoogte</Keyword><Type codeSpace="ISO">theme</Type></Keywords>
+    <ServiceType codeSpace="OGC">OGC WCS</ServiceType>
+    <ServiceTypeVersion>2.0.1</ServiceTypeVersion>
+    <Profile>http://www.opengis.net/spec/WCS/2.0/conf/core</Profile>
+    <Fees>NONE</Fees>
+    <AccessConstraints>NONE</AccessConstraints>
+  </ServiceIdentification>
+  <ServiceProvider>
+    <ProviderName>PDOK</ProviderName>
+    <ProviderSite xl:type="simple" xl:href="https://www.pdok.nl"/>
+    <ServiceContact>
+      <IndividualName>KlantContactCenter PDOK</IndividualName>
+      <ContactInfo>
+        <Phone><Voice>+31 88 183 2200</Voice></Phone>
+        <Address><City>Apeldoorn</City><Country>Netherlands</Country><ElectronicMailAddress>info@pdok.nl</ElectronicMailAddress></Address>
+      </ContactInfo>
+      <Role codeSpace="ISOTC211/19115">pointOfContact</Role>
+    </ServiceContact>
+  </ServiceProvider>
+  <OperationsMetadata>
+    <Operation name="GetCoverage">
+      <DCP><HTTP>
+        <Get xl:href="https://example.com/wcs?"/>
+        <Post xl:href="https://example.com/wcs">
+          <Constraint name="PostEncoding"><AllowedValues><Value>XML</Value></AllowedValues></Constraint>
+        </Post>
+      </HTTP></DCP>
+      <Parameter name="format"><AllowedValues><Value>image/tiff</Value></AllowedValues><DefaultValue>image/tiff</DefaultValue></Parameter>
+      <Metadata xl:href="https://example.com/getcoverage.html" xl:title="GetCoverage"/>
+    </Operation>
+    <Parameter name="scaleFactor">
+      <AllowedValues><Range o:rangeClosure="open-closed"><MinimumValue>0</MinimumValue><MaximumValue>1</MaximumValue></Range></AllowedValues>
+      <DataType o:reference="http://www.w3.org/2001/XMLSchema#double">xs:double</DataType>
+    </Parameter>
+    <Constraint name="CountDefault"><NoValues/><DefaultValue>1000</DefaultValue></Constraint>
+  </OperationsMetadata>
+  <Languages><Language>en</Language><Language>nl</Language></Languages>
+</Capabilities>`)
+
+	expected := capabilities{
+		XMLName: xml.Name{Local: `ows:Capabilities`},
+		ServiceIdentification: &ServiceIdentification{
+			Title:              LanguageStrings{{Value: `Elevation`, Lang: sp(`en`)}, {Value: `Hoogte`, Lang: sp(`nl`)}},
+			Abstract:           LanguageStrings{{Value: `Elevation model of the Netherlands`}},
+			Keywords:           []Keywords{{Keyword: []LanguageString{{Value: `elevation`, Lang: sp(`en`)}, {Value: `hoogte`, Lang: sp(`nl`)}}, Type: &Code{Value: `theme`, CodeSpace: sp(`ISO`)}}},
+			ServiceType:        Code{Value: `OGC WCS`, CodeSpace: sp(`OGC`)},
+			ServiceTypeVersion: []string{`2.0.1`},
+			Profile:            []string{`http://www.opengis.net/spec/WCS/2.0/conf/core`},
+			Fees:               sp(`NONE`),
+			AccessConstraints:  []string{`NONE`},
+		},
+		ServiceProvider: &ServiceProvider{
+			ProviderName: `PDOK`,
+			ProviderSite: &OnlineResource{Type: sp(`simple`), Href: `https://www.pdok.nl`},
+			ServiceContact: ResponsibleParty{
+				IndividualName: `KlantContactCenter PDOK`,
+				ContactInfo: &ContactInfo{
+					Phone:   &Phone{Voice: []string{`+31 88 183 2200`}},
+					Address: &Address{City: `Apeldoorn`, Country: `Netherlands`, ElectronicMailAddress: []string{`info@pdok.nl`}},
+				},
+				Role: &Code{Value: `pointOfContact`, CodeSpace: sp(`ISOTC211/19115`)},
+			},
+		},
+		OperationsMetadata: &OperationsMetadata{
+			Operation: []Operation{{
+				Name: `GetCoverage`,
+				DCP: []DCP{{HTTP: HTTP{
+					Get: []RequestMethod{{OnlineResource: OnlineResource{Href: `https://example.com/wcs?`}}},
+					Post: []RequestMethod{{OnlineResource: OnlineResource{Href: `https://example.com/wcs`},
+						Constraint: []Domain{{Name: `PostEncoding`, AllowedValues: &AllowedValues{Value: []string{`XML`}}}}}},
+				}}},
+				Parameter: []Domain{{Name: `format`, AllowedValues: &AllowedValues{Value: []string{`image/tiff`}}, DefaultValue: sp(`image/tiff`)}},
+				Metadata:  []Metadata{{Href: sp(`https://example.com/getcoverage.html`), Title: sp(`GetCoverage`)}},
+			}},
+			Parameter: []Domain{{
+				Name:          `scaleFactor`,
+				AllowedValues: &AllowedValues{Range: []Range{{Closure: sp(OpenClosed), MinimumValue: sp(`0`), MaximumValue: sp(`1`)}}},
+				DataType:      &DomainMetadata{Reference: sp(`http://www.w3.org/2001/XMLSchema#double`), Value: `xs:double`},
+			}},
+			Constraint: []Domain{{Name: `CountDefault`, NoValues: &NoValues{}, DefaultValue: sp(`1000`)}},
+		},
+		Languages: &Languages{Language: []string{`en`, `nl`}},
+	}
+
+	var c capabilities
+	if err := utils.UnmarshalPrefixed(doc, &c, Prefixes); err != nil {
+		t.Fatalf("test: %d, expected no error,\n got: %s", 0, err)
+	}
+	if !reflect.DeepEqual(c, expected) {
+		t.Errorf("test: %d, expected: %+v,\n got: %+v", 0, expected, c)
+	}
+
+	// marshalled back it is parsed to the same capabilities
+	b, err := utils.NewEncoder(Prefixes).Marshal(c)
+	if err != nil {
+		t.Fatalf("test: %d, expected no error,\n got: %s", 1, err)
+	}
+	var roundtrip capabilities
+	if err := utils.UnmarshalPrefixed(b, &roundtrip, Prefixes); err != nil || !reflect.DeepEqual(roundtrip, expected) {
+		t.Errorf("test: %d, expected: %+v,\n got: %+v %v\n%s", 1, expected, roundtrip, err, b)
+	}
+}
+
+func TestOperationsMetadataLookup(t *testing.T) {
+	m := OperationsMetadata{Operation: []Operation{
+		{Name: `GetCapabilities`, DCP: []DCP{{HTTP: HTTP{Get: []RequestMethod{{OnlineResource: OnlineResource{Href: `https://example.com/a`}}}}},
+			{HTTP: HTTP{Get: []RequestMethod{{OnlineResource: OnlineResource{Href: `https://example.com/b`}}}}}}},
+		{Name: `GetCoverage`, DCP: []DCP{{HTTP: HTTP{Post: []RequestMethod{{OnlineResource: OnlineResource{Href: `https://example.com/c`}}}}}}},
+	}}
+
+	var tests = []struct {
+		name  string
+		found bool
+		get   int
+		post  int
+	}{
+		0: {name: `GetCapabilities`, found: true, get: 2},
+		1: {name: `GetCoverage`, found: true, post: 1},
+		2: {name: `DescribeCoverage`},
+	}
+
+	for k, test := range tests {
+		o, found := m.Lookup(test.name)
+		if found != test.found || len(o.Get()) != test.get || len(o.Post()) != test.post {
+			t.Errorf("test: %d, expected: %t %d %d,\n got: %t %d %d", k, test.found, test.get, test.post, found, len(o.Get()), len(o.Post()))
+		}
+	}
+}
@@ -0,0 +1,13 @@
+package wsc200
+
+// ServiceIdentification contains the metadata about the service itself
+type ServiceIdentification struct {
+	Title              LanguageStrings `xml:"ows:Title" yaml:"title"`
+	Abstract           LanguageStrings `xml:"ows:Abstract" yaml:"abstract,omitempty"`
+	Keywords           []Keywords      `xml:"ows:Keywords" yaml:"keywords,omitempty"`
+	ServiceType        Code            `xml:"ows:ServiceType" yaml:"serviceType"`
+	ServiceTypeVersion []string        `xml:"ows:ServiceTypeVersion" yaml:"serviceTypeVersion"`
+	Profile            []string        `xml:"ows:Profile" yaml:"profile,omitempty"`
+	Fees               *string         `xml:"ows:Fees,omitempty" yaml:"fees,omitempty"`
+	AccessConstraints  []string        `xml:"ows:AccessConstraints" yaml:"accessConstraints,omitempty"`
+}
@@ -0,0 +1,47 @@
+package wsc200
+
+// ServiceProvider contains the metadata about the organisation operating the service
+type ServiceProvider struct {
+	ProviderName   string           `xml:"ows:ProviderName" yaml:"providerName"`
+	ProviderSite   *OnlineResource  `xml:"ows:ProviderSite,omitempty" yaml:"providerSite,omitempty"`
+	ServiceContact ResponsibleParty `xml:"ows:ServiceContact" yaml:"serviceContact"`
+}
+
+// ResponsibleParty is the person to contact for the service
+type ResponsibleParty struct {
+	IndividualName string       `xml:"ows:IndividualName,omitempty" yaml:"individualName,omitempty"`
+	PositionName   string       `xml:"ows:PositionName,omitempty" yaml:"positionName,omitempty"`
+	ContactInfo    *ContactInfo `xml:"ows:ContactInfo,omitempty" yaml:"contactInfo,omitempty"`
+	Role           *Code        `xml:"ows:Role,omitempty" yaml:"role,omitempty"`
+}
+
+// ContactInfo contains the address and the other ways to contact the responsible party
+type ContactInfo struct {
+	Phone               *Phone          `xml:"ows:Phone,omitempty" yaml:"phone,omitempty"`
+	Address             *Address        `xml:"ows:Address,omitempty" yaml:"address,omitempty"`
+	OnlineResource      *OnlineResource `xml:"ows:OnlineResource,omitempty" yaml:"onlineResource,omitempty"`
+	HoursOfService      string          `xml:"ows:HoursOfService,omitempty" yaml:"hoursOfService,omitempty"`
+	ContactInstructions string          `xml:"ows:ContactInstructions,omitempty" yaml:"contactInstructions,omitempty"`
+}
+
+// Phone contains the telephone and fax numbers
+type Phone struct {
+	Voice     []string `xml:"ows:Voice" yaml:"voice,omitempty"`
+	Facsimile []string `xml:"ows:Facsimile" yaml:"facsimile,omitempty"`
+}
+
+// Address is the postal and email address
+type Address struct {
+	DeliveryPoint         []string `xml:"ows:DeliveryPoint" yaml:"deliveryPoint,omitempty"`
+	City                  string   `xml:"ows:City,omitempty" yaml:"city,omitempty"`
+	AdministrativeArea    string   `xml:"ows:AdministrativeArea,omitempty" yaml:"administrativeArea,omitempty"`
+	PostalCode            string   `xml:"ows:PostalCode,omitempty" yaml:"postalCode,omitempty"`
+	Country               string   `xml:"ows:Country,omitempty" yaml:"country,omitempty"`
+	ElectronicMailAddress []string `xml:"ows:ElectronicMailAddress" yaml:"electronicMailAddress,omitempty"`
+}
+
+// OnlineResource is a link to a web accessible resource, like the website of the provider
+type OnlineResource struct {
+	Type *string `xml:"xlink:type,attr,omitempty" yaml:"type,omitempty"`
+	Href string  `xml:"xlink:href,attr" yaml:"href"`
+}